@@ -0,0 +1,54 @@
+package opts
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseInodeQuota parses inode quota configurations of container,
+// the format is the same with disk quota, such as "/=100000" or "/=80000:100000".
+func ParseInodeQuota(quotas []string) (map[string]string, error) {
+	quotaMaps, err := ParseDiskQuota(quotas)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range quotaMaps {
+		if _, _, err := ParseInodeLimit(v); err != nil {
+			return nil, err
+		}
+	}
+
+	return quotaMaps, nil
+}
+
+// ParseInodeLimit parses the inode limit in format of "hard" or "soft:hard",
+// it returns the soft limit and hard limit.
+func ParseInodeLimit(limit string) (uint64, uint64, error) {
+	var (
+		soft, hard uint64
+		err        error
+	)
+
+	parts := strings.Split(limit, ":")
+	switch len(parts) {
+	case 1:
+		hard, err = strconv.ParseUint(parts[0], 10, 64)
+	case 2:
+		if soft, err = strconv.ParseUint(parts[0], 10, 64); err == nil {
+			hard, err = strconv.ParseUint(parts[1], 10, 64)
+		}
+	default:
+		return 0, 0, fmt.Errorf("invalid format for inode quota: %s", limit)
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid inode quota %s: %v", limit, err)
+	}
+
+	if hard != 0 && soft > hard {
+		return 0, 0, fmt.Errorf("invalid inode quota %s: soft limit is larger than hard limit", limit)
+	}
+
+	return soft, hard, nil
+}
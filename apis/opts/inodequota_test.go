@@ -0,0 +1,61 @@
+package opts
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseInodeQuota(t *testing.T) {
+	tests := []struct {
+		name    string
+		quotas  []string
+		want    map[string]string
+		wantErr bool
+	}{
+		{name: "empty", quotas: []string{""}, want: nil, wantErr: true},
+		{name: "hard", quotas: []string{"/=100000"}, want: map[string]string{"/": "100000"}, wantErr: false},
+		{name: "all", quotas: []string{"100000"}, want: map[string]string{".*": "100000"}, wantErr: false},
+		{name: "soft and hard", quotas: []string{"/=80000:100000", "/data=10"}, want: map[string]string{"/": "80000:100000", "/data": "10"}, wantErr: false},
+		{name: "invalid number", quotas: []string{"/=10k"}, want: nil, wantErr: true},
+		{name: "soft larger than hard", quotas: []string{"/=100:10"}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseInodeQuota(tt.quotas)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseInodeQuota() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseInodeQuota() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInodeLimit(t *testing.T) {
+	tests := []struct {
+		limit    string
+		soft     uint64
+		hard     uint64
+		hasError bool
+	}{
+		{limit: "100", soft: 0, hard: 100},
+		{limit: "80:100", soft: 80, hard: 100},
+		{limit: "80:0", soft: 80, hard: 0},
+		{limit: "", hasError: true},
+		{limit: "1:2:3", hasError: true},
+		{limit: "-1", hasError: true},
+		{limit: "100:80", hasError: true},
+	}
+	for _, tt := range tests {
+		soft, hard, err := ParseInodeLimit(tt.limit)
+		if (err != nil) != tt.hasError {
+			t.Errorf("ParseInodeLimit(%s) error = %v, hasError %v", tt.limit, err, tt.hasError)
+			continue
+		}
+		if soft != tt.soft || hard != tt.hard {
+			t.Errorf("ParseInodeLimit(%s) = %d:%d, want %d:%d", tt.limit, soft, hard, tt.soft, tt.hard)
+		}
+	}
+}
@@ -452,6 +452,34 @@ func (s *Server) upgradeContainer(ctx context.Context, rw http.ResponseWriter, r
 	return nil
 }
 
+func (s *Server) rollbackContainer(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	label := util_metrics.ActionRollbackLabel
+	defer func(start time.Time) {
+		metrics.ContainerActionsCounter.WithLabelValues(label).Inc()
+		metrics.ContainerActionsTimer.WithLabelValues(label).Observe(time.Since(start).Seconds())
+	}(time.Now())
+
+	name := mux.Vars(req)["name"]
+
+	if err := s.ContainerMgr.Rollback(ctx, name); err != nil {
+		return err
+	}
+
+	metrics.ContainerSuccessActionsCounter.WithLabelValues(label).Inc()
+
+	rw.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *Server) remountLxcfs(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	results, err := s.ContainerMgr.RemountLxcfs(ctx)
+	if err != nil {
+		return err
+	}
+
+	return EncodeResponse(rw, http.StatusOK, results)
+}
+
 func (s *Server) topContainer(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	name := mux.Vars(req)["name"]
 
@@ -624,12 +652,53 @@ func (s *Server) deleteContainerCheckpoint(ctx context.Context, rw http.Response
 	return nil
 }
 
+func (s *Server) exportContainerCheckpoint(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	options := &types.CheckpointExportOptions{
+		CheckpointID:  mux.Vars(req)["id"],
+		CheckpointDir: req.FormValue("dir"),
+		Rootfs:        httputils.BoolValue(req, "rootfs"),
+	}
+
+	// ensure CheckpointID should not be empty
+	if options.CheckpointID == "" {
+		return httputils.NewHTTPError(fmt.Errorf("checkpoint id should not be empty"), http.StatusBadRequest)
+	}
+
+	rw.Header().Set("Content-Type", "application/x-tar")
+	return s.ContainerMgr.ExportCheckpoint(ctx, name, options, newWriteFlusher(rw))
+}
+
+func (s *Server) importContainerCheckpoint(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	options := &types.CheckpointImportOptions{
+		CheckpointID:  req.FormValue("checkpoint"),
+		CheckpointDir: req.FormValue("dir"),
+	}
+
+	checkpoint, err := s.ContainerMgr.ImportCheckpoint(ctx, name, options, req.Body)
+	if err != nil {
+		return err
+	}
+
+	return EncodeResponse(rw, http.StatusCreated, checkpoint)
+}
+
 func (s *Server) commitContainer(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	options := &types.ContainerCommitOptions{
 		Repository: req.FormValue("repo"),
 		Tag:        req.FormValue("tag"),
 		Author:     req.FormValue("author"),
 		Comment:    req.FormValue("comment"),
+		Squash:     httputils.BoolValue(req, "squash"),
+	}
+	// NOTE: the form has been parsed by FormValue.
+	options.Changes = req.Form["changes"]
+	if req.FormValue("pause") != "" {
+		pause := httputils.BoolValue(req, "pause")
+		options.Pause = &pause
 	}
 
 	id, err := s.ContainerMgr.Commit(ctx, req.FormValue("container"), options)
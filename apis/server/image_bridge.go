@@ -154,7 +154,8 @@ func (s *Server) removeImage(ctx context.Context, rw http.ResponseWriter, req *h
 		containers, err := s.ContainerMgr.List(ctx, &mgr.ContainerListOption{
 			All: true,
 			FilterFunc: func(c *mgr.Container) bool {
-				return c.Image == image.ID
+				// the image of revision kept by upgrade is in use too.
+				return c.Image == image.ID || (c.Revision != nil && c.Revision.Image == image.ID)
 			}})
 		if err != nil {
 			return err
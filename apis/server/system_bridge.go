@@ -9,6 +9,7 @@ import (
 
 	"github.com/alibaba/pouch/apis/filters"
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/mgr"
 	"github.com/alibaba/pouch/pkg/httputils"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/utils"
@@ -56,6 +57,16 @@ func (s *Server) updateDaemon(ctx context.Context, rw http.ResponseWriter, req *
 	return s.SystemMgr.UpdateDaemon(cfg)
 }
 
+func (s *Server) reconcileSystem(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
+	remove := httputils.BoolValue(req, "remove")
+
+	result, err := s.ContainerMgr.ReconcileSnapshots(ctx, remove, time.Now().Add(-mgr.OrphanSnapshotGracePeriod))
+	if err != nil {
+		return err
+	}
+	return EncodeResponse(rw, http.StatusOK, result)
+}
+
 func (s *Server) auth(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
 	auth := types.AuthConfig{}
 
@@ -22,6 +22,12 @@ type CheckpointCreateOptions struct {
 
 	// exit
 	Exit bool `json:"Exit,omitempty"`
+
+	// the checkpoint id of previous pre-dump, only the memory changed since then is dumped
+	ParentCheckpoint string `json:"ParentCheckpoint,omitempty"`
+
+	// dump the memory of container only and keep it running, the pre-dump is used as parent of the next checkpoint to shrink its freeze time
+	PreDump bool `json:"PreDump,omitempty"`
 }
 
 // Validate validates this checkpoint create options
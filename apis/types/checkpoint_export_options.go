@@ -0,0 +1,48 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// CheckpointExportOptions options of exporting a checkpoint of a container
+// swagger:model CheckpointExportOptions
+type CheckpointExportOptions struct {
+
+	// checkpoint dir
+	CheckpointDir string `json:"CheckpointDir,omitempty"`
+
+	// checkpoint ID
+	CheckpointID string `json:"CheckpointID,omitempty"`
+
+	// export the writable layer of the container
+	Rootfs bool `json:"Rootfs,omitempty"`
+}
+
+// Validate validates this checkpoint export options
+func (m *CheckpointExportOptions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CheckpointExportOptions) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CheckpointExportOptions) UnmarshalBinary(b []byte) error {
+	var res CheckpointExportOptions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
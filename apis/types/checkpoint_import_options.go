@@ -0,0 +1,45 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// CheckpointImportOptions options of importing a checkpoint to a container
+// swagger:model CheckpointImportOptions
+type CheckpointImportOptions struct {
+
+	// checkpoint dir
+	CheckpointDir string `json:"CheckpointDir,omitempty"`
+
+	// checkpoint id to import as, defaults to the checkpoint id in the bundle
+	CheckpointID string `json:"CheckpointID,omitempty"`
+}
+
+// Validate validates this checkpoint import options
+func (m *CheckpointImportOptions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CheckpointImportOptions) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CheckpointImportOptions) UnmarshalBinary(b []byte) error {
+	var res CheckpointImportOptions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -17,12 +17,21 @@ type ContainerCommitOptions struct {
 	// author is the one build the image
 	Author string `json:"Author,omitempty"`
 
+	// Dockerfile instructions to apply to the image config, supports ENV, CMD, ENTRYPOINT, EXPOSE, LABEL, USER and WORKDIR
+	Changes []string `json:"Changes"`
+
 	// comment is external information add for the image
 	Comment string `json:"Comment,omitempty"`
 
+	// pause the running container during commit, defaults to true
+	Pause *bool `json:"Pause,omitempty"`
+
 	// repository is the image name
 	Repository string `json:"Repository,omitempty"`
 
+	// squash the layers of image and container into one layer
+	Squash bool `json:"Squash,omitempty"`
+
 	// tag is the image tag
 	Tag string `json:"Tag,omitempty"`
 }
@@ -36,6 +36,12 @@ type ContainerConfig struct {
 	// Whether to generate the network files(/etc/hostname, /etc/hosts and /etc/resolv.conf) for container.
 	DisableNetworkFiles bool `json:"DisableNetworkFiles,omitempty"`
 
+	// Set the disk profile for container.
+	// The profile is one of the disk profiles configured in daemon, it bundles
+	// the rootfs disk quota and the blkio throttles of the underlying device.
+	//
+	DiskProfile string `json:"DiskProfile,omitempty"`
+
 	// Set disk quota for container.
 	// Key is the dir in container.
 	// Value is disk quota size for the dir.
@@ -73,6 +79,12 @@ type ContainerConfig struct {
 	// Initial script executed in container. The script will be executed before entrypoint or command
 	InitScript string `json:"InitScript,omitempty"`
 
+	// Set inode quota for container.
+	// Key is the dir in container, the same with DiskQuota.
+	// Value is the inode limit for the dir, in format of `hard` or `soft:hard`.
+	//
+	InodeQuota map[string]string `json:"InodeQuota,omitempty"`
+
 	// User-defined key/value metadata.
 	Labels map[string]string `json:"Labels,omitempty"`
 
@@ -20,6 +20,11 @@ import (
 // swagger:model ContainerUpgradeConfig
 type ContainerUpgradeConfig struct {
 
+	// A list of volume bindings to add to the container in the form `["host-src:container-dest:options", ...]`,
+	// the existing binding with the same destination is replaced.
+	//
+	Binds []string `json:"Binds"`
+
 	// Execution commands and args
 	Cmd []string `json:"Cmd"`
 
@@ -28,9 +33,36 @@ type ContainerUpgradeConfig struct {
 	//
 	Entrypoint []string `json:"Entrypoint"`
 
+	// A list of environment variables to merge into the container in the form `["VAR=value", ...]`.
+	// A variable like "A=" means updating env A in container to be empty value.
+	// A variable without `=` is removed from the environment, rather than to have an empty value.
+	//
+	Env []string `json:"Env"`
+
 	// image
 	// Required: true
 	Image string `json:"Image"`
+
+	// Labels to merge into the container, the label with empty value is removed.
+	Labels map[string]string `json:"Labels,omitempty"`
+
+	// Only carry over the files changed under the paths in the writable layer of the old container,
+	// it implies `PreserveRootfs`.
+	//
+	PreservePaths []string `json:"PreservePaths"`
+
+	// Carry over the files changed in the writable layer of the old container to the new container.
+	//
+	PreserveRootfs bool `json:"PreserveRootfs,omitempty"`
+
+	// The probe to check the new container after upgrade, the container is rolled back to the old
+	// image and writable layer if the probe fails. It only works for running container, the upgrade
+	// is rejected if the probe is specified for the container which is not running.
+	//
+	ReadinessProbe *UpgradeProbe `json:"ReadinessProbe,omitempty"`
+
+	// Resources to update, only the non-zero fields are updated.
+	Resources *Resources `json:"Resources,omitempty"`
 }
 
 // Validate validates this container upgrade config
@@ -41,6 +73,14 @@ func (m *ContainerUpgradeConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateReadinessProbe(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResources(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -56,6 +96,42 @@ func (m *ContainerUpgradeConfig) validateImage(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ContainerUpgradeConfig) validateReadinessProbe(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ReadinessProbe) { // not required
+		return nil
+	}
+
+	if m.ReadinessProbe != nil {
+		if err := m.ReadinessProbe.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("ReadinessProbe")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ContainerUpgradeConfig) validateResources(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Resources) { // not required
+		return nil
+	}
+
+	if m.Resources != nil {
+		if err := m.Resources.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Resources")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ContainerUpgradeConfig) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -73,6 +73,9 @@ type HostConfig struct {
 	// A list of additional groups that the container process will run as.
 	GroupAdd []string `json:"GroupAdd"`
 
+	// Run an init inside the container that forwards signals and reaps processes.
+	Init bool `json:"Init,omitempty"`
+
 	// Initial script executed in container. The script will be executed before entrypoint or command
 	InitScript string `json:"InitScript,omitempty"`
 
@@ -213,6 +216,8 @@ func (m *HostConfig) UnmarshalJSON(raw []byte) error {
 
 		GroupAdd []string `json:"GroupAdd"`
 
+		Init bool `json:"Init,omitempty"`
+
 		InitScript string `json:"InitScript,omitempty"`
 
 		IpcMode string `json:"IpcMode,omitempty"`
@@ -301,6 +306,8 @@ func (m *HostConfig) UnmarshalJSON(raw []byte) error {
 
 	m.GroupAdd = dataAO0.GroupAdd
 
+	m.Init = dataAO0.Init
+
 	m.InitScript = dataAO0.InitScript
 
 	m.IpcMode = dataAO0.IpcMode
@@ -400,6 +407,8 @@ func (m HostConfig) MarshalJSON() ([]byte, error) {
 
 		GroupAdd []string `json:"GroupAdd"`
 
+		Init bool `json:"Init,omitempty"`
+
 		InitScript string `json:"InitScript,omitempty"`
 
 		IpcMode string `json:"IpcMode,omitempty"`
@@ -485,6 +494,8 @@ func (m HostConfig) MarshalJSON() ([]byte, error) {
 
 	dataAO0.GroupAdd = m.GroupAdd
 
+	dataAO0.Init = m.Init
+
 	dataAO0.InitScript = m.InitScript
 
 	dataAO0.IpcMode = m.IpcMode
@@ -0,0 +1,47 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// HTTPGetAction HTTPGetAction describes the HTTP GET request sent to the container IP, the status code in [200, 400)
+// means success.
+//
+// swagger:model HTTPGetAction
+type HTTPGetAction struct {
+
+	// The path to request.
+	Path string `json:"Path,omitempty"`
+
+	// The port of the container IP.
+	Port int64 `json:"Port,omitempty"`
+}
+
+// Validate validates this HTTP get action
+func (m *HTTPGetAction) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HTTPGetAction) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HTTPGetAction) UnmarshalBinary(b []byte) error {
+	var res HTTPGetAction
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,66 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// OrphanSnapshot OrphanSnapshot is a snapshot which belongs to no container and no image.
+// swagger:model OrphanSnapshot
+type OrphanSnapshot struct {
+
+	// The time when the snapshot is created
+	Created string `json:"Created,omitempty"`
+
+	// Error occurred when removing the snapshot
+	Error string `json:"Error,omitempty"`
+
+	// Number of inodes used by the snapshot
+	Inodes int64 `json:"Inodes,omitempty"`
+
+	// Key of the snapshot
+	Key string `json:"Key,omitempty"`
+
+	// Kind of the snapshot, active, committed or view
+	Kind string `json:"Kind,omitempty"`
+
+	// Parent of the snapshot
+	Parent string `json:"Parent,omitempty"`
+
+	// Whether the snapshot has been removed
+	Removed bool `json:"Removed,omitempty"`
+
+	// Disk usage of the snapshot in bytes, excluding its parents
+	Size int64 `json:"Size,omitempty"`
+
+	// Snapshotter which the snapshot belongs to
+	Snapshotter string `json:"Snapshotter,omitempty"`
+}
+
+// Validate validates this orphan snapshot
+func (m *OrphanSnapshot) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OrphanSnapshot) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OrphanSnapshot) UnmarshalBinary(b []byte) error {
+	var res OrphanSnapshot
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,51 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// RemountLxcfsResult The result of remounting lxcfs in a container
+// swagger:model RemountLxcfsResult
+type RemountLxcfsResult struct {
+
+	// Error occurred when remounting lxcfs
+	Error string `json:"Error,omitempty"`
+
+	// ID of the container
+	ID string `json:"ID,omitempty"`
+
+	// Name of the container
+	Name string `json:"Name,omitempty"`
+
+	// Status of remounting, OK or Failed
+	Status string `json:"Status,omitempty"`
+}
+
+// Validate validates this remount lxcfs result
+func (m *RemountLxcfsResult) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RemountLxcfsResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RemountLxcfsResult) UnmarshalBinary(b []byte) error {
+	var res RemountLxcfsResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,82 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// SystemReconcileResult Result of reconciling orphaned snapshots
+// swagger:model SystemReconcileResult
+type SystemReconcileResult struct {
+
+	// List of orphaned snapshots
+	OrphanSnapshots []*OrphanSnapshot `json:"OrphanSnapshots"`
+
+	// Disk space reclaimed in bytes
+	SpaceReclaimed int64 `json:"SpaceReclaimed,omitempty"`
+}
+
+// Validate validates this system reconcile result
+func (m *SystemReconcileResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateOrphanSnapshots(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SystemReconcileResult) validateOrphanSnapshots(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.OrphanSnapshots) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.OrphanSnapshots); i++ {
+		if swag.IsZero(m.OrphanSnapshots[i]) { // not required
+			continue
+		}
+
+		if m.OrphanSnapshots[i] != nil {
+			if err := m.OrphanSnapshots[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("OrphanSnapshots" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SystemReconcileResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SystemReconcileResult) UnmarshalBinary(b []byte) error {
+	var res SystemReconcileResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
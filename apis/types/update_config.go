@@ -16,6 +16,9 @@ import (
 type UpdateConfig struct {
 	Resources
 
+	// update disk profile for container
+	DiskProfile string `json:"DiskProfile,omitempty"`
+
 	// update disk quota for container
 	DiskQuota map[string]string `json:"DiskQuota,omitempty"`
 
@@ -25,6 +28,9 @@ type UpdateConfig struct {
 	//
 	Env []string `json:"Env"`
 
+	// update inode quota for container
+	InodeQuota map[string]string `json:"InodeQuota,omitempty"`
+
 	// List of labels set to container.
 	Label []string `json:"Label"`
 
@@ -46,10 +52,14 @@ func (m *UpdateConfig) UnmarshalJSON(raw []byte) error {
 
 	// AO1
 	var dataAO1 struct {
+		DiskProfile string `json:"DiskProfile,omitempty"`
+
 		DiskQuota map[string]string `json:"DiskQuota,omitempty"`
 
 		Env []string `json:"Env"`
 
+		InodeQuota map[string]string `json:"InodeQuota,omitempty"`
+
 		Label []string `json:"Label"`
 
 		RestartPolicy *RestartPolicy `json:"RestartPolicy,omitempty"`
@@ -60,10 +70,14 @@ func (m *UpdateConfig) UnmarshalJSON(raw []byte) error {
 		return err
 	}
 
+	m.DiskProfile = dataAO1.DiskProfile
+
 	m.DiskQuota = dataAO1.DiskQuota
 
 	m.Env = dataAO1.Env
 
+	m.InodeQuota = dataAO1.InodeQuota
+
 	m.Label = dataAO1.Label
 
 	m.RestartPolicy = dataAO1.RestartPolicy
@@ -84,10 +98,14 @@ func (m UpdateConfig) MarshalJSON() ([]byte, error) {
 	_parts = append(_parts, aO0)
 
 	var dataAO1 struct {
+		DiskProfile string `json:"DiskProfile,omitempty"`
+
 		DiskQuota map[string]string `json:"DiskQuota,omitempty"`
 
 		Env []string `json:"Env"`
 
+		InodeQuota map[string]string `json:"InodeQuota,omitempty"`
+
 		Label []string `json:"Label"`
 
 		RestartPolicy *RestartPolicy `json:"RestartPolicy,omitempty"`
@@ -95,10 +113,14 @@ func (m UpdateConfig) MarshalJSON() ([]byte, error) {
 		SpecAnnotation map[string]string `json:"SpecAnnotation,omitempty"`
 	}
 
+	dataAO1.DiskProfile = m.DiskProfile
+
 	dataAO1.DiskQuota = m.DiskQuota
 
 	dataAO1.Env = m.Env
 
+	dataAO1.InodeQuota = m.InodeQuota
+
 	dataAO1.Label = m.Label
 
 	dataAO1.RestartPolicy = m.RestartPolicy
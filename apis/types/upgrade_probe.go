@@ -0,0 +1,87 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// UpgradeProbe UpgradeProbe describes the readiness check of container after upgrade. Only one of `Exec`, `TCPPort`
+// and `HTTPGet` should be specified.
+//
+// swagger:model UpgradeProbe
+type UpgradeProbe struct {
+
+	// The command to run in the container, exit code 0 means the container is ready.
+	Exec []string `json:"Exec"`
+
+	// HTTP get
+	HTTPGet *HTTPGetAction `json:"HTTPGet,omitempty"`
+
+	// The time to wait between probes in seconds, defaults to 1.
+	Interval int64 `json:"Interval,omitempty"`
+
+	// The number of probes before the container is considered not ready, defaults to 3.
+	Retries int64 `json:"Retries,omitempty"`
+
+	// The port of the container IP to connect, the container is ready if the connection is established.
+	TCPPort int64 `json:"TCPPort,omitempty"`
+
+	// The timeout of each probe in seconds, defaults to 1.
+	Timeout int64 `json:"Timeout,omitempty"`
+}
+
+// Validate validates this upgrade probe
+func (m *UpgradeProbe) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHTTPGet(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *UpgradeProbe) validateHTTPGet(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HTTPGet) { // not required
+		return nil
+	}
+
+	if m.HTTPGet != nil {
+		if err := m.HTTPGet.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("HTTPGet")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *UpgradeProbe) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *UpgradeProbe) UnmarshalBinary(b []byte) error {
+	var res UpgradeProbe
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
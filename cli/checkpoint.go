@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/alibaba/pouch/apis/types"
@@ -11,7 +12,7 @@ import (
 )
 
 // checkpointDescription is used to describe checkpoint command in detail and auto generate command doc.
-var checkpointDescription = "\nManage checkpoint commands, create checkpoint."
+var checkpointDescription = "\nManage checkpoint commands, create, list, delete, export and import checkpoint."
 
 // CheckpointCommand use to implement 'checkpoint' command, it checkpoint a container.
 type CheckpointCommand struct {
@@ -32,12 +33,16 @@ func (cp *CheckpointCommand) Init(c *Cli) {
 	c.AddCommand(cp, &CheckpointCreateCommand{})
 	c.AddCommand(cp, &CheckpointListCommand{})
 	c.AddCommand(cp, &CheckpointDelCommand{})
+	c.AddCommand(cp, &CheckpointExportCommand{})
+	c.AddCommand(cp, &CheckpointImportCommand{})
 }
 
 // checkpoint subcommands
 
 // checkpointCreateDescription is used to describe checkpoint create command in detail and auto generate command doc.
-var checkpointCreateDescription = "Create a checkpoint from a running container instance keep the state for restore later."
+var checkpointCreateDescription = "Create a checkpoint from a running container instance keep the state for restore later. " +
+	"The memory of a large container can be pre-dumped for several times with --pre-dump while the container keeps running, " +
+	"each pre-dump or the final checkpoint specifies the previous one by --parent, so only the memory changed since then is dumped."
 
 // CheckpointCreateCommand use to implement 'checkpoint create' command, it create a container checkpoint.
 type CheckpointCreateCommand struct {
@@ -45,6 +50,8 @@ type CheckpointCreateCommand struct {
 
 	leaveRunning bool
 	cpDir        string
+	preDump      bool
+	parent       string
 }
 
 // Init initialize checkpoint create command.
@@ -69,9 +76,11 @@ func (cc *CheckpointCreateCommand) runCheckpointCreate(args []string) error {
 	apiClient := cc.cli.Client()
 
 	if err := apiClient.ContainerCheckpointCreate(ctx, args[0], types.CheckpointCreateOptions{
-		CheckpointID:  args[1],
-		CheckpointDir: cc.cpDir,
-		Exit:          !cc.leaveRunning,
+		CheckpointID:     args[1],
+		CheckpointDir:    cc.cpDir,
+		Exit:             !cc.leaveRunning && !cc.preDump,
+		PreDump:          cc.preDump,
+		ParentCheckpoint: cc.parent,
 	}); err != nil {
 		return err
 	}
@@ -85,12 +94,18 @@ func (cc *CheckpointCreateCommand) addFlags() {
 	flagSet := cc.cmd.Flags()
 	flagSet.BoolVar(&cc.leaveRunning, "leave-running", false, "keep container running after creating checkpoint")
 	flagSet.StringVar(&cc.cpDir, "checkpoint-dir", "", "directory to store checkpoints images")
+	flagSet.BoolVar(&cc.preDump, "pre-dump", false, "dump the memory of container only and keep it running, which is used as parent of the next checkpoint")
+	flagSet.StringVar(&cc.parent, "parent", "", "previous pre-dump checkpoint, only the memory changed since then is dumped")
 }
 
 // checkpointCreateExample shows examples in checkpoint create command, and is used in auto-generated cli docs.
 func checkpointCreateExample() string {
 	return `$ pouch checkpoint create container-name cp0
-cp0`
+cp0
+$ pouch checkpoint create --pre-dump container-name pre0
+pre0
+$ pouch checkpoint create --parent pre0 container-name cp1
+cp1`
 }
 
 // checkpointListDescription is used to describe checkpoint list command in detail and auto generate command doc.
@@ -200,3 +215,147 @@ func checkpointDeleteExample() string {
 	return `$ pouch checkpoint delete container-name
 cp0`
 }
+
+// checkpointExportDescription is used to describe checkpoint export command in detail and auto generate command doc.
+var checkpointExportDescription = "Export a container checkpoint to a tar archive, which includes the checkpoint images, " +
+	"the container config and optionally the writable layer of the container. The archive can be imported " +
+	"on another host to restore the container."
+
+// CheckpointExportCommand use to implement 'checkpoint export' command, it exports a container checkpoint.
+type CheckpointExportCommand struct {
+	CheckpointCommand
+	cpDir  string
+	output string
+	rootfs bool
+}
+
+// Init initialize checkpoint export command.
+func (cc *CheckpointExportCommand) Init(c *Cli) {
+	cc.cli = c
+	cc.cmd = &cobra.Command{
+		Use:   "export [OPTIONS] CONTAINER CHECKPOINT",
+		Short: "export a container checkpoint to a tar archive",
+		Long:  checkpointExportDescription,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.runCheckpointExport(args)
+		},
+		Example: checkpointExportExample(),
+	}
+	cc.addFlags()
+}
+
+// runCheckpointExport is the entry of checkpoint export command.
+func (cc *CheckpointExportCommand) runCheckpointExport(args []string) error {
+	ctx := context.Background()
+	apiClient := cc.cli.Client()
+
+	r, err := apiClient.ContainerCheckpointExport(ctx, args[0], types.CheckpointExportOptions{
+		CheckpointID:  args[1],
+		CheckpointDir: cc.cpDir,
+		Rootfs:        cc.rootfs,
+	})
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	out := os.Stdout
+	if cc.output != "" {
+		out, err = os.Create(cc.output)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+
+	_, err = io.Copy(out, r)
+	return err
+}
+
+// addFlags adds flags for specific command.
+func (cc *CheckpointExportCommand) addFlags() {
+	flagSet := cc.cmd.Flags()
+	flagSet.StringVar(&cc.cpDir, "checkpoint-dir", "", "directory to store checkpoints images")
+	flagSet.StringVarP(&cc.output, "output", "o", "", "write to a tar archive file, instead of STDOUT")
+	flagSet.BoolVar(&cc.rootfs, "rootfs", false, "export the writable layer of the container")
+}
+
+// checkpointExportExample shows examples in checkpoint export command, and is used in auto-generated cli docs.
+func checkpointExportExample() string {
+	return `$ pouch checkpoint create container-name cp0
+cp0
+$ pouch checkpoint export --rootfs -o cp0.tar container-name cp0`
+}
+
+// checkpointImportDescription is used to describe checkpoint import command in detail and auto generate command doc.
+var checkpointImportDescription = "Import a container checkpoint from a tar archive created by checkpoint export. " +
+	"If the container does not exist, it is created with the config in the archive, and the image of " +
+	"the container should be pulled first. Then the container can be restored by start --checkpoint."
+
+// CheckpointImportCommand use to implement 'checkpoint import' command, it imports a container checkpoint.
+type CheckpointImportCommand struct {
+	CheckpointCommand
+	cpDir      string
+	input      string
+	checkpoint string
+}
+
+// Init initialize checkpoint import command.
+func (cc *CheckpointImportCommand) Init(c *Cli) {
+	cc.cli = c
+	cc.cmd = &cobra.Command{
+		Use:   "import [OPTIONS] CONTAINER",
+		Short: "import a container checkpoint from a tar archive",
+		Long:  checkpointImportDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.runCheckpointImport(args)
+		},
+		Example: checkpointImportExample(),
+	}
+	cc.addFlags()
+}
+
+// runCheckpointImport is the entry of checkpoint import command.
+func (cc *CheckpointImportCommand) runCheckpointImport(args []string) error {
+	ctx := context.Background()
+	apiClient := cc.cli.Client()
+
+	var in io.Reader = os.Stdin
+	if cc.input != "" {
+		file, err := os.Open(cc.input)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		in = file
+	}
+
+	checkpoint, err := apiClient.ContainerCheckpointImport(ctx, args[0], types.CheckpointImportOptions{
+		CheckpointID:  cc.checkpoint,
+		CheckpointDir: cc.cpDir,
+	}, in)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, checkpoint.CheckpointName)
+	return nil
+}
+
+// addFlags adds flags for specific command.
+func (cc *CheckpointImportCommand) addFlags() {
+	flagSet := cc.cmd.Flags()
+	flagSet.StringVar(&cc.cpDir, "checkpoint-dir", "", "directory to store checkpoints images")
+	flagSet.StringVarP(&cc.input, "input", "i", "", "read from a tar archive file, instead of STDIN")
+	flagSet.StringVar(&cc.checkpoint, "checkpoint", "", "checkpoint id to import as, defaults to the checkpoint id in the archive")
+}
+
+// checkpointImportExample shows examples in checkpoint import command, and is used in auto-generated cli docs.
+func checkpointImportExample() string {
+	return `$ pouch checkpoint import -i cp0.tar container-name
+cp0
+$ pouch start --checkpoint cp0 container-name
+container-name`
+}
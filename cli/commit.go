@@ -12,13 +12,18 @@ import (
 )
 
 // commitDescription is used to describe commit command in detail and auto generate command doc.
-var commitDescription = "commit an image from a container."
+var commitDescription = "commit an image from a container. The Dockerfile instructions ENV, CMD, ENTRYPOINT, " +
+	"EXPOSE, LABEL, USER and WORKDIR can be applied to the image config by --change. The running container " +
+	"is paused during commit by default, and the layers of image can be squashed into one by --squash."
 
 // CommitCommand is used to implement 'commit' command.
 type CommitCommand struct {
 	baseCommand
 	author  string
 	message string
+	changes []string
+	pause   bool
+	squash  bool
 }
 
 // Init initializes CommitCommand command.
@@ -43,6 +48,9 @@ func (cc *CommitCommand) addFlags() {
 
 	flagSet.StringVarP(&cc.author, "author", "a", "", "Image author, eg.(name <email@email.com>)")
 	flagSet.StringVarP(&cc.message, "message", "m", "", "Commit message")
+	flagSet.StringArrayVarP(&cc.changes, "change", "c", nil, "Apply Dockerfile instruction to the image config")
+	flagSet.BoolVarP(&cc.pause, "pause", "p", true, "Pause container during commit")
+	flagSet.BoolVar(&cc.squash, "squash", false, "Squash the layers of image and container into one layer")
 }
 
 // runCommit is the entry of CommitCommand command.
@@ -73,6 +81,9 @@ func (cc *CommitCommand) runCommit(args []string) error {
 		Tag:        tag,
 		Comment:    cc.message,
 		Author:     cc.author,
+		Changes:    cc.changes,
+		Pause:      &cc.pause,
+		Squash:     cc.squash,
 	}
 
 	respCommit, err := apiClient.ContainerCommit(ctx, id, commitConfig)
@@ -88,5 +99,7 @@ func (cc *CommitCommand) runCommit(args []string) error {
 func commitExample() string {
 	return `$ pouch commit 25bf50 test:image
 1c7e415csa333
+$ pouch commit --squash -c "ENV DEBUG=true" -c "EXPOSE 8080" -c 'CMD ["nginx", "-g", "daemon off;"]' 25bf50 test:squashed
+2f4d1ab2e8c5
 `
 }
@@ -40,6 +40,8 @@ func addCommonFlags(flagSet *pflag.FlagSet) *container {
 	flagSet.StringVar(&c.hostname, "hostname", "", "Set container's hostname")
 	flagSet.BoolVar(&c.disableNetworkFiles, "disable-network-files", false, "Disable the generation of network files(/etc/hostname, /etc/hosts and /etc/resolv.conf) for container. If true, no network files will be generated. Default false")
 
+	flagSet.BoolVar(&c.init, "init", false, "Run an init inside the container that forwards signals and reaps processes, pouch-init in PATH or init-path of pouchd is required")
+
 	// Intel RDT
 	flagSet.StringVar(&c.IntelRdtL3Cbm, "intel-rdt-l3-cbm", "", "Limit container resource for Intel RDT/CAT which introduced in Linux 4.10 kernel")
 
@@ -95,6 +97,7 @@ func addCommonFlags(flagSet *pflag.FlagSet) *container {
 	flagSet.StringSliceVar(&c.groupAdd, "group-add", nil, "Add additional groups to join")
 
 	flagSet.StringVar(&c.utsMode, "uts", "", "UTS namespace to use")
+	flagSet.StringVar(&c.usernsMode, "userns", "", "User namespace to use, can be host, auto, remap[:start:size] or container:<id>")
 
 	flagSet.VarP(config.NewVolumes(&c.volume), "volume", "v", "Bind mount volumes to container, format is: [source:]<destination>[:mode], [source] can be volume or host's path, <destination> is container's path, [mode] can be \"ro/rw/dr/rr/z/Z/nocopy/private/rprivate/slave/rslave/shared/rshared\"")
 	flagSet.StringSliceVar(&c.volumesFrom, "volumes-from", nil, "set volumes from other containers, format is <container>[:mode]")
@@ -114,6 +117,8 @@ func addCommonFlags(flagSet *pflag.FlagSet) *container {
 
 	// disk quota
 	flagSet.StringSliceVar(&c.diskQuota, "disk-quota", nil, "Set disk quota for container")
+	flagSet.StringSliceVar(&c.inodeQuota, "inode-quota", nil, "Set inode quota for container, the limit is in format of hard or soft:hard(/=80000:100000)")
+	flagSet.StringVar(&c.diskProfile, "disk-profile", "", "Set disk profile configured in daemon for container")
 	flagSet.StringVar(&c.quotaID, "quota-id", "", "Specified quota id, if id < 0, it means pouchd alloc a unique quota id")
 
 	// additional runtime spec annotations
@@ -56,11 +56,13 @@ type container struct {
 
 	devices       []string
 	enableLxcfs   bool
+	init          bool
 	privileged    bool
 	restartPolicy string
 	ipcMode       string
 	pidMode       string
 	utsMode       string
+	usernsMode    string
 	sysctls       []string
 
 	// set network options
@@ -82,6 +84,8 @@ type container struct {
 	capDrop        []string
 	IntelRdtL3Cbm  string
 	diskQuota      []string
+	diskProfile    string
+	inodeQuota     []string
 	quotaID        string
 	oomScoreAdj    int64
 	specAnnotation []string
@@ -165,6 +169,11 @@ func (c *container) config() (*types.ContainerCreateConfig, error) {
 		return nil, err
 	}
 
+	inodeQuota, err := opts.ParseInodeQuota(c.inodeQuota)
+	if err != nil {
+		return nil, err
+	}
+
 	specAnnotation, err := opts.ParseAnnotation(c.specAnnotation)
 	if err != nil {
 		return nil, err
@@ -222,6 +231,8 @@ func (c *container) config() (*types.ContainerCreateConfig, error) {
 			InitScript:          c.initScript,
 			ExposedPorts:        ports,
 			DiskQuota:           diskQuota,
+			DiskProfile:         c.diskProfile,
+			InodeQuota:          inodeQuota,
 			QuotaID:             quotaID,
 			SpecAnnotation:      specAnnotation,
 			NetPriority:         c.netPriority,
@@ -274,11 +285,13 @@ func (c *container) config() (*types.ContainerCreateConfig, error) {
 			DNSOptions:      c.dnsOptions,
 			DNSSearch:       c.dnsSearch,
 			EnableLxcfs:     c.enableLxcfs,
+			Init:            c.init,
 			Privileged:      c.privileged,
 			RestartPolicy:   restartPolicy,
 			IpcMode:         c.ipcMode,
 			PidMode:         c.pidMode,
 			UTSMode:         c.utsMode,
+			UsernsMode:      c.usernsMode,
 			GroupAdd:        c.groupAdd,
 			Sysctls:         sysctls,
 			SecurityOpt:     c.securityOpt,
@@ -52,6 +52,7 @@ func main() {
 	cli.AddCommand(base, &RemountLxcfsCommand{})
 	cli.AddCommand(base, &WaitCommand{})
 	cli.AddCommand(base, &DaemonUpdateCommand{})
+	cli.AddCommand(base, &SystemCommand{})
 	cli.AddCommand(base, &CheckpointCommand{})
 	cli.AddCommand(base, &EventsCommand{})
 	cli.AddCommand(base, &CommitCommand{})
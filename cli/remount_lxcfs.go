@@ -1,13 +1,18 @@
 package main
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/spf13/cobra"
 )
 
 // remountLxcfsDescription is used to describe remount-lxcfs command in detail and auto generate command doc.
-var remountLxcfsDescription = "\nremount lxcfs in containers."
+var remountLxcfsDescription = "\nremount lxcfs in containers. " +
+	"It re-binds the lxcfs proc files inside every running container which enables lxcfs, " +
+	"and is used to recover the dead binds after lxcfs restarts."
 
-// RemountLxcfsCommand is used to implement 'ps' command.
+// RemountLxcfsCommand is used to implement 'remount-lxcfs' command.
 type RemountLxcfsCommand struct {
 	baseCommand
 }
@@ -29,7 +34,36 @@ func (p *RemountLxcfsCommand) Init(c *Cli) {
 
 // runRemountLxcfs is the entry of remountLxcfsCommand command.
 func (p *RemountLxcfsCommand) runRemountLxcfs(args []string) error {
-	// TODO: get containers which enable lxcfs, and exec remount in them
+	ctx := context.Background()
+	apiClient := p.cli.Client()
+
+	results, err := apiClient.ContainerRemountLxcfs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to remount lxcfs: %v", err)
+	}
+
+	display := p.cli.NewTableDisplay()
+	display.AddRow([]string{"ID", "Status"})
+
+	failed := 0
+	for _, r := range results {
+		id := r.ID
+		if len(id) > 6 {
+			id = id[:6]
+		}
+
+		status := r.Status
+		if r.Error != "" {
+			failed++
+			status = fmt.Sprintf("%s: %s", r.Status, r.Error)
+		}
+		display.AddRow([]string{id, status})
+	}
+	display.Flush()
+
+	if failed > 0 {
+		return fmt.Errorf("failed to remount lxcfs in %d container(s)", failed)
+	}
 	return nil
 }
 
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+// systemDescription is used to describe system command in detail and auto generate command doc.
+var systemDescription = "Manage the resources of pouchd on the host. " +
+	"It contains the function of reconciling the snapshots which belong to no container and no image."
+
+// SystemCommand is used to implement 'system' command.
+type SystemCommand struct {
+	baseCommand
+}
+
+// Init initializes SystemCommand command.
+func (s *SystemCommand) Init(c *Cli) {
+	s.cli = c
+
+	s.cmd = &cobra.Command{
+		Use:   "system [command]",
+		Short: "Manage pouchd system resources",
+		Long:  systemDescription,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return fmt.Errorf("command 'pouch system %s' does not exist.\nPlease execute `pouch system --help` for more help", args[0])
+		},
+	}
+
+	c.AddCommand(s, &SystemReconcileCommand{})
+}
+
+// RunE is the entry of SystemCommand command.
+func (s *SystemCommand) RunE(args []string) error {
+	return nil
+}
+
+// systemReconcileDescription is used to describe system reconcile command in detail and auto generate command doc.
+var systemReconcileDescription = "List the snapshots which belong to no container and no image in every snapshotter, " +
+	"such as the snapshots left by crash during creating or upgrading container. " +
+	"The snapshots created in the last ten minutes are skipped since they may be in use. " +
+	"Use --remove to remove the orphaned snapshots."
+
+// SystemReconcileCommand is used to implement 'system reconcile' command.
+type SystemReconcileCommand struct {
+	baseCommand
+	remove bool
+}
+
+// Init initializes SystemReconcileCommand command.
+func (s *SystemReconcileCommand) Init(c *Cli) {
+	s.cli = c
+
+	s.cmd = &cobra.Command{
+		Use:   "reconcile [OPTIONS]",
+		Short: "Reconcile orphaned snapshots",
+		Long:  systemReconcileDescription,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return s.runSystemReconcile(args)
+		},
+		Example: systemReconcileExample(),
+	}
+	s.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (s *SystemReconcileCommand) addFlags() {
+	flagSet := s.cmd.Flags()
+	flagSet.BoolVar(&s.remove, "remove", false, "Remove the orphaned snapshots")
+}
+
+// runSystemReconcile is the entry of system reconcile command.
+func (s *SystemReconcileCommand) runSystemReconcile(args []string) error {
+	ctx := context.Background()
+	apiClient := s.cli.Client()
+
+	result, err := apiClient.SystemReconcile(ctx, s.remove)
+	if err != nil {
+		return err
+	}
+
+	display := s.cli.NewTableDisplay()
+	display.AddRow([]string{"SNAPSHOTTER", "KEY", "KIND", "SIZE", "REMOVED"})
+
+	var total int64
+	for _, sn := range result.OrphanSnapshots {
+		removed := fmt.Sprintf("%v", sn.Removed)
+		if sn.Error != "" {
+			removed = sn.Error
+		}
+		display.AddRow([]string{sn.Snapshotter, sn.Key, sn.Kind, units.HumanSize(float64(sn.Size)), removed})
+		total += sn.Size
+	}
+	display.Flush()
+
+	fmt.Printf("\nTotal orphaned: %s, reclaimed: %s\n", units.HumanSize(float64(total)), units.HumanSize(float64(result.SpaceReclaimed)))
+	return nil
+}
+
+// systemReconcileExample shows examples in system reconcile command, and is used in auto-generated cli docs.
+func systemReconcileExample() string {
+	return `$ pouch system reconcile --remove
+SNAPSHOTTER   KEY                                                                KIND     SIZE     REMOVED
+overlayfs     5b2a5f2e6f6d9c4f2e1d8c0a5e6b7c3d2f1e0a9b8c7d6e5f4a3b2c1d0e9f8a7b   Active   12.3MB   true
+
+Total orphaned: 12.3MB, reclaimed: 12.3MB`
+}
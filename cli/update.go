@@ -55,6 +55,8 @@ func (uc *UpdateCommand) addFlags() {
 	flagSet.StringSliceVarP(&uc.labels, "label", "l", nil, "Update labels for container")
 	flagSet.StringVar(&uc.restartPolicy, "restart", "", "Restart policy to apply when container exits")
 	flagSet.StringSliceVar(&uc.diskQuota, "disk-quota", nil, "Update disk quota for container(/=10g)")
+	flagSet.StringSliceVar(&uc.inodeQuota, "inode-quota", nil, "Update inode quota for container(/=80000:100000)")
+	flagSet.StringVar(&uc.diskProfile, "disk-profile", "", "Update disk profile configured in daemon for container")
 	flagSet.StringArrayVar(&uc.specAnnotation, "annotation", nil, "Update annotation for runtime spec")
 }
 
@@ -98,6 +100,11 @@ func (uc *UpdateCommand) updateRun(args []string) error {
 		return err
 	}
 
+	inodeQuota, err := opts.ParseInodeQuota(uc.inodeQuota)
+	if err != nil {
+		return err
+	}
+
 	annotation, err := opts.ParseAnnotation(uc.specAnnotation)
 	if err != nil {
 		return err
@@ -109,6 +116,8 @@ func (uc *UpdateCommand) updateRun(args []string) error {
 		RestartPolicy:  restartPolicy,
 		Resources:      resource,
 		DiskQuota:      diskQuota,
+		DiskProfile:    uc.diskProfile,
+		InodeQuota:     inodeQuota,
 		SpecAnnotation: annotation,
 	}
 
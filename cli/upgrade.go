@@ -3,8 +3,10 @@ package main
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/alibaba/pouch/apis/opts"
 	"github.com/alibaba/pouch/apis/types"
 
 	"github.com/spf13/cobra"
@@ -14,13 +16,40 @@ import (
 var upgradeDescription = "upgrade is a feature to replace a container's image. " +
 	"You can specify the new Entrypoint and Cmd for the new container. When you want to update " +
 	"a container's image, but inherit the network and volumes of the old container, then you should " +
-	"think about the upgrade feature."
+	"think about the upgrade feature. The files changed in the writable layer of the old container can " +
+	"be carried over to the new container by --preserve-rootfs or --preserve-path. The old image, writable " +
+	"layer and config are kept as the revision of the container, which can be restored by --rollback. " +
+	"If a readiness probe is specified, the running container is rolled back automatically when the probe fails. " +
+	"A readiness probe cannot be used when the container is not running. " +
+	"The env, labels, volumes and resources of the container can be patched in the same upgrade, " +
+	"and the image can be omitted to upgrade the container with its current image."
 
 // UpgradeCommand use to implement 'upgrade' command, it is used to upgrade a container.
 type UpgradeCommand struct {
 	baseCommand
-	entrypoint string
-	image      string
+	entrypoint     string
+	image          string
+	preserveRootfs bool
+	preservePaths  []string
+	rollback       bool
+
+	env        []string
+	labels     []string
+	volumes    []string
+	cpuperiod  int64
+	cpushare   int64
+	cpuquota   int64
+	cpusetcpus string
+	cpusetmems string
+	memory     string
+	memorySwap string
+
+	probeCmd      string
+	probeTCPPort  int64
+	probeHTTPGet  string
+	probeTimeout  int64
+	probeInterval int64
+	probeRetries  int64
 }
 
 // Init initialize upgrade command.
@@ -45,6 +74,25 @@ func (ug *UpgradeCommand) addFlags() {
 	flagSet.SetInterspersed(false)
 	flagSet.StringVar(&ug.entrypoint, "entrypoint", "", "Overwrite the default ENTRYPOINT of the image")
 	flagSet.StringVar(&ug.image, "image", "", "Specify image of the new container")
+	flagSet.BoolVar(&ug.preserveRootfs, "preserve-rootfs", false, "Carry over the files changed in the writable layer of the old container")
+	flagSet.StringSliceVar(&ug.preservePaths, "preserve-path", nil, "Carry over the files changed under the path in the writable layer of the old container")
+	flagSet.BoolVar(&ug.rollback, "rollback", false, "Rollback the container to the revision before the last upgrade")
+	flagSet.StringSliceVarP(&ug.env, "env", "e", nil, "Set environment variables of the new container, an env without value is removed")
+	flagSet.StringSliceVarP(&ug.labels, "label", "l", nil, "Set labels of the new container, a label with empty value is removed")
+	flagSet.StringSliceVarP(&ug.volumes, "volume", "v", nil, "Bind mount volumes of the new container, replace the old one with the same destination")
+	flagSet.Int64Var(&ug.cpuperiod, "cpu-period", 0, "Limit CPU CFS (Completely Fair Scheduler) period, range is in [1000(1ms),1000000(1s)]")
+	flagSet.Int64Var(&ug.cpushare, "cpu-shares", 0, "CPU shares (relative weight)")
+	flagSet.Int64Var(&ug.cpuquota, "cpu-quota", 0, "Limit CPU CFS (Completely Fair Scheduler) quota")
+	flagSet.StringVar(&ug.cpusetcpus, "cpuset-cpus", "", "CPUs in cpuset which to allow execution (0-3, 0, 1)")
+	flagSet.StringVar(&ug.cpusetmems, "cpuset-mems", "", "MEMs in cpuset which to allow execution (0-3, 0, 1)")
+	flagSet.StringVarP(&ug.memory, "memory", "m", "", "Container memory limit")
+	flagSet.StringVar(&ug.memorySwap, "memory-swap", "", "Container swap limit")
+	flagSet.StringVar(&ug.probeCmd, "probe-cmd", "", "Command to check readiness of the upgraded container, rollback if it fails")
+	flagSet.Int64Var(&ug.probeTCPPort, "probe-tcp", 0, "Port of container IP to check readiness of the upgraded container, rollback if it fails")
+	flagSet.StringVar(&ug.probeHTTPGet, "probe-http", "", "HTTP GET request to container IP in PORT[/PATH] format to check readiness of the upgraded container, rollback if it fails")
+	flagSet.Int64Var(&ug.probeTimeout, "probe-timeout", 0, "Timeout in seconds of each readiness probe, defaults to 1")
+	flagSet.Int64Var(&ug.probeInterval, "probe-interval", 0, "Time in seconds between readiness probes, defaults to 1")
+	flagSet.Int64Var(&ug.probeRetries, "probe-retries", 0, "Number of readiness probes before rollback, defaults to 3")
 }
 
 // runUpgrade is the entry of UpgradeCommand command.
@@ -59,19 +107,62 @@ func (ug *UpgradeCommand) runUpgrade(args []string) error {
 		cmd = args[1:]
 	}
 
+	ctx := context.Background()
+	apiClient := ug.cli.Client()
+
+	resources, err := ug.resources()
+	if err != nil {
+		return err
+	}
+	hasPatch := len(ug.env) > 0 || len(ug.labels) > 0 || len(ug.volumes) > 0 || resources != nil
+
+	if ug.rollback {
+		if ug.image != "" || ug.entrypoint != "" || ug.preserveRootfs || len(ug.preservePaths) > 0 || len(cmd) > 0 ||
+			ug.probeCmd != "" || ug.probeTCPPort != 0 || ug.probeHTTPGet != "" || hasPatch {
+			return fmt.Errorf("failed to rollback container: --rollback can't be used with other options or args")
+		}
+
+		if err := apiClient.ContainerRollback(ctx, name); err != nil {
+			return err
+		}
+
+		fmt.Println(name)
+		return nil
+	}
+
 	image := ug.image
 	if image == "" {
-		return fmt.Errorf("failed to upgrade container: must specify new image")
+		if !hasPatch {
+			return fmt.Errorf("failed to upgrade container: must specify new image")
+		}
+
+		// only patch the config, keep the current image of container.
+		c, err := apiClient.ContainerGet(ctx, name)
+		if err != nil {
+			return err
+		}
+		image = c.Config.Image
 	}
 
 	upgradeConfig := &types.ContainerUpgradeConfig{
-		Image:      image,
-		Cmd:        cmd,
-		Entrypoint: strings.Fields(ug.entrypoint),
+		Image:          image,
+		Cmd:            cmd,
+		Entrypoint:     strings.Fields(ug.entrypoint),
+		PreserveRootfs: ug.preserveRootfs,
+		PreservePaths:  ug.preservePaths,
+		Env:            ug.env,
+		Binds:          ug.volumes,
+		Resources:      resources,
+	}
+	if len(ug.labels) > 0 {
+		upgradeConfig.Labels = opts.ParseLabels(ug.labels)
 	}
 
-	ctx := context.Background()
-	apiClient := ug.cli.Client()
+	probe, err := ug.readinessProbe()
+	if err != nil {
+		return err
+	}
+	upgradeConfig.ReadinessProbe = probe
 
 	if err := pullMissingImage(ctx, apiClient, image, false); err != nil {
 		return err
@@ -85,10 +176,76 @@ func (ug *UpgradeCommand) runUpgrade(args []string) error {
 	return nil
 }
 
+// resources parses the resources to patch from flags, nil is returned if
+// none of them is specified.
+func (ug *UpgradeCommand) resources() (*types.Resources, error) {
+	memory, err := opts.ParseMemory(ug.memory)
+	if err != nil {
+		return nil, err
+	}
+
+	memorySwap, err := opts.ParseMemorySwap(ug.memorySwap)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := types.Resources{
+		CPUPeriod:  ug.cpuperiod,
+		CPUShares:  ug.cpushare,
+		CPUQuota:   ug.cpuquota,
+		CpusetCpus: ug.cpusetcpus,
+		CpusetMems: ug.cpusetmems,
+		Memory:     memory,
+		MemorySwap: memorySwap,
+	}
+	if resources.CPUPeriod == 0 && resources.CPUShares == 0 && resources.CPUQuota == 0 &&
+		resources.CpusetCpus == "" && resources.CpusetMems == "" && resources.Memory == 0 && resources.MemorySwap == 0 {
+		return nil, nil
+	}
+	return &resources, nil
+}
+
+// readinessProbe parses the readiness probe from flags.
+func (ug *UpgradeCommand) readinessProbe() (*types.UpgradeProbe, error) {
+	if ug.probeCmd == "" && ug.probeTCPPort == 0 && ug.probeHTTPGet == "" {
+		return nil, nil
+	}
+
+	probe := &types.UpgradeProbe{
+		Exec:     strings.Fields(ug.probeCmd),
+		TCPPort:  ug.probeTCPPort,
+		Timeout:  ug.probeTimeout,
+		Interval: ug.probeInterval,
+		Retries:  ug.probeRetries,
+	}
+
+	if ug.probeHTTPGet != "" {
+		parts := strings.SplitN(ug.probeHTTPGet, "/", 2)
+		port, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --probe-http %s: %v", ug.probeHTTPGet, err)
+		}
+
+		probe.HTTPGet = &types.HTTPGetAction{Port: port, Path: "/"}
+		if len(parts) == 2 {
+			probe.HTTPGet.Path += parts[1]
+		}
+	}
+	return probe, nil
+}
+
 //upgradeExample shows examples in exec command, and is used in auto-generated cli docs.
 func upgradeExample() string {
 	return ` $ pouch run -d -m 20m --name test  registry.hub.docker.com/library/busybox:latest
 4c58d27f58d38776dda31c01c897bbf554c802a9b80ae4dc20be1337f8a969f2
 $ pouch upgrade --image registry.hub.docker.com/library/hello-world:latest test
+test
+$ pouch upgrade --image registry.hub.docker.com/library/busybox:1.28 --preserve-path /data test
+test
+$ pouch upgrade --rollback test
+test
+$ pouch upgrade --image registry.hub.docker.com/library/busybox:1.28 --probe-cmd "ls /ready" --probe-retries 5 test
+test
+$ pouch upgrade -e VERSION=2 -l tier=backend -v /data/logs:/logs -m 50m test
 test`
 }
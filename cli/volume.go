@@ -133,7 +133,7 @@ func parseVolume(volumeCreateConfig *types.VolumeCreateConfig, v *VolumeCreateCo
 
 	// analyze options.
 	for _, option := range v.options {
-		opt := strings.Split(option, "=")
+		opt := strings.SplitN(option, "=", 2)
 		if len(opt) != 2 {
 			return fmt.Errorf("unknown option %s: option format must be key=value", option)
 		}
@@ -158,6 +158,13 @@ Mountpoint:
 Name:         pouch-volume
 Scope:
 CreatedAt:
+Driver:       local
+
+$ pouch volume create -d local -n nfs-volume -o type=nfs -o device=:/data -o o=addr=192.168.0.10,rw
+Mountpoint:
+Name:         nfs-volume
+Scope:
+CreatedAt:
 Driver:       local`
 }
 
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/url"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// ContainerCheckpointExport exports a checkpoint of container as a tar stream bundle.
+func (client *APIClient) ContainerCheckpointExport(ctx context.Context, name string, options types.CheckpointExportOptions) (io.ReadCloser, error) {
+	q := url.Values{}
+	if options.CheckpointDir != "" {
+		q.Set("dir", options.CheckpointDir)
+	}
+	if options.Rootfs {
+		q.Set("rootfs", "true")
+	}
+
+	resp, err := client.get(ctx, "/containers/"+name+"/checkpoints/"+options.CheckpointID+"/export", q, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
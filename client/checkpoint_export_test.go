@@ -0,0 +1,53 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+func TestCheckpointExportError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.ContainerCheckpointExport(context.Background(), "nothing", types.CheckpointExportOptions{CheckpointID: "noid"})
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestCheckpointExport(t *testing.T) {
+	expectedURL := "/containers/container_id/checkpoints/cp0/export"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if rootfs := req.FormValue("rootfs"); rootfs != "true" {
+			return nil, fmt.Errorf("expected rootfs true, got %s", rootfs)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("bundle"))),
+		}, nil
+	})
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+	r, err := client.ContainerCheckpointExport(context.Background(), "container_id", types.CheckpointExportOptions{CheckpointID: "cp0", Rootfs: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil || string(data) != "bundle" {
+		t.Fatalf("expected bundle, got %s, %v", data, err)
+	}
+}
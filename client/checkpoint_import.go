@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/url"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// ContainerCheckpointImport imports a checkpoint bundle to container, the
+// container is created with the config in bundle if it does not exist.
+func (client *APIClient) ContainerCheckpointImport(ctx context.Context, name string, options types.CheckpointImportOptions, reader io.Reader) (*types.Checkpoint, error) {
+	q := url.Values{}
+	if options.CheckpointID != "" {
+		q.Set("checkpoint", options.CheckpointID)
+	}
+	if options.CheckpointDir != "" {
+		q.Set("dir", options.CheckpointDir)
+	}
+
+	headers := map[string][]string{}
+	headers["Content-Type"] = []string{"application/x-tar"}
+
+	resp, err := client.postRawData(ctx, "/containers/"+name+"/checkpoints/import", q, reader, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint := &types.Checkpoint{}
+	err = decodeBody(checkpoint, resp.Body)
+	ensureCloseReader(resp)
+
+	return checkpoint, err
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+func TestCheckpointImportError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.ContainerCheckpointImport(context.Background(), "nothing", types.CheckpointImportOptions{}, bytes.NewReader(nil))
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestCheckpointImport(t *testing.T) {
+	expectedURL := "/containers/container_id/checkpoints/import"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "POST" {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+		if checkpoint := req.URL.Query().Get("checkpoint"); checkpoint != "cp1" {
+			return nil, fmt.Errorf("expected checkpoint cp1, got %s", checkpoint)
+		}
+
+		b, err := json.Marshal(types.Checkpoint{ContainerID: "container_id", CheckpointName: "cp1"})
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+	checkpoint, err := client.ContainerCheckpointImport(context.Background(), "container_id", types.CheckpointImportOptions{CheckpointID: "cp1"}, bytes.NewReader([]byte("bundle")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if checkpoint.CheckpointName != "cp1" {
+		t.Fatalf("expected checkpoint cp1, got %s", checkpoint.CheckpointName)
+	}
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"net/url"
+	"strconv"
 
 	"github.com/alibaba/pouch/apis/types"
 )
@@ -15,6 +16,15 @@ func (client *APIClient) ContainerCommit(ctx context.Context, name string, optio
 	q.Set("tag", options.Tag)
 	q.Set("comment", options.Comment)
 	q.Set("author", options.Author)
+	for _, change := range options.Changes {
+		q.Add("changes", change)
+	}
+	if options.Pause != nil {
+		q.Set("pause", strconv.FormatBool(*options.Pause))
+	}
+	if options.Squash {
+		q.Set("squash", "true")
+	}
 
 	response := &types.ContainerCommitResp{}
 	resp, err := client.post(ctx, "/commit", q, nil, nil)
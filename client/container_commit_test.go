@@ -70,3 +70,43 @@ func TestCommit(t *testing.T) {
 	}
 	assert.Equal(t, r.ID, "newid")
 }
+
+func TestCommitWithOptions(t *testing.T) {
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		q := req.URL.Query()
+		if changes := q["changes"]; !assert.ObjectsAreEqual([]string{"ENV A=1", "EXPOSE 80"}, changes) {
+			return nil, fmt.Errorf("expected changes, got %v", changes)
+		}
+		if pause := q.Get("pause"); pause != "false" {
+			return nil, fmt.Errorf("expected pause false, got %s", pause)
+		}
+		if squash := q.Get("squash"); squash != "true" {
+			return nil, fmt.Errorf("expected squash true, got %s", squash)
+		}
+
+		b, err := json.Marshal(types.ContainerCommitResp{ID: "newid"})
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	pause := false
+	r, err := client.ContainerCommit(context.Background(), "id", types.ContainerCommitOptions{
+		Repository: "foo",
+		Tag:        "bar",
+		Changes:    []string{"ENV A=1", "EXPOSE 80"},
+		Pause:      &pause,
+		Squash:     true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, r.ID, "newid")
+}
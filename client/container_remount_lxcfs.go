@@ -0,0 +1,21 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// ContainerRemountLxcfs remounts lxcfs in the running containers which enable lxcfs.
+func (client *APIClient) ContainerRemountLxcfs(ctx context.Context) ([]*types.RemountLxcfsResult, error) {
+	resp, err := client.post(ctx, "/containers/remount-lxcfs", nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*types.RemountLxcfsResult
+	err = decodeBody(&results, resp.Body)
+	ensureCloseReader(resp)
+
+	return results, err
+}
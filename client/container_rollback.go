@@ -0,0 +1,14 @@
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+// ContainerRollback rollbacks a container to the revision before the last upgrade.
+func (client *APIClient) ContainerRollback(ctx context.Context, name string) error {
+	resp, err := client.post(ctx, "/containers/"+name+"/rollback", url.Values{}, nil, nil)
+	ensureCloseReader(resp)
+
+	return err
+}
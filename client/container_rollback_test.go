@@ -0,0 +1,44 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestContainerRollbackError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusBadRequest, "no revision to rollback")),
+	}
+	err := client.ContainerRollback(context.Background(), "nothing")
+	if err == nil || !strings.Contains(err.Error(), "no revision to rollback") {
+		t.Fatalf("expected a no revision error, got %v", err)
+	}
+}
+
+func TestContainerRollback(t *testing.T) {
+	expectedURL := "/containers/container_id/rollback"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "POST" {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+		}, nil
+	})
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+	if err := client.ContainerRollback(context.Background(), "container_id"); err != nil {
+		t.Fatal(err)
+	}
+}
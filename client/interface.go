@@ -39,13 +39,17 @@ type ContainerAPIClient interface {
 	ContainerUnpause(ctx context.Context, name string) error
 	ContainerUpdate(ctx context.Context, name string, config *types.UpdateConfig) error
 	ContainerUpgrade(ctx context.Context, name string, config *types.ContainerUpgradeConfig) error
+	ContainerRollback(ctx context.Context, name string) error
 	ContainerTop(ctx context.Context, name string, arguments []string) (types.ContainerProcessList, error)
+	ContainerRemountLxcfs(ctx context.Context) ([]*types.RemountLxcfsResult, error)
 	ContainerLogs(ctx context.Context, name string, options types.ContainerLogsOptions) (io.ReadCloser, error)
 	ContainerResize(ctx context.Context, name, height, width string) error
 	ContainerWait(ctx context.Context, name string) (types.ContainerWaitOKBody, error)
 	ContainerCheckpointCreate(ctx context.Context, name string, options types.CheckpointCreateOptions) error
 	ContainerCheckpointList(ctx context.Context, name string, options types.CheckpointListOptions) ([]string, error)
 	ContainerCheckpointDelete(ctx context.Context, name string, options types.CheckpointDeleteOptions) error
+	ContainerCheckpointExport(ctx context.Context, name string, options types.CheckpointExportOptions) (io.ReadCloser, error)
+	ContainerCheckpointImport(ctx context.Context, name string, options types.CheckpointImportOptions, reader io.Reader) (*types.Checkpoint, error)
 	ContainerCommit(ctx context.Context, name string, options types.ContainerCommitOptions) (*types.ContainerCommitResp, error)
 	ContainerStats(ctx context.Context, name string, stream bool) (io.ReadCloser, error)
 	ContainerStatPath(ctx context.Context, name string, path string) (types.ContainerPathStat, error)
@@ -82,6 +86,7 @@ type SystemAPIClient interface {
 	SystemInfo(ctx context.Context) (*types.SystemInfo, error)
 	RegistryLogin(ctx context.Context, auth *types.AuthConfig) (*types.AuthResponse, error)
 	DaemonUpdate(ctx context.Context, daemonConfig *types.DaemonUpdateConfig) error
+	SystemReconcile(ctx context.Context, remove bool) (*types.SystemReconcileResult, error)
 	Events(ctx context.Context, since string, until string, filters filters.Args) (io.ReadCloser, error)
 }
 
@@ -0,0 +1,27 @@
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// SystemReconcile requests daemon to list the orphaned snapshots, and remove them if remove is true.
+func (client *APIClient) SystemReconcile(ctx context.Context, remove bool) (*types.SystemReconcileResult, error) {
+	q := url.Values{}
+	if remove {
+		q.Set("remove", "true")
+	}
+
+	resp, err := client.post(ctx, "/system/reconcile", q, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.SystemReconcileResult{}
+	err = decodeBody(result, resp.Body)
+	ensureCloseReader(resp)
+
+	return result, err
+}
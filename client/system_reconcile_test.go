@@ -0,0 +1,70 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystemReconcileError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.SystemReconcile(context.Background(), false)
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestSystemReconcile(t *testing.T) {
+	expectedURL := "/system/reconcile"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "POST" {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+		if remove := req.URL.Query().Get("remove"); remove != "true" {
+			return nil, fmt.Errorf("expected remove to be true, got %s", remove)
+		}
+
+		result := types.SystemReconcileResult{
+			OrphanSnapshots: []*types.OrphanSnapshot{
+				{Key: "abc", Snapshotter: "overlayfs", Kind: "Active", Size: 1024, Removed: true},
+			},
+			SpaceReclaimed: 1024,
+		}
+		b, err := json.Marshal(result)
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(b))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	result, err := client.SystemReconcile(context.Background(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(1024), result.SpaceReclaimed)
+	assert.Equal(t, 1, len(result.OrphanSnapshots))
+	assert.Equal(t, "abc", result.OrphanSnapshots[0].Key)
+}
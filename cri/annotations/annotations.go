@@ -26,6 +26,12 @@ const (
 	// KubernetesRuntime is the runtime
 	KubernetesRuntime = "io.kubernetes.runtime"
 
+	// IngressBandwidth is the bandwidth limit of incoming traffic of the pod
+	IngressBandwidth = "kubernetes.io/ingress-bandwidth"
+
+	// EgressBandwidth is the bandwidth limit of outgoing traffic of the pod
+	EgressBandwidth = "kubernetes.io/egress-bandwidth"
+
 	// LxcfsEnabled whether to enable lxcfs for a container
 	LxcfsEnabled = "io.kubernetes.lxcfs.enabled"
 
@@ -38,6 +44,13 @@ const (
 	// PidsLimitExtendAnnotation is the extend annotation of pids limit
 	PidsLimitExtendAnnotation = "io.alibaba.pouch.resources.pids-limit"
 
+	// AdditionalNetworksExtendAnnotation is the extend annotation of the CNI networks
+	// attached to the pod besides the default one, separated by comma
+	AdditionalNetworksExtendAnnotation = "io.alibaba.pouch.network.additional-networks"
+
+	// InitExtendAnnotation is the extend annotation of whether to run an init inside container
+	InitExtendAnnotation = "io.alibaba.pouch.init"
+
 	// PassthruKey specify whether an interface is pass through to qemu
 	PassthruKey = "io.alibaba.pouch.vm.passthru"
 
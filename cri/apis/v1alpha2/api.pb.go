@@ -29,6 +29,8 @@ limitations under the License.
 		PortMapping
 		Mount
 		NamespaceOption
+		IDMapping
+		UserNamespace
 		Int64Value
 		LinuxSandboxSecurityContext
 		LinuxPodSandboxConfig
@@ -42,6 +44,7 @@ limitations under the License.
 		RemovePodSandboxResponse
 		PodSandboxStatusRequest
 		PodSandboxNetworkStatus
+		PodIP
 		Namespace
 		LinuxPodSandboxStatus
 		PodSandboxStatus
@@ -127,6 +130,18 @@ limitations under the License.
 		ContainerStats
 		CpuUsage
 		MemoryUsage
+		PodSandboxStatsRequest
+		PodSandboxStatsResponse
+		PodSandboxStatsFilter
+		ListPodSandboxStatsRequest
+		ListPodSandboxStatsResponse
+		PodSandboxAttributes
+		PodSandboxStats
+		LinuxPodSandboxStats
+		WindowsPodSandboxStats
+		NetworkUsage
+		NetworkInterfaceUsage
+		ProcessUsage
 		ReopenContainerLogRequest
 		ReopenContainerLogResponse
 		PauseContainerRequest
@@ -137,6 +152,10 @@ limitations under the License.
 		RemoveVolumeResponse
 		StartPodSandboxRequest
 		StartPodSandboxResponse
+		GetEventsRequest
+		ContainerEventResponse
+		CheckpointContainerRequest
+		CheckpointContainerResponse
 */
 package v1alpha2
 
@@ -309,6 +328,37 @@ func (x ContainerState) String() string {
 }
 func (ContainerState) EnumDescriptor() ([]byte, []int) { return fileDescriptorApi, []int{4} }
 
+type ContainerEventType int32
+
+const (
+	// Container created.
+	ContainerEventType_CONTAINER_CREATED_EVENT ContainerEventType = 0
+	// Container started.
+	ContainerEventType_CONTAINER_STARTED_EVENT ContainerEventType = 1
+	// Container stopped.
+	ContainerEventType_CONTAINER_STOPPED_EVENT ContainerEventType = 2
+	// Container deleted.
+	ContainerEventType_CONTAINER_DELETED_EVENT ContainerEventType = 3
+)
+
+var ContainerEventType_name = map[int32]string{
+	0: "CONTAINER_CREATED_EVENT",
+	1: "CONTAINER_STARTED_EVENT",
+	2: "CONTAINER_STOPPED_EVENT",
+	3: "CONTAINER_DELETED_EVENT",
+}
+var ContainerEventType_value = map[string]int32{
+	"CONTAINER_CREATED_EVENT": 0,
+	"CONTAINER_STARTED_EVENT": 1,
+	"CONTAINER_STOPPED_EVENT": 2,
+	"CONTAINER_DELETED_EVENT": 3,
+}
+
+func (x ContainerEventType) String() string {
+	return proto.EnumName(ContainerEventType_name, int32(x))
+}
+func (ContainerEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptorApi, []int{5} }
+
 type VersionRequest struct {
 	// Version of the kubelet runtime API.
 	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -529,6 +579,11 @@ type NamespaceOption struct {
 	// Note: There is currently no way to set CONTAINER scoped IPC in the Kubernetes API.
 	// Namespaces currently set by the kubelet: POD, NODE
 	Ipc NamespaceMode `protobuf:"varint,3,opt,name=ipc,proto3,enum=runtime.v1alpha2.NamespaceMode" json:"ipc,omitempty"`
+	// User namespace options for this sandbox, the containers in the pod share
+	// the user namespace of the sandbox.
+	// If it is nil, NODE mode is assumed, which means the user namespace of
+	// the node is used.
+	UsernsOptions *UserNamespace `protobuf:"bytes,5,opt,name=userns_options,json=usernsOptions" json:"userns_options,omitempty"`
 }
 
 func (m *NamespaceOption) Reset()                    { *m = NamespaceOption{} }
@@ -556,6 +611,85 @@ func (m *NamespaceOption) GetIpc() NamespaceMode {
 	return NamespaceMode_POD
 }
 
+func (m *NamespaceOption) GetUsernsOptions() *UserNamespace {
+	if m != nil {
+		return m.UsernsOptions
+	}
+	return nil
+}
+
+// IDMapping describes host to container ID mappings for a pod sandbox.
+type IDMapping struct {
+	// HostId is the id on the host.
+	HostId uint32 `protobuf:"varint,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	// ContainerId is the id in the container.
+	ContainerId uint32 `protobuf:"varint,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// Length is the size of the range to map.
+	Length uint32 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (m *IDMapping) Reset()                    { *m = IDMapping{} }
+func (*IDMapping) ProtoMessage()               {}
+func (*IDMapping) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{6} }
+
+func (m *IDMapping) GetHostId() uint32 {
+	if m != nil {
+		return m.HostId
+	}
+	return 0
+}
+
+func (m *IDMapping) GetContainerId() uint32 {
+	if m != nil {
+		return m.ContainerId
+	}
+	return 0
+}
+
+func (m *IDMapping) GetLength() uint32 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+// UserNamespace describes the intended user namespace configuration for a pod sandbox.
+type UserNamespace struct {
+	// Mode is the NamespaceMode for this UserNamespace.
+	// Note: NamespaceMode for UserNamespace supports only POD and NODE.
+	Mode NamespaceMode `protobuf:"varint,1,opt,name=mode,proto3,enum=runtime.v1alpha2.NamespaceMode" json:"mode,omitempty"`
+	// Uids specifies the UID mappings for the user namespace. If the mode is
+	// POD and no mapping is specified, the runtime allocates the mappings.
+	Uids []*IDMapping `protobuf:"bytes,2,rep,name=uids" json:"uids,omitempty"`
+	// Gids specifies the GID mappings for the user namespace.
+	Gids []*IDMapping `protobuf:"bytes,3,rep,name=gids" json:"gids,omitempty"`
+}
+
+func (m *UserNamespace) Reset()                    { *m = UserNamespace{} }
+func (*UserNamespace) ProtoMessage()               {}
+func (*UserNamespace) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{7} }
+
+func (m *UserNamespace) GetMode() NamespaceMode {
+	if m != nil {
+		return m.Mode
+	}
+	return NamespaceMode_POD
+}
+
+func (m *UserNamespace) GetUids() []*IDMapping {
+	if m != nil {
+		return m.Uids
+	}
+	return nil
+}
+
+func (m *UserNamespace) GetGids() []*IDMapping {
+	if m != nil {
+		return m.Gids
+	}
+	return nil
+}
+
 // Int64Value is the wrapper of int64.
 type Int64Value struct {
 	// The value.
@@ -564,7 +698,7 @@ type Int64Value struct {
 
 func (m *Int64Value) Reset()                    { *m = Int64Value{} }
 func (*Int64Value) ProtoMessage()               {}
-func (*Int64Value) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{6} }
+func (*Int64Value) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{8} }
 
 func (m *Int64Value) GetValue() int64 {
 	if m != nil {
@@ -611,7 +745,7 @@ type LinuxSandboxSecurityContext struct {
 
 func (m *LinuxSandboxSecurityContext) Reset()                    { *m = LinuxSandboxSecurityContext{} }
 func (*LinuxSandboxSecurityContext) ProtoMessage()               {}
-func (*LinuxSandboxSecurityContext) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{7} }
+func (*LinuxSandboxSecurityContext) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{9} }
 
 func (m *LinuxSandboxSecurityContext) GetNamespaceOptions() *NamespaceOption {
 	if m != nil {
@@ -684,7 +818,7 @@ type LinuxPodSandboxConfig struct {
 
 func (m *LinuxPodSandboxConfig) Reset()                    { *m = LinuxPodSandboxConfig{} }
 func (*LinuxPodSandboxConfig) ProtoMessage()               {}
-func (*LinuxPodSandboxConfig) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{8} }
+func (*LinuxPodSandboxConfig) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{10} }
 
 func (m *LinuxPodSandboxConfig) GetCgroupParent() string {
 	if m != nil {
@@ -724,7 +858,7 @@ type PodSandboxMetadata struct {
 
 func (m *PodSandboxMetadata) Reset()                    { *m = PodSandboxMetadata{} }
 func (*PodSandboxMetadata) ProtoMessage()               {}
-func (*PodSandboxMetadata) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{9} }
+func (*PodSandboxMetadata) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{11} }
 
 func (m *PodSandboxMetadata) GetName() string {
 	if m != nil {
@@ -809,7 +943,7 @@ type PodSandboxConfig struct {
 
 func (m *PodSandboxConfig) Reset()                    { *m = PodSandboxConfig{} }
 func (*PodSandboxConfig) ProtoMessage()               {}
-func (*PodSandboxConfig) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{10} }
+func (*PodSandboxConfig) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{12} }
 
 func (m *PodSandboxConfig) GetMetadata() *PodSandboxMetadata {
 	if m != nil {
@@ -880,7 +1014,7 @@ type RunPodSandboxRequest struct {
 
 func (m *RunPodSandboxRequest) Reset()                    { *m = RunPodSandboxRequest{} }
 func (*RunPodSandboxRequest) ProtoMessage()               {}
-func (*RunPodSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{11} }
+func (*RunPodSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{13} }
 
 func (m *RunPodSandboxRequest) GetConfig() *PodSandboxConfig {
 	if m != nil {
@@ -903,7 +1037,7 @@ type RunPodSandboxResponse struct {
 
 func (m *RunPodSandboxResponse) Reset()                    { *m = RunPodSandboxResponse{} }
 func (*RunPodSandboxResponse) ProtoMessage()               {}
-func (*RunPodSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{12} }
+func (*RunPodSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{14} }
 
 func (m *RunPodSandboxResponse) GetPodSandboxId() string {
 	if m != nil {
@@ -919,7 +1053,7 @@ type StopPodSandboxRequest struct {
 
 func (m *StopPodSandboxRequest) Reset()                    { *m = StopPodSandboxRequest{} }
 func (*StopPodSandboxRequest) ProtoMessage()               {}
-func (*StopPodSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{13} }
+func (*StopPodSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{15} }
 
 func (m *StopPodSandboxRequest) GetPodSandboxId() string {
 	if m != nil {
@@ -933,7 +1067,7 @@ type StopPodSandboxResponse struct {
 
 func (m *StopPodSandboxResponse) Reset()                    { *m = StopPodSandboxResponse{} }
 func (*StopPodSandboxResponse) ProtoMessage()               {}
-func (*StopPodSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{14} }
+func (*StopPodSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{16} }
 
 type RemovePodSandboxRequest struct {
 	// ID of the PodSandbox to remove.
@@ -942,7 +1076,7 @@ type RemovePodSandboxRequest struct {
 
 func (m *RemovePodSandboxRequest) Reset()                    { *m = RemovePodSandboxRequest{} }
 func (*RemovePodSandboxRequest) ProtoMessage()               {}
-func (*RemovePodSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{15} }
+func (*RemovePodSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{17} }
 
 func (m *RemovePodSandboxRequest) GetPodSandboxId() string {
 	if m != nil {
@@ -956,7 +1090,7 @@ type RemovePodSandboxResponse struct {
 
 func (m *RemovePodSandboxResponse) Reset()                    { *m = RemovePodSandboxResponse{} }
 func (*RemovePodSandboxResponse) ProtoMessage()               {}
-func (*RemovePodSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{16} }
+func (*RemovePodSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{18} }
 
 type PodSandboxStatusRequest struct {
 	// ID of the PodSandbox for which to retrieve status.
@@ -967,7 +1101,7 @@ type PodSandboxStatusRequest struct {
 
 func (m *PodSandboxStatusRequest) Reset()                    { *m = PodSandboxStatusRequest{} }
 func (*PodSandboxStatusRequest) ProtoMessage()               {}
-func (*PodSandboxStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{17} }
+func (*PodSandboxStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{19} }
 
 func (m *PodSandboxStatusRequest) GetPodSandboxId() string {
 	if m != nil {
@@ -987,11 +1121,13 @@ func (m *PodSandboxStatusRequest) GetVerbose() bool {
 type PodSandboxNetworkStatus struct {
 	// IP address of the PodSandbox.
 	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	// list of additional ips (not inclusive of PodSandboxNetworkStatus.Ip) of the PodSandBoxNetworkStatus
+	AdditionalIps []*PodIP `protobuf:"bytes,2,rep,name=additional_ips,json=additionalIps" json:"additional_ips,omitempty"`
 }
 
 func (m *PodSandboxNetworkStatus) Reset()                    { *m = PodSandboxNetworkStatus{} }
 func (*PodSandboxNetworkStatus) ProtoMessage()               {}
-func (*PodSandboxNetworkStatus) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{18} }
+func (*PodSandboxNetworkStatus) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{20} }
 
 func (m *PodSandboxNetworkStatus) GetIp() string {
 	if m != nil {
@@ -1000,6 +1136,30 @@ func (m *PodSandboxNetworkStatus) GetIp() string {
 	return ""
 }
 
+func (m *PodSandboxNetworkStatus) GetAdditionalIps() []*PodIP {
+	if m != nil {
+		return m.AdditionalIps
+	}
+	return nil
+}
+
+// PodIP represents an ip of a Pod
+type PodIP struct {
+	// an ip is a string representation of an IPv4 or an IPv6
+	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+}
+
+func (m *PodIP) Reset()                    { *m = PodIP{} }
+func (*PodIP) ProtoMessage()               {}
+func (*PodIP) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{21} }
+
+func (m *PodIP) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
 // Namespace contains paths to the namespaces.
 type Namespace struct {
 	// Namespace options for Linux namespaces.
@@ -1008,7 +1168,7 @@ type Namespace struct {
 
 func (m *Namespace) Reset()                    { *m = Namespace{} }
 func (*Namespace) ProtoMessage()               {}
-func (*Namespace) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{19} }
+func (*Namespace) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{22} }
 
 func (m *Namespace) GetOptions() *NamespaceOption {
 	if m != nil {
@@ -1025,7 +1185,7 @@ type LinuxPodSandboxStatus struct {
 
 func (m *LinuxPodSandboxStatus) Reset()                    { *m = LinuxPodSandboxStatus{} }
 func (*LinuxPodSandboxStatus) ProtoMessage()               {}
-func (*LinuxPodSandboxStatus) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{20} }
+func (*LinuxPodSandboxStatus) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{23} }
 
 func (m *LinuxPodSandboxStatus) GetNamespaces() *Namespace {
 	if m != nil {
@@ -1059,7 +1219,7 @@ type PodSandboxStatus struct {
 
 func (m *PodSandboxStatus) Reset()                    { *m = PodSandboxStatus{} }
 func (*PodSandboxStatus) ProtoMessage()               {}
-func (*PodSandboxStatus) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{21} }
+func (*PodSandboxStatus) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{24} }
 
 func (m *PodSandboxStatus) GetId() string {
 	if m != nil {
@@ -1129,7 +1289,7 @@ type PodSandboxStatusResponse struct {
 
 func (m *PodSandboxStatusResponse) Reset()                    { *m = PodSandboxStatusResponse{} }
 func (*PodSandboxStatusResponse) ProtoMessage()               {}
-func (*PodSandboxStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{22} }
+func (*PodSandboxStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{25} }
 
 func (m *PodSandboxStatusResponse) GetStatus() *PodSandboxStatus {
 	if m != nil {
@@ -1153,7 +1313,7 @@ type PodSandboxStateValue struct {
 
 func (m *PodSandboxStateValue) Reset()                    { *m = PodSandboxStateValue{} }
 func (*PodSandboxStateValue) ProtoMessage()               {}
-func (*PodSandboxStateValue) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{23} }
+func (*PodSandboxStateValue) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{26} }
 
 func (m *PodSandboxStateValue) GetState() PodSandboxState {
 	if m != nil {
@@ -1177,7 +1337,7 @@ type PodSandboxFilter struct {
 
 func (m *PodSandboxFilter) Reset()                    { *m = PodSandboxFilter{} }
 func (*PodSandboxFilter) ProtoMessage()               {}
-func (*PodSandboxFilter) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{24} }
+func (*PodSandboxFilter) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{27} }
 
 func (m *PodSandboxFilter) GetId() string {
 	if m != nil {
@@ -1207,7 +1367,7 @@ type ListPodSandboxRequest struct {
 
 func (m *ListPodSandboxRequest) Reset()                    { *m = ListPodSandboxRequest{} }
 func (*ListPodSandboxRequest) ProtoMessage()               {}
-func (*ListPodSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{25} }
+func (*ListPodSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{28} }
 
 func (m *ListPodSandboxRequest) GetFilter() *PodSandboxFilter {
 	if m != nil {
@@ -1237,7 +1397,7 @@ type PodSandbox struct {
 
 func (m *PodSandbox) Reset()                    { *m = PodSandbox{} }
 func (*PodSandbox) ProtoMessage()               {}
-func (*PodSandbox) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{26} }
+func (*PodSandbox) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{29} }
 
 func (m *PodSandbox) GetId() string {
 	if m != nil {
@@ -1288,7 +1448,7 @@ type ListPodSandboxResponse struct {
 
 func (m *ListPodSandboxResponse) Reset()                    { *m = ListPodSandboxResponse{} }
 func (*ListPodSandboxResponse) ProtoMessage()               {}
-func (*ListPodSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{27} }
+func (*ListPodSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{30} }
 
 func (m *ListPodSandboxResponse) GetItems() []*PodSandbox {
 	if m != nil {
@@ -1306,7 +1466,7 @@ type ImageSpec struct {
 
 func (m *ImageSpec) Reset()                    { *m = ImageSpec{} }
 func (*ImageSpec) ProtoMessage()               {}
-func (*ImageSpec) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{28} }
+func (*ImageSpec) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{31} }
 
 func (m *ImageSpec) GetImage() string {
 	if m != nil {
@@ -1322,7 +1482,7 @@ type KeyValue struct {
 
 func (m *KeyValue) Reset()                    { *m = KeyValue{} }
 func (*KeyValue) ProtoMessage()               {}
-func (*KeyValue) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{29} }
+func (*KeyValue) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{32} }
 
 func (m *KeyValue) GetKey() string {
 	if m != nil {
@@ -1378,7 +1538,7 @@ type LinuxContainerResources struct {
 
 func (m *LinuxContainerResources) Reset()                    { *m = LinuxContainerResources{} }
 func (*LinuxContainerResources) ProtoMessage()               {}
-func (*LinuxContainerResources) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{30} }
+func (*LinuxContainerResources) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{33} }
 
 func (m *LinuxContainerResources) GetCpuPeriod() int64 {
 	if m != nil {
@@ -1516,7 +1676,7 @@ type WeightDevice struct {
 
 func (m *WeightDevice) Reset()                    { *m = WeightDevice{} }
 func (*WeightDevice) ProtoMessage()               {}
-func (*WeightDevice) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{31} }
+func (*WeightDevice) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{34} }
 
 func (m *WeightDevice) GetPath() string {
 	if m != nil {
@@ -1542,7 +1702,7 @@ type ThrottleDevice struct {
 
 func (m *ThrottleDevice) Reset()                    { *m = ThrottleDevice{} }
 func (*ThrottleDevice) ProtoMessage()               {}
-func (*ThrottleDevice) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{32} }
+func (*ThrottleDevice) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{35} }
 
 func (m *ThrottleDevice) GetPath() string {
 	if m != nil {
@@ -1570,7 +1730,7 @@ type Ulimit struct {
 
 func (m *Ulimit) Reset()                    { *m = Ulimit{} }
 func (*Ulimit) ProtoMessage()               {}
-func (*Ulimit) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{33} }
+func (*Ulimit) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{36} }
 
 func (m *Ulimit) GetName() string {
 	if m != nil {
@@ -1603,7 +1763,7 @@ type SELinuxOption struct {
 
 func (m *SELinuxOption) Reset()                    { *m = SELinuxOption{} }
 func (*SELinuxOption) ProtoMessage()               {}
-func (*SELinuxOption) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{34} }
+func (*SELinuxOption) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{37} }
 
 func (m *SELinuxOption) GetUser() string {
 	if m != nil {
@@ -1643,7 +1803,7 @@ type Capability struct {
 
 func (m *Capability) Reset()                    { *m = Capability{} }
 func (*Capability) ProtoMessage()               {}
-func (*Capability) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{35} }
+func (*Capability) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{38} }
 
 func (m *Capability) GetAddCapabilities() []string {
 	if m != nil {
@@ -1730,7 +1890,7 @@ type LinuxContainerSecurityContext struct {
 func (m *LinuxContainerSecurityContext) Reset()      { *m = LinuxContainerSecurityContext{} }
 func (*LinuxContainerSecurityContext) ProtoMessage() {}
 func (*LinuxContainerSecurityContext) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{36}
+	return fileDescriptorApi, []int{39}
 }
 
 func (m *LinuxContainerSecurityContext) GetCapabilities() *Capability {
@@ -1842,7 +2002,7 @@ type LinuxContainerConfig struct {
 
 func (m *LinuxContainerConfig) Reset()                    { *m = LinuxContainerConfig{} }
 func (*LinuxContainerConfig) ProtoMessage()               {}
-func (*LinuxContainerConfig) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{37} }
+func (*LinuxContainerConfig) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{40} }
 
 func (m *LinuxContainerConfig) GetResources() *LinuxContainerResources {
 	if m != nil {
@@ -1869,7 +2029,7 @@ type WindowsContainerSecurityContext struct {
 func (m *WindowsContainerSecurityContext) Reset()      { *m = WindowsContainerSecurityContext{} }
 func (*WindowsContainerSecurityContext) ProtoMessage() {}
 func (*WindowsContainerSecurityContext) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{38}
+	return fileDescriptorApi, []int{41}
 }
 
 func (m *WindowsContainerSecurityContext) GetRunAsUsername() string {
@@ -1890,7 +2050,7 @@ type WindowsContainerConfig struct {
 
 func (m *WindowsContainerConfig) Reset()                    { *m = WindowsContainerConfig{} }
 func (*WindowsContainerConfig) ProtoMessage()               {}
-func (*WindowsContainerConfig) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{39} }
+func (*WindowsContainerConfig) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{42} }
 
 func (m *WindowsContainerConfig) GetResources() *WindowsContainerResources {
 	if m != nil {
@@ -1921,7 +2081,7 @@ type WindowsContainerResources struct {
 
 func (m *WindowsContainerResources) Reset()                    { *m = WindowsContainerResources{} }
 func (*WindowsContainerResources) ProtoMessage()               {}
-func (*WindowsContainerResources) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{40} }
+func (*WindowsContainerResources) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{43} }
 
 func (m *WindowsContainerResources) GetCpuShares() int64 {
 	if m != nil {
@@ -1965,7 +2125,7 @@ type ContainerMetadata struct {
 
 func (m *ContainerMetadata) Reset()                    { *m = ContainerMetadata{} }
 func (*ContainerMetadata) ProtoMessage()               {}
-func (*ContainerMetadata) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{41} }
+func (*ContainerMetadata) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{44} }
 
 func (m *ContainerMetadata) GetName() string {
 	if m != nil {
@@ -1996,7 +2156,7 @@ type Device struct {
 
 func (m *Device) Reset()                    { *m = Device{} }
 func (*Device) ProtoMessage()               {}
-func (*Device) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{42} }
+func (*Device) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{45} }
 
 func (m *Device) GetContainerPath() string {
 	if m != nil {
@@ -2089,7 +2249,7 @@ type ContainerConfig struct {
 
 func (m *ContainerConfig) Reset()                    { *m = ContainerConfig{} }
 func (*ContainerConfig) ProtoMessage()               {}
-func (*ContainerConfig) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{43} }
+func (*ContainerConfig) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{46} }
 
 func (m *ContainerConfig) GetMetadata() *ContainerMetadata {
 	if m != nil {
@@ -2231,7 +2391,7 @@ type CreateContainerRequest struct {
 
 func (m *CreateContainerRequest) Reset()                    { *m = CreateContainerRequest{} }
 func (*CreateContainerRequest) ProtoMessage()               {}
-func (*CreateContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{44} }
+func (*CreateContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{47} }
 
 func (m *CreateContainerRequest) GetPodSandboxId() string {
 	if m != nil {
@@ -2261,7 +2421,7 @@ type CreateContainerResponse struct {
 
 func (m *CreateContainerResponse) Reset()                    { *m = CreateContainerResponse{} }
 func (*CreateContainerResponse) ProtoMessage()               {}
-func (*CreateContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{45} }
+func (*CreateContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{48} }
 
 func (m *CreateContainerResponse) GetContainerId() string {
 	if m != nil {
@@ -2277,7 +2437,7 @@ type StartContainerRequest struct {
 
 func (m *StartContainerRequest) Reset()                    { *m = StartContainerRequest{} }
 func (*StartContainerRequest) ProtoMessage()               {}
-func (*StartContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{46} }
+func (*StartContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{49} }
 
 func (m *StartContainerRequest) GetContainerId() string {
 	if m != nil {
@@ -2291,7 +2451,7 @@ type StartContainerResponse struct {
 
 func (m *StartContainerResponse) Reset()                    { *m = StartContainerResponse{} }
 func (*StartContainerResponse) ProtoMessage()               {}
-func (*StartContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{47} }
+func (*StartContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{50} }
 
 type StopContainerRequest struct {
 	// ID of the container to stop.
@@ -2303,7 +2463,7 @@ type StopContainerRequest struct {
 
 func (m *StopContainerRequest) Reset()                    { *m = StopContainerRequest{} }
 func (*StopContainerRequest) ProtoMessage()               {}
-func (*StopContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{48} }
+func (*StopContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{51} }
 
 func (m *StopContainerRequest) GetContainerId() string {
 	if m != nil {
@@ -2324,7 +2484,7 @@ type StopContainerResponse struct {
 
 func (m *StopContainerResponse) Reset()                    { *m = StopContainerResponse{} }
 func (*StopContainerResponse) ProtoMessage()               {}
-func (*StopContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{49} }
+func (*StopContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{52} }
 
 type RemoveContainerRequest struct {
 	// ID of the container to remove.
@@ -2333,7 +2493,7 @@ type RemoveContainerRequest struct {
 
 func (m *RemoveContainerRequest) Reset()                    { *m = RemoveContainerRequest{} }
 func (*RemoveContainerRequest) ProtoMessage()               {}
-func (*RemoveContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{50} }
+func (*RemoveContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{53} }
 
 func (m *RemoveContainerRequest) GetContainerId() string {
 	if m != nil {
@@ -2347,7 +2507,7 @@ type RemoveContainerResponse struct {
 
 func (m *RemoveContainerResponse) Reset()                    { *m = RemoveContainerResponse{} }
 func (*RemoveContainerResponse) ProtoMessage()               {}
-func (*RemoveContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{51} }
+func (*RemoveContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{54} }
 
 // ContainerStateValue is the wrapper of ContainerState.
 type ContainerStateValue struct {
@@ -2357,7 +2517,7 @@ type ContainerStateValue struct {
 
 func (m *ContainerStateValue) Reset()                    { *m = ContainerStateValue{} }
 func (*ContainerStateValue) ProtoMessage()               {}
-func (*ContainerStateValue) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{52} }
+func (*ContainerStateValue) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{55} }
 
 func (m *ContainerStateValue) GetState() ContainerState {
 	if m != nil {
@@ -2383,7 +2543,7 @@ type ContainerFilter struct {
 
 func (m *ContainerFilter) Reset()                    { *m = ContainerFilter{} }
 func (*ContainerFilter) ProtoMessage()               {}
-func (*ContainerFilter) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{53} }
+func (*ContainerFilter) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{56} }
 
 func (m *ContainerFilter) GetId() string {
 	if m != nil {
@@ -2419,7 +2579,7 @@ type ListContainersRequest struct {
 
 func (m *ListContainersRequest) Reset()                    { *m = ListContainersRequest{} }
 func (*ListContainersRequest) ProtoMessage()               {}
-func (*ListContainersRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{54} }
+func (*ListContainersRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{57} }
 
 func (m *ListContainersRequest) GetFilter() *ContainerFilter {
 	if m != nil {
@@ -2458,7 +2618,7 @@ type Container struct {
 
 func (m *Container) Reset()                    { *m = Container{} }
 func (*Container) ProtoMessage()               {}
-func (*Container) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{55} }
+func (*Container) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{58} }
 
 func (m *Container) GetId() string {
 	if m != nil {
@@ -2530,7 +2690,7 @@ type ListContainersResponse struct {
 
 func (m *ListContainersResponse) Reset()                    { *m = ListContainersResponse{} }
 func (*ListContainersResponse) ProtoMessage()               {}
-func (*ListContainersResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{56} }
+func (*ListContainersResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{59} }
 
 func (m *ListContainersResponse) GetContainers() []*Container {
 	if m != nil {
@@ -2548,7 +2708,7 @@ type ContainerStatusRequest struct {
 
 func (m *ContainerStatusRequest) Reset()                    { *m = ContainerStatusRequest{} }
 func (*ContainerStatusRequest) ProtoMessage()               {}
-func (*ContainerStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{57} }
+func (*ContainerStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{60} }
 
 func (m *ContainerStatusRequest) GetContainerId() string {
 	if m != nil {
@@ -2613,7 +2773,7 @@ type ContainerStatus struct {
 
 func (m *ContainerStatus) Reset()                    { *m = ContainerStatus{} }
 func (*ContainerStatus) ProtoMessage()               {}
-func (*ContainerStatus) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{58} }
+func (*ContainerStatus) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{61} }
 
 func (m *ContainerStatus) GetId() string {
 	if m != nil {
@@ -2753,7 +2913,7 @@ type Volume struct {
 
 func (m *Volume) Reset()                    { *m = Volume{} }
 func (*Volume) ProtoMessage()               {}
-func (*Volume) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{59} }
+func (*Volume) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{62} }
 
 type ContainerStatusResponse struct {
 	// Status of the container.
@@ -2767,7 +2927,7 @@ type ContainerStatusResponse struct {
 
 func (m *ContainerStatusResponse) Reset()                    { *m = ContainerStatusResponse{} }
 func (*ContainerStatusResponse) ProtoMessage()               {}
-func (*ContainerStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{60} }
+func (*ContainerStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{63} }
 
 func (m *ContainerStatusResponse) GetStatus() *ContainerStatus {
 	if m != nil {
@@ -2797,7 +2957,7 @@ type UpdateContainerResourcesRequest struct {
 func (m *UpdateContainerResourcesRequest) Reset()      { *m = UpdateContainerResourcesRequest{} }
 func (*UpdateContainerResourcesRequest) ProtoMessage() {}
 func (*UpdateContainerResourcesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{61}
+	return fileDescriptorApi, []int{64}
 }
 
 func (m *UpdateContainerResourcesRequest) GetContainerId() string {
@@ -2827,7 +2987,7 @@ type UpdateContainerResourcesResponse struct {
 func (m *UpdateContainerResourcesResponse) Reset()      { *m = UpdateContainerResourcesResponse{} }
 func (*UpdateContainerResourcesResponse) ProtoMessage() {}
 func (*UpdateContainerResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{62}
+	return fileDescriptorApi, []int{65}
 }
 
 type ExecSyncRequest struct {
@@ -2841,7 +3001,7 @@ type ExecSyncRequest struct {
 
 func (m *ExecSyncRequest) Reset()                    { *m = ExecSyncRequest{} }
 func (*ExecSyncRequest) ProtoMessage()               {}
-func (*ExecSyncRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{63} }
+func (*ExecSyncRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{66} }
 
 func (m *ExecSyncRequest) GetContainerId() string {
 	if m != nil {
@@ -2875,7 +3035,7 @@ type ExecSyncResponse struct {
 
 func (m *ExecSyncResponse) Reset()                    { *m = ExecSyncResponse{} }
 func (*ExecSyncResponse) ProtoMessage()               {}
-func (*ExecSyncResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{64} }
+func (*ExecSyncResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{67} }
 
 func (m *ExecSyncResponse) GetStdout() []byte {
 	if m != nil {
@@ -2921,7 +3081,7 @@ type ExecRequest struct {
 
 func (m *ExecRequest) Reset()                    { *m = ExecRequest{} }
 func (*ExecRequest) ProtoMessage()               {}
-func (*ExecRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{65} }
+func (*ExecRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{68} }
 
 func (m *ExecRequest) GetContainerId() string {
 	if m != nil {
@@ -2972,7 +3132,7 @@ type ExecResponse struct {
 
 func (m *ExecResponse) Reset()                    { *m = ExecResponse{} }
 func (*ExecResponse) ProtoMessage()               {}
-func (*ExecResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{66} }
+func (*ExecResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{69} }
 
 func (m *ExecResponse) GetUrl() string {
 	if m != nil {
@@ -3003,7 +3163,7 @@ type AttachRequest struct {
 
 func (m *AttachRequest) Reset()                    { *m = AttachRequest{} }
 func (*AttachRequest) ProtoMessage()               {}
-func (*AttachRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{67} }
+func (*AttachRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{70} }
 
 func (m *AttachRequest) GetContainerId() string {
 	if m != nil {
@@ -3047,7 +3207,7 @@ type AttachResponse struct {
 
 func (m *AttachResponse) Reset()                    { *m = AttachResponse{} }
 func (*AttachResponse) ProtoMessage()               {}
-func (*AttachResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{68} }
+func (*AttachResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{71} }
 
 func (m *AttachResponse) GetUrl() string {
 	if m != nil {
@@ -3065,7 +3225,7 @@ type PortForwardRequest struct {
 
 func (m *PortForwardRequest) Reset()                    { *m = PortForwardRequest{} }
 func (*PortForwardRequest) ProtoMessage()               {}
-func (*PortForwardRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{69} }
+func (*PortForwardRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{72} }
 
 func (m *PortForwardRequest) GetPodSandboxId() string {
 	if m != nil {
@@ -3088,7 +3248,7 @@ type PortForwardResponse struct {
 
 func (m *PortForwardResponse) Reset()                    { *m = PortForwardResponse{} }
 func (*PortForwardResponse) ProtoMessage()               {}
-func (*PortForwardResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{70} }
+func (*PortForwardResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{73} }
 
 func (m *PortForwardResponse) GetUrl() string {
 	if m != nil {
@@ -3104,7 +3264,7 @@ type ImageFilter struct {
 
 func (m *ImageFilter) Reset()                    { *m = ImageFilter{} }
 func (*ImageFilter) ProtoMessage()               {}
-func (*ImageFilter) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{71} }
+func (*ImageFilter) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{74} }
 
 func (m *ImageFilter) GetImage() *ImageSpec {
 	if m != nil {
@@ -3120,7 +3280,7 @@ type ListImagesRequest struct {
 
 func (m *ListImagesRequest) Reset()                    { *m = ListImagesRequest{} }
 func (*ListImagesRequest) ProtoMessage()               {}
-func (*ListImagesRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{72} }
+func (*ListImagesRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{75} }
 
 func (m *ListImagesRequest) GetFilter() *ImageFilter {
 	if m != nil {
@@ -3152,7 +3312,7 @@ type Image struct {
 
 func (m *Image) Reset()                    { *m = Image{} }
 func (*Image) ProtoMessage()               {}
-func (*Image) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{73} }
+func (*Image) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{76} }
 
 func (m *Image) GetId() string {
 	if m != nil {
@@ -3210,7 +3370,7 @@ type ListImagesResponse struct {
 
 func (m *ListImagesResponse) Reset()                    { *m = ListImagesResponse{} }
 func (*ListImagesResponse) ProtoMessage()               {}
-func (*ListImagesResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{74} }
+func (*ListImagesResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{77} }
 
 func (m *ListImagesResponse) GetImages() []*Image {
 	if m != nil {
@@ -3228,7 +3388,7 @@ type ImageStatusRequest struct {
 
 func (m *ImageStatusRequest) Reset()                    { *m = ImageStatusRequest{} }
 func (*ImageStatusRequest) ProtoMessage()               {}
-func (*ImageStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{75} }
+func (*ImageStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{78} }
 
 func (m *ImageStatusRequest) GetImage() *ImageSpec {
 	if m != nil {
@@ -3256,7 +3416,7 @@ type ImageStatusResponse struct {
 
 func (m *ImageStatusResponse) Reset()                    { *m = ImageStatusResponse{} }
 func (*ImageStatusResponse) ProtoMessage()               {}
-func (*ImageStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{76} }
+func (*ImageStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{79} }
 
 func (m *ImageStatusResponse) GetImage() *Image {
 	if m != nil {
@@ -3287,7 +3447,7 @@ type AuthConfig struct {
 
 func (m *AuthConfig) Reset()                    { *m = AuthConfig{} }
 func (*AuthConfig) ProtoMessage()               {}
-func (*AuthConfig) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{77} }
+func (*AuthConfig) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{80} }
 
 func (m *AuthConfig) GetUsername() string {
 	if m != nil {
@@ -3342,7 +3502,7 @@ type PullImageRequest struct {
 
 func (m *PullImageRequest) Reset()                    { *m = PullImageRequest{} }
 func (*PullImageRequest) ProtoMessage()               {}
-func (*PullImageRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{78} }
+func (*PullImageRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{81} }
 
 func (m *PullImageRequest) GetImage() *ImageSpec {
 	if m != nil {
@@ -3373,7 +3533,7 @@ type PullImageResponse struct {
 
 func (m *PullImageResponse) Reset()                    { *m = PullImageResponse{} }
 func (*PullImageResponse) ProtoMessage()               {}
-func (*PullImageResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{79} }
+func (*PullImageResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{82} }
 
 func (m *PullImageResponse) GetImageRef() string {
 	if m != nil {
@@ -3389,7 +3549,7 @@ type RemoveImageRequest struct {
 
 func (m *RemoveImageRequest) Reset()                    { *m = RemoveImageRequest{} }
 func (*RemoveImageRequest) ProtoMessage()               {}
-func (*RemoveImageRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{80} }
+func (*RemoveImageRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{83} }
 
 func (m *RemoveImageRequest) GetImage() *ImageSpec {
 	if m != nil {
@@ -3403,7 +3563,7 @@ type RemoveImageResponse struct {
 
 func (m *RemoveImageResponse) Reset()                    { *m = RemoveImageResponse{} }
 func (*RemoveImageResponse) ProtoMessage()               {}
-func (*RemoveImageResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{81} }
+func (*RemoveImageResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{84} }
 
 type NetworkConfig struct {
 	// CIDR to use for pod IP addresses. If the CIDR is empty, runtimes
@@ -3413,7 +3573,7 @@ type NetworkConfig struct {
 
 func (m *NetworkConfig) Reset()                    { *m = NetworkConfig{} }
 func (*NetworkConfig) ProtoMessage()               {}
-func (*NetworkConfig) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{82} }
+func (*NetworkConfig) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{85} }
 
 func (m *NetworkConfig) GetPodCidr() string {
 	if m != nil {
@@ -3428,7 +3588,7 @@ type RuntimeConfig struct {
 
 func (m *RuntimeConfig) Reset()                    { *m = RuntimeConfig{} }
 func (*RuntimeConfig) ProtoMessage()               {}
-func (*RuntimeConfig) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{83} }
+func (*RuntimeConfig) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{86} }
 
 func (m *RuntimeConfig) GetNetworkConfig() *NetworkConfig {
 	if m != nil {
@@ -3443,7 +3603,7 @@ type UpdateRuntimeConfigRequest struct {
 
 func (m *UpdateRuntimeConfigRequest) Reset()                    { *m = UpdateRuntimeConfigRequest{} }
 func (*UpdateRuntimeConfigRequest) ProtoMessage()               {}
-func (*UpdateRuntimeConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{84} }
+func (*UpdateRuntimeConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{87} }
 
 func (m *UpdateRuntimeConfigRequest) GetRuntimeConfig() *RuntimeConfig {
 	if m != nil {
@@ -3457,7 +3617,7 @@ type UpdateRuntimeConfigResponse struct {
 
 func (m *UpdateRuntimeConfigResponse) Reset()                    { *m = UpdateRuntimeConfigResponse{} }
 func (*UpdateRuntimeConfigResponse) ProtoMessage()               {}
-func (*UpdateRuntimeConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{85} }
+func (*UpdateRuntimeConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{88} }
 
 // RuntimeCondition contains condition information for the runtime.
 // There are 2 kinds of runtime conditions:
@@ -3485,7 +3645,7 @@ type RuntimeCondition struct {
 
 func (m *RuntimeCondition) Reset()                    { *m = RuntimeCondition{} }
 func (*RuntimeCondition) ProtoMessage()               {}
-func (*RuntimeCondition) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{86} }
+func (*RuntimeCondition) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{89} }
 
 func (m *RuntimeCondition) GetType() string {
 	if m != nil {
@@ -3523,7 +3683,7 @@ type RuntimeStatus struct {
 
 func (m *RuntimeStatus) Reset()                    { *m = RuntimeStatus{} }
 func (*RuntimeStatus) ProtoMessage()               {}
-func (*RuntimeStatus) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{87} }
+func (*RuntimeStatus) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{90} }
 
 func (m *RuntimeStatus) GetConditions() []*RuntimeCondition {
 	if m != nil {
@@ -3539,7 +3699,7 @@ type StatusRequest struct {
 
 func (m *StatusRequest) Reset()                    { *m = StatusRequest{} }
 func (*StatusRequest) ProtoMessage()               {}
-func (*StatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{88} }
+func (*StatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{91} }
 
 func (m *StatusRequest) GetVerbose() bool {
 	if m != nil {
@@ -3560,7 +3720,7 @@ type StatusResponse struct {
 
 func (m *StatusResponse) Reset()                    { *m = StatusResponse{} }
 func (*StatusResponse) ProtoMessage()               {}
-func (*StatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{89} }
+func (*StatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{92} }
 
 func (m *StatusResponse) GetStatus() *RuntimeStatus {
 	if m != nil {
@@ -3581,7 +3741,7 @@ type ImageFsInfoRequest struct {
 
 func (m *ImageFsInfoRequest) Reset()                    { *m = ImageFsInfoRequest{} }
 func (*ImageFsInfoRequest) ProtoMessage()               {}
-func (*ImageFsInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{90} }
+func (*ImageFsInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{93} }
 
 // UInt64Value is the wrapper of uint64.
 type UInt64Value struct {
@@ -3591,7 +3751,7 @@ type UInt64Value struct {
 
 func (m *UInt64Value) Reset()                    { *m = UInt64Value{} }
 func (*UInt64Value) ProtoMessage()               {}
-func (*UInt64Value) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{91} }
+func (*UInt64Value) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{94} }
 
 func (m *UInt64Value) GetValue() uint64 {
 	if m != nil {
@@ -3608,7 +3768,7 @@ type FilesystemIdentifier struct {
 
 func (m *FilesystemIdentifier) Reset()                    { *m = FilesystemIdentifier{} }
 func (*FilesystemIdentifier) ProtoMessage()               {}
-func (*FilesystemIdentifier) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{92} }
+func (*FilesystemIdentifier) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{95} }
 
 func (m *FilesystemIdentifier) GetMountpoint() string {
 	if m != nil {
@@ -3635,7 +3795,7 @@ type FilesystemUsage struct {
 
 func (m *FilesystemUsage) Reset()                    { *m = FilesystemUsage{} }
 func (*FilesystemUsage) ProtoMessage()               {}
-func (*FilesystemUsage) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{93} }
+func (*FilesystemUsage) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{96} }
 
 func (m *FilesystemUsage) GetTimestamp() int64 {
 	if m != nil {
@@ -3672,7 +3832,7 @@ type ImageFsInfoResponse struct {
 
 func (m *ImageFsInfoResponse) Reset()                    { *m = ImageFsInfoResponse{} }
 func (*ImageFsInfoResponse) ProtoMessage()               {}
-func (*ImageFsInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{94} }
+func (*ImageFsInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{97} }
 
 func (m *ImageFsInfoResponse) GetImageFilesystems() []*FilesystemUsage {
 	if m != nil {
@@ -3688,7 +3848,7 @@ type ContainerStatsRequest struct {
 
 func (m *ContainerStatsRequest) Reset()                    { *m = ContainerStatsRequest{} }
 func (*ContainerStatsRequest) ProtoMessage()               {}
-func (*ContainerStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{95} }
+func (*ContainerStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{98} }
 
 func (m *ContainerStatsRequest) GetContainerId() string {
 	if m != nil {
@@ -3704,7 +3864,7 @@ type ContainerStatsResponse struct {
 
 func (m *ContainerStatsResponse) Reset()                    { *m = ContainerStatsResponse{} }
 func (*ContainerStatsResponse) ProtoMessage()               {}
-func (*ContainerStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{96} }
+func (*ContainerStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{99} }
 
 func (m *ContainerStatsResponse) GetStats() *ContainerStats {
 	if m != nil {
@@ -3720,7 +3880,7 @@ type ListContainerStatsRequest struct {
 
 func (m *ListContainerStatsRequest) Reset()                    { *m = ListContainerStatsRequest{} }
 func (*ListContainerStatsRequest) ProtoMessage()               {}
-func (*ListContainerStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{97} }
+func (*ListContainerStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{100} }
 
 func (m *ListContainerStatsRequest) GetFilter() *ContainerStatsFilter {
 	if m != nil {
@@ -3744,7 +3904,7 @@ type ContainerStatsFilter struct {
 
 func (m *ContainerStatsFilter) Reset()                    { *m = ContainerStatsFilter{} }
 func (*ContainerStatsFilter) ProtoMessage()               {}
-func (*ContainerStatsFilter) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{98} }
+func (*ContainerStatsFilter) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{101} }
 
 func (m *ContainerStatsFilter) GetId() string {
 	if m != nil {
@@ -3774,7 +3934,7 @@ type ListContainerStatsResponse struct {
 
 func (m *ListContainerStatsResponse) Reset()                    { *m = ListContainerStatsResponse{} }
 func (*ListContainerStatsResponse) ProtoMessage()               {}
-func (*ListContainerStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{99} }
+func (*ListContainerStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{102} }
 
 func (m *ListContainerStatsResponse) GetStats() []*ContainerStats {
 	if m != nil {
@@ -3800,7 +3960,7 @@ type ContainerAttributes struct {
 
 func (m *ContainerAttributes) Reset()                    { *m = ContainerAttributes{} }
 func (*ContainerAttributes) ProtoMessage()               {}
-func (*ContainerAttributes) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{100} }
+func (*ContainerAttributes) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{103} }
 
 func (m *ContainerAttributes) GetId() string {
 	if m != nil {
@@ -3844,7 +4004,7 @@ type ContainerStats struct {
 
 func (m *ContainerStats) Reset()                    { *m = ContainerStats{} }
 func (*ContainerStats) ProtoMessage()               {}
-func (*ContainerStats) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{101} }
+func (*ContainerStats) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{104} }
 
 func (m *ContainerStats) GetAttributes() *ContainerAttributes {
 	if m != nil {
@@ -3884,7 +4044,7 @@ type CpuUsage struct {
 
 func (m *CpuUsage) Reset()                    { *m = CpuUsage{} }
 func (*CpuUsage) ProtoMessage()               {}
-func (*CpuUsage) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{102} }
+func (*CpuUsage) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{105} }
 
 func (m *CpuUsage) GetTimestamp() int64 {
 	if m != nil {
@@ -3910,7 +4070,7 @@ type MemoryUsage struct {
 
 func (m *MemoryUsage) Reset()                    { *m = MemoryUsage{} }
 func (*MemoryUsage) ProtoMessage()               {}
-func (*MemoryUsage) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{103} }
+func (*MemoryUsage) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{106} }
 
 func (m *MemoryUsage) GetTimestamp() int64 {
 	if m != nil {
@@ -3926,128 +4086,586 @@ func (m *MemoryUsage) GetWorkingSetBytes() *UInt64Value {
 	return nil
 }
 
-type ReopenContainerLogRequest struct {
-	// ID of the container for which to reopen the log.
-	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+type PodSandboxStatsRequest struct {
+	// ID of the pod sandbox for which to retrieve stats.
+	PodSandboxId string `protobuf:"bytes,1,opt,name=pod_sandbox_id,json=podSandboxId,proto3" json:"pod_sandbox_id,omitempty"`
 }
 
-func (m *ReopenContainerLogRequest) Reset()                    { *m = ReopenContainerLogRequest{} }
-func (*ReopenContainerLogRequest) ProtoMessage()               {}
-func (*ReopenContainerLogRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{104} }
+func (m *PodSandboxStatsRequest) Reset()                    { *m = PodSandboxStatsRequest{} }
+func (*PodSandboxStatsRequest) ProtoMessage()               {}
+func (*PodSandboxStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{107} }
 
-func (m *ReopenContainerLogRequest) GetContainerId() string {
+func (m *PodSandboxStatsRequest) GetPodSandboxId() string {
 	if m != nil {
-		return m.ContainerId
+		return m.PodSandboxId
 	}
 	return ""
 }
 
-type ReopenContainerLogResponse struct {
+type PodSandboxStatsResponse struct {
+	Stats *PodSandboxStats `protobuf:"bytes,1,opt,name=stats" json:"stats,omitempty"`
 }
 
-func (m *ReopenContainerLogResponse) Reset()                    { *m = ReopenContainerLogResponse{} }
-func (*ReopenContainerLogResponse) ProtoMessage()               {}
-func (*ReopenContainerLogResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{105} }
+func (m *PodSandboxStatsResponse) Reset()                    { *m = PodSandboxStatsResponse{} }
+func (*PodSandboxStatsResponse) ProtoMessage()               {}
+func (*PodSandboxStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{108} }
 
-type PauseContainerRequest struct {
-	// ID of the container to pause.
-	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+func (m *PodSandboxStatsResponse) GetStats() *PodSandboxStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
 }
 
-func (m *PauseContainerRequest) Reset()                    { *m = PauseContainerRequest{} }
-func (*PauseContainerRequest) ProtoMessage()               {}
-func (*PauseContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{106} }
+// PodSandboxStatsFilter is used to filter pod sandboxes.
+// All those fields are combined with 'AND'.
+type PodSandboxStatsFilter struct {
+	// ID of the pod sandbox.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// LabelSelector to select matches.
+	// Only api.MatchLabels is supported for now and the requirements
+	// are ANDed. MatchExpressions is not supported yet.
+	LabelSelector map[string]string `protobuf:"bytes,2,rep,name=label_selector,json=labelSelector" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
 
-func (m *PauseContainerRequest) GetContainerId() string {
+func (m *PodSandboxStatsFilter) Reset()                    { *m = PodSandboxStatsFilter{} }
+func (*PodSandboxStatsFilter) ProtoMessage()               {}
+func (*PodSandboxStatsFilter) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{109} }
+
+func (m *PodSandboxStatsFilter) GetId() string {
 	if m != nil {
-		return m.ContainerId
+		return m.Id
 	}
 	return ""
 }
 
-type PauseContainerResponse struct {
+func (m *PodSandboxStatsFilter) GetLabelSelector() map[string]string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return nil
 }
 
-func (m *PauseContainerResponse) Reset()                    { *m = PauseContainerResponse{} }
-func (*PauseContainerResponse) ProtoMessage()               {}
-func (*PauseContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{107} }
+type ListPodSandboxStatsRequest struct {
+	// Filter for the list request.
+	Filter *PodSandboxStatsFilter `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+}
 
-type UnpauseContainerRequest struct {
-	// ID of the container to unpause.
-	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+func (m *ListPodSandboxStatsRequest) Reset()                    { *m = ListPodSandboxStatsRequest{} }
+func (*ListPodSandboxStatsRequest) ProtoMessage()               {}
+func (*ListPodSandboxStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{110} }
+
+func (m *ListPodSandboxStatsRequest) GetFilter() *PodSandboxStatsFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
 }
 
-func (m *UnpauseContainerRequest) Reset()                    { *m = UnpauseContainerRequest{} }
-func (*UnpauseContainerRequest) ProtoMessage()               {}
-func (*UnpauseContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{108} }
+type ListPodSandboxStatsResponse struct {
+	// Stats of the pod sandbox.
+	Stats []*PodSandboxStats `protobuf:"bytes,1,rep,name=stats" json:"stats,omitempty"`
+}
 
-func (m *UnpauseContainerRequest) GetContainerId() string {
+func (m *ListPodSandboxStatsResponse) Reset()      { *m = ListPodSandboxStatsResponse{} }
+func (*ListPodSandboxStatsResponse) ProtoMessage() {}
+func (*ListPodSandboxStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{111}
+}
+
+func (m *ListPodSandboxStatsResponse) GetStats() []*PodSandboxStats {
 	if m != nil {
-		return m.ContainerId
+		return m.Stats
+	}
+	return nil
+}
+
+// PodSandboxAttributes provides basic information of the pod sandbox.
+type PodSandboxAttributes struct {
+	// ID of the pod sandbox.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Metadata of the pod sandbox.
+	Metadata *PodSandboxMetadata `protobuf:"bytes,2,opt,name=metadata" json:"metadata,omitempty"`
+	// Key-value pairs that may be used to scope and select individual resources.
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Unstructured key-value map holding arbitrary metadata.
+	// Annotations MUST NOT be altered by the runtime; the value of this field
+	// MUST be identical to that of the corresponding PodSandboxStatus used to
+	// instantiate the PodSandbox this status represents.
+	Annotations map[string]string `protobuf:"bytes,4,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *PodSandboxAttributes) Reset()                    { *m = PodSandboxAttributes{} }
+func (*PodSandboxAttributes) ProtoMessage()               {}
+func (*PodSandboxAttributes) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{112} }
+
+func (m *PodSandboxAttributes) GetId() string {
+	if m != nil {
+		return m.Id
 	}
 	return ""
 }
 
-type UnpauseContainerResponse struct {
+func (m *PodSandboxAttributes) GetMetadata() *PodSandboxMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
 }
 
-func (m *UnpauseContainerResponse) Reset()                    { *m = UnpauseContainerResponse{} }
-func (*UnpauseContainerResponse) ProtoMessage()               {}
-func (*UnpauseContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{109} }
+func (m *PodSandboxAttributes) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
 
-type RemoveVolumeRequest struct {
-	// Name of the volume to remove
-	VolumeName string `protobuf:"bytes,1,opt,name=volume_name,json=volumeName,proto3" json:"volume_name,omitempty"`
+func (m *PodSandboxAttributes) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
 }
 
-func (m *RemoveVolumeRequest) Reset()                    { *m = RemoveVolumeRequest{} }
-func (*RemoveVolumeRequest) ProtoMessage()               {}
-func (*RemoveVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{110} }
+// PodSandboxStats provides the resource usage statistics for a pod.
+// The linux or windows field will be populated depending on the platform.
+type PodSandboxStats struct {
+	// Information of the pod.
+	Attributes *PodSandboxAttributes `protobuf:"bytes,1,opt,name=attributes" json:"attributes,omitempty"`
+	// Stats from linux.
+	Linux *LinuxPodSandboxStats `protobuf:"bytes,2,opt,name=linux" json:"linux,omitempty"`
+	// Stats from windows.
+	Windows *WindowsPodSandboxStats `protobuf:"bytes,3,opt,name=windows" json:"windows,omitempty"`
+}
 
-func (m *RemoveVolumeRequest) GetVolumeName() string {
+func (m *PodSandboxStats) Reset()                    { *m = PodSandboxStats{} }
+func (*PodSandboxStats) ProtoMessage()               {}
+func (*PodSandboxStats) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{113} }
+
+func (m *PodSandboxStats) GetAttributes() *PodSandboxAttributes {
 	if m != nil {
-		return m.VolumeName
+		return m.Attributes
 	}
-	return ""
+	return nil
 }
 
-type RemoveVolumeResponse struct {
+func (m *PodSandboxStats) GetLinux() *LinuxPodSandboxStats {
+	if m != nil {
+		return m.Linux
+	}
+	return nil
 }
 
-func (m *RemoveVolumeResponse) Reset()                    { *m = RemoveVolumeResponse{} }
-func (*RemoveVolumeResponse) ProtoMessage()               {}
-func (*RemoveVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{111} }
+func (m *PodSandboxStats) GetWindows() *WindowsPodSandboxStats {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
 
-type StartPodSandboxRequest struct {
-	// ID of the PodSandbox to start.
-	PodSandboxId string `protobuf:"bytes,1,opt,name=pod_sandbox_id,json=podSandboxId,proto3" json:"pod_sandbox_id,omitempty"`
+// LinuxPodSandboxStats provides the resource usage statistics for a pod sandbox on linux.
+type LinuxPodSandboxStats struct {
+	// CPU usage gathered for the pod sandbox.
+	Cpu *CpuUsage `protobuf:"bytes,1,opt,name=cpu" json:"cpu,omitempty"`
+	// Memory usage gathered for the pod sandbox.
+	Memory *MemoryUsage `protobuf:"bytes,2,opt,name=memory" json:"memory,omitempty"`
+	// Network usage gathered for the pod sandbox.
+	Network *NetworkUsage `protobuf:"bytes,3,opt,name=network" json:"network,omitempty"`
+	// Stats pertaining to processes in the pod sandbox.
+	Process *ProcessUsage `protobuf:"bytes,4,opt,name=process" json:"process,omitempty"`
+	// Stats of containers in the measured pod sandbox.
+	Containers []*ContainerStats `protobuf:"bytes,5,rep,name=containers" json:"containers,omitempty"`
 }
 
-func (m *StartPodSandboxRequest) Reset()                    { *m = StartPodSandboxRequest{} }
-func (*StartPodSandboxRequest) ProtoMessage()               {}
-func (*StartPodSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{112} }
+func (m *LinuxPodSandboxStats) Reset()                    { *m = LinuxPodSandboxStats{} }
+func (*LinuxPodSandboxStats) ProtoMessage()               {}
+func (*LinuxPodSandboxStats) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{114} }
 
-func (m *StartPodSandboxRequest) GetPodSandboxId() string {
+func (m *LinuxPodSandboxStats) GetCpu() *CpuUsage {
 	if m != nil {
-		return m.PodSandboxId
+		return m.Cpu
+	}
+	return nil
+}
+
+func (m *LinuxPodSandboxStats) GetMemory() *MemoryUsage {
+	if m != nil {
+		return m.Memory
+	}
+	return nil
+}
+
+func (m *LinuxPodSandboxStats) GetNetwork() *NetworkUsage {
+	if m != nil {
+		return m.Network
+	}
+	return nil
+}
+
+func (m *LinuxPodSandboxStats) GetProcess() *ProcessUsage {
+	if m != nil {
+		return m.Process
+	}
+	return nil
+}
+
+func (m *LinuxPodSandboxStats) GetContainers() []*ContainerStats {
+	if m != nil {
+		return m.Containers
+	}
+	return nil
+}
+
+// WindowsPodSandboxStats provides the resource usage statistics for a pod sandbox on windows.
+type WindowsPodSandboxStats struct {
+}
+
+func (m *WindowsPodSandboxStats) Reset()                    { *m = WindowsPodSandboxStats{} }
+func (*WindowsPodSandboxStats) ProtoMessage()               {}
+func (*WindowsPodSandboxStats) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{115} }
+
+// NetworkUsage contains data about network resources.
+type NetworkUsage struct {
+	// The time at which these stats were updated.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Stats for the default network interface.
+	DefaultInterface *NetworkInterfaceUsage `protobuf:"bytes,2,opt,name=default_interface,json=defaultInterface" json:"default_interface,omitempty"`
+	// Stats for all found network interfaces, excluding the default.
+	Interfaces []*NetworkInterfaceUsage `protobuf:"bytes,3,rep,name=interfaces" json:"interfaces,omitempty"`
+}
+
+func (m *NetworkUsage) Reset()                    { *m = NetworkUsage{} }
+func (*NetworkUsage) ProtoMessage()               {}
+func (*NetworkUsage) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{116} }
+
+func (m *NetworkUsage) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *NetworkUsage) GetDefaultInterface() *NetworkInterfaceUsage {
+	if m != nil {
+		return m.DefaultInterface
+	}
+	return nil
+}
+
+func (m *NetworkUsage) GetInterfaces() []*NetworkInterfaceUsage {
+	if m != nil {
+		return m.Interfaces
+	}
+	return nil
+}
+
+// NetworkInterfaceUsage contains resource value data about a network interface.
+type NetworkInterfaceUsage struct {
+	// The name of the network interface.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Cumulative count of bytes received.
+	RxBytes *UInt64Value `protobuf:"bytes,2,opt,name=rx_bytes,json=rxBytes" json:"rx_bytes,omitempty"`
+	// Cumulative count of receive errors encountered.
+	RxErrors *UInt64Value `protobuf:"bytes,3,opt,name=rx_errors,json=rxErrors" json:"rx_errors,omitempty"`
+	// Cumulative count of bytes transmitted.
+	TxBytes *UInt64Value `protobuf:"bytes,4,opt,name=tx_bytes,json=txBytes" json:"tx_bytes,omitempty"`
+	// Cumulative count of transmit errors encountered.
+	TxErrors *UInt64Value `protobuf:"bytes,5,opt,name=tx_errors,json=txErrors" json:"tx_errors,omitempty"`
+}
+
+func (m *NetworkInterfaceUsage) Reset()                    { *m = NetworkInterfaceUsage{} }
+func (*NetworkInterfaceUsage) ProtoMessage()               {}
+func (*NetworkInterfaceUsage) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{117} }
+
+func (m *NetworkInterfaceUsage) GetName() string {
+	if m != nil {
+		return m.Name
 	}
 	return ""
 }
 
-type StartPodSandboxResponse struct {
+func (m *NetworkInterfaceUsage) GetRxBytes() *UInt64Value {
+	if m != nil {
+		return m.RxBytes
+	}
+	return nil
 }
 
-func (m *StartPodSandboxResponse) Reset()                    { *m = StartPodSandboxResponse{} }
-func (*StartPodSandboxResponse) ProtoMessage()               {}
-func (*StartPodSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{113} }
+func (m *NetworkInterfaceUsage) GetRxErrors() *UInt64Value {
+	if m != nil {
+		return m.RxErrors
+	}
+	return nil
+}
 
-func init() {
-	proto.RegisterType((*VersionRequest)(nil), "runtime.v1alpha2.VersionRequest")
-	proto.RegisterType((*VersionResponse)(nil), "runtime.v1alpha2.VersionResponse")
-	proto.RegisterType((*DNSConfig)(nil), "runtime.v1alpha2.DNSConfig")
-	proto.RegisterType((*PortMapping)(nil), "runtime.v1alpha2.PortMapping")
+func (m *NetworkInterfaceUsage) GetTxBytes() *UInt64Value {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *NetworkInterfaceUsage) GetTxErrors() *UInt64Value {
+	if m != nil {
+		return m.TxErrors
+	}
+	return nil
+}
+
+// ProcessUsage are stats pertaining to processes.
+type ProcessUsage struct {
+	// The time at which these stats were updated.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Number of processes.
+	ProcessCount *UInt64Value `protobuf:"bytes,2,opt,name=process_count,json=processCount" json:"process_count,omitempty"`
+}
+
+func (m *ProcessUsage) Reset()                    { *m = ProcessUsage{} }
+func (*ProcessUsage) ProtoMessage()               {}
+func (*ProcessUsage) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{118} }
+
+func (m *ProcessUsage) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ProcessUsage) GetProcessCount() *UInt64Value {
+	if m != nil {
+		return m.ProcessCount
+	}
+	return nil
+}
+
+type ReopenContainerLogRequest struct {
+	// ID of the container for which to reopen the log.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+}
+
+func (m *ReopenContainerLogRequest) Reset()                    { *m = ReopenContainerLogRequest{} }
+func (*ReopenContainerLogRequest) ProtoMessage()               {}
+func (*ReopenContainerLogRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{119} }
+
+func (m *ReopenContainerLogRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+type ReopenContainerLogResponse struct {
+}
+
+func (m *ReopenContainerLogResponse) Reset()                    { *m = ReopenContainerLogResponse{} }
+func (*ReopenContainerLogResponse) ProtoMessage()               {}
+func (*ReopenContainerLogResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{120} }
+
+type PauseContainerRequest struct {
+	// ID of the container to pause.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+}
+
+func (m *PauseContainerRequest) Reset()                    { *m = PauseContainerRequest{} }
+func (*PauseContainerRequest) ProtoMessage()               {}
+func (*PauseContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{121} }
+
+func (m *PauseContainerRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+type PauseContainerResponse struct {
+}
+
+func (m *PauseContainerResponse) Reset()                    { *m = PauseContainerResponse{} }
+func (*PauseContainerResponse) ProtoMessage()               {}
+func (*PauseContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{122} }
+
+type UnpauseContainerRequest struct {
+	// ID of the container to unpause.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+}
+
+func (m *UnpauseContainerRequest) Reset()                    { *m = UnpauseContainerRequest{} }
+func (*UnpauseContainerRequest) ProtoMessage()               {}
+func (*UnpauseContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{123} }
+
+func (m *UnpauseContainerRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+type UnpauseContainerResponse struct {
+}
+
+func (m *UnpauseContainerResponse) Reset()                    { *m = UnpauseContainerResponse{} }
+func (*UnpauseContainerResponse) ProtoMessage()               {}
+func (*UnpauseContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{124} }
+
+type RemoveVolumeRequest struct {
+	// Name of the volume to remove
+	VolumeName string `protobuf:"bytes,1,opt,name=volume_name,json=volumeName,proto3" json:"volume_name,omitempty"`
+}
+
+func (m *RemoveVolumeRequest) Reset()                    { *m = RemoveVolumeRequest{} }
+func (*RemoveVolumeRequest) ProtoMessage()               {}
+func (*RemoveVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{125} }
+
+func (m *RemoveVolumeRequest) GetVolumeName() string {
+	if m != nil {
+		return m.VolumeName
+	}
+	return ""
+}
+
+type RemoveVolumeResponse struct {
+}
+
+func (m *RemoveVolumeResponse) Reset()                    { *m = RemoveVolumeResponse{} }
+func (*RemoveVolumeResponse) ProtoMessage()               {}
+func (*RemoveVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{126} }
+
+type StartPodSandboxRequest struct {
+	// ID of the PodSandbox to start.
+	PodSandboxId string `protobuf:"bytes,1,opt,name=pod_sandbox_id,json=podSandboxId,proto3" json:"pod_sandbox_id,omitempty"`
+}
+
+func (m *StartPodSandboxRequest) Reset()                    { *m = StartPodSandboxRequest{} }
+func (*StartPodSandboxRequest) ProtoMessage()               {}
+func (*StartPodSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{127} }
+
+func (m *StartPodSandboxRequest) GetPodSandboxId() string {
+	if m != nil {
+		return m.PodSandboxId
+	}
+	return ""
+}
+
+type StartPodSandboxResponse struct {
+}
+
+func (m *StartPodSandboxResponse) Reset()                    { *m = StartPodSandboxResponse{} }
+func (*StartPodSandboxResponse) ProtoMessage()               {}
+func (*StartPodSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{128} }
+
+type GetEventsRequest struct {
+}
+
+func (m *GetEventsRequest) Reset()                    { *m = GetEventsRequest{} }
+func (*GetEventsRequest) ProtoMessage()               {}
+func (*GetEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{129} }
+
+type ContainerEventResponse struct {
+	// ID of the container.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// Type of the container event.
+	ContainerEventType ContainerEventType `protobuf:"varint,2,opt,name=container_event_type,json=containerEventType,proto3,enum=runtime.v1alpha2.ContainerEventType" json:"container_event_type,omitempty"`
+	// Creation timestamp of this event in nanoseconds.
+	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Status of the pod sandbox the container belongs to.
+	PodSandboxStatus *PodSandboxStatus `protobuf:"bytes,4,opt,name=pod_sandbox_status,json=podSandboxStatus" json:"pod_sandbox_status,omitempty"`
+	// Statuses of the containers in the pod sandbox.
+	ContainersStatuses []*ContainerStatus `protobuf:"bytes,5,rep,name=containers_statuses,json=containersStatuses" json:"containers_statuses,omitempty"`
+}
+
+func (m *ContainerEventResponse) Reset()                    { *m = ContainerEventResponse{} }
+func (*ContainerEventResponse) ProtoMessage()               {}
+func (*ContainerEventResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{130} }
+
+func (m *ContainerEventResponse) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *ContainerEventResponse) GetContainerEventType() ContainerEventType {
+	if m != nil {
+		return m.ContainerEventType
+	}
+	return ContainerEventType_CONTAINER_CREATED_EVENT
+}
+
+func (m *ContainerEventResponse) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ContainerEventResponse) GetPodSandboxStatus() *PodSandboxStatus {
+	if m != nil {
+		return m.PodSandboxStatus
+	}
+	return nil
+}
+
+func (m *ContainerEventResponse) GetContainersStatuses() []*ContainerStatus {
+	if m != nil {
+		return m.ContainersStatuses
+	}
+	return nil
+}
+
+type CheckpointContainerRequest struct {
+	// ID of the container to be checkpointed.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// Location of the checkpoint archive used for export.
+	Location string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// Timeout in seconds for the checkpoint to complete.
+	// Timeout of zero means to use the CRI default.
+	// Timeout > 0 means to use the user specified timeout.
+	Timeout int64 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (m *CheckpointContainerRequest) Reset()                    { *m = CheckpointContainerRequest{} }
+func (*CheckpointContainerRequest) ProtoMessage()               {}
+func (*CheckpointContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{131} }
+
+func (m *CheckpointContainerRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *CheckpointContainerRequest) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
+func (m *CheckpointContainerRequest) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+type CheckpointContainerResponse struct {
+}
+
+func (m *CheckpointContainerResponse) Reset()      { *m = CheckpointContainerResponse{} }
+func (*CheckpointContainerResponse) ProtoMessage() {}
+func (*CheckpointContainerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{132}
+}
+
+func init() {
+	proto.RegisterType((*VersionRequest)(nil), "runtime.v1alpha2.VersionRequest")
+	proto.RegisterType((*VersionResponse)(nil), "runtime.v1alpha2.VersionResponse")
+	proto.RegisterType((*DNSConfig)(nil), "runtime.v1alpha2.DNSConfig")
+	proto.RegisterType((*PortMapping)(nil), "runtime.v1alpha2.PortMapping")
 	proto.RegisterType((*Mount)(nil), "runtime.v1alpha2.Mount")
 	proto.RegisterType((*NamespaceOption)(nil), "runtime.v1alpha2.NamespaceOption")
+	proto.RegisterType((*IDMapping)(nil), "runtime.v1alpha2.IDMapping")
+	proto.RegisterType((*UserNamespace)(nil), "runtime.v1alpha2.UserNamespace")
 	proto.RegisterType((*Int64Value)(nil), "runtime.v1alpha2.Int64Value")
 	proto.RegisterType((*LinuxSandboxSecurityContext)(nil), "runtime.v1alpha2.LinuxSandboxSecurityContext")
 	proto.RegisterType((*LinuxPodSandboxConfig)(nil), "runtime.v1alpha2.LinuxPodSandboxConfig")
@@ -4061,6 +4679,7 @@ func init() {
 	proto.RegisterType((*RemovePodSandboxResponse)(nil), "runtime.v1alpha2.RemovePodSandboxResponse")
 	proto.RegisterType((*PodSandboxStatusRequest)(nil), "runtime.v1alpha2.PodSandboxStatusRequest")
 	proto.RegisterType((*PodSandboxNetworkStatus)(nil), "runtime.v1alpha2.PodSandboxNetworkStatus")
+	proto.RegisterType((*PodIP)(nil), "runtime.v1alpha2.PodIP")
 	proto.RegisterType((*Namespace)(nil), "runtime.v1alpha2.Namespace")
 	proto.RegisterType((*LinuxPodSandboxStatus)(nil), "runtime.v1alpha2.LinuxPodSandboxStatus")
 	proto.RegisterType((*PodSandboxStatus)(nil), "runtime.v1alpha2.PodSandboxStatus")
@@ -4146,6 +4765,18 @@ func init() {
 	proto.RegisterType((*ContainerStats)(nil), "runtime.v1alpha2.ContainerStats")
 	proto.RegisterType((*CpuUsage)(nil), "runtime.v1alpha2.CpuUsage")
 	proto.RegisterType((*MemoryUsage)(nil), "runtime.v1alpha2.MemoryUsage")
+	proto.RegisterType((*PodSandboxStatsRequest)(nil), "runtime.v1alpha2.PodSandboxStatsRequest")
+	proto.RegisterType((*PodSandboxStatsResponse)(nil), "runtime.v1alpha2.PodSandboxStatsResponse")
+	proto.RegisterType((*PodSandboxStatsFilter)(nil), "runtime.v1alpha2.PodSandboxStatsFilter")
+	proto.RegisterType((*ListPodSandboxStatsRequest)(nil), "runtime.v1alpha2.ListPodSandboxStatsRequest")
+	proto.RegisterType((*ListPodSandboxStatsResponse)(nil), "runtime.v1alpha2.ListPodSandboxStatsResponse")
+	proto.RegisterType((*PodSandboxAttributes)(nil), "runtime.v1alpha2.PodSandboxAttributes")
+	proto.RegisterType((*PodSandboxStats)(nil), "runtime.v1alpha2.PodSandboxStats")
+	proto.RegisterType((*LinuxPodSandboxStats)(nil), "runtime.v1alpha2.LinuxPodSandboxStats")
+	proto.RegisterType((*WindowsPodSandboxStats)(nil), "runtime.v1alpha2.WindowsPodSandboxStats")
+	proto.RegisterType((*NetworkUsage)(nil), "runtime.v1alpha2.NetworkUsage")
+	proto.RegisterType((*NetworkInterfaceUsage)(nil), "runtime.v1alpha2.NetworkInterfaceUsage")
+	proto.RegisterType((*ProcessUsage)(nil), "runtime.v1alpha2.ProcessUsage")
 	proto.RegisterType((*ReopenContainerLogRequest)(nil), "runtime.v1alpha2.ReopenContainerLogRequest")
 	proto.RegisterType((*ReopenContainerLogResponse)(nil), "runtime.v1alpha2.ReopenContainerLogResponse")
 	proto.RegisterType((*PauseContainerRequest)(nil), "runtime.v1alpha2.PauseContainerRequest")
@@ -4156,11 +4787,16 @@ func init() {
 	proto.RegisterType((*RemoveVolumeResponse)(nil), "runtime.v1alpha2.RemoveVolumeResponse")
 	proto.RegisterType((*StartPodSandboxRequest)(nil), "runtime.v1alpha2.StartPodSandboxRequest")
 	proto.RegisterType((*StartPodSandboxResponse)(nil), "runtime.v1alpha2.StartPodSandboxResponse")
+	proto.RegisterType((*GetEventsRequest)(nil), "runtime.v1alpha2.GetEventsRequest")
+	proto.RegisterType((*ContainerEventResponse)(nil), "runtime.v1alpha2.ContainerEventResponse")
+	proto.RegisterType((*CheckpointContainerRequest)(nil), "runtime.v1alpha2.CheckpointContainerRequest")
+	proto.RegisterType((*CheckpointContainerResponse)(nil), "runtime.v1alpha2.CheckpointContainerResponse")
 	proto.RegisterEnum("runtime.v1alpha2.Protocol", Protocol_name, Protocol_value)
 	proto.RegisterEnum("runtime.v1alpha2.MountPropagation", MountPropagation_name, MountPropagation_value)
 	proto.RegisterEnum("runtime.v1alpha2.NamespaceMode", NamespaceMode_name, NamespaceMode_value)
 	proto.RegisterEnum("runtime.v1alpha2.PodSandboxState", PodSandboxState_name, PodSandboxState_value)
 	proto.RegisterEnum("runtime.v1alpha2.ContainerState", ContainerState_name, ContainerState_value)
+	proto.RegisterEnum("runtime.v1alpha2.ContainerEventType", ContainerEventType_name, ContainerEventType_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4243,6 +4879,11 @@ type RuntimeServiceClient interface {
 	ContainerStats(ctx context.Context, in *ContainerStatsRequest, opts ...grpc.CallOption) (*ContainerStatsResponse, error)
 	// ListContainerStats returns stats of all running containers.
 	ListContainerStats(ctx context.Context, in *ListContainerStatsRequest, opts ...grpc.CallOption) (*ListContainerStatsResponse, error)
+	// PodSandboxStats returns stats of the pod sandbox. If the pod sandbox does not
+	// exist, the call returns an error.
+	PodSandboxStats(ctx context.Context, in *PodSandboxStatsRequest, opts ...grpc.CallOption) (*PodSandboxStatsResponse, error)
+	// ListPodSandboxStats returns stats of the pod sandboxes matching a filter.
+	ListPodSandboxStats(ctx context.Context, in *ListPodSandboxStatsRequest, opts ...grpc.CallOption) (*ListPodSandboxStatsResponse, error)
 	// UpdateRuntimeConfig updates the runtime configuration based on the given request.
 	UpdateRuntimeConfig(ctx context.Context, in *UpdateRuntimeConfigRequest, opts ...grpc.CallOption) (*UpdateRuntimeConfigResponse, error)
 	// Status returns the status of the runtime.
@@ -4251,6 +4892,10 @@ type RuntimeServiceClient interface {
 	// and we should reconfigure it with network plugin which will make sure it reacquire its original network configuration,
 	// like IP address.
 	StartPodSandbox(ctx context.Context, in *StartPodSandboxRequest, opts ...grpc.CallOption) (*StartPodSandboxResponse, error)
+	// GetContainerEvents gets container events from the CRI runtime.
+	GetContainerEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (RuntimeService_GetContainerEventsClient, error)
+	// CheckpointContainer checkpoints a container.
+	CheckpointContainer(ctx context.Context, in *CheckpointContainerRequest, opts ...grpc.CallOption) (*CheckpointContainerResponse, error)
 }
 
 type runtimeServiceClient struct {
@@ -4459,6 +5104,24 @@ func (c *runtimeServiceClient) ListContainerStats(ctx context.Context, in *ListC
 	return out, nil
 }
 
+func (c *runtimeServiceClient) PodSandboxStats(ctx context.Context, in *PodSandboxStatsRequest, opts ...grpc.CallOption) (*PodSandboxStatsResponse, error) {
+	out := new(PodSandboxStatsResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/PodSandboxStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ListPodSandboxStats(ctx context.Context, in *ListPodSandboxStatsRequest, opts ...grpc.CallOption) (*ListPodSandboxStatsResponse, error) {
+	out := new(ListPodSandboxStatsResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/ListPodSandboxStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) UpdateRuntimeConfig(ctx context.Context, in *UpdateRuntimeConfigRequest, opts ...grpc.CallOption) (*UpdateRuntimeConfigResponse, error) {
 	out := new(UpdateRuntimeConfigResponse)
 	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/UpdateRuntimeConfig", in, out, c.cc, opts...)
@@ -4486,6 +5149,47 @@ func (c *runtimeServiceClient) StartPodSandbox(ctx context.Context, in *StartPod
 	return out, nil
 }
 
+func (c *runtimeServiceClient) GetContainerEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (RuntimeService_GetContainerEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_RuntimeService_serviceDesc.Streams[0], c.cc, "/runtime.v1alpha2.RuntimeService/GetContainerEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &runtimeServiceGetContainerEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RuntimeService_GetContainerEventsClient interface {
+	Recv() (*ContainerEventResponse, error)
+	grpc.ClientStream
+}
+
+type runtimeServiceGetContainerEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *runtimeServiceGetContainerEventsClient) Recv() (*ContainerEventResponse, error) {
+	m := new(ContainerEventResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *runtimeServiceClient) CheckpointContainer(ctx context.Context, in *CheckpointContainerRequest, opts ...grpc.CallOption) (*CheckpointContainerResponse, error) {
+	out := new(CheckpointContainerResponse)
+	err := grpc.Invoke(ctx, "/runtime.v1alpha2.RuntimeService/CheckpointContainer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for RuntimeService service
 
 type RuntimeServiceServer interface {
@@ -4558,6 +5262,11 @@ type RuntimeServiceServer interface {
 	ContainerStats(context.Context, *ContainerStatsRequest) (*ContainerStatsResponse, error)
 	// ListContainerStats returns stats of all running containers.
 	ListContainerStats(context.Context, *ListContainerStatsRequest) (*ListContainerStatsResponse, error)
+	// PodSandboxStats returns stats of the pod sandbox. If the pod sandbox does not
+	// exist, the call returns an error.
+	PodSandboxStats(context.Context, *PodSandboxStatsRequest) (*PodSandboxStatsResponse, error)
+	// ListPodSandboxStats returns stats of the pod sandboxes matching a filter.
+	ListPodSandboxStats(context.Context, *ListPodSandboxStatsRequest) (*ListPodSandboxStatsResponse, error)
 	// UpdateRuntimeConfig updates the runtime configuration based on the given request.
 	UpdateRuntimeConfig(context.Context, *UpdateRuntimeConfigRequest) (*UpdateRuntimeConfigResponse, error)
 	// Status returns the status of the runtime.
@@ -4566,6 +5275,10 @@ type RuntimeServiceServer interface {
 	// and we should reconfigure it with network plugin which will make sure it reacquire its original network configuration,
 	// like IP address.
 	StartPodSandbox(context.Context, *StartPodSandboxRequest) (*StartPodSandboxResponse, error)
+	// GetContainerEvents gets container events from the CRI runtime.
+	GetContainerEvents(*GetEventsRequest, RuntimeService_GetContainerEventsServer) error
+	// CheckpointContainer checkpoints a container.
+	CheckpointContainer(context.Context, *CheckpointContainerRequest) (*CheckpointContainerResponse, error)
 }
 
 func RegisterRuntimeServiceServer(s *grpc.Server, srv RuntimeServiceServer) {
@@ -4968,64 +5681,139 @@ func _RuntimeService_ListContainerStats_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RuntimeService_UpdateRuntimeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRuntimeConfigRequest)
+func _RuntimeService_PodSandboxStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodSandboxStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuntimeServiceServer).UpdateRuntimeConfig(ctx, in)
+		return srv.(RuntimeServiceServer).PodSandboxStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/runtime.v1alpha2.RuntimeService/UpdateRuntimeConfig",
+		FullMethod: "/runtime.v1alpha2.RuntimeService/PodSandboxStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuntimeServiceServer).UpdateRuntimeConfig(ctx, req.(*UpdateRuntimeConfigRequest))
+		return srv.(RuntimeServiceServer).PodSandboxStats(ctx, req.(*PodSandboxStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RuntimeService_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatusRequest)
+func _RuntimeService_ListPodSandboxStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPodSandboxStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuntimeServiceServer).Status(ctx, in)
+		return srv.(RuntimeServiceServer).ListPodSandboxStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/runtime.v1alpha2.RuntimeService/Status",
+		FullMethod: "/runtime.v1alpha2.RuntimeService/ListPodSandboxStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuntimeServiceServer).Status(ctx, req.(*StatusRequest))
+		return srv.(RuntimeServiceServer).ListPodSandboxStats(ctx, req.(*ListPodSandboxStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RuntimeService_StartPodSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartPodSandboxRequest)
+func _RuntimeService_UpdateRuntimeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRuntimeConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuntimeServiceServer).StartPodSandbox(ctx, in)
+		return srv.(RuntimeServiceServer).UpdateRuntimeConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/runtime.v1alpha2.RuntimeService/StartPodSandbox",
+		FullMethod: "/runtime.v1alpha2.RuntimeService/UpdateRuntimeConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuntimeServiceServer).StartPodSandbox(ctx, req.(*StartPodSandboxRequest))
+		return srv.(RuntimeServiceServer).UpdateRuntimeConfig(ctx, req.(*UpdateRuntimeConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _RuntimeService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "runtime.v1alpha2.RuntimeService",
-	HandlerType: (*RuntimeServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
+func _RuntimeService_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runtime.v1alpha2.RuntimeService/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).Status(ctx, req.(*StatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_StartPodSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPodSandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).StartPodSandbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runtime.v1alpha2.RuntimeService/StartPodSandbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).StartPodSandbox(ctx, req.(*StartPodSandboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_GetContainerEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RuntimeServiceServer).GetContainerEvents(m, &runtimeServiceGetContainerEventsServer{stream})
+}
+
+type RuntimeService_GetContainerEventsServer interface {
+	Send(*ContainerEventResponse) error
+	grpc.ServerStream
+}
+
+type runtimeServiceGetContainerEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *runtimeServiceGetContainerEventsServer) Send(m *ContainerEventResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RuntimeService_CheckpointContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).CheckpointContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runtime.v1alpha2.RuntimeService/CheckpointContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).CheckpointContainer(ctx, req.(*CheckpointContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RuntimeService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "runtime.v1alpha2.RuntimeService",
+	HandlerType: (*RuntimeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Version",
 			Handler:    _RuntimeService_Version_Handler,
@@ -5114,6 +5902,14 @@ var _RuntimeService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListContainerStats",
 			Handler:    _RuntimeService_ListContainerStats_Handler,
 		},
+		{
+			MethodName: "PodSandboxStats",
+			Handler:    _RuntimeService_PodSandboxStats_Handler,
+		},
+		{
+			MethodName: "ListPodSandboxStats",
+			Handler:    _RuntimeService_ListPodSandboxStats_Handler,
+		},
 		{
 			MethodName: "UpdateRuntimeConfig",
 			Handler:    _RuntimeService_UpdateRuntimeConfig_Handler,
@@ -5126,8 +5922,18 @@ var _RuntimeService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StartPodSandbox",
 			Handler:    _RuntimeService_StartPodSandbox_Handler,
 		},
+		{
+			MethodName: "CheckpointContainer",
+			Handler:    _RuntimeService_CheckpointContainer_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetContainerEvents",
+			Handler:       _RuntimeService_GetContainerEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
 }
 
@@ -5672,6 +6478,96 @@ func (m *NamespaceOption) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Ipc))
 	}
+	if m.UsernsOptions != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.UsernsOptions.Size()))
+		n1, err := m.UsernsOptions.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *IDMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IDMapping) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.HostId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.HostId))
+	}
+	if m.ContainerId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.ContainerId))
+	}
+	if m.Length != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Length))
+	}
+	return i, nil
+}
+
+func (m *UserNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserNamespace) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Mode))
+	}
+	if len(m.Uids) > 0 {
+		for _, msg := range m.Uids {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Gids) > 0 {
+		for _, msg := range m.Gids {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -5717,31 +6613,31 @@ func (m *LinuxSandboxSecurityContext) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.NamespaceOptions.Size()))
-		n1, err := m.NamespaceOptions.MarshalTo(dAtA[i:])
+		n2, err := m.NamespaceOptions.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n1
+		i += n2
 	}
 	if m.SelinuxOptions != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.SelinuxOptions.Size()))
-		n2, err := m.SelinuxOptions.MarshalTo(dAtA[i:])
+		n3, err := m.SelinuxOptions.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n3
 	}
 	if m.RunAsUser != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.RunAsUser.Size()))
-		n3, err := m.RunAsUser.MarshalTo(dAtA[i:])
+		n4, err := m.RunAsUser.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n4
 	}
 	if m.ReadonlyRootfs {
 		dAtA[i] = 0x20
@@ -5754,22 +6650,22 @@ func (m *LinuxSandboxSecurityContext) MarshalTo(dAtA []byte) (int, error) {
 		i++
 	}
 	if len(m.SupplementalGroups) > 0 {
-		dAtA5 := make([]byte, len(m.SupplementalGroups)*10)
-		var j4 int
+		dAtA6 := make([]byte, len(m.SupplementalGroups)*10)
+		var j5 int
 		for _, num1 := range m.SupplementalGroups {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j4++
+				j5++
 			}
-			dAtA5[j4] = uint8(num)
-			j4++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
 		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintApi(dAtA, i, uint64(j4))
-		i += copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintApi(dAtA, i, uint64(j5))
+		i += copy(dAtA[i:], dAtA6[:j5])
 	}
 	if m.Privileged {
 		dAtA[i] = 0x30
@@ -5791,11 +6687,11 @@ func (m *LinuxSandboxSecurityContext) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.RunAsGroup.Size()))
-		n6, err := m.RunAsGroup.MarshalTo(dAtA[i:])
+		n7, err := m.RunAsGroup.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n7
 	}
 	return i, nil
 }
@@ -5825,11 +6721,11 @@ func (m *LinuxPodSandboxConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.SecurityContext.Size()))
-		n7, err := m.SecurityContext.MarshalTo(dAtA[i:])
+		n8, err := m.SecurityContext.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
 	}
 	if len(m.Sysctls) > 0 {
 		for k := range m.Sysctls {
@@ -5911,11 +6807,11 @@ func (m *PodSandboxConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Metadata.Size()))
-		n8, err := m.Metadata.MarshalTo(dAtA[i:])
+		n9, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n9
 	}
 	if len(m.Hostname) > 0 {
 		dAtA[i] = 0x12
@@ -5933,11 +6829,11 @@ func (m *PodSandboxConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.DnsConfig.Size()))
-		n9, err := m.DnsConfig.MarshalTo(dAtA[i:])
+		n10, err := m.DnsConfig.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n10
 	}
 	if len(m.PortMappings) > 0 {
 		for _, msg := range m.PortMappings {
@@ -5989,11 +6885,11 @@ func (m *PodSandboxConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Linux.Size()))
-		n10, err := m.Linux.MarshalTo(dAtA[i:])
+		n11, err := m.Linux.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
 	}
 	return i, nil
 }
@@ -6017,11 +6913,11 @@ func (m *RunPodSandboxRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Config.Size()))
-		n11, err := m.Config.MarshalTo(dAtA[i:])
+		n12, err := m.Config.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n12
 	}
 	if len(m.RuntimeHandler) > 0 {
 		dAtA[i] = 0x12
@@ -6185,6 +7081,42 @@ func (m *PodSandboxNetworkStatus) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *PodSandboxNetworkStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ip) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Ip)))
+		i += copy(dAtA[i:], m.Ip)
+	}
+	if len(m.AdditionalIps) > 0 {
+		for _, msg := range m.AdditionalIps {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *PodIP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PodIP) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6217,11 +7149,11 @@ func (m *Namespace) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Options.Size()))
-		n12, err := m.Options.MarshalTo(dAtA[i:])
+		n13, err := m.Options.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n13
 	}
 	return i, nil
 }
@@ -6245,11 +7177,11 @@ func (m *LinuxPodSandboxStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Namespaces.Size()))
-		n13, err := m.Namespaces.MarshalTo(dAtA[i:])
+		n14, err := m.Namespaces.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n14
 	}
 	return i, nil
 }
@@ -6279,11 +7211,11 @@ func (m *PodSandboxStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Metadata.Size()))
-		n14, err := m.Metadata.MarshalTo(dAtA[i:])
+		n15, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n15
 	}
 	if m.State != 0 {
 		dAtA[i] = 0x18
@@ -6299,21 +7231,21 @@ func (m *PodSandboxStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Network.Size()))
-		n15, err := m.Network.MarshalTo(dAtA[i:])
+		n16, err := m.Network.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n16
 	}
 	if m.Linux != nil {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Linux.Size()))
-		n16, err := m.Linux.MarshalTo(dAtA[i:])
+		n17, err := m.Linux.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n17
 	}
 	if len(m.Labels) > 0 {
 		for k := range m.Labels {
@@ -6371,11 +7303,11 @@ func (m *PodSandboxStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Status.Size()))
-		n17, err := m.Status.MarshalTo(dAtA[i:])
+		n18, err := m.Status.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n18
 	}
 	if len(m.Info) > 0 {
 		for k := range m.Info {
@@ -6445,11 +7377,11 @@ func (m *PodSandboxFilter) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.State.Size()))
-		n18, err := m.State.MarshalTo(dAtA[i:])
+		n19, err := m.State.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n19
 	}
 	if len(m.LabelSelector) > 0 {
 		for k := range m.LabelSelector {
@@ -6490,11 +7422,11 @@ func (m *ListPodSandboxRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Filter.Size()))
-		n19, err := m.Filter.MarshalTo(dAtA[i:])
+		n20, err := m.Filter.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n20
 	}
 	return i, nil
 }
@@ -6524,11 +7456,11 @@ func (m *PodSandbox) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Metadata.Size()))
-		n20, err := m.Metadata.MarshalTo(dAtA[i:])
+		n21, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n21
 	}
 	if m.State != 0 {
 		dAtA[i] = 0x18
@@ -6829,11 +7761,11 @@ func (m *LinuxContainerResources) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.MemorySwappiness.Size()))
-		n21, err := m.MemorySwappiness.MarshalTo(dAtA[i:])
+		n22, err := m.MemorySwappiness.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n22
 	}
 	if len(m.Ulimits) > 0 {
 		for _, msg := range m.Ulimits {
@@ -7053,11 +7985,11 @@ func (m *LinuxContainerSecurityContext) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Capabilities.Size()))
-		n22, err := m.Capabilities.MarshalTo(dAtA[i:])
+		n23, err := m.Capabilities.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n23
 	}
 	if m.Privileged {
 		dAtA[i] = 0x10
@@ -7073,31 +8005,31 @@ func (m *LinuxContainerSecurityContext) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.NamespaceOptions.Size()))
-		n23, err := m.NamespaceOptions.MarshalTo(dAtA[i:])
+		n24, err := m.NamespaceOptions.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n24
 	}
 	if m.SelinuxOptions != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.SelinuxOptions.Size()))
-		n24, err := m.SelinuxOptions.MarshalTo(dAtA[i:])
+		n25, err := m.SelinuxOptions.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n25
 	}
 	if m.RunAsUser != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.RunAsUser.Size()))
-		n25, err := m.RunAsUser.MarshalTo(dAtA[i:])
+		n26, err := m.RunAsUser.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n26
 	}
 	if len(m.RunAsUsername) > 0 {
 		dAtA[i] = 0x32
@@ -7116,22 +8048,22 @@ func (m *LinuxContainerSecurityContext) MarshalTo(dAtA []byte) (int, error) {
 		i++
 	}
 	if len(m.SupplementalGroups) > 0 {
-		dAtA27 := make([]byte, len(m.SupplementalGroups)*10)
-		var j26 int
+		dAtA28 := make([]byte, len(m.SupplementalGroups)*10)
+		var j27 int
 		for _, num1 := range m.SupplementalGroups {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j26++
+				j27++
 			}
-			dAtA27[j26] = uint8(num)
-			j26++
+			dAtA28[j27] = uint8(num)
+			j27++
 		}
 		dAtA[i] = 0x42
 		i++
-		i = encodeVarintApi(dAtA, i, uint64(j26))
-		i += copy(dAtA[i:], dAtA27[:j26])
+		i = encodeVarintApi(dAtA, i, uint64(j27))
+		i += copy(dAtA[i:], dAtA28[:j27])
 	}
 	if len(m.ApparmorProfile) > 0 {
 		dAtA[i] = 0x4a
@@ -7159,11 +8091,11 @@ func (m *LinuxContainerSecurityContext) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.RunAsGroup.Size()))
-		n28, err := m.RunAsGroup.MarshalTo(dAtA[i:])
+		n29, err := m.RunAsGroup.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n29
 	}
 	if len(m.MaskedPaths) > 0 {
 		for _, s := range m.MaskedPaths {
@@ -7217,21 +8149,21 @@ func (m *LinuxContainerConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Resources.Size()))
-		n29, err := m.Resources.MarshalTo(dAtA[i:])
+		n30, err := m.Resources.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n30
 	}
 	if m.SecurityContext != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.SecurityContext.Size()))
-		n30, err := m.SecurityContext.MarshalTo(dAtA[i:])
+		n31, err := m.SecurityContext.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n31
 	}
 	return i, nil
 }
@@ -7279,21 +8211,21 @@ func (m *WindowsContainerConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Resources.Size()))
-		n31, err := m.Resources.MarshalTo(dAtA[i:])
+		n32, err := m.Resources.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n32
 	}
 	if m.SecurityContext != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.SecurityContext.Size()))
-		n32, err := m.SecurityContext.MarshalTo(dAtA[i:])
+		n33, err := m.SecurityContext.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n33
 	}
 	return i, nil
 }
@@ -7420,21 +8352,21 @@ func (m *ContainerConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Metadata.Size()))
-		n33, err := m.Metadata.MarshalTo(dAtA[i:])
+		n34, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n34
 	}
 	if m.Image != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Image.Size()))
-		n34, err := m.Image.MarshalTo(dAtA[i:])
+		n35, err := m.Image.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n35
 	}
 	if len(m.Command) > 0 {
 		for _, s := range m.Command {
@@ -7582,11 +8514,11 @@ func (m *ContainerConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x7a
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Linux.Size()))
-		n35, err := m.Linux.MarshalTo(dAtA[i:])
+		n36, err := m.Linux.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n36
 	}
 	if m.Windows != nil {
 		dAtA[i] = 0x82
@@ -7594,11 +8526,11 @@ func (m *ContainerConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Windows.Size()))
-		n36, err := m.Windows.MarshalTo(dAtA[i:])
+		n37, err := m.Windows.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n37
 	}
 	if m.NetPriority != 0 {
 		dAtA[i] = 0xa0
@@ -7643,21 +8575,21 @@ func (m *CreateContainerRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Config.Size()))
-		n37, err := m.Config.MarshalTo(dAtA[i:])
+		n38, err := m.Config.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n38
 	}
 	if m.SandboxConfig != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.SandboxConfig.Size()))
-		n38, err := m.SandboxConfig.MarshalTo(dAtA[i:])
+		n39, err := m.SandboxConfig.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n39
 	}
 	return i, nil
 }
@@ -7865,11 +8797,11 @@ func (m *ContainerFilter) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.State.Size()))
-		n39, err := m.State.MarshalTo(dAtA[i:])
+		n40, err := m.State.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n40
 	}
 	if len(m.PodSandboxId) > 0 {
 		dAtA[i] = 0x1a
@@ -7916,11 +8848,11 @@ func (m *ListContainersRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Filter.Size()))
-		n40, err := m.Filter.MarshalTo(dAtA[i:])
+		n41, err := m.Filter.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n41
 	}
 	return i, nil
 }
@@ -7956,21 +8888,21 @@ func (m *Container) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Metadata.Size()))
-		n41, err := m.Metadata.MarshalTo(dAtA[i:])
+		n42, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n42
 	}
 	if m.Image != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Image.Size()))
-		n42, err := m.Image.MarshalTo(dAtA[i:])
+		n43, err := m.Image.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n43
 	}
 	if len(m.ImageRef) > 0 {
 		dAtA[i] = 0x2a
@@ -8114,11 +9046,11 @@ func (m *ContainerStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Metadata.Size()))
-		n43, err := m.Metadata.MarshalTo(dAtA[i:])
+		n44, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n44
 	}
 	if m.State != 0 {
 		dAtA[i] = 0x18
@@ -8149,11 +9081,11 @@ func (m *ContainerStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Image.Size()))
-		n44, err := m.Image.MarshalTo(dAtA[i:])
+		n45, err := m.Image.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n45
 	}
 	if len(m.ImageRef) > 0 {
 		dAtA[i] = 0x4a
@@ -8247,11 +9179,11 @@ func (m *ContainerStatus) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintApi(dAtA, i, uint64(v.Size()))
-				n45, err := v.MarshalTo(dAtA[i:])
+				n46, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n45
+				i += n46
 			}
 		}
 	}
@@ -8261,11 +9193,11 @@ func (m *ContainerStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Resources.Size()))
-		n46, err := m.Resources.MarshalTo(dAtA[i:])
+		n47, err := m.Resources.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n47
 	}
 	if len(m.QuotaId) > 0 {
 		dAtA[i] = 0xb2
@@ -8329,11 +9261,11 @@ func (m *ContainerStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Status.Size()))
-		n47, err := m.Status.MarshalTo(dAtA[i:])
+		n48, err := m.Status.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n48
 	}
 	if len(m.Info) > 0 {
 		for k := range m.Info {
@@ -8380,11 +9312,11 @@ func (m *UpdateContainerResourcesRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Linux.Size()))
-		n48, err := m.Linux.MarshalTo(dAtA[i:])
+		n49, err := m.Linux.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n49
 	}
 	if len(m.SpecAnnotations) > 0 {
 		for k := range m.SpecAnnotations {
@@ -8718,22 +9650,22 @@ func (m *PortForwardRequest) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], m.PodSandboxId)
 	}
 	if len(m.Port) > 0 {
-		dAtA50 := make([]byte, len(m.Port)*10)
-		var j49 int
+		dAtA51 := make([]byte, len(m.Port)*10)
+		var j50 int
 		for _, num1 := range m.Port {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA50[j49] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA51[j50] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j49++
+				j50++
 			}
-			dAtA50[j49] = uint8(num)
-			j49++
+			dAtA51[j50] = uint8(num)
+			j50++
 		}
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintApi(dAtA, i, uint64(j49))
-		i += copy(dAtA[i:], dAtA50[:j49])
+		i = encodeVarintApi(dAtA, i, uint64(j50))
+		i += copy(dAtA[i:], dAtA51[:j50])
 	}
 	return i, nil
 }
@@ -8781,11 +9713,11 @@ func (m *ImageFilter) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Image.Size()))
-		n51, err := m.Image.MarshalTo(dAtA[i:])
+		n52, err := m.Image.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n52
 	}
 	return i, nil
 }
@@ -8809,11 +9741,11 @@ func (m *ListImagesRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Filter.Size()))
-		n52, err := m.Filter.MarshalTo(dAtA[i:])
+		n53, err := m.Filter.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n53
 	}
 	return i, nil
 }
@@ -8878,11 +9810,11 @@ func (m *Image) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Uid.Size()))
-		n53, err := m.Uid.MarshalTo(dAtA[i:])
+		n54, err := m.Uid.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n54
 	}
 	if len(m.Username) > 0 {
 		dAtA[i] = 0x32
@@ -8912,11 +9844,11 @@ func (m *Image) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintApi(dAtA, i, uint64(v.Size()))
-				n54, err := v.MarshalTo(dAtA[i:])
+				n55, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n54
+				i += n55
 			}
 		}
 	}
@@ -8972,11 +9904,11 @@ func (m *ImageStatusRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Image.Size()))
-		n55, err := m.Image.MarshalTo(dAtA[i:])
+		n56, err := m.Image.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n56
 	}
 	if m.Verbose {
 		dAtA[i] = 0x10
@@ -9010,11 +9942,11 @@ func (m *ImageStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Image.Size()))
-		n56, err := m.Image.MarshalTo(dAtA[i:])
+		n57, err := m.Image.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n57
 	}
 	if len(m.Info) > 0 {
 		for k := range m.Info {
@@ -9109,31 +10041,31 @@ func (m *PullImageRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Image.Size()))
-		n57, err := m.Image.MarshalTo(dAtA[i:])
+		n58, err := m.Image.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n58
 	}
 	if m.Auth != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Auth.Size()))
-		n58, err := m.Auth.MarshalTo(dAtA[i:])
+		n59, err := m.Auth.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n59
 	}
 	if m.SandboxConfig != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.SandboxConfig.Size()))
-		n59, err := m.SandboxConfig.MarshalTo(dAtA[i:])
+		n60, err := m.SandboxConfig.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n60
 	}
 	return i, nil
 }
@@ -9181,11 +10113,11 @@ func (m *RemoveImageRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Image.Size()))
-		n60, err := m.Image.MarshalTo(dAtA[i:])
+		n61, err := m.Image.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n61
 	}
 	return i, nil
 }
@@ -9251,11 +10183,11 @@ func (m *RuntimeConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.NetworkConfig.Size()))
-		n61, err := m.NetworkConfig.MarshalTo(dAtA[i:])
+		n62, err := m.NetworkConfig.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n61
+		i += n62
 	}
 	return i, nil
 }
@@ -9279,11 +10211,11 @@ func (m *UpdateRuntimeConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.RuntimeConfig.Size()))
-		n62, err := m.RuntimeConfig.MarshalTo(dAtA[i:])
+		n63, err := m.RuntimeConfig.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n62
+		i += n63
 	}
 	return i, nil
 }
@@ -9429,11 +10361,11 @@ func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Status.Size()))
-		n63, err := m.Status.MarshalTo(dAtA[i:])
+		n64, err := m.Status.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n64
 	}
 	if len(m.Info) > 0 {
 		for k := range m.Info {
@@ -9544,31 +10476,31 @@ func (m *FilesystemUsage) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.FsId.Size()))
-		n64, err := m.FsId.MarshalTo(dAtA[i:])
+		n65, err := m.FsId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n65
 	}
 	if m.UsedBytes != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.UsedBytes.Size()))
-		n65, err := m.UsedBytes.MarshalTo(dAtA[i:])
+		n66, err := m.UsedBytes.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n66
 	}
 	if m.InodesUsed != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.InodesUsed.Size()))
-		n66, err := m.InodesUsed.MarshalTo(dAtA[i:])
+		n67, err := m.InodesUsed.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n67
 	}
 	return i, nil
 }
@@ -9646,11 +10578,11 @@ func (m *ContainerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Stats.Size()))
-		n67, err := m.Stats.MarshalTo(dAtA[i:])
+		n68, err := m.Stats.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
+		i += n68
 	}
 	return i, nil
 }
@@ -9674,11 +10606,11 @@ func (m *ListContainerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Filter.Size()))
-		n68, err := m.Filter.MarshalTo(dAtA[i:])
+		n69, err := m.Filter.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n69
 	}
 	return i, nil
 }
@@ -9785,11 +10717,11 @@ func (m *ContainerAttributes) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Metadata.Size()))
-		n69, err := m.Metadata.MarshalTo(dAtA[i:])
+		n70, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n70
 	}
 	if len(m.Labels) > 0 {
 		for k := range m.Labels {
@@ -9847,41 +10779,41 @@ func (m *ContainerStats) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Attributes.Size()))
-		n70, err := m.Attributes.MarshalTo(dAtA[i:])
+		n71, err := m.Attributes.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n70
+		i += n71
 	}
 	if m.Cpu != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Cpu.Size()))
-		n71, err := m.Cpu.MarshalTo(dAtA[i:])
+		n72, err := m.Cpu.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n72
 	}
 	if m.Memory != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Memory.Size()))
-		n72, err := m.Memory.MarshalTo(dAtA[i:])
+		n73, err := m.Memory.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n73
 	}
 	if m.WritableLayer != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.WritableLayer.Size()))
-		n73, err := m.WritableLayer.MarshalTo(dAtA[i:])
+		n74, err := m.WritableLayer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += n74
 	}
 	return i, nil
 }
@@ -9910,11 +10842,11 @@ func (m *CpuUsage) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.UsageCoreNanoSeconds.Size()))
-		n74, err := m.UsageCoreNanoSeconds.MarshalTo(dAtA[i:])
+		n75, err := m.UsageCoreNanoSeconds.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n74
+		i += n75
 	}
 	return i, nil
 }
@@ -9943,16 +10875,16 @@ func (m *MemoryUsage) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.WorkingSetBytes.Size()))
-		n75, err := m.WorkingSetBytes.MarshalTo(dAtA[i:])
+		n76, err := m.WorkingSetBytes.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
+		i += n76
 	}
 	return i, nil
 }
 
-func (m *ReopenContainerLogRequest) Marshal() (dAtA []byte, err error) {
+func (m *PodSandboxStatsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -9962,21 +10894,21 @@ func (m *ReopenContainerLogRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReopenContainerLogRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PodSandboxStatsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContainerId) > 0 {
+	if len(m.PodSandboxId) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.PodSandboxId)))
+		i += copy(dAtA[i:], m.PodSandboxId)
 	}
 	return i, nil
 }
 
-func (m *ReopenContainerLogResponse) Marshal() (dAtA []byte, err error) {
+func (m *PodSandboxStatsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -9986,15 +10918,25 @@ func (m *ReopenContainerLogResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReopenContainerLogResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PodSandboxStatsResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Stats != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Stats.Size()))
+		n77, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n77
+	}
 	return i, nil
 }
 
-func (m *PauseContainerRequest) Marshal() (dAtA []byte, err error) {
+func (m *PodSandboxStatsFilter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -10004,21 +10946,38 @@ func (m *PauseContainerRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PauseContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PodSandboxStatsFilter) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContainerId) > 0 {
+	if len(m.Id) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.LabelSelector) > 0 {
+		for k := range m.LabelSelector {
+			dAtA[i] = 0x12
+			i++
+			v := m.LabelSelector[k]
+			mapSize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
 	}
 	return i, nil
 }
 
-func (m *PauseContainerResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListPodSandboxStatsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -10028,15 +10987,25 @@ func (m *PauseContainerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PauseContainerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListPodSandboxStatsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Filter != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Filter.Size()))
+		n78, err := m.Filter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n78
+	}
 	return i, nil
 }
 
-func (m *UnpauseContainerRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListPodSandboxStatsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -10046,21 +11015,27 @@ func (m *UnpauseContainerRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnpauseContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListPodSandboxStatsResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContainerId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
+	if len(m.Stats) > 0 {
+		for _, msg := range m.Stats {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
 
-func (m *UnpauseContainerResponse) Marshal() (dAtA []byte, err error) {
+func (m *PodSandboxAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -10070,15 +11045,65 @@ func (m *UnpauseContainerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnpauseContainerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PodSandboxAttributes) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Metadata != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Metadata.Size()))
+		n79, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n79
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Labels[k]
+			mapSize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			dAtA[i] = 0x22
+			i++
+			v := m.Annotations[k]
+			mapSize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
 	return i, nil
 }
 
-func (m *RemoveVolumeRequest) Marshal() (dAtA []byte, err error) {
+func (m *PodSandboxStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -10088,21 +11113,45 @@ func (m *RemoveVolumeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveVolumeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PodSandboxStats) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.VolumeName) > 0 {
+	if m.Attributes != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.VolumeName)))
-		i += copy(dAtA[i:], m.VolumeName)
+		i = encodeVarintApi(dAtA, i, uint64(m.Attributes.Size()))
+		n80, err := m.Attributes.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n80
+	}
+	if m.Linux != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Linux.Size()))
+		n81, err := m.Linux.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n81
+	}
+	if m.Windows != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Windows.Size()))
+		n82, err := m.Windows.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n82
 	}
 	return i, nil
 }
 
-func (m *RemoveVolumeResponse) Marshal() (dAtA []byte, err error) {
+func (m *LinuxPodSandboxStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -10112,15 +11161,67 @@ func (m *RemoveVolumeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveVolumeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *LinuxPodSandboxStats) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Cpu != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Cpu.Size()))
+		n83, err := m.Cpu.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n83
+	}
+	if m.Memory != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Memory.Size()))
+		n84, err := m.Memory.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n84
+	}
+	if m.Network != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Network.Size()))
+		n85, err := m.Network.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n85
+	}
+	if m.Process != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Process.Size()))
+		n86, err := m.Process.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n86
+	}
+	if len(m.Containers) > 0 {
+		for _, msg := range m.Containers {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
-func (m *StartPodSandboxRequest) Marshal() (dAtA []byte, err error) {
+func (m *WindowsPodSandboxStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -10130,21 +11231,15 @@ func (m *StartPodSandboxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StartPodSandboxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *WindowsPodSandboxStats) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.PodSandboxId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.PodSandboxId)))
-		i += copy(dAtA[i:], m.PodSandboxId)
-	}
 	return i, nil
 }
 
-func (m *StartPodSandboxResponse) Marshal() (dAtA []byte, err error) {
+func (m *NetworkUsage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -10154,1046 +11249,958 @@ func (m *StartPodSandboxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StartPodSandboxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *NetworkUsage) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	return i, nil
-}
-
-func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Timestamp))
+	}
+	if m.DefaultInterface != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.DefaultInterface.Size()))
+		n87, err := m.DefaultInterface.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n87
+	}
+	if len(m.Interfaces) > 0 {
+		for _, msg := range m.Interfaces {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
 }
-func (m *VersionRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+
+func (m *NetworkInterfaceUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *VersionResponse) Size() (n int) {
+func (m *NetworkInterfaceUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	l = len(m.RuntimeName)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.RxBytes != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.RxBytes.Size()))
+		n88, err := m.RxBytes.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n88
 	}
-	l = len(m.RuntimeVersion)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.RxErrors != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.RxErrors.Size()))
+		n89, err := m.RxErrors.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n89
 	}
-	l = len(m.RuntimeApiVersion)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.TxBytes != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.TxBytes.Size()))
+		n90, err := m.TxBytes.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n90
 	}
-	return n
+	if m.TxErrors != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.TxErrors.Size()))
+		n91, err := m.TxErrors.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n91
+	}
+	return i, nil
 }
 
-func (m *DNSConfig) Size() (n int) {
+func (m *ProcessUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Servers) > 0 {
-		for _, s := range m.Servers {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Timestamp))
 	}
-	if len(m.Searches) > 0 {
-		for _, s := range m.Searches {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
+	if m.ProcessCount != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.ProcessCount.Size()))
+		n92, err := m.ProcessCount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n92
 	}
-	if len(m.Options) > 0 {
-		for _, s := range m.Options {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
+	return i, nil
+}
+
+func (m *ReopenContainerLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PortMapping) Size() (n int) {
+func (m *ReopenContainerLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Protocol != 0 {
-		n += 1 + sovApi(uint64(m.Protocol))
-	}
-	if m.ContainerPort != 0 {
-		n += 1 + sovApi(uint64(m.ContainerPort))
-	}
-	if m.HostPort != 0 {
-		n += 1 + sovApi(uint64(m.HostPort))
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
 	}
-	l = len(m.HostIp)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	return i, nil
+}
+
+func (m *ReopenContainerLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Mount) Size() (n int) {
+func (m *ReopenContainerLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContainerPath)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.HostPath)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Readonly {
-		n += 2
-	}
-	if m.SelinuxRelabel {
-		n += 2
-	}
-	if m.Propagation != 0 {
-		n += 1 + sovApi(uint64(m.Propagation))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 2 + l + sovApi(uint64(l))
+	return i, nil
+}
+
+func (m *PauseContainerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NamespaceOption) Size() (n int) {
+func (m *PauseContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Network != 0 {
-		n += 1 + sovApi(uint64(m.Network))
-	}
-	if m.Pid != 0 {
-		n += 1 + sovApi(uint64(m.Pid))
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
 	}
-	if m.Ipc != 0 {
-		n += 1 + sovApi(uint64(m.Ipc))
+	return i, nil
+}
+
+func (m *PauseContainerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Int64Value) Size() (n int) {
+func (m *PauseContainerResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		n += 1 + sovApi(uint64(m.Value))
+	return i, nil
+}
+
+func (m *UnpauseContainerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LinuxSandboxSecurityContext) Size() (n int) {
+func (m *UnpauseContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.NamespaceOptions != nil {
-		l = m.NamespaceOptions.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.SelinuxOptions != nil {
-		l = m.SelinuxOptions.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.RunAsUser != nil {
-		l = m.RunAsUser.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
 	}
-	if m.ReadonlyRootfs {
-		n += 2
-	}
-	if len(m.SupplementalGroups) > 0 {
-		l = 0
-		for _, e := range m.SupplementalGroups {
-			l += sovApi(uint64(e))
-		}
-		n += 1 + sovApi(uint64(l)) + l
-	}
-	if m.Privileged {
-		n += 2
-	}
-	l = len(m.SeccompProfilePath)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.RunAsGroup != nil {
-		l = m.RunAsGroup.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	return n
+	return i, nil
 }
 
-func (m *LinuxPodSandboxConfig) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.CgroupParent)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.SecurityContext != nil {
-		l = m.SecurityContext.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if len(m.Sysctls) > 0 {
-		for k, v := range m.Sysctls {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
+func (m *UnpauseContainerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PodSandboxMetadata) Size() (n int) {
+func (m *UnpauseContainerResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.Uid)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovApi(uint64(m.Attempt))
-	}
-	return n
+	return i, nil
 }
 
-func (m *PodSandboxConfig) Size() (n int) {
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.Hostname)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.LogDirectory)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.DnsConfig != nil {
-		l = m.DnsConfig.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if len(m.PortMappings) > 0 {
-		for _, e := range m.PortMappings {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
-	}
-	if m.Linux != nil {
-		l = m.Linux.Size()
-		n += 1 + l + sovApi(uint64(l))
+func (m *RemoveVolumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RunPodSandboxRequest) Size() (n int) {
+func (m *RemoveVolumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.RuntimeHandler)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.VolumeName) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.VolumeName)))
+		i += copy(dAtA[i:], m.VolumeName)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RunPodSandboxResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.PodSandboxId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+func (m *RemoveVolumeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *StopPodSandboxRequest) Size() (n int) {
+func (m *RemoveVolumeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PodSandboxId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	return n
+	return i, nil
 }
 
-func (m *StopPodSandboxResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *StartPodSandboxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *RemovePodSandboxRequest) Size() (n int) {
+func (m *StartPodSandboxRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PodSandboxId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.PodSandboxId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.PodSandboxId)))
+		i += copy(dAtA[i:], m.PodSandboxId)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RemovePodSandboxResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *StartPodSandboxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *PodSandboxStatusRequest) Size() (n int) {
+func (m *StartPodSandboxResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PodSandboxId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Verbose {
-		n += 2
-	}
-	return n
+	return i, nil
 }
 
-func (m *PodSandboxNetworkStatus) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Ip)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+func (m *GetEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Namespace) Size() (n int) {
+func (m *GetEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Options != nil {
-		l = m.Options.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	return n
+	return i, nil
 }
 
-func (m *LinuxPodSandboxStatus) Size() (n int) {
-	var l int
-	_ = l
-	if m.Namespaces != nil {
-		l = m.Namespaces.Size()
-		n += 1 + l + sovApi(uint64(l))
+func (m *ContainerEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PodSandboxStatus) Size() (n int) {
+func (m *ContainerEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
 	}
-	if m.State != 0 {
-		n += 1 + sovApi(uint64(m.State))
+	if m.ContainerEventType != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.ContainerEventType))
 	}
 	if m.CreatedAt != 0 {
-		n += 1 + sovApi(uint64(m.CreatedAt))
-	}
-	if m.Network != nil {
-		l = m.Network.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Linux != nil {
-		l = m.Linux.Size()
-		n += 1 + l + sovApi(uint64(l))
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.CreatedAt))
 	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+	if m.PodSandboxStatus != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PodSandboxStatus.Size()))
+		n93, err := m.PodSandboxStatus.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n93
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+	if len(m.ContainersStatuses) > 0 {
+		for _, msg := range m.ContainersStatuses {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *PodSandboxStatusResponse) Size() (n int) {
+func (m *CheckpointContainerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
 	}
-	if len(m.Info) > 0 {
-		for k, v := range m.Info {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
+	if len(m.Location) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Location)))
+		i += copy(dAtA[i:], m.Location)
 	}
-	return n
+	if m.Timeout != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Timeout))
+	}
+	return i, nil
 }
 
-func (m *PodSandboxStateValue) Size() (n int) {
-	var l int
-	_ = l
-	if m.State != 0 {
-		n += 1 + sovApi(uint64(m.State))
+func (m *CheckpointContainerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PodSandboxFilter) Size() (n int) {
+func (m *CheckpointContainerResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if len(m.LabelSelector) > 0 {
-		for k, v := range m.LabelSelector {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
-	}
-	return n
+	return i, nil
 }
 
-func (m *ListPodSandboxRequest) Size() (n int) {
+func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *VersionRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Filter != nil {
-		l = m.Filter.Size()
+	l = len(m.Version)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *PodSandbox) Size() (n int) {
+func (m *VersionResponse) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Id)
+	l = len(m.Version)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
+	l = len(m.RuntimeName)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.State != 0 {
-		n += 1 + sovApi(uint64(m.State))
-	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovApi(uint64(m.CreatedAt))
-	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
+	l = len(m.RuntimeVersion)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
+	l = len(m.RuntimeApiVersion)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *ListPodSandboxResponse) Size() (n int) {
+func (m *DNSConfig) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
+	if len(m.Servers) > 0 {
+		for _, s := range m.Servers {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Searches) > 0 {
+		for _, s := range m.Searches {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Options) > 0 {
+		for _, s := range m.Options {
+			l = len(s)
 			n += 1 + l + sovApi(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *ImageSpec) Size() (n int) {
+func (m *PortMapping) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Image)
+	if m.Protocol != 0 {
+		n += 1 + sovApi(uint64(m.Protocol))
+	}
+	if m.ContainerPort != 0 {
+		n += 1 + sovApi(uint64(m.ContainerPort))
+	}
+	if m.HostPort != 0 {
+		n += 1 + sovApi(uint64(m.HostPort))
+	}
+	l = len(m.HostIp)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *KeyValue) Size() (n int) {
+func (m *Mount) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Key)
+	l = len(m.ContainerPath)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.Value)
+	l = len(m.HostPath)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if m.Readonly {
+		n += 2
+	}
+	if m.SelinuxRelabel {
+		n += 2
+	}
+	if m.Propagation != 0 {
+		n += 1 + sovApi(uint64(m.Propagation))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 2 + l + sovApi(uint64(l))
+	}
 	return n
 }
 
-func (m *LinuxContainerResources) Size() (n int) {
+func (m *NamespaceOption) Size() (n int) {
 	var l int
 	_ = l
-	if m.CpuPeriod != 0 {
-		n += 1 + sovApi(uint64(m.CpuPeriod))
+	if m.Network != 0 {
+		n += 1 + sovApi(uint64(m.Network))
 	}
-	if m.CpuQuota != 0 {
-		n += 1 + sovApi(uint64(m.CpuQuota))
+	if m.Pid != 0 {
+		n += 1 + sovApi(uint64(m.Pid))
 	}
-	if m.CpuShares != 0 {
-		n += 1 + sovApi(uint64(m.CpuShares))
+	if m.Ipc != 0 {
+		n += 1 + sovApi(uint64(m.Ipc))
 	}
-	if m.MemoryLimitInBytes != 0 {
-		n += 1 + sovApi(uint64(m.MemoryLimitInBytes))
+	if m.UsernsOptions != nil {
+		l = m.UsernsOptions.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.OomScoreAdj != 0 {
-		n += 1 + sovApi(uint64(m.OomScoreAdj))
+	return n
+}
+
+func (m *IDMapping) Size() (n int) {
+	var l int
+	_ = l
+	if m.HostId != 0 {
+		n += 1 + sovApi(uint64(m.HostId))
 	}
-	l = len(m.CpusetCpus)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.ContainerId != 0 {
+		n += 1 + sovApi(uint64(m.ContainerId))
 	}
-	l = len(m.CpusetMems)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.Length != 0 {
+		n += 1 + sovApi(uint64(m.Length))
 	}
-	if len(m.DiskQuota) > 0 {
-		for k, v := range m.DiskQuota {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 2 + sovApi(uint64(mapEntrySize))
-		}
-	}
-	if m.BlkioWeight != 0 {
-		n += 2 + sovApi(uint64(m.BlkioWeight))
-	}
-	if len(m.BlkioWeightDevice) > 0 {
-		for _, e := range m.BlkioWeightDevice {
-			l = e.Size()
-			n += 2 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.BlkioDeviceReadBps) > 0 {
-		for _, e := range m.BlkioDeviceReadBps {
-			l = e.Size()
-			n += 2 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.BlkioDeviceWriteBps) > 0 {
-		for _, e := range m.BlkioDeviceWriteBps {
-			l = e.Size()
-			n += 2 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.BlkioDeviceRead_IOps) > 0 {
-		for _, e := range m.BlkioDeviceRead_IOps {
-			l = e.Size()
-			n += 2 + l + sovApi(uint64(l))
-		}
+	return n
+}
+
+func (m *UserNamespace) Size() (n int) {
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovApi(uint64(m.Mode))
 	}
-	if len(m.BlkioDeviceWrite_IOps) > 0 {
-		for _, e := range m.BlkioDeviceWrite_IOps {
+	if len(m.Uids) > 0 {
+		for _, e := range m.Uids {
 			l = e.Size()
-			n += 2 + l + sovApi(uint64(l))
+			n += 1 + l + sovApi(uint64(l))
 		}
 	}
-	if m.KernelMemory != 0 {
-		n += 2 + sovApi(uint64(m.KernelMemory))
-	}
-	if m.MemoryReservation != 0 {
-		n += 2 + sovApi(uint64(m.MemoryReservation))
-	}
-	if m.MemorySwappiness != nil {
-		l = m.MemorySwappiness.Size()
-		n += 2 + l + sovApi(uint64(l))
-	}
-	if len(m.Ulimits) > 0 {
-		for _, e := range m.Ulimits {
+	if len(m.Gids) > 0 {
+		for _, e := range m.Gids {
 			l = e.Size()
-			n += 2 + l + sovApi(uint64(l))
+			n += 1 + l + sovApi(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *WeightDevice) Size() (n int) {
+func (m *Int64Value) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Weight != 0 {
-		n += 1 + sovApi(uint64(m.Weight))
+	if m.Value != 0 {
+		n += 1 + sovApi(uint64(m.Value))
 	}
 	return n
 }
 
-func (m *ThrottleDevice) Size() (n int) {
+func (m *LinuxSandboxSecurityContext) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Path)
+	if m.NamespaceOptions != nil {
+		l = m.NamespaceOptions.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.SelinuxOptions != nil {
+		l = m.SelinuxOptions.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.RunAsUser != nil {
+		l = m.RunAsUser.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ReadonlyRootfs {
+		n += 2
+	}
+	if len(m.SupplementalGroups) > 0 {
+		l = 0
+		for _, e := range m.SupplementalGroups {
+			l += sovApi(uint64(e))
+		}
+		n += 1 + sovApi(uint64(l)) + l
+	}
+	if m.Privileged {
+		n += 2
+	}
+	l = len(m.SeccompProfilePath)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Rate != 0 {
-		n += 1 + sovApi(uint64(m.Rate))
+	if m.RunAsGroup != nil {
+		l = m.RunAsGroup.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *Ulimit) Size() (n int) {
+func (m *LinuxPodSandboxConfig) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.CgroupParent)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Hard != 0 {
-		n += 1 + sovApi(uint64(m.Hard))
+	if m.SecurityContext != nil {
+		l = m.SecurityContext.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Soft != 0 {
-		n += 1 + sovApi(uint64(m.Soft))
+	if len(m.Sysctls) > 0 {
+		for k, v := range m.Sysctls {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
 	}
 	return n
 }
 
-func (m *SELinuxOption) Size() (n int) {
+func (m *PodSandboxMetadata) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.Role)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.Type)
+	l = len(m.Uid)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.Level)
+	l = len(m.Namespace)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *Capability) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.AddCapabilities) > 0 {
-		for _, s := range m.AddCapabilities {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.DropCapabilities) > 0 {
-		for _, s := range m.DropCapabilities {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
+	if m.Attempt != 0 {
+		n += 1 + sovApi(uint64(m.Attempt))
 	}
 	return n
 }
 
-func (m *LinuxContainerSecurityContext) Size() (n int) {
+func (m *PodSandboxConfig) Size() (n int) {
 	var l int
 	_ = l
-	if m.Capabilities != nil {
-		l = m.Capabilities.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Privileged {
-		n += 2
-	}
-	if m.NamespaceOptions != nil {
-		l = m.NamespaceOptions.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.SelinuxOptions != nil {
-		l = m.SelinuxOptions.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.RunAsUser != nil {
-		l = m.RunAsUser.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.RunAsUsername)
-	if l > 0 {
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.ReadonlyRootfs {
-		n += 2
-	}
-	if len(m.SupplementalGroups) > 0 {
-		l = 0
-		for _, e := range m.SupplementalGroups {
-			l += sovApi(uint64(e))
-		}
-		n += 1 + sovApi(uint64(l)) + l
-	}
-	l = len(m.ApparmorProfile)
+	l = len(m.Hostname)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.SeccompProfilePath)
+	l = len(m.LogDirectory)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.NoNewPrivs {
-		n += 2
-	}
-	if m.RunAsGroup != nil {
-		l = m.RunAsGroup.Size()
+	if m.DnsConfig != nil {
+		l = m.DnsConfig.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.MaskedPaths) > 0 {
-		for _, s := range m.MaskedPaths {
-			l = len(s)
+	if len(m.PortMappings) > 0 {
+		for _, e := range m.PortMappings {
+			l = e.Size()
 			n += 1 + l + sovApi(uint64(l))
 		}
 	}
-	if len(m.ReadonlyPaths) > 0 {
-		for _, s := range m.ReadonlyPaths {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
 		}
 	}
+	if m.Linux != nil {
+		l = m.Linux.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
 	return n
 }
 
-func (m *LinuxContainerConfig) Size() (n int) {
+func (m *RunPodSandboxRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Resources != nil {
-		l = m.Resources.Size()
+	if m.Config != nil {
+		l = m.Config.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.SecurityContext != nil {
-		l = m.SecurityContext.Size()
+	l = len(m.RuntimeHandler)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *WindowsContainerSecurityContext) Size() (n int) {
+func (m *RunPodSandboxResponse) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.RunAsUsername)
+	l = len(m.PodSandboxId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *WindowsContainerConfig) Size() (n int) {
+func (m *StopPodSandboxRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Resources != nil {
-		l = m.Resources.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.SecurityContext != nil {
-		l = m.SecurityContext.Size()
+	l = len(m.PodSandboxId)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *WindowsContainerResources) Size() (n int) {
+func (m *StopPodSandboxResponse) Size() (n int) {
 	var l int
 	_ = l
-	if m.CpuShares != 0 {
-		n += 1 + sovApi(uint64(m.CpuShares))
-	}
-	if m.CpuCount != 0 {
-		n += 1 + sovApi(uint64(m.CpuCount))
-	}
-	if m.CpuMaximum != 0 {
-		n += 1 + sovApi(uint64(m.CpuMaximum))
-	}
-	if m.MemoryLimitInBytes != 0 {
-		n += 1 + sovApi(uint64(m.MemoryLimitInBytes))
+	return n
+}
+
+func (m *RemovePodSandboxRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.PodSandboxId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *ContainerMetadata) Size() (n int) {
+func (m *RemovePodSandboxResponse) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovApi(uint64(m.Attempt))
-	}
 	return n
 }
 
-func (m *Device) Size() (n int) {
+func (m *PodSandboxStatusRequest) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ContainerPath)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.HostPath)
+	l = len(m.PodSandboxId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.Permissions)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.Verbose {
+		n += 2
 	}
 	return n
 }
 
-func (m *ContainerConfig) Size() (n int) {
+func (m *PodSandboxNetworkStatus) Size() (n int) {
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Image != nil {
-		l = m.Image.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if len(m.Command) > 0 {
-		for _, s := range m.Command {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
-	}
-	l = len(m.WorkingDir)
+	l = len(m.Ip)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Envs) > 0 {
-		for _, e := range m.Envs {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.Mounts) > 0 {
-		for _, e := range m.Mounts {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.Devices) > 0 {
-		for _, e := range m.Devices {
+	if len(m.AdditionalIps) > 0 {
+		for _, e := range m.AdditionalIps {
 			l = e.Size()
 			n += 1 + l + sovApi(uint64(l))
 		}
 	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
-	}
-	l = len(m.LogPath)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Stdin {
-		n += 2
-	}
-	if m.StdinOnce {
-		n += 2
-	}
-	if m.Tty {
-		n += 2
-	}
-	if m.Linux != nil {
-		l = m.Linux.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Windows != nil {
-		l = m.Windows.Size()
-		n += 2 + l + sovApi(uint64(l))
-	}
-	if m.NetPriority != 0 {
-		n += 2 + sovApi(uint64(m.NetPriority))
-	}
-	l = len(m.QuotaId)
-	if l > 0 {
-		n += 2 + l + sovApi(uint64(l))
-	}
 	return n
 }
 
-func (m *CreateContainerRequest) Size() (n int) {
+func (m *PodIP) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.PodSandboxId)
+	l = len(m.Ip)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.SandboxConfig != nil {
-		l = m.SandboxConfig.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
 	return n
 }
 
-func (m *CreateContainerResponse) Size() (n int) {
+func (m *Namespace) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
+	if m.Options != nil {
+		l = m.Options.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *StartContainerRequest) Size() (n int) {
+func (m *LinuxPodSandboxStatus) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
+	if m.Namespaces != nil {
+		l = m.Namespaces.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *StartContainerResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *StopContainerRequest) Size() (n int) {
+func (m *PodSandboxStatus) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ContainerId)
+	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovApi(uint64(m.Timeout))
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *StopContainerResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *RemoveContainerRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
+	if m.State != 0 {
+		n += 1 + sovApi(uint64(m.State))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovApi(uint64(m.CreatedAt))
+	}
+	if m.Network != nil {
+		l = m.Network.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Linux != nil {
+		l = m.Linux.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
-func (m *RemoveContainerResponse) Size() (n int) {
+func (m *PodSandboxStatusResponse) Size() (n int) {
 	var l int
 	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Info) > 0 {
+		for k, v := range m.Info {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
-func (m *ContainerStateValue) Size() (n int) {
+func (m *PodSandboxStateValue) Size() (n int) {
 	var l int
 	_ = l
 	if m.State != 0 {
@@ -11202,7 +12209,7 @@ func (m *ContainerStateValue) Size() (n int) {
 	return n
 }
 
-func (m *ContainerFilter) Size() (n int) {
+func (m *PodSandboxFilter) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.Id)
@@ -11213,10 +12220,6 @@ func (m *ContainerFilter) Size() (n int) {
 		l = m.State.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.PodSandboxId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
 	if len(m.LabelSelector) > 0 {
 		for k, v := range m.LabelSelector {
 			_ = k
@@ -11228,7 +12231,7 @@ func (m *ContainerFilter) Size() (n int) {
 	return n
 }
 
-func (m *ListContainersRequest) Size() (n int) {
+func (m *ListPodSandboxRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Filter != nil {
@@ -11238,29 +12241,17 @@ func (m *ListContainersRequest) Size() (n int) {
 	return n
 }
 
-func (m *Container) Size() (n int) {
+func (m *PodSandbox) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.PodSandboxId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
 	if m.Metadata != nil {
 		l = m.Metadata.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Image != nil {
-		l = m.Image.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.ImageRef)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
 	if m.State != 0 {
 		n += 1 + sovApi(uint64(m.State))
 	}
@@ -11286,11 +12277,11 @@ func (m *Container) Size() (n int) {
 	return n
 }
 
-func (m *ListContainersResponse) Size() (n int) {
+func (m *ListPodSandboxResponse) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Containers) > 0 {
-		for _, e := range m.Containers {
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
 			l = e.Size()
 			n += 1 + l + sovApi(uint64(l))
 		}
@@ -11298,110 +12289,109 @@ func (m *ListContainersResponse) Size() (n int) {
 	return n
 }
 
-func (m *ContainerStatusRequest) Size() (n int) {
+func (m *ImageSpec) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ContainerId)
+	l = len(m.Image)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Verbose {
-		n += 2
-	}
 	return n
 }
 
-func (m *ContainerStatus) Size() (n int) {
+func (m *KeyValue) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Id)
+	l = len(m.Key)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
+	l = len(m.Value)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.State != 0 {
-		n += 1 + sovApi(uint64(m.State))
-	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovApi(uint64(m.CreatedAt))
-	}
-	if m.StartedAt != 0 {
-		n += 1 + sovApi(uint64(m.StartedAt))
+	return n
+}
+
+func (m *LinuxContainerResources) Size() (n int) {
+	var l int
+	_ = l
+	if m.CpuPeriod != 0 {
+		n += 1 + sovApi(uint64(m.CpuPeriod))
 	}
-	if m.FinishedAt != 0 {
-		n += 1 + sovApi(uint64(m.FinishedAt))
+	if m.CpuQuota != 0 {
+		n += 1 + sovApi(uint64(m.CpuQuota))
 	}
-	if m.ExitCode != 0 {
-		n += 1 + sovApi(uint64(m.ExitCode))
+	if m.CpuShares != 0 {
+		n += 1 + sovApi(uint64(m.CpuShares))
 	}
-	if m.Image != nil {
-		l = m.Image.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if m.MemoryLimitInBytes != 0 {
+		n += 1 + sovApi(uint64(m.MemoryLimitInBytes))
 	}
-	l = len(m.ImageRef)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.OomScoreAdj != 0 {
+		n += 1 + sovApi(uint64(m.OomScoreAdj))
 	}
-	l = len(m.Reason)
+	l = len(m.CpusetCpus)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.Message)
+	l = len(m.CpusetMems)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
+	if len(m.DiskQuota) > 0 {
+		for k, v := range m.DiskQuota {
 			_ = k
 			_ = v
 			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+			n += mapEntrySize + 2 + sovApi(uint64(mapEntrySize))
 		}
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+	if m.BlkioWeight != 0 {
+		n += 2 + sovApi(uint64(m.BlkioWeight))
+	}
+	if len(m.BlkioWeightDevice) > 0 {
+		for _, e := range m.BlkioWeightDevice {
+			l = e.Size()
+			n += 2 + l + sovApi(uint64(l))
 		}
 	}
-	if len(m.Mounts) > 0 {
-		for _, e := range m.Mounts {
+	if len(m.BlkioDeviceReadBps) > 0 {
+		for _, e := range m.BlkioDeviceReadBps {
 			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
+			n += 2 + l + sovApi(uint64(l))
 		}
 	}
-	l = len(m.LogPath)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.BlkioDeviceWriteBps) > 0 {
+		for _, e := range m.BlkioDeviceWriteBps {
+			l = e.Size()
+			n += 2 + l + sovApi(uint64(l))
+		}
 	}
-	if len(m.Volumes) > 0 {
-		for k, v := range m.Volumes {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovApi(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + l
-			n += mapEntrySize + 2 + sovApi(uint64(mapEntrySize))
+	if len(m.BlkioDeviceRead_IOps) > 0 {
+		for _, e := range m.BlkioDeviceRead_IOps {
+			l = e.Size()
+			n += 2 + l + sovApi(uint64(l))
 		}
 	}
-	if m.Resources != nil {
-		l = m.Resources.Size()
-		n += 2 + l + sovApi(uint64(l))
+	if len(m.BlkioDeviceWrite_IOps) > 0 {
+		for _, e := range m.BlkioDeviceWrite_IOps {
+			l = e.Size()
+			n += 2 + l + sovApi(uint64(l))
+		}
 	}
-	l = len(m.QuotaId)
-	if l > 0 {
+	if m.KernelMemory != 0 {
+		n += 2 + sovApi(uint64(m.KernelMemory))
+	}
+	if m.MemoryReservation != 0 {
+		n += 2 + sovApi(uint64(m.MemoryReservation))
+	}
+	if m.MemorySwappiness != nil {
+		l = m.MemorySwappiness.Size()
 		n += 2 + l + sovApi(uint64(l))
 	}
-	if len(m.Envs) > 0 {
-		for _, e := range m.Envs {
+	if len(m.Ulimits) > 0 {
+		for _, e := range m.Ulimits {
 			l = e.Size()
 			n += 2 + l + sovApi(uint64(l))
 		}
@@ -11409,339 +12399,342 @@ func (m *ContainerStatus) Size() (n int) {
 	return n
 }
 
-func (m *Volume) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *ContainerStatusResponse) Size() (n int) {
+func (m *WeightDevice) Size() (n int) {
 	var l int
 	_ = l
-	if m.Status != nil {
-		l = m.Status.Size()
+	l = len(m.Path)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Info) > 0 {
-		for k, v := range m.Info {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
+	if m.Weight != 0 {
+		n += 1 + sovApi(uint64(m.Weight))
 	}
 	return n
 }
 
-func (m *UpdateContainerResourcesRequest) Size() (n int) {
+func (m *ThrottleDevice) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ContainerId)
+	l = len(m.Path)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Linux != nil {
-		l = m.Linux.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if len(m.SpecAnnotations) > 0 {
-		for k, v := range m.SpecAnnotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 2 + sovApi(uint64(mapEntrySize))
-		}
+	if m.Rate != 0 {
+		n += 1 + sovApi(uint64(m.Rate))
 	}
 	return n
 }
 
-func (m *UpdateContainerResourcesResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *ExecSyncRequest) Size() (n int) {
+func (m *Ulimit) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ContainerId)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Cmd) > 0 {
-		for _, s := range m.Cmd {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
+	if m.Hard != 0 {
+		n += 1 + sovApi(uint64(m.Hard))
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovApi(uint64(m.Timeout))
+	if m.Soft != 0 {
+		n += 1 + sovApi(uint64(m.Soft))
 	}
 	return n
 }
 
-func (m *ExecSyncResponse) Size() (n int) {
+func (m *SELinuxOption) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Stdout)
+	l = len(m.User)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.Stderr)
+	l = len(m.Role)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.ExitCode != 0 {
-		n += 1 + sovApi(uint64(m.ExitCode))
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *ExecRequest) Size() (n int) {
+func (m *Capability) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if len(m.Cmd) > 0 {
-		for _, s := range m.Cmd {
+	if len(m.AddCapabilities) > 0 {
+		for _, s := range m.AddCapabilities {
 			l = len(s)
 			n += 1 + l + sovApi(uint64(l))
 		}
 	}
-	if m.Tty {
-		n += 2
-	}
-	if m.Stdin {
-		n += 2
-	}
-	if m.Stdout {
-		n += 2
-	}
-	if m.Stderr {
-		n += 2
+	if len(m.DropCapabilities) > 0 {
+		for _, s := range m.DropCapabilities {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *ExecResponse) Size() (n int) {
+func (m *LinuxContainerSecurityContext) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Url)
-	if l > 0 {
+	if m.Capabilities != nil {
+		l = m.Capabilities.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *AttachRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
+	if m.Privileged {
+		n += 2
+	}
+	if m.NamespaceOptions != nil {
+		l = m.NamespaceOptions.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Stdin {
-		n += 2
+	if m.SelinuxOptions != nil {
+		l = m.SelinuxOptions.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Tty {
-		n += 2
+	if m.RunAsUser != nil {
+		l = m.RunAsUser.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Stdout {
-		n += 2
+	l = len(m.RunAsUsername)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Stderr {
+	if m.ReadonlyRootfs {
 		n += 2
 	}
-	return n
-}
-
-func (m *AttachResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Url)
+	if len(m.SupplementalGroups) > 0 {
+		l = 0
+		for _, e := range m.SupplementalGroups {
+			l += sovApi(uint64(e))
+		}
+		n += 1 + sovApi(uint64(l)) + l
+	}
+	l = len(m.ApparmorProfile)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *PortForwardRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.PodSandboxId)
+	l = len(m.SeccompProfilePath)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Port) > 0 {
-		l = 0
-		for _, e := range m.Port {
-			l += sovApi(uint64(e))
+	if m.NoNewPrivs {
+		n += 2
+	}
+	if m.RunAsGroup != nil {
+		l = m.RunAsGroup.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.MaskedPaths) > 0 {
+		for _, s := range m.MaskedPaths {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.ReadonlyPaths) > 0 {
+		for _, s := range m.ReadonlyPaths {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
 		}
-		n += 1 + sovApi(uint64(l)) + l
 	}
 	return n
 }
 
-func (m *PortForwardResponse) Size() (n int) {
+func (m *LinuxContainerConfig) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Url)
-	if l > 0 {
+	if m.Resources != nil {
+		l = m.Resources.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.SecurityContext != nil {
+		l = m.SecurityContext.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *ImageFilter) Size() (n int) {
+func (m *WindowsContainerSecurityContext) Size() (n int) {
 	var l int
 	_ = l
-	if m.Image != nil {
-		l = m.Image.Size()
+	l = len(m.RunAsUsername)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *ListImagesRequest) Size() (n int) {
+func (m *WindowsContainerConfig) Size() (n int) {
 	var l int
 	_ = l
-	if m.Filter != nil {
-		l = m.Filter.Size()
+	if m.Resources != nil {
+		l = m.Resources.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.SecurityContext != nil {
+		l = m.SecurityContext.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *Image) Size() (n int) {
+func (m *WindowsContainerResources) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if len(m.RepoTags) > 0 {
-		for _, s := range m.RepoTags {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
-	}
-	if len(m.RepoDigests) > 0 {
-		for _, s := range m.RepoDigests {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
-		}
-	}
-	if m.Size_ != 0 {
-		n += 1 + sovApi(uint64(m.Size_))
+	if m.CpuShares != 0 {
+		n += 1 + sovApi(uint64(m.CpuShares))
 	}
-	if m.Uid != nil {
-		l = m.Uid.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if m.CpuCount != 0 {
+		n += 1 + sovApi(uint64(m.CpuCount))
 	}
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.CpuMaximum != 0 {
+		n += 1 + sovApi(uint64(m.CpuMaximum))
 	}
-	if len(m.Volumes) > 0 {
-		for k, v := range m.Volumes {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovApi(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + l
-			n += mapEntrySize + 2 + sovApi(uint64(mapEntrySize))
-		}
+	if m.MemoryLimitInBytes != 0 {
+		n += 1 + sovApi(uint64(m.MemoryLimitInBytes))
 	}
 	return n
 }
 
-func (m *ListImagesResponse) Size() (n int) {
+func (m *ContainerMetadata) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
-		}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovApi(uint64(m.Attempt))
 	}
 	return n
 }
 
-func (m *ImageStatusRequest) Size() (n int) {
+func (m *Device) Size() (n int) {
 	var l int
 	_ = l
-	if m.Image != nil {
-		l = m.Image.Size()
+	l = len(m.ContainerPath)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Verbose {
-		n += 2
+	l = len(m.HostPath)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Permissions)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *ImageStatusResponse) Size() (n int) {
+func (m *ContainerConfig) Size() (n int) {
 	var l int
 	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.Image != nil {
 		l = m.Image.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Info) > 0 {
-		for k, v := range m.Info {
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	l = len(m.WorkingDir)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Envs) > 0 {
+		for _, e := range m.Envs {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Mounts) > 0 {
+		for _, e := range m.Mounts {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Devices) > 0 {
+		for _, e := range m.Devices {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
 			_ = k
 			_ = v
 			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
 			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
 		}
 	}
-	return n
-}
-
-func (m *AuthConfig) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
 	}
-	l = len(m.Password)
+	l = len(m.LogPath)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.Auth)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.Stdin {
+		n += 2
 	}
-	l = len(m.ServerAddress)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.StdinOnce {
+		n += 2
 	}
-	l = len(m.IdentityToken)
-	if l > 0 {
+	if m.Tty {
+		n += 2
+	}
+	if m.Linux != nil {
+		l = m.Linux.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.RegistryToken)
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 2 + l + sovApi(uint64(l))
+	}
+	if m.NetPriority != 0 {
+		n += 2 + sovApi(uint64(m.NetPriority))
+	}
+	l = len(m.QuotaId)
 	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+		n += 2 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *PullImageRequest) Size() (n int) {
+func (m *CreateContainerRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Image != nil {
-		l = m.Image.Size()
+	l = len(m.PodSandboxId)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Auth != nil {
-		l = m.Auth.Size()
+	if m.Config != nil {
+		l = m.Config.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
 	if m.SandboxConfig != nil {
@@ -11751,119 +12744,93 @@ func (m *PullImageRequest) Size() (n int) {
 	return n
 }
 
-func (m *PullImageResponse) Size() (n int) {
+func (m *CreateContainerResponse) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ImageRef)
+	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *RemoveImageRequest) Size() (n int) {
+func (m *StartContainerRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Image != nil {
-		l = m.Image.Size()
+	l = len(m.ContainerId)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *RemoveImageResponse) Size() (n int) {
+func (m *StartContainerResponse) Size() (n int) {
 	var l int
 	_ = l
 	return n
 }
 
-func (m *NetworkConfig) Size() (n int) {
+func (m *StopContainerRequest) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.PodCidr)
+	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if m.Timeout != 0 {
+		n += 1 + sovApi(uint64(m.Timeout))
+	}
 	return n
 }
 
-func (m *RuntimeConfig) Size() (n int) {
+func (m *StopContainerResponse) Size() (n int) {
 	var l int
 	_ = l
-	if m.NetworkConfig != nil {
-		l = m.NetworkConfig.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
 	return n
 }
 
-func (m *UpdateRuntimeConfigRequest) Size() (n int) {
+func (m *RemoveContainerRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.RuntimeConfig != nil {
-		l = m.RuntimeConfig.Size()
+	l = len(m.ContainerId)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *UpdateRuntimeConfigResponse) Size() (n int) {
+func (m *RemoveContainerResponse) Size() (n int) {
 	var l int
 	_ = l
 	return n
 }
 
-func (m *RuntimeCondition) Size() (n int) {
+func (m *ContainerStateValue) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Status {
-		n += 2
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.State != 0 {
+		n += 1 + sovApi(uint64(m.State))
 	}
 	return n
 }
 
-func (m *RuntimeStatus) Size() (n int) {
+func (m *ContainerFilter) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
-		}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *StatusRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Verbose {
-		n += 2
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *StatusResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Status != nil {
-		l = m.Status.Size()
+	l = len(m.PodSandboxId)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.Info) > 0 {
-		for k, v := range m.Info {
+	if len(m.LabelSelector) > 0 {
+		for k, v := range m.LabelSelector {
 			_ = k
 			_ = v
 			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
@@ -11873,57 +12840,69 @@ func (m *StatusResponse) Size() (n int) {
 	return n
 }
 
-func (m *ImageFsInfoRequest) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *UInt64Value) Size() (n int) {
+func (m *ListContainersRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Value != 0 {
-		n += 1 + sovApi(uint64(m.Value))
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *FilesystemIdentifier) Size() (n int) {
+func (m *Container) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Mountpoint)
+	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *FilesystemUsage) Size() (n int) {
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovApi(uint64(m.Timestamp))
+	l = len(m.PodSandboxId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.FsId != nil {
-		l = m.FsId.Size()
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.UsedBytes != nil {
-		l = m.UsedBytes.Size()
+	if m.Image != nil {
+		l = m.Image.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.InodesUsed != nil {
-		l = m.InodesUsed.Size()
+	l = len(m.ImageRef)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if m.State != 0 {
+		n += 1 + sovApi(uint64(m.State))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovApi(uint64(m.CreatedAt))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
-func (m *ImageFsInfoResponse) Size() (n int) {
+func (m *ListContainersResponse) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.ImageFilesystems) > 0 {
-		for _, e := range m.ImageFilesystems {
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
 			l = e.Size()
 			n += 1 + l + sovApi(uint64(l))
 		}
@@ -11931,79 +12910,59 @@ func (m *ImageFsInfoResponse) Size() (n int) {
 	return n
 }
 
-func (m *ContainerStatsRequest) Size() (n int) {
+func (m *ContainerStatusRequest) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *ContainerStatsResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	return n
-}
-
-func (m *ListContainerStatsRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Filter != nil {
-		l = m.Filter.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if m.Verbose {
+		n += 2
 	}
 	return n
 }
 
-func (m *ContainerStatsFilter) Size() (n int) {
+func (m *ContainerStatus) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	l = len(m.PodSandboxId)
-	if l > 0 {
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if len(m.LabelSelector) > 0 {
-		for k, v := range m.LabelSelector {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
+	if m.State != 0 {
+		n += 1 + sovApi(uint64(m.State))
 	}
-	return n
-}
-
-func (m *ListContainerStatsResponse) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Stats) > 0 {
-		for _, e := range m.Stats {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
-		}
+	if m.CreatedAt != 0 {
+		n += 1 + sovApi(uint64(m.CreatedAt))
 	}
-	return n
-}
-
-func (m *ContainerAttributes) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
+	if m.StartedAt != 0 {
+		n += 1 + sovApi(uint64(m.StartedAt))
+	}
+	if m.FinishedAt != 0 {
+		n += 1 + sovApi(uint64(m.FinishedAt))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovApi(uint64(m.ExitCode))
+	}
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ImageRef)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	if len(m.Labels) > 0 {
@@ -12022,1435 +12981,1406 @@ func (m *ContainerAttributes) Size() (n int) {
 			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
 		}
 	}
-	return n
-}
-
-func (m *ContainerStats) Size() (n int) {
-	var l int
-	_ = l
-	if m.Attributes != nil {
-		l = m.Attributes.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.Mounts) > 0 {
+		for _, e := range m.Mounts {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
-	if m.Cpu != nil {
-		l = m.Cpu.Size()
+	l = len(m.LogPath)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Memory != nil {
-		l = m.Memory.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if len(m.Volumes) > 0 {
+		for k, v := range m.Volumes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovApi(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovApi(uint64(mapEntrySize))
+		}
 	}
-	if m.WritableLayer != nil {
-		l = m.WritableLayer.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if m.Resources != nil {
+		l = m.Resources.Size()
+		n += 2 + l + sovApi(uint64(l))
+	}
+	l = len(m.QuotaId)
+	if l > 0 {
+		n += 2 + l + sovApi(uint64(l))
+	}
+	if len(m.Envs) > 0 {
+		for _, e := range m.Envs {
+			l = e.Size()
+			n += 2 + l + sovApi(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *CpuUsage) Size() (n int) {
+func (m *Volume) Size() (n int) {
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovApi(uint64(m.Timestamp))
-	}
-	if m.UsageCoreNanoSeconds != nil {
-		l = m.UsageCoreNanoSeconds.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
 	return n
 }
 
-func (m *MemoryUsage) Size() (n int) {
+func (m *ContainerStatusResponse) Size() (n int) {
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovApi(uint64(m.Timestamp))
-	}
-	if m.WorkingSetBytes != nil {
-		l = m.WorkingSetBytes.Size()
+	if m.Status != nil {
+		l = m.Status.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if len(m.Info) > 0 {
+		for k, v := range m.Info {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
-func (m *ReopenContainerLogRequest) Size() (n int) {
+func (m *UpdateContainerResourcesRequest) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if m.Linux != nil {
+		l = m.Linux.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.SpecAnnotations) > 0 {
+		for k, v := range m.SpecAnnotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 2 + sovApi(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
-func (m *ReopenContainerLogResponse) Size() (n int) {
+func (m *UpdateContainerResourcesResponse) Size() (n int) {
 	var l int
 	_ = l
 	return n
 }
 
-func (m *PauseContainerRequest) Size() (n int) {
+func (m *ExecSyncRequest) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if len(m.Cmd) > 0 {
+		for _, s := range m.Cmd {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovApi(uint64(m.Timeout))
+	}
 	return n
 }
 
-func (m *PauseContainerResponse) Size() (n int) {
+func (m *ExecSyncResponse) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.Stdout)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Stderr)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovApi(uint64(m.ExitCode))
+	}
 	return n
 }
 
-func (m *UnpauseContainerRequest) Size() (n int) {
+func (m *ExecRequest) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if len(m.Cmd) > 0 {
+		for _, s := range m.Cmd {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if m.Tty {
+		n += 2
+	}
+	if m.Stdin {
+		n += 2
+	}
+	if m.Stdout {
+		n += 2
+	}
+	if m.Stderr {
+		n += 2
+	}
 	return n
 }
 
-func (m *UnpauseContainerResponse) Size() (n int) {
+func (m *ExecResponse) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	return n
 }
 
-func (m *RemoveVolumeRequest) Size() (n int) {
+func (m *AttachRequest) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.VolumeName)
+	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if m.Stdin {
+		n += 2
+	}
+	if m.Tty {
+		n += 2
+	}
+	if m.Stdout {
+		n += 2
+	}
+	if m.Stderr {
+		n += 2
+	}
 	return n
 }
 
-func (m *RemoveVolumeResponse) Size() (n int) {
+func (m *AttachResponse) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	return n
 }
 
-func (m *StartPodSandboxRequest) Size() (n int) {
+func (m *PortForwardRequest) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.PodSandboxId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if len(m.Port) > 0 {
+		l = 0
+		for _, e := range m.Port {
+			l += sovApi(uint64(e))
+		}
+		n += 1 + sovApi(uint64(l)) + l
+	}
 	return n
 }
 
-func (m *StartPodSandboxResponse) Size() (n int) {
+func (m *PortForwardResponse) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	return n
 }
 
-func sovApi(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *ImageFilter) Size() (n int) {
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
-func sozApi(x uint64) (n int) {
-	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *VersionRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListImagesRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&VersionRequest{`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *VersionResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Image) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&VersionResponse{`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`RuntimeName:` + fmt.Sprintf("%v", this.RuntimeName) + `,`,
-		`RuntimeVersion:` + fmt.Sprintf("%v", this.RuntimeVersion) + `,`,
-		`RuntimeApiVersion:` + fmt.Sprintf("%v", this.RuntimeApiVersion) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DNSConfig) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.RepoTags) > 0 {
+		for _, s := range m.RepoTags {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&DNSConfig{`,
-		`Servers:` + fmt.Sprintf("%v", this.Servers) + `,`,
-		`Searches:` + fmt.Sprintf("%v", this.Searches) + `,`,
-		`Options:` + fmt.Sprintf("%v", this.Options) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PortMapping) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.RepoDigests) > 0 {
+		for _, s := range m.RepoDigests {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&PortMapping{`,
-		`Protocol:` + fmt.Sprintf("%v", this.Protocol) + `,`,
-		`ContainerPort:` + fmt.Sprintf("%v", this.ContainerPort) + `,`,
-		`HostPort:` + fmt.Sprintf("%v", this.HostPort) + `,`,
-		`HostIp:` + fmt.Sprintf("%v", this.HostIp) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Mount) String() string {
-	if this == nil {
-		return "nil"
+	if m.Size_ != 0 {
+		n += 1 + sovApi(uint64(m.Size_))
 	}
-	s := strings.Join([]string{`&Mount{`,
-		`ContainerPath:` + fmt.Sprintf("%v", this.ContainerPath) + `,`,
-		`HostPath:` + fmt.Sprintf("%v", this.HostPath) + `,`,
-		`Readonly:` + fmt.Sprintf("%v", this.Readonly) + `,`,
-		`SelinuxRelabel:` + fmt.Sprintf("%v", this.SelinuxRelabel) + `,`,
-		`Propagation:` + fmt.Sprintf("%v", this.Propagation) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *NamespaceOption) String() string {
-	if this == nil {
-		return "nil"
+	if m.Uid != nil {
+		l = m.Uid.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&NamespaceOption{`,
-		`Network:` + fmt.Sprintf("%v", this.Network) + `,`,
-		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
-		`Ipc:` + fmt.Sprintf("%v", this.Ipc) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Int64Value) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&Int64Value{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *LinuxSandboxSecurityContext) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Volumes) > 0 {
+		for k, v := range m.Volumes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovApi(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovApi(uint64(mapEntrySize))
+		}
 	}
-	s := strings.Join([]string{`&LinuxSandboxSecurityContext{`,
-		`NamespaceOptions:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceOptions), "NamespaceOption", "NamespaceOption", 1) + `,`,
-		`SelinuxOptions:` + strings.Replace(fmt.Sprintf("%v", this.SelinuxOptions), "SELinuxOption", "SELinuxOption", 1) + `,`,
-		`RunAsUser:` + strings.Replace(fmt.Sprintf("%v", this.RunAsUser), "Int64Value", "Int64Value", 1) + `,`,
-		`ReadonlyRootfs:` + fmt.Sprintf("%v", this.ReadonlyRootfs) + `,`,
-		`SupplementalGroups:` + fmt.Sprintf("%v", this.SupplementalGroups) + `,`,
-		`Privileged:` + fmt.Sprintf("%v", this.Privileged) + `,`,
-		`SeccompProfilePath:` + fmt.Sprintf("%v", this.SeccompProfilePath) + `,`,
-		`RunAsGroup:` + strings.Replace(fmt.Sprintf("%v", this.RunAsGroup), "Int64Value", "Int64Value", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *LinuxPodSandboxConfig) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListImagesResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
-	keysForSysctls := make([]string, 0, len(this.Sysctls))
-	for k := range this.Sysctls {
-		keysForSysctls = append(keysForSysctls, k)
+	return n
+}
+
+func (m *ImageStatusRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSysctls)
-	mapStringForSysctls := "map[string]string{"
-	for _, k := range keysForSysctls {
-		mapStringForSysctls += fmt.Sprintf("%v: %v,", k, this.Sysctls[k])
+	if m.Verbose {
+		n += 2
 	}
-	mapStringForSysctls += "}"
-	s := strings.Join([]string{`&LinuxPodSandboxConfig{`,
-		`CgroupParent:` + fmt.Sprintf("%v", this.CgroupParent) + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "LinuxSandboxSecurityContext", "LinuxSandboxSecurityContext", 1) + `,`,
-		`Sysctls:` + mapStringForSysctls + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PodSandboxMetadata) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ImageStatusResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&PodSandboxMetadata{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Uid:` + fmt.Sprintf("%v", this.Uid) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.Info) > 0 {
+		for k, v := range m.Info {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
 }
-func (this *PodSandboxConfig) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *AuthConfig) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	l = len(m.Auth)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	l = len(m.ServerAddress)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	l = len(m.IdentityToken)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&PodSandboxConfig{`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "PodSandboxMetadata", "PodSandboxMetadata", 1) + `,`,
-		`Hostname:` + fmt.Sprintf("%v", this.Hostname) + `,`,
-		`LogDirectory:` + fmt.Sprintf("%v", this.LogDirectory) + `,`,
-		`DnsConfig:` + strings.Replace(fmt.Sprintf("%v", this.DnsConfig), "DNSConfig", "DNSConfig", 1) + `,`,
-		`PortMappings:` + strings.Replace(fmt.Sprintf("%v", this.PortMappings), "PortMapping", "PortMapping", 1) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`Linux:` + strings.Replace(fmt.Sprintf("%v", this.Linux), "LinuxPodSandboxConfig", "LinuxPodSandboxConfig", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RunPodSandboxRequest) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.RegistryToken)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&RunPodSandboxRequest{`,
-		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "PodSandboxConfig", "PodSandboxConfig", 1) + `,`,
-		`RuntimeHandler:` + fmt.Sprintf("%v", this.RuntimeHandler) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *RunPodSandboxResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PullImageRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&RunPodSandboxResponse{`,
-		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *StopPodSandboxRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.Auth != nil {
+		l = m.Auth.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&StopPodSandboxRequest{`,
-		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *StopPodSandboxResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.SandboxConfig != nil {
+		l = m.SandboxConfig.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&StopPodSandboxResponse{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *RemovePodSandboxRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PullImageResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ImageRef)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&RemovePodSandboxRequest{`,
-		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *RemovePodSandboxResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RemoveImageRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&RemovePodSandboxResponse{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PodSandboxStatusRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&PodSandboxStatusRequest{`,
-		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
-		`Verbose:` + fmt.Sprintf("%v", this.Verbose) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *RemoveImageResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
 }
-func (this *PodSandboxNetworkStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *NetworkConfig) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.PodCidr)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&PodSandboxNetworkStatus{`,
-		`Ip:` + fmt.Sprintf("%v", this.Ip) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Namespace) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RuntimeConfig) Size() (n int) {
+	var l int
+	_ = l
+	if m.NetworkConfig != nil {
+		l = m.NetworkConfig.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&Namespace{`,
-		`Options:` + strings.Replace(fmt.Sprintf("%v", this.Options), "NamespaceOption", "NamespaceOption", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *LinuxPodSandboxStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UpdateRuntimeConfigRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.RuntimeConfig != nil {
+		l = m.RuntimeConfig.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&LinuxPodSandboxStatus{`,
-		`Namespaces:` + strings.Replace(fmt.Sprintf("%v", this.Namespaces), "Namespace", "Namespace", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PodSandboxStatus) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+
+func (m *UpdateRuntimeConfigResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RuntimeCondition) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	if m.Status {
+		n += 2
 	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&PodSandboxStatus{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "PodSandboxMetadata", "PodSandboxMetadata", 1) + `,`,
-		`State:` + fmt.Sprintf("%v", this.State) + `,`,
-		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
-		`Network:` + strings.Replace(fmt.Sprintf("%v", this.Network), "PodSandboxNetworkStatus", "PodSandboxNetworkStatus", 1) + `,`,
-		`Linux:` + strings.Replace(fmt.Sprintf("%v", this.Linux), "LinuxPodSandboxStatus", "LinuxPodSandboxStatus", 1) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PodSandboxStatusResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForInfo := make([]string, 0, len(this.Info))
-	for k := range this.Info {
-		keysForInfo = append(keysForInfo, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForInfo)
-	mapStringForInfo := "map[string]string{"
-	for _, k := range keysForInfo {
-		mapStringForInfo += fmt.Sprintf("%v: %v,", k, this.Info[k])
+
+func (m *RuntimeStatus) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
-	mapStringForInfo += "}"
-	s := strings.Join([]string{`&PodSandboxStatusResponse{`,
-		`Status:` + strings.Replace(fmt.Sprintf("%v", this.Status), "PodSandboxStatus", "PodSandboxStatus", 1) + `,`,
-		`Info:` + mapStringForInfo + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PodSandboxStateValue) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StatusRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Verbose {
+		n += 2
 	}
-	s := strings.Join([]string{`&PodSandboxStateValue{`,
-		`State:` + fmt.Sprintf("%v", this.State) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PodSandboxFilter) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForLabelSelector := make([]string, 0, len(this.LabelSelector))
-	for k := range this.LabelSelector {
-		keysForLabelSelector = append(keysForLabelSelector, k)
+
+func (m *StatusResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabelSelector)
-	mapStringForLabelSelector := "map[string]string{"
-	for _, k := range keysForLabelSelector {
-		mapStringForLabelSelector += fmt.Sprintf("%v: %v,", k, this.LabelSelector[k])
+	if len(m.Info) > 0 {
+		for k, v := range m.Info {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
 	}
-	mapStringForLabelSelector += "}"
-	s := strings.Join([]string{`&PodSandboxFilter{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`State:` + strings.Replace(fmt.Sprintf("%v", this.State), "PodSandboxStateValue", "PodSandboxStateValue", 1) + `,`,
-		`LabelSelector:` + mapStringForLabelSelector + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ListPodSandboxRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ImageFsInfoRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UInt64Value) Size() (n int) {
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovApi(uint64(m.Value))
 	}
-	s := strings.Join([]string{`&ListPodSandboxRequest{`,
-		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "PodSandboxFilter", "PodSandboxFilter", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PodSandbox) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *FilesystemIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Mountpoint)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	return n
+}
+
+func (m *FilesystemUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovApi(uint64(m.Timestamp))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	if m.FsId != nil {
+		l = m.FsId.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	if m.UsedBytes != nil {
+		l = m.UsedBytes.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	if m.InodesUsed != nil {
+		l = m.InodesUsed.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&PodSandbox{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "PodSandboxMetadata", "PodSandboxMetadata", 1) + `,`,
-		`State:` + fmt.Sprintf("%v", this.State) + `,`,
-		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ListPodSandboxResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ImageFsInfoResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.ImageFilesystems) > 0 {
+		for _, e := range m.ImageFilesystems {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ListPodSandboxResponse{`,
-		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "PodSandbox", "PodSandbox", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ImageSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ContainerStatsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&ImageSpec{`,
-		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *KeyValue) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ContainerStatsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&KeyValue{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *LinuxContainerResources) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListContainerStatsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	keysForDiskQuota := make([]string, 0, len(this.DiskQuota))
-	for k := range this.DiskQuota {
-		keysForDiskQuota = append(keysForDiskQuota, k)
+	return n
+}
+
+func (m *ContainerStatsFilter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForDiskQuota)
-	mapStringForDiskQuota := "map[string]string{"
-	for _, k := range keysForDiskQuota {
-		mapStringForDiskQuota += fmt.Sprintf("%v: %v,", k, this.DiskQuota[k])
+	l = len(m.PodSandboxId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForDiskQuota += "}"
-	s := strings.Join([]string{`&LinuxContainerResources{`,
-		`CpuPeriod:` + fmt.Sprintf("%v", this.CpuPeriod) + `,`,
-		`CpuQuota:` + fmt.Sprintf("%v", this.CpuQuota) + `,`,
-		`CpuShares:` + fmt.Sprintf("%v", this.CpuShares) + `,`,
-		`MemoryLimitInBytes:` + fmt.Sprintf("%v", this.MemoryLimitInBytes) + `,`,
-		`OomScoreAdj:` + fmt.Sprintf("%v", this.OomScoreAdj) + `,`,
-		`CpusetCpus:` + fmt.Sprintf("%v", this.CpusetCpus) + `,`,
-		`CpusetMems:` + fmt.Sprintf("%v", this.CpusetMems) + `,`,
-		`DiskQuota:` + mapStringForDiskQuota + `,`,
-		`BlkioWeight:` + fmt.Sprintf("%v", this.BlkioWeight) + `,`,
-		`BlkioWeightDevice:` + strings.Replace(fmt.Sprintf("%v", this.BlkioWeightDevice), "WeightDevice", "WeightDevice", 1) + `,`,
-		`BlkioDeviceReadBps:` + strings.Replace(fmt.Sprintf("%v", this.BlkioDeviceReadBps), "ThrottleDevice", "ThrottleDevice", 1) + `,`,
-		`BlkioDeviceWriteBps:` + strings.Replace(fmt.Sprintf("%v", this.BlkioDeviceWriteBps), "ThrottleDevice", "ThrottleDevice", 1) + `,`,
-		`BlkioDeviceRead_IOps:` + strings.Replace(fmt.Sprintf("%v", this.BlkioDeviceRead_IOps), "ThrottleDevice", "ThrottleDevice", 1) + `,`,
-		`BlkioDeviceWrite_IOps:` + strings.Replace(fmt.Sprintf("%v", this.BlkioDeviceWrite_IOps), "ThrottleDevice", "ThrottleDevice", 1) + `,`,
-		`KernelMemory:` + fmt.Sprintf("%v", this.KernelMemory) + `,`,
-		`MemoryReservation:` + fmt.Sprintf("%v", this.MemoryReservation) + `,`,
-		`MemorySwappiness:` + strings.Replace(fmt.Sprintf("%v", this.MemorySwappiness), "Int64Value", "Int64Value", 1) + `,`,
-		`Ulimits:` + strings.Replace(fmt.Sprintf("%v", this.Ulimits), "Ulimit", "Ulimit", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WeightDevice) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.LabelSelector) > 0 {
+		for k, v := range m.LabelSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
 	}
-	s := strings.Join([]string{`&WeightDevice{`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ThrottleDevice) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListContainerStatsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ThrottleDevice{`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`Rate:` + fmt.Sprintf("%v", this.Rate) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Ulimit) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ContainerAttributes) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&Ulimit{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Hard:` + fmt.Sprintf("%v", this.Hard) + `,`,
-		`Soft:` + fmt.Sprintf("%v", this.Soft) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SELinuxOption) String() string {
-	if this == nil {
-		return "nil"
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&SELinuxOption{`,
-		`User:` + fmt.Sprintf("%v", this.User) + `,`,
-		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Level:` + fmt.Sprintf("%v", this.Level) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Capability) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
 	}
-	s := strings.Join([]string{`&Capability{`,
-		`AddCapabilities:` + fmt.Sprintf("%v", this.AddCapabilities) + `,`,
-		`DropCapabilities:` + fmt.Sprintf("%v", this.DropCapabilities) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *LinuxContainerSecurityContext) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
 	}
-	s := strings.Join([]string{`&LinuxContainerSecurityContext{`,
-		`Capabilities:` + strings.Replace(fmt.Sprintf("%v", this.Capabilities), "Capability", "Capability", 1) + `,`,
-		`Privileged:` + fmt.Sprintf("%v", this.Privileged) + `,`,
-		`NamespaceOptions:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceOptions), "NamespaceOption", "NamespaceOption", 1) + `,`,
-		`SelinuxOptions:` + strings.Replace(fmt.Sprintf("%v", this.SelinuxOptions), "SELinuxOption", "SELinuxOption", 1) + `,`,
-		`RunAsUser:` + strings.Replace(fmt.Sprintf("%v", this.RunAsUser), "Int64Value", "Int64Value", 1) + `,`,
-		`RunAsUsername:` + fmt.Sprintf("%v", this.RunAsUsername) + `,`,
-		`ReadonlyRootfs:` + fmt.Sprintf("%v", this.ReadonlyRootfs) + `,`,
-		`SupplementalGroups:` + fmt.Sprintf("%v", this.SupplementalGroups) + `,`,
-		`ApparmorProfile:` + fmt.Sprintf("%v", this.ApparmorProfile) + `,`,
-		`SeccompProfilePath:` + fmt.Sprintf("%v", this.SeccompProfilePath) + `,`,
-		`NoNewPrivs:` + fmt.Sprintf("%v", this.NoNewPrivs) + `,`,
-		`RunAsGroup:` + strings.Replace(fmt.Sprintf("%v", this.RunAsGroup), "Int64Value", "Int64Value", 1) + `,`,
-		`MaskedPaths:` + fmt.Sprintf("%v", this.MaskedPaths) + `,`,
-		`ReadonlyPaths:` + fmt.Sprintf("%v", this.ReadonlyPaths) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *LinuxContainerConfig) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ContainerStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.Attributes != nil {
+		l = m.Attributes.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&LinuxContainerConfig{`,
-		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "LinuxContainerResources", "LinuxContainerResources", 1) + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "LinuxContainerSecurityContext", "LinuxContainerSecurityContext", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WindowsContainerSecurityContext) String() string {
-	if this == nil {
-		return "nil"
+	if m.Cpu != nil {
+		l = m.Cpu.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&WindowsContainerSecurityContext{`,
-		`RunAsUsername:` + fmt.Sprintf("%v", this.RunAsUsername) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WindowsContainerConfig) String() string {
-	if this == nil {
-		return "nil"
+	if m.Memory != nil {
+		l = m.Memory.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&WindowsContainerConfig{`,
-		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "WindowsContainerResources", "WindowsContainerResources", 1) + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "WindowsContainerSecurityContext", "WindowsContainerSecurityContext", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WindowsContainerResources) String() string {
-	if this == nil {
-		return "nil"
+	if m.WritableLayer != nil {
+		l = m.WritableLayer.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&WindowsContainerResources{`,
-		`CpuShares:` + fmt.Sprintf("%v", this.CpuShares) + `,`,
-		`CpuCount:` + fmt.Sprintf("%v", this.CpuCount) + `,`,
-		`CpuMaximum:` + fmt.Sprintf("%v", this.CpuMaximum) + `,`,
-		`MemoryLimitInBytes:` + fmt.Sprintf("%v", this.MemoryLimitInBytes) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ContainerMetadata) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CpuUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovApi(uint64(m.Timestamp))
 	}
-	s := strings.Join([]string{`&ContainerMetadata{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Device) String() string {
-	if this == nil {
-		return "nil"
+	if m.UsageCoreNanoSeconds != nil {
+		l = m.UsageCoreNanoSeconds.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&Device{`,
-		`ContainerPath:` + fmt.Sprintf("%v", this.ContainerPath) + `,`,
-		`HostPath:` + fmt.Sprintf("%v", this.HostPath) + `,`,
-		`Permissions:` + fmt.Sprintf("%v", this.Permissions) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ContainerConfig) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
-	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+
+func (m *MemoryUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovApi(uint64(m.Timestamp))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	if m.WorkingSetBytes != nil {
+		l = m.WorkingSetBytes.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&ContainerConfig{`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "ContainerMetadata", "ContainerMetadata", 1) + `,`,
-		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "ImageSpec", 1) + `,`,
-		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
-		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
-		`WorkingDir:` + fmt.Sprintf("%v", this.WorkingDir) + `,`,
-		`Envs:` + strings.Replace(fmt.Sprintf("%v", this.Envs), "KeyValue", "KeyValue", 1) + `,`,
-		`Mounts:` + strings.Replace(fmt.Sprintf("%v", this.Mounts), "Mount", "Mount", 1) + `,`,
-		`Devices:` + strings.Replace(fmt.Sprintf("%v", this.Devices), "Device", "Device", 1) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`LogPath:` + fmt.Sprintf("%v", this.LogPath) + `,`,
-		`Stdin:` + fmt.Sprintf("%v", this.Stdin) + `,`,
-		`StdinOnce:` + fmt.Sprintf("%v", this.StdinOnce) + `,`,
-		`Tty:` + fmt.Sprintf("%v", this.Tty) + `,`,
-		`Linux:` + strings.Replace(fmt.Sprintf("%v", this.Linux), "LinuxContainerConfig", "LinuxContainerConfig", 1) + `,`,
-		`Windows:` + strings.Replace(fmt.Sprintf("%v", this.Windows), "WindowsContainerConfig", "WindowsContainerConfig", 1) + `,`,
-		`NetPriority:` + fmt.Sprintf("%v", this.NetPriority) + `,`,
-		`QuotaId:` + fmt.Sprintf("%v", this.QuotaId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *CreateContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PodSandboxStatsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.PodSandboxId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&CreateContainerRequest{`,
-		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
-		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "ContainerConfig", "ContainerConfig", 1) + `,`,
-		`SandboxConfig:` + strings.Replace(fmt.Sprintf("%v", this.SandboxConfig), "PodSandboxConfig", "PodSandboxConfig", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *CreateContainerResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PodSandboxStatsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&CreateContainerResponse{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *StartContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PodSandboxStatsFilter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&StartContainerRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *StartContainerResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.LabelSelector) > 0 {
+		for k, v := range m.LabelSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
 	}
-	s := strings.Join([]string{`&StartContainerResponse{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *StopContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListPodSandboxStatsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&StopContainerRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *StopContainerResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListPodSandboxStatsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&StopContainerResponse{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *RemoveContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PodSandboxAttributes) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&RemoveContainerRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RemoveContainerResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&RemoveContainerResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ContainerStateValue) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
 	}
-	s := strings.Join([]string{`&ContainerStateValue{`,
-		`State:` + fmt.Sprintf("%v", this.State) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
 }
-func (this *ContainerFilter) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PodSandboxStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.Attributes != nil {
+		l = m.Attributes.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	keysForLabelSelector := make([]string, 0, len(this.LabelSelector))
-	for k := range this.LabelSelector {
-		keysForLabelSelector = append(keysForLabelSelector, k)
+	if m.Linux != nil {
+		l = m.Linux.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabelSelector)
-	mapStringForLabelSelector := "map[string]string{"
-	for _, k := range keysForLabelSelector {
-		mapStringForLabelSelector += fmt.Sprintf("%v: %v,", k, this.LabelSelector[k])
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForLabelSelector += "}"
-	s := strings.Join([]string{`&ContainerFilter{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`State:` + strings.Replace(fmt.Sprintf("%v", this.State), "ContainerStateValue", "ContainerStateValue", 1) + `,`,
-		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
-		`LabelSelector:` + mapStringForLabelSelector + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ListContainersRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ListContainersRequest{`,
-		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "ContainerFilter", "ContainerFilter", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Container) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+
+func (m *LinuxPodSandboxStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.Cpu != nil {
+		l = m.Cpu.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	if m.Memory != nil {
+		l = m.Memory.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	if m.Network != nil {
+		l = m.Network.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	if m.Process != nil {
+		l = m.Process.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&Container{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "ContainerMetadata", "ContainerMetadata", 1) + `,`,
-		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "ImageSpec", 1) + `,`,
-		`ImageRef:` + fmt.Sprintf("%v", this.ImageRef) + `,`,
-		`State:` + fmt.Sprintf("%v", this.State) + `,`,
-		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ListContainersResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ListContainersResponse{`,
-		`Containers:` + strings.Replace(fmt.Sprintf("%v", this.Containers), "Container", "Container", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ContainerStatusRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ContainerStatusRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`Verbose:` + fmt.Sprintf("%v", this.Verbose) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *WindowsPodSandboxStats) Size() (n int) {
+	var l int
+	_ = l
+	return n
 }
-func (this *ContainerStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *NetworkUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovApi(uint64(m.Timestamp))
 	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	if m.DefaultInterface != nil {
+		l = m.DefaultInterface.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	if len(m.Interfaces) > 0 {
+		for _, e := range m.Interfaces {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
 	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	return n
+}
+
+func (m *NetworkInterfaceUsage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	if m.RxBytes != nil {
+		l = m.RxBytes.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	keysForVolumes := make([]string, 0, len(this.Volumes))
-	for k := range this.Volumes {
-		keysForVolumes = append(keysForVolumes, k)
+	if m.RxErrors != nil {
+		l = m.RxErrors.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForVolumes)
-	mapStringForVolumes := "map[string]*Volume{"
-	for _, k := range keysForVolumes {
-		mapStringForVolumes += fmt.Sprintf("%v: %v,", k, this.Volumes[k])
+	if m.TxBytes != nil {
+		l = m.TxBytes.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForVolumes += "}"
-	s := strings.Join([]string{`&ContainerStatus{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "ContainerMetadata", "ContainerMetadata", 1) + `,`,
-		`State:` + fmt.Sprintf("%v", this.State) + `,`,
-		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
-		`StartedAt:` + fmt.Sprintf("%v", this.StartedAt) + `,`,
-		`FinishedAt:` + fmt.Sprintf("%v", this.FinishedAt) + `,`,
-		`ExitCode:` + fmt.Sprintf("%v", this.ExitCode) + `,`,
-		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "ImageSpec", 1) + `,`,
-		`ImageRef:` + fmt.Sprintf("%v", this.ImageRef) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`Mounts:` + strings.Replace(fmt.Sprintf("%v", this.Mounts), "Mount", "Mount", 1) + `,`,
-		`LogPath:` + fmt.Sprintf("%v", this.LogPath) + `,`,
-		`Volumes:` + mapStringForVolumes + `,`,
-		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "LinuxContainerResources", "LinuxContainerResources", 1) + `,`,
-		`QuotaId:` + fmt.Sprintf("%v", this.QuotaId) + `,`,
-		`Envs:` + strings.Replace(fmt.Sprintf("%v", this.Envs), "KeyValue", "KeyValue", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Volume) String() string {
-	if this == nil {
-		return "nil"
+	if m.TxErrors != nil {
+		l = m.TxErrors.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&Volume{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ContainerStatusResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForInfo := make([]string, 0, len(this.Info))
-	for k := range this.Info {
-		keysForInfo = append(keysForInfo, k)
+
+func (m *ProcessUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovApi(uint64(m.Timestamp))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForInfo)
-	mapStringForInfo := "map[string]string{"
-	for _, k := range keysForInfo {
-		mapStringForInfo += fmt.Sprintf("%v: %v,", k, this.Info[k])
+	if m.ProcessCount != nil {
+		l = m.ProcessCount.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForInfo += "}"
-	s := strings.Join([]string{`&ContainerStatusResponse{`,
-		`Status:` + strings.Replace(fmt.Sprintf("%v", this.Status), "ContainerStatus", "ContainerStatus", 1) + `,`,
-		`Info:` + mapStringForInfo + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *UpdateContainerResourcesRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForSpecAnnotations := make([]string, 0, len(this.SpecAnnotations))
-	for k := range this.SpecAnnotations {
-		keysForSpecAnnotations = append(keysForSpecAnnotations, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSpecAnnotations)
-	mapStringForSpecAnnotations := "map[string]string{"
-	for _, k := range keysForSpecAnnotations {
-		mapStringForSpecAnnotations += fmt.Sprintf("%v: %v,", k, this.SpecAnnotations[k])
+
+func (m *ReopenContainerLogRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	mapStringForSpecAnnotations += "}"
-	s := strings.Join([]string{`&UpdateContainerResourcesRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`Linux:` + strings.Replace(fmt.Sprintf("%v", this.Linux), "LinuxContainerResources", "LinuxContainerResources", 1) + `,`,
-		`SpecAnnotations:` + mapStringForSpecAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *UpdateContainerResourcesResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&UpdateContainerResourcesResponse{`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ReopenContainerLogResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
 }
-func (this *ExecSyncRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PauseContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	s := strings.Join([]string{`&ExecSyncRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`Cmd:` + fmt.Sprintf("%v", this.Cmd) + `,`,
-		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ExecSyncResponse) String() string {
+
+func (m *PauseContainerResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnpauseContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *UnpauseContainerResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveVolumeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.VolumeName)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveVolumeResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StartPodSandboxRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.PodSandboxId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *StartPodSandboxResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetEventsRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ContainerEventResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ContainerEventType != 0 {
+		n += 1 + sovApi(uint64(m.ContainerEventType))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovApi(uint64(m.CreatedAt))
+	}
+	if m.PodSandboxStatus != nil {
+		l = m.PodSandboxStatus.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.ContainersStatuses) > 0 {
+		for _, e := range m.ContainersStatuses {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Location)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovApi(uint64(m.Timeout))
+	}
+	return n
+}
+
+func (m *CheckpointContainerResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func sovApi(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozApi(x uint64) (n int) {
+	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *VersionRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ExecSyncResponse{`,
-		`Stdout:` + fmt.Sprintf("%v", this.Stdout) + `,`,
-		`Stderr:` + fmt.Sprintf("%v", this.Stderr) + `,`,
-		`ExitCode:` + fmt.Sprintf("%v", this.ExitCode) + `,`,
+	s := strings.Join([]string{`&VersionRequest{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ExecRequest) String() string {
+func (this *VersionResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ExecRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`Cmd:` + fmt.Sprintf("%v", this.Cmd) + `,`,
-		`Tty:` + fmt.Sprintf("%v", this.Tty) + `,`,
-		`Stdin:` + fmt.Sprintf("%v", this.Stdin) + `,`,
-		`Stdout:` + fmt.Sprintf("%v", this.Stdout) + `,`,
-		`Stderr:` + fmt.Sprintf("%v", this.Stderr) + `,`,
+	s := strings.Join([]string{`&VersionResponse{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`RuntimeName:` + fmt.Sprintf("%v", this.RuntimeName) + `,`,
+		`RuntimeVersion:` + fmt.Sprintf("%v", this.RuntimeVersion) + `,`,
+		`RuntimeApiVersion:` + fmt.Sprintf("%v", this.RuntimeApiVersion) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ExecResponse) String() string {
+func (this *DNSConfig) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ExecResponse{`,
-		`Url:` + fmt.Sprintf("%v", this.Url) + `,`,
+	s := strings.Join([]string{`&DNSConfig{`,
+		`Servers:` + fmt.Sprintf("%v", this.Servers) + `,`,
+		`Searches:` + fmt.Sprintf("%v", this.Searches) + `,`,
+		`Options:` + fmt.Sprintf("%v", this.Options) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *AttachRequest) String() string {
+func (this *PortMapping) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&AttachRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`Stdin:` + fmt.Sprintf("%v", this.Stdin) + `,`,
-		`Tty:` + fmt.Sprintf("%v", this.Tty) + `,`,
-		`Stdout:` + fmt.Sprintf("%v", this.Stdout) + `,`,
-		`Stderr:` + fmt.Sprintf("%v", this.Stderr) + `,`,
+	s := strings.Join([]string{`&PortMapping{`,
+		`Protocol:` + fmt.Sprintf("%v", this.Protocol) + `,`,
+		`ContainerPort:` + fmt.Sprintf("%v", this.ContainerPort) + `,`,
+		`HostPort:` + fmt.Sprintf("%v", this.HostPort) + `,`,
+		`HostIp:` + fmt.Sprintf("%v", this.HostIp) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *AttachResponse) String() string {
+func (this *Mount) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&AttachResponse{`,
-		`Url:` + fmt.Sprintf("%v", this.Url) + `,`,
+	s := strings.Join([]string{`&Mount{`,
+		`ContainerPath:` + fmt.Sprintf("%v", this.ContainerPath) + `,`,
+		`HostPath:` + fmt.Sprintf("%v", this.HostPath) + `,`,
+		`Readonly:` + fmt.Sprintf("%v", this.Readonly) + `,`,
+		`SelinuxRelabel:` + fmt.Sprintf("%v", this.SelinuxRelabel) + `,`,
+		`Propagation:` + fmt.Sprintf("%v", this.Propagation) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *PortForwardRequest) String() string {
+func (this *NamespaceOption) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PortForwardRequest{`,
-		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
-		`Port:` + fmt.Sprintf("%v", this.Port) + `,`,
+	s := strings.Join([]string{`&NamespaceOption{`,
+		`Network:` + fmt.Sprintf("%v", this.Network) + `,`,
+		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
+		`Ipc:` + fmt.Sprintf("%v", this.Ipc) + `,`,
+		`UsernsOptions:` + strings.Replace(fmt.Sprintf("%v", this.UsernsOptions), "UserNamespace", "UserNamespace", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *PortForwardResponse) String() string {
+func (this *IDMapping) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PortForwardResponse{`,
-		`Url:` + fmt.Sprintf("%v", this.Url) + `,`,
+	s := strings.Join([]string{`&IDMapping{`,
+		`HostId:` + fmt.Sprintf("%v", this.HostId) + `,`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`Length:` + fmt.Sprintf("%v", this.Length) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ImageFilter) String() string {
+func (this *UserNamespace) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ImageFilter{`,
-		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "ImageSpec", 1) + `,`,
+	s := strings.Join([]string{`&UserNamespace{`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`Uids:` + strings.Replace(fmt.Sprintf("%v", this.Uids), "IDMapping", "IDMapping", 1) + `,`,
+		`Gids:` + strings.Replace(fmt.Sprintf("%v", this.Gids), "IDMapping", "IDMapping", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ListImagesRequest) String() string {
+func (this *Int64Value) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ListImagesRequest{`,
-		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "ImageFilter", "ImageFilter", 1) + `,`,
+	s := strings.Join([]string{`&Int64Value{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *Image) String() string {
+func (this *LinuxSandboxSecurityContext) String() string {
 	if this == nil {
 		return "nil"
 	}
-	keysForVolumes := make([]string, 0, len(this.Volumes))
-	for k := range this.Volumes {
-		keysForVolumes = append(keysForVolumes, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForVolumes)
-	mapStringForVolumes := "map[string]*Volume{"
-	for _, k := range keysForVolumes {
-		mapStringForVolumes += fmt.Sprintf("%v: %v,", k, this.Volumes[k])
-	}
-	mapStringForVolumes += "}"
-	s := strings.Join([]string{`&Image{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`RepoTags:` + fmt.Sprintf("%v", this.RepoTags) + `,`,
-		`RepoDigests:` + fmt.Sprintf("%v", this.RepoDigests) + `,`,
-		`Size_:` + fmt.Sprintf("%v", this.Size_) + `,`,
-		`Uid:` + strings.Replace(fmt.Sprintf("%v", this.Uid), "Int64Value", "Int64Value", 1) + `,`,
-		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
-		`Volumes:` + mapStringForVolumes + `,`,
+	s := strings.Join([]string{`&LinuxSandboxSecurityContext{`,
+		`NamespaceOptions:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceOptions), "NamespaceOption", "NamespaceOption", 1) + `,`,
+		`SelinuxOptions:` + strings.Replace(fmt.Sprintf("%v", this.SelinuxOptions), "SELinuxOption", "SELinuxOption", 1) + `,`,
+		`RunAsUser:` + strings.Replace(fmt.Sprintf("%v", this.RunAsUser), "Int64Value", "Int64Value", 1) + `,`,
+		`ReadonlyRootfs:` + fmt.Sprintf("%v", this.ReadonlyRootfs) + `,`,
+		`SupplementalGroups:` + fmt.Sprintf("%v", this.SupplementalGroups) + `,`,
+		`Privileged:` + fmt.Sprintf("%v", this.Privileged) + `,`,
+		`SeccompProfilePath:` + fmt.Sprintf("%v", this.SeccompProfilePath) + `,`,
+		`RunAsGroup:` + strings.Replace(fmt.Sprintf("%v", this.RunAsGroup), "Int64Value", "Int64Value", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ListImagesResponse) String() string {
+func (this *LinuxPodSandboxConfig) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ListImagesResponse{`,
-		`Images:` + strings.Replace(fmt.Sprintf("%v", this.Images), "Image", "Image", 1) + `,`,
+	keysForSysctls := make([]string, 0, len(this.Sysctls))
+	for k := range this.Sysctls {
+		keysForSysctls = append(keysForSysctls, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSysctls)
+	mapStringForSysctls := "map[string]string{"
+	for _, k := range keysForSysctls {
+		mapStringForSysctls += fmt.Sprintf("%v: %v,", k, this.Sysctls[k])
+	}
+	mapStringForSysctls += "}"
+	s := strings.Join([]string{`&LinuxPodSandboxConfig{`,
+		`CgroupParent:` + fmt.Sprintf("%v", this.CgroupParent) + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "LinuxSandboxSecurityContext", "LinuxSandboxSecurityContext", 1) + `,`,
+		`Sysctls:` + mapStringForSysctls + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ImageStatusRequest) String() string {
+func (this *PodSandboxMetadata) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ImageStatusRequest{`,
-		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "ImageSpec", 1) + `,`,
-		`Verbose:` + fmt.Sprintf("%v", this.Verbose) + `,`,
+	s := strings.Join([]string{`&PodSandboxMetadata{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Uid:` + fmt.Sprintf("%v", this.Uid) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ImageStatusResponse) String() string {
+func (this *PodSandboxConfig) String() string {
 	if this == nil {
 		return "nil"
 	}
-	keysForInfo := make([]string, 0, len(this.Info))
-	for k := range this.Info {
-		keysForInfo = append(keysForInfo, k)
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForInfo)
-	mapStringForInfo := "map[string]string{"
-	for _, k := range keysForInfo {
-		mapStringForInfo += fmt.Sprintf("%v: %v,", k, this.Info[k])
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
 	}
-	mapStringForInfo += "}"
-	s := strings.Join([]string{`&ImageStatusResponse{`,
-		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "Image", "Image", 1) + `,`,
-		`Info:` + mapStringForInfo + `,`,
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&PodSandboxConfig{`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "PodSandboxMetadata", "PodSandboxMetadata", 1) + `,`,
+		`Hostname:` + fmt.Sprintf("%v", this.Hostname) + `,`,
+		`LogDirectory:` + fmt.Sprintf("%v", this.LogDirectory) + `,`,
+		`DnsConfig:` + strings.Replace(fmt.Sprintf("%v", this.DnsConfig), "DNSConfig", "DNSConfig", 1) + `,`,
+		`PortMappings:` + strings.Replace(fmt.Sprintf("%v", this.PortMappings), "PortMapping", "PortMapping", 1) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`Linux:` + strings.Replace(fmt.Sprintf("%v", this.Linux), "LinuxPodSandboxConfig", "LinuxPodSandboxConfig", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *AuthConfig) String() string {
+func (this *RunPodSandboxRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&AuthConfig{`,
-		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
-		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
-		`Auth:` + fmt.Sprintf("%v", this.Auth) + `,`,
-		`ServerAddress:` + fmt.Sprintf("%v", this.ServerAddress) + `,`,
-		`IdentityToken:` + fmt.Sprintf("%v", this.IdentityToken) + `,`,
-		`RegistryToken:` + fmt.Sprintf("%v", this.RegistryToken) + `,`,
+	s := strings.Join([]string{`&RunPodSandboxRequest{`,
+		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "PodSandboxConfig", "PodSandboxConfig", 1) + `,`,
+		`RuntimeHandler:` + fmt.Sprintf("%v", this.RuntimeHandler) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *PullImageRequest) String() string {
+func (this *RunPodSandboxResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PullImageRequest{`,
-		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "ImageSpec", 1) + `,`,
-		`Auth:` + strings.Replace(fmt.Sprintf("%v", this.Auth), "AuthConfig", "AuthConfig", 1) + `,`,
-		`SandboxConfig:` + strings.Replace(fmt.Sprintf("%v", this.SandboxConfig), "PodSandboxConfig", "PodSandboxConfig", 1) + `,`,
+	s := strings.Join([]string{`&RunPodSandboxResponse{`,
+		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *PullImageResponse) String() string {
+func (this *StopPodSandboxRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PullImageResponse{`,
-		`ImageRef:` + fmt.Sprintf("%v", this.ImageRef) + `,`,
+	s := strings.Join([]string{`&StopPodSandboxRequest{`,
+		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RemoveImageRequest) String() string {
+func (this *StopPodSandboxResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RemoveImageRequest{`,
-		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "ImageSpec", 1) + `,`,
+	s := strings.Join([]string{`&StopPodSandboxResponse{`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RemoveImageResponse) String() string {
+func (this *RemovePodSandboxRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RemoveImageResponse{`,
+	s := strings.Join([]string{`&RemovePodSandboxRequest{`,
+		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *NetworkConfig) String() string {
+func (this *RemovePodSandboxResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&NetworkConfig{`,
-		`PodCidr:` + fmt.Sprintf("%v", this.PodCidr) + `,`,
+	s := strings.Join([]string{`&RemovePodSandboxResponse{`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RuntimeConfig) String() string {
+func (this *PodSandboxStatusRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RuntimeConfig{`,
-		`NetworkConfig:` + strings.Replace(fmt.Sprintf("%v", this.NetworkConfig), "NetworkConfig", "NetworkConfig", 1) + `,`,
+	s := strings.Join([]string{`&PodSandboxStatusRequest{`,
+		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
+		`Verbose:` + fmt.Sprintf("%v", this.Verbose) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *UpdateRuntimeConfigRequest) String() string {
+func (this *PodSandboxNetworkStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&UpdateRuntimeConfigRequest{`,
-		`RuntimeConfig:` + strings.Replace(fmt.Sprintf("%v", this.RuntimeConfig), "RuntimeConfig", "RuntimeConfig", 1) + `,`,
+	s := strings.Join([]string{`&PodSandboxNetworkStatus{`,
+		`Ip:` + fmt.Sprintf("%v", this.Ip) + `,`,
+		`AdditionalIps:` + strings.Replace(fmt.Sprintf("%v", this.AdditionalIps), "PodIP", "PodIP", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *UpdateRuntimeConfigResponse) String() string {
+func (this *PodIP) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&UpdateRuntimeConfigResponse{`,
+	s := strings.Join([]string{`&PodIP{`,
+		`Ip:` + fmt.Sprintf("%v", this.Ip) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RuntimeCondition) String() string {
+func (this *Namespace) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RuntimeCondition{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+	s := strings.Join([]string{`&Namespace{`,
+		`Options:` + strings.Replace(fmt.Sprintf("%v", this.Options), "NamespaceOption", "NamespaceOption", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RuntimeStatus) String() string {
+func (this *LinuxPodSandboxStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RuntimeStatus{`,
-		`Conditions:` + strings.Replace(fmt.Sprintf("%v", this.Conditions), "RuntimeCondition", "RuntimeCondition", 1) + `,`,
+	s := strings.Join([]string{`&LinuxPodSandboxStatus{`,
+		`Namespaces:` + strings.Replace(fmt.Sprintf("%v", this.Namespaces), "Namespace", "Namespace", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *StatusRequest) String() string {
+func (this *PodSandboxStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&StatusRequest{`,
-		`Verbose:` + fmt.Sprintf("%v", this.Verbose) + `,`,
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&PodSandboxStatus{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "PodSandboxMetadata", "PodSandboxMetadata", 1) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`Network:` + strings.Replace(fmt.Sprintf("%v", this.Network), "PodSandboxNetworkStatus", "PodSandboxNetworkStatus", 1) + `,`,
+		`Linux:` + strings.Replace(fmt.Sprintf("%v", this.Linux), "LinuxPodSandboxStatus", "LinuxPodSandboxStatus", 1) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *StatusResponse) String() string {
+func (this *PodSandboxStatusResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
@@ -13464,96 +14394,24 @@ func (this *StatusResponse) String() string {
 		mapStringForInfo += fmt.Sprintf("%v: %v,", k, this.Info[k])
 	}
 	mapStringForInfo += "}"
-	s := strings.Join([]string{`&StatusResponse{`,
-		`Status:` + strings.Replace(fmt.Sprintf("%v", this.Status), "RuntimeStatus", "RuntimeStatus", 1) + `,`,
+	s := strings.Join([]string{`&PodSandboxStatusResponse{`,
+		`Status:` + strings.Replace(fmt.Sprintf("%v", this.Status), "PodSandboxStatus", "PodSandboxStatus", 1) + `,`,
 		`Info:` + mapStringForInfo + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ImageFsInfoRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ImageFsInfoRequest{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UInt64Value) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&UInt64Value{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *FilesystemIdentifier) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&FilesystemIdentifier{`,
-		`Mountpoint:` + fmt.Sprintf("%v", this.Mountpoint) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *FilesystemUsage) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&FilesystemUsage{`,
-		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
-		`FsId:` + strings.Replace(fmt.Sprintf("%v", this.FsId), "FilesystemIdentifier", "FilesystemIdentifier", 1) + `,`,
-		`UsedBytes:` + strings.Replace(fmt.Sprintf("%v", this.UsedBytes), "UInt64Value", "UInt64Value", 1) + `,`,
-		`InodesUsed:` + strings.Replace(fmt.Sprintf("%v", this.InodesUsed), "UInt64Value", "UInt64Value", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ImageFsInfoResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ImageFsInfoResponse{`,
-		`ImageFilesystems:` + strings.Replace(fmt.Sprintf("%v", this.ImageFilesystems), "FilesystemUsage", "FilesystemUsage", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ContainerStatsRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ContainerStatsRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ContainerStatsResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ContainerStatsResponse{`,
-		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "ContainerStats", "ContainerStats", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ListContainerStatsRequest) String() string {
+func (this *PodSandboxStateValue) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ListContainerStatsRequest{`,
-		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "ContainerStatsFilter", "ContainerStatsFilter", 1) + `,`,
+	s := strings.Join([]string{`&PodSandboxStateValue{`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ContainerStatsFilter) String() string {
+func (this *PodSandboxFilter) String() string {
 	if this == nil {
 		return "nil"
 	}
@@ -13567,25 +14425,25 @@ func (this *ContainerStatsFilter) String() string {
 		mapStringForLabelSelector += fmt.Sprintf("%v: %v,", k, this.LabelSelector[k])
 	}
 	mapStringForLabelSelector += "}"
-	s := strings.Join([]string{`&ContainerStatsFilter{`,
+	s := strings.Join([]string{`&PodSandboxFilter{`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
+		`State:` + strings.Replace(fmt.Sprintf("%v", this.State), "PodSandboxStateValue", "PodSandboxStateValue", 1) + `,`,
 		`LabelSelector:` + mapStringForLabelSelector + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ListContainerStatsResponse) String() string {
+func (this *ListPodSandboxRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ListContainerStatsResponse{`,
-		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "ContainerStats", "ContainerStats", 1) + `,`,
+	s := strings.Join([]string{`&ListPodSandboxRequest{`,
+		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "PodSandboxFilter", "PodSandboxFilter", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ContainerAttributes) String() string {
+func (this *PodSandbox) String() string {
 	if this == nil {
 		return "nil"
 	}
@@ -13609,154 +14467,4192 @@ func (this *ContainerAttributes) String() string {
 		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
 	}
 	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&ContainerAttributes{`,
+	s := strings.Join([]string{`&PodSandbox{`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "ContainerMetadata", "ContainerMetadata", 1) + `,`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "PodSandboxMetadata", "PodSandboxMetadata", 1) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
 		`Labels:` + mapStringForLabels + `,`,
 		`Annotations:` + mapStringForAnnotations + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ContainerStats) String() string {
+func (this *ListPodSandboxResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ContainerStats{`,
-		`Attributes:` + strings.Replace(fmt.Sprintf("%v", this.Attributes), "ContainerAttributes", "ContainerAttributes", 1) + `,`,
-		`Cpu:` + strings.Replace(fmt.Sprintf("%v", this.Cpu), "CpuUsage", "CpuUsage", 1) + `,`,
-		`Memory:` + strings.Replace(fmt.Sprintf("%v", this.Memory), "MemoryUsage", "MemoryUsage", 1) + `,`,
-		`WritableLayer:` + strings.Replace(fmt.Sprintf("%v", this.WritableLayer), "FilesystemUsage", "FilesystemUsage", 1) + `,`,
+	s := strings.Join([]string{`&ListPodSandboxResponse{`,
+		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "PodSandbox", "PodSandbox", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *CpuUsage) String() string {
+func (this *ImageSpec) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&CpuUsage{`,
-		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
-		`UsageCoreNanoSeconds:` + strings.Replace(fmt.Sprintf("%v", this.UsageCoreNanoSeconds), "UInt64Value", "UInt64Value", 1) + `,`,
+	s := strings.Join([]string{`&ImageSpec{`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *MemoryUsage) String() string {
+func (this *KeyValue) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&MemoryUsage{`,
-		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
-		`WorkingSetBytes:` + strings.Replace(fmt.Sprintf("%v", this.WorkingSetBytes), "UInt64Value", "UInt64Value", 1) + `,`,
+	s := strings.Join([]string{`&KeyValue{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ReopenContainerLogRequest) String() string {
+func (this *LinuxContainerResources) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ReopenContainerLogRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+	keysForDiskQuota := make([]string, 0, len(this.DiskQuota))
+	for k := range this.DiskQuota {
+		keysForDiskQuota = append(keysForDiskQuota, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForDiskQuota)
+	mapStringForDiskQuota := "map[string]string{"
+	for _, k := range keysForDiskQuota {
+		mapStringForDiskQuota += fmt.Sprintf("%v: %v,", k, this.DiskQuota[k])
+	}
+	mapStringForDiskQuota += "}"
+	s := strings.Join([]string{`&LinuxContainerResources{`,
+		`CpuPeriod:` + fmt.Sprintf("%v", this.CpuPeriod) + `,`,
+		`CpuQuota:` + fmt.Sprintf("%v", this.CpuQuota) + `,`,
+		`CpuShares:` + fmt.Sprintf("%v", this.CpuShares) + `,`,
+		`MemoryLimitInBytes:` + fmt.Sprintf("%v", this.MemoryLimitInBytes) + `,`,
+		`OomScoreAdj:` + fmt.Sprintf("%v", this.OomScoreAdj) + `,`,
+		`CpusetCpus:` + fmt.Sprintf("%v", this.CpusetCpus) + `,`,
+		`CpusetMems:` + fmt.Sprintf("%v", this.CpusetMems) + `,`,
+		`DiskQuota:` + mapStringForDiskQuota + `,`,
+		`BlkioWeight:` + fmt.Sprintf("%v", this.BlkioWeight) + `,`,
+		`BlkioWeightDevice:` + strings.Replace(fmt.Sprintf("%v", this.BlkioWeightDevice), "WeightDevice", "WeightDevice", 1) + `,`,
+		`BlkioDeviceReadBps:` + strings.Replace(fmt.Sprintf("%v", this.BlkioDeviceReadBps), "ThrottleDevice", "ThrottleDevice", 1) + `,`,
+		`BlkioDeviceWriteBps:` + strings.Replace(fmt.Sprintf("%v", this.BlkioDeviceWriteBps), "ThrottleDevice", "ThrottleDevice", 1) + `,`,
+		`BlkioDeviceRead_IOps:` + strings.Replace(fmt.Sprintf("%v", this.BlkioDeviceRead_IOps), "ThrottleDevice", "ThrottleDevice", 1) + `,`,
+		`BlkioDeviceWrite_IOps:` + strings.Replace(fmt.Sprintf("%v", this.BlkioDeviceWrite_IOps), "ThrottleDevice", "ThrottleDevice", 1) + `,`,
+		`KernelMemory:` + fmt.Sprintf("%v", this.KernelMemory) + `,`,
+		`MemoryReservation:` + fmt.Sprintf("%v", this.MemoryReservation) + `,`,
+		`MemorySwappiness:` + strings.Replace(fmt.Sprintf("%v", this.MemorySwappiness), "Int64Value", "Int64Value", 1) + `,`,
+		`Ulimits:` + strings.Replace(fmt.Sprintf("%v", this.Ulimits), "Ulimit", "Ulimit", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ReopenContainerLogResponse) String() string {
+func (this *WeightDevice) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ReopenContainerLogResponse{`,
+	s := strings.Join([]string{`&WeightDevice{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *PauseContainerRequest) String() string {
+func (this *ThrottleDevice) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PauseContainerRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+	s := strings.Join([]string{`&ThrottleDevice{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Rate:` + fmt.Sprintf("%v", this.Rate) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *PauseContainerResponse) String() string {
+func (this *Ulimit) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PauseContainerResponse{`,
+	s := strings.Join([]string{`&Ulimit{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Hard:` + fmt.Sprintf("%v", this.Hard) + `,`,
+		`Soft:` + fmt.Sprintf("%v", this.Soft) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *UnpauseContainerRequest) String() string {
+func (this *SELinuxOption) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&UnpauseContainerRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+	s := strings.Join([]string{`&SELinuxOption{`,
+		`User:` + fmt.Sprintf("%v", this.User) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Level:` + fmt.Sprintf("%v", this.Level) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *UnpauseContainerResponse) String() string {
+func (this *Capability) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&UnpauseContainerResponse{`,
+	s := strings.Join([]string{`&Capability{`,
+		`AddCapabilities:` + fmt.Sprintf("%v", this.AddCapabilities) + `,`,
+		`DropCapabilities:` + fmt.Sprintf("%v", this.DropCapabilities) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RemoveVolumeRequest) String() string {
+func (this *LinuxContainerSecurityContext) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RemoveVolumeRequest{`,
-		`VolumeName:` + fmt.Sprintf("%v", this.VolumeName) + `,`,
+	s := strings.Join([]string{`&LinuxContainerSecurityContext{`,
+		`Capabilities:` + strings.Replace(fmt.Sprintf("%v", this.Capabilities), "Capability", "Capability", 1) + `,`,
+		`Privileged:` + fmt.Sprintf("%v", this.Privileged) + `,`,
+		`NamespaceOptions:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceOptions), "NamespaceOption", "NamespaceOption", 1) + `,`,
+		`SelinuxOptions:` + strings.Replace(fmt.Sprintf("%v", this.SelinuxOptions), "SELinuxOption", "SELinuxOption", 1) + `,`,
+		`RunAsUser:` + strings.Replace(fmt.Sprintf("%v", this.RunAsUser), "Int64Value", "Int64Value", 1) + `,`,
+		`RunAsUsername:` + fmt.Sprintf("%v", this.RunAsUsername) + `,`,
+		`ReadonlyRootfs:` + fmt.Sprintf("%v", this.ReadonlyRootfs) + `,`,
+		`SupplementalGroups:` + fmt.Sprintf("%v", this.SupplementalGroups) + `,`,
+		`ApparmorProfile:` + fmt.Sprintf("%v", this.ApparmorProfile) + `,`,
+		`SeccompProfilePath:` + fmt.Sprintf("%v", this.SeccompProfilePath) + `,`,
+		`NoNewPrivs:` + fmt.Sprintf("%v", this.NoNewPrivs) + `,`,
+		`RunAsGroup:` + strings.Replace(fmt.Sprintf("%v", this.RunAsGroup), "Int64Value", "Int64Value", 1) + `,`,
+		`MaskedPaths:` + fmt.Sprintf("%v", this.MaskedPaths) + `,`,
+		`ReadonlyPaths:` + fmt.Sprintf("%v", this.ReadonlyPaths) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RemoveVolumeResponse) String() string {
+func (this *LinuxContainerConfig) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RemoveVolumeResponse{`,
+	s := strings.Join([]string{`&LinuxContainerConfig{`,
+		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "LinuxContainerResources", "LinuxContainerResources", 1) + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "LinuxContainerSecurityContext", "LinuxContainerSecurityContext", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *StartPodSandboxRequest) String() string {
+func (this *WindowsContainerSecurityContext) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&StartPodSandboxRequest{`,
+	s := strings.Join([]string{`&WindowsContainerSecurityContext{`,
+		`RunAsUsername:` + fmt.Sprintf("%v", this.RunAsUsername) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WindowsContainerConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WindowsContainerConfig{`,
+		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "WindowsContainerResources", "WindowsContainerResources", 1) + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "WindowsContainerSecurityContext", "WindowsContainerSecurityContext", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WindowsContainerResources) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WindowsContainerResources{`,
+		`CpuShares:` + fmt.Sprintf("%v", this.CpuShares) + `,`,
+		`CpuCount:` + fmt.Sprintf("%v", this.CpuCount) + `,`,
+		`CpuMaximum:` + fmt.Sprintf("%v", this.CpuMaximum) + `,`,
+		`MemoryLimitInBytes:` + fmt.Sprintf("%v", this.MemoryLimitInBytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerMetadata{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Device) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Device{`,
+		`ContainerPath:` + fmt.Sprintf("%v", this.ContainerPath) + `,`,
+		`HostPath:` + fmt.Sprintf("%v", this.HostPath) + `,`,
+		`Permissions:` + fmt.Sprintf("%v", this.Permissions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&ContainerConfig{`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "ContainerMetadata", "ContainerMetadata", 1) + `,`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "ImageSpec", 1) + `,`,
+		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`WorkingDir:` + fmt.Sprintf("%v", this.WorkingDir) + `,`,
+		`Envs:` + strings.Replace(fmt.Sprintf("%v", this.Envs), "KeyValue", "KeyValue", 1) + `,`,
+		`Mounts:` + strings.Replace(fmt.Sprintf("%v", this.Mounts), "Mount", "Mount", 1) + `,`,
+		`Devices:` + strings.Replace(fmt.Sprintf("%v", this.Devices), "Device", "Device", 1) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`LogPath:` + fmt.Sprintf("%v", this.LogPath) + `,`,
+		`Stdin:` + fmt.Sprintf("%v", this.Stdin) + `,`,
+		`StdinOnce:` + fmt.Sprintf("%v", this.StdinOnce) + `,`,
+		`Tty:` + fmt.Sprintf("%v", this.Tty) + `,`,
+		`Linux:` + strings.Replace(fmt.Sprintf("%v", this.Linux), "LinuxContainerConfig", "LinuxContainerConfig", 1) + `,`,
+		`Windows:` + strings.Replace(fmt.Sprintf("%v", this.Windows), "WindowsContainerConfig", "WindowsContainerConfig", 1) + `,`,
+		`NetPriority:` + fmt.Sprintf("%v", this.NetPriority) + `,`,
+		`QuotaId:` + fmt.Sprintf("%v", this.QuotaId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateContainerRequest{`,
 		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
+		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "ContainerConfig", "ContainerConfig", 1) + `,`,
+		`SandboxConfig:` + strings.Replace(fmt.Sprintf("%v", this.SandboxConfig), "PodSandboxConfig", "PodSandboxConfig", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *StartPodSandboxResponse) String() string {
+func (this *CreateContainerResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&StartPodSandboxResponse{`,
+	s := strings.Join([]string{`&CreateContainerResponse{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func valueToStringApi(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *StartContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartContainerRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartContainerResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartContainerResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StopContainerRequest) String() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	s := strings.Join([]string{`&StopContainerRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StopContainerResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StopContainerResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveContainerRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveContainerResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveContainerResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerStateValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerStateValue{`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabelSelector := make([]string, 0, len(this.LabelSelector))
+	for k := range this.LabelSelector {
+		keysForLabelSelector = append(keysForLabelSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabelSelector)
+	mapStringForLabelSelector := "map[string]string{"
+	for _, k := range keysForLabelSelector {
+		mapStringForLabelSelector += fmt.Sprintf("%v: %v,", k, this.LabelSelector[k])
+	}
+	mapStringForLabelSelector += "}"
+	s := strings.Join([]string{`&ContainerFilter{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`State:` + strings.Replace(fmt.Sprintf("%v", this.State), "ContainerStateValue", "ContainerStateValue", 1) + `,`,
+		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
+		`LabelSelector:` + mapStringForLabelSelector + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListContainersRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListContainersRequest{`,
+		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "ContainerFilter", "ContainerFilter", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Container) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&Container{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "ContainerMetadata", "ContainerMetadata", 1) + `,`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "ImageSpec", 1) + `,`,
+		`ImageRef:` + fmt.Sprintf("%v", this.ImageRef) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListContainersResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListContainersResponse{`,
+		`Containers:` + strings.Replace(fmt.Sprintf("%v", this.Containers), "Container", "Container", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerStatusRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`Verbose:` + fmt.Sprintf("%v", this.Verbose) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	keysForVolumes := make([]string, 0, len(this.Volumes))
+	for k := range this.Volumes {
+		keysForVolumes = append(keysForVolumes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForVolumes)
+	mapStringForVolumes := "map[string]*Volume{"
+	for _, k := range keysForVolumes {
+		mapStringForVolumes += fmt.Sprintf("%v: %v,", k, this.Volumes[k])
+	}
+	mapStringForVolumes += "}"
+	s := strings.Join([]string{`&ContainerStatus{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "ContainerMetadata", "ContainerMetadata", 1) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`StartedAt:` + fmt.Sprintf("%v", this.StartedAt) + `,`,
+		`FinishedAt:` + fmt.Sprintf("%v", this.FinishedAt) + `,`,
+		`ExitCode:` + fmt.Sprintf("%v", this.ExitCode) + `,`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "ImageSpec", 1) + `,`,
+		`ImageRef:` + fmt.Sprintf("%v", this.ImageRef) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`Mounts:` + strings.Replace(fmt.Sprintf("%v", this.Mounts), "Mount", "Mount", 1) + `,`,
+		`LogPath:` + fmt.Sprintf("%v", this.LogPath) + `,`,
+		`Volumes:` + mapStringForVolumes + `,`,
+		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "LinuxContainerResources", "LinuxContainerResources", 1) + `,`,
+		`QuotaId:` + fmt.Sprintf("%v", this.QuotaId) + `,`,
+		`Envs:` + strings.Replace(fmt.Sprintf("%v", this.Envs), "KeyValue", "KeyValue", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Volume) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Volume{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForInfo := make([]string, 0, len(this.Info))
+	for k := range this.Info {
+		keysForInfo = append(keysForInfo, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForInfo)
+	mapStringForInfo := "map[string]string{"
+	for _, k := range keysForInfo {
+		mapStringForInfo += fmt.Sprintf("%v: %v,", k, this.Info[k])
+	}
+	mapStringForInfo += "}"
+	s := strings.Join([]string{`&ContainerStatusResponse{`,
+		`Status:` + strings.Replace(fmt.Sprintf("%v", this.Status), "ContainerStatus", "ContainerStatus", 1) + `,`,
+		`Info:` + mapStringForInfo + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateContainerResourcesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSpecAnnotations := make([]string, 0, len(this.SpecAnnotations))
+	for k := range this.SpecAnnotations {
+		keysForSpecAnnotations = append(keysForSpecAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSpecAnnotations)
+	mapStringForSpecAnnotations := "map[string]string{"
+	for _, k := range keysForSpecAnnotations {
+		mapStringForSpecAnnotations += fmt.Sprintf("%v: %v,", k, this.SpecAnnotations[k])
+	}
+	mapStringForSpecAnnotations += "}"
+	s := strings.Join([]string{`&UpdateContainerResourcesRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`Linux:` + strings.Replace(fmt.Sprintf("%v", this.Linux), "LinuxContainerResources", "LinuxContainerResources", 1) + `,`,
+		`SpecAnnotations:` + mapStringForSpecAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateContainerResourcesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateContainerResourcesResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExecSyncRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExecSyncRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`Cmd:` + fmt.Sprintf("%v", this.Cmd) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExecSyncResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExecSyncResponse{`,
+		`Stdout:` + fmt.Sprintf("%v", this.Stdout) + `,`,
+		`Stderr:` + fmt.Sprintf("%v", this.Stderr) + `,`,
+		`ExitCode:` + fmt.Sprintf("%v", this.ExitCode) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExecRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExecRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`Cmd:` + fmt.Sprintf("%v", this.Cmd) + `,`,
+		`Tty:` + fmt.Sprintf("%v", this.Tty) + `,`,
+		`Stdin:` + fmt.Sprintf("%v", this.Stdin) + `,`,
+		`Stdout:` + fmt.Sprintf("%v", this.Stdout) + `,`,
+		`Stderr:` + fmt.Sprintf("%v", this.Stderr) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExecResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExecResponse{`,
+		`Url:` + fmt.Sprintf("%v", this.Url) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AttachRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AttachRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`Stdin:` + fmt.Sprintf("%v", this.Stdin) + `,`,
+		`Tty:` + fmt.Sprintf("%v", this.Tty) + `,`,
+		`Stdout:` + fmt.Sprintf("%v", this.Stdout) + `,`,
+		`Stderr:` + fmt.Sprintf("%v", this.Stderr) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AttachResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AttachResponse{`,
+		`Url:` + fmt.Sprintf("%v", this.Url) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PortForwardRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PortForwardRequest{`,
+		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
+		`Port:` + fmt.Sprintf("%v", this.Port) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PortForwardResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PortForwardResponse{`,
+		`Url:` + fmt.Sprintf("%v", this.Url) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageFilter{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "ImageSpec", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListImagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListImagesRequest{`,
+		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "ImageFilter", "ImageFilter", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Image) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForVolumes := make([]string, 0, len(this.Volumes))
+	for k := range this.Volumes {
+		keysForVolumes = append(keysForVolumes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForVolumes)
+	mapStringForVolumes := "map[string]*Volume{"
+	for _, k := range keysForVolumes {
+		mapStringForVolumes += fmt.Sprintf("%v: %v,", k, this.Volumes[k])
+	}
+	mapStringForVolumes += "}"
+	s := strings.Join([]string{`&Image{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`RepoTags:` + fmt.Sprintf("%v", this.RepoTags) + `,`,
+		`RepoDigests:` + fmt.Sprintf("%v", this.RepoDigests) + `,`,
+		`Size_:` + fmt.Sprintf("%v", this.Size_) + `,`,
+		`Uid:` + strings.Replace(fmt.Sprintf("%v", this.Uid), "Int64Value", "Int64Value", 1) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Volumes:` + mapStringForVolumes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListImagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListImagesResponse{`,
+		`Images:` + strings.Replace(fmt.Sprintf("%v", this.Images), "Image", "Image", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageStatusRequest{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "ImageSpec", 1) + `,`,
+		`Verbose:` + fmt.Sprintf("%v", this.Verbose) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForInfo := make([]string, 0, len(this.Info))
+	for k := range this.Info {
+		keysForInfo = append(keysForInfo, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForInfo)
+	mapStringForInfo := "map[string]string{"
+	for _, k := range keysForInfo {
+		mapStringForInfo += fmt.Sprintf("%v: %v,", k, this.Info[k])
+	}
+	mapStringForInfo += "}"
+	s := strings.Join([]string{`&ImageStatusResponse{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "Image", "Image", 1) + `,`,
+		`Info:` + mapStringForInfo + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuthConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuthConfig{`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`Auth:` + fmt.Sprintf("%v", this.Auth) + `,`,
+		`ServerAddress:` + fmt.Sprintf("%v", this.ServerAddress) + `,`,
+		`IdentityToken:` + fmt.Sprintf("%v", this.IdentityToken) + `,`,
+		`RegistryToken:` + fmt.Sprintf("%v", this.RegistryToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PullImageRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PullImageRequest{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "ImageSpec", 1) + `,`,
+		`Auth:` + strings.Replace(fmt.Sprintf("%v", this.Auth), "AuthConfig", "AuthConfig", 1) + `,`,
+		`SandboxConfig:` + strings.Replace(fmt.Sprintf("%v", this.SandboxConfig), "PodSandboxConfig", "PodSandboxConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PullImageResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PullImageResponse{`,
+		`ImageRef:` + fmt.Sprintf("%v", this.ImageRef) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveImageRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveImageRequest{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "ImageSpec", "ImageSpec", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveImageResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveImageResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkConfig{`,
+		`PodCidr:` + fmt.Sprintf("%v", this.PodCidr) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RuntimeConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RuntimeConfig{`,
+		`NetworkConfig:` + strings.Replace(fmt.Sprintf("%v", this.NetworkConfig), "NetworkConfig", "NetworkConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateRuntimeConfigRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateRuntimeConfigRequest{`,
+		`RuntimeConfig:` + strings.Replace(fmt.Sprintf("%v", this.RuntimeConfig), "RuntimeConfig", "RuntimeConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateRuntimeConfigResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateRuntimeConfigResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RuntimeCondition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RuntimeCondition{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RuntimeStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RuntimeStatus{`,
+		`Conditions:` + strings.Replace(fmt.Sprintf("%v", this.Conditions), "RuntimeCondition", "RuntimeCondition", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StatusRequest{`,
+		`Verbose:` + fmt.Sprintf("%v", this.Verbose) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForInfo := make([]string, 0, len(this.Info))
+	for k := range this.Info {
+		keysForInfo = append(keysForInfo, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForInfo)
+	mapStringForInfo := "map[string]string{"
+	for _, k := range keysForInfo {
+		mapStringForInfo += fmt.Sprintf("%v: %v,", k, this.Info[k])
+	}
+	mapStringForInfo += "}"
+	s := strings.Join([]string{`&StatusResponse{`,
+		`Status:` + strings.Replace(fmt.Sprintf("%v", this.Status), "RuntimeStatus", "RuntimeStatus", 1) + `,`,
+		`Info:` + mapStringForInfo + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageFsInfoRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageFsInfoRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UInt64Value) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UInt64Value{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FilesystemIdentifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FilesystemIdentifier{`,
+		`Mountpoint:` + fmt.Sprintf("%v", this.Mountpoint) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FilesystemUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FilesystemUsage{`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`FsId:` + strings.Replace(fmt.Sprintf("%v", this.FsId), "FilesystemIdentifier", "FilesystemIdentifier", 1) + `,`,
+		`UsedBytes:` + strings.Replace(fmt.Sprintf("%v", this.UsedBytes), "UInt64Value", "UInt64Value", 1) + `,`,
+		`InodesUsed:` + strings.Replace(fmt.Sprintf("%v", this.InodesUsed), "UInt64Value", "UInt64Value", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageFsInfoResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageFsInfoResponse{`,
+		`ImageFilesystems:` + strings.Replace(fmt.Sprintf("%v", this.ImageFilesystems), "FilesystemUsage", "FilesystemUsage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerStatsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerStatsRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerStatsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerStatsResponse{`,
+		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "ContainerStats", "ContainerStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListContainerStatsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListContainerStatsRequest{`,
+		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "ContainerStatsFilter", "ContainerStatsFilter", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerStatsFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabelSelector := make([]string, 0, len(this.LabelSelector))
+	for k := range this.LabelSelector {
+		keysForLabelSelector = append(keysForLabelSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabelSelector)
+	mapStringForLabelSelector := "map[string]string{"
+	for _, k := range keysForLabelSelector {
+		mapStringForLabelSelector += fmt.Sprintf("%v: %v,", k, this.LabelSelector[k])
+	}
+	mapStringForLabelSelector += "}"
+	s := strings.Join([]string{`&ContainerStatsFilter{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
+		`LabelSelector:` + mapStringForLabelSelector + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListContainerStatsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListContainerStatsResponse{`,
+		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "ContainerStats", "ContainerStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&ContainerAttributes{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "ContainerMetadata", "ContainerMetadata", 1) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerStats{`,
+		`Attributes:` + strings.Replace(fmt.Sprintf("%v", this.Attributes), "ContainerAttributes", "ContainerAttributes", 1) + `,`,
+		`Cpu:` + strings.Replace(fmt.Sprintf("%v", this.Cpu), "CpuUsage", "CpuUsage", 1) + `,`,
+		`Memory:` + strings.Replace(fmt.Sprintf("%v", this.Memory), "MemoryUsage", "MemoryUsage", 1) + `,`,
+		`WritableLayer:` + strings.Replace(fmt.Sprintf("%v", this.WritableLayer), "FilesystemUsage", "FilesystemUsage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CpuUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CpuUsage{`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`UsageCoreNanoSeconds:` + strings.Replace(fmt.Sprintf("%v", this.UsageCoreNanoSeconds), "UInt64Value", "UInt64Value", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MemoryUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MemoryUsage{`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`WorkingSetBytes:` + strings.Replace(fmt.Sprintf("%v", this.WorkingSetBytes), "UInt64Value", "UInt64Value", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxStatsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodSandboxStatsRequest{`,
+		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxStatsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodSandboxStatsResponse{`,
+		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "PodSandboxStats", "PodSandboxStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxStatsFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabelSelector := make([]string, 0, len(this.LabelSelector))
+	for k := range this.LabelSelector {
+		keysForLabelSelector = append(keysForLabelSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabelSelector)
+	mapStringForLabelSelector := "map[string]string{"
+	for _, k := range keysForLabelSelector {
+		mapStringForLabelSelector += fmt.Sprintf("%v: %v,", k, this.LabelSelector[k])
+	}
+	mapStringForLabelSelector += "}"
+	s := strings.Join([]string{`&PodSandboxStatsFilter{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`LabelSelector:` + mapStringForLabelSelector + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListPodSandboxStatsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListPodSandboxStatsRequest{`,
+		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "PodSandboxStatsFilter", "PodSandboxStatsFilter", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListPodSandboxStatsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListPodSandboxStatsResponse{`,
+		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "PodSandboxStats", "PodSandboxStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&PodSandboxAttributes{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "PodSandboxMetadata", "PodSandboxMetadata", 1) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodSandboxStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodSandboxStats{`,
+		`Attributes:` + strings.Replace(fmt.Sprintf("%v", this.Attributes), "PodSandboxAttributes", "PodSandboxAttributes", 1) + `,`,
+		`Linux:` + strings.Replace(fmt.Sprintf("%v", this.Linux), "LinuxPodSandboxStats", "LinuxPodSandboxStats", 1) + `,`,
+		`Windows:` + strings.Replace(fmt.Sprintf("%v", this.Windows), "WindowsPodSandboxStats", "WindowsPodSandboxStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LinuxPodSandboxStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LinuxPodSandboxStats{`,
+		`Cpu:` + strings.Replace(fmt.Sprintf("%v", this.Cpu), "CpuUsage", "CpuUsage", 1) + `,`,
+		`Memory:` + strings.Replace(fmt.Sprintf("%v", this.Memory), "MemoryUsage", "MemoryUsage", 1) + `,`,
+		`Network:` + strings.Replace(fmt.Sprintf("%v", this.Network), "NetworkUsage", "NetworkUsage", 1) + `,`,
+		`Process:` + strings.Replace(fmt.Sprintf("%v", this.Process), "ProcessUsage", "ProcessUsage", 1) + `,`,
+		`Containers:` + strings.Replace(fmt.Sprintf("%v", this.Containers), "ContainerStats", "ContainerStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WindowsPodSandboxStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WindowsPodSandboxStats{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkUsage{`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`DefaultInterface:` + strings.Replace(fmt.Sprintf("%v", this.DefaultInterface), "NetworkInterfaceUsage", "NetworkInterfaceUsage", 1) + `,`,
+		`Interfaces:` + strings.Replace(fmt.Sprintf("%v", this.Interfaces), "NetworkInterfaceUsage", "NetworkInterfaceUsage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkInterfaceUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkInterfaceUsage{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`RxBytes:` + strings.Replace(fmt.Sprintf("%v", this.RxBytes), "UInt64Value", "UInt64Value", 1) + `,`,
+		`RxErrors:` + strings.Replace(fmt.Sprintf("%v", this.RxErrors), "UInt64Value", "UInt64Value", 1) + `,`,
+		`TxBytes:` + strings.Replace(fmt.Sprintf("%v", this.TxBytes), "UInt64Value", "UInt64Value", 1) + `,`,
+		`TxErrors:` + strings.Replace(fmt.Sprintf("%v", this.TxErrors), "UInt64Value", "UInt64Value", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProcessUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProcessUsage{`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`ProcessCount:` + strings.Replace(fmt.Sprintf("%v", this.ProcessCount), "UInt64Value", "UInt64Value", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReopenContainerLogRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReopenContainerLogRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReopenContainerLogResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReopenContainerLogResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseContainerRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseContainerResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseContainerResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseContainerRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseContainerResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseContainerResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveVolumeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveVolumeRequest{`,
+		`VolumeName:` + fmt.Sprintf("%v", this.VolumeName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveVolumeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveVolumeResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartPodSandboxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartPodSandboxRequest{`,
+		`PodSandboxId:` + fmt.Sprintf("%v", this.PodSandboxId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartPodSandboxResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartPodSandboxResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetEventsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetEventsRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerEventResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerEventResponse{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`ContainerEventType:` + fmt.Sprintf("%v", this.ContainerEventType) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`PodSandboxStatus:` + strings.Replace(fmt.Sprintf("%v", this.PodSandboxStatus), "PodSandboxStatus", "PodSandboxStatus", 1) + `,`,
+		`ContainersStatuses:` + strings.Replace(fmt.Sprintf("%v", this.ContainersStatuses), "ContainerStatus", "ContainerStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckpointContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckpointContainerRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`Location:` + fmt.Sprintf("%v", this.Location) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckpointContainerResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckpointContainerResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringApi(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *VersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeApiVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeApiVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DNSConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DNSConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DNSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Servers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Servers = append(m.Servers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Searches", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Searches = append(m.Searches, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			m.Protocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Protocol |= (Protocol(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPort", wireType)
+			}
+			m.ContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContainerPort |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostPort", wireType)
+			}
+			m.HostPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HostPort |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostIp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostIp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Mount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Mount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Mount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Readonly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Readonly = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelinuxRelabel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SelinuxRelabel = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Propagation", wireType)
+			}
+			m.Propagation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Propagation |= (MountPropagation(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+			}
+			m.Network = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Network |= (NamespaceMode(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			m.Pid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pid |= (NamespaceMode(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipc", wireType)
+			}
+			m.Ipc = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ipc |= (NamespaceMode(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernsOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UsernsOptions == nil {
+				m.UsernsOptions = &UserNamespace{}
+			}
+			if err := m.UsernsOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IDMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IDMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IDMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostId", wireType)
+			}
+			m.HostId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HostId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			m.ContainerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContainerId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= (NamespaceMode(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uids = append(m.Uids, &IDMapping{})
+			if err := m.Uids[len(m.Uids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gids = append(m.Gids, &IDMapping{})
+			if err := m.Gids[len(m.Gids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Int64Value) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Int64Value: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Int64Value: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinuxSandboxSecurityContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinuxSandboxSecurityContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinuxSandboxSecurityContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NamespaceOptions == nil {
+				m.NamespaceOptions = &NamespaceOption{}
+			}
+			if err := m.NamespaceOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelinuxOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SelinuxOptions == nil {
+				m.SelinuxOptions = &SELinuxOption{}
+			}
+			if err := m.SelinuxOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAsUser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunAsUser == nil {
+				m.RunAsUser = &Int64Value{}
+			}
+			if err := m.RunAsUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadonlyRootfs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadonlyRootfs = bool(v != 0)
+		case 5:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SupplementalGroups = append(m.SupplementalGroups, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SupplementalGroups = append(m.SupplementalGroups, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplementalGroups", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Privileged = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeccompProfilePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeccompProfilePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAsGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunAsGroup == nil {
+				m.RunAsGroup = &Int64Value{}
+			}
+			if err := m.RunAsGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinuxPodSandboxConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinuxPodSandboxConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinuxPodSandboxConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CgroupParent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CgroupParent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecurityContext == nil {
+				m.SecurityContext = &LinuxSandboxSecurityContext{}
+			}
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sysctls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sysctls == nil {
+				m.Sysctls = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Sysctls[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodSandboxMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodSandboxMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodSandboxMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodSandboxConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodSandboxConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodSandboxConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &PodSandboxMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogDirectory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogDirectory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DnsConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DnsConfig == nil {
+				m.DnsConfig = &DNSConfig{}
+			}
+			if err := m.DnsConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortMappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortMappings = append(m.PortMappings, &PortMapping{})
+			if err := m.PortMappings[len(m.PortMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Linux", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Linux == nil {
+				m.Linux = &LinuxPodSandboxConfig{}
+			}
+			if err := m.Linux.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunPodSandboxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunPodSandboxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunPodSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &PodSandboxConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeHandler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeHandler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunPodSandboxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunPodSandboxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunPodSandboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopPodSandboxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopPodSandboxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopPodSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopPodSandboxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopPodSandboxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopPodSandboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemovePodSandboxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemovePodSandboxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemovePodSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemovePodSandboxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemovePodSandboxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemovePodSandboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *VersionRequest) Unmarshal(dAtA []byte) error {
+func (m *PodSandboxStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13779,15 +18675,15 @@ func (m *VersionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VersionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodSandboxStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodSandboxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13812,8 +18708,28 @@ func (m *VersionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verbose", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verbose = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -13835,7 +18751,7 @@ func (m *VersionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VersionResponse) Unmarshal(dAtA []byte) error {
+func (m *PodSandboxNetworkStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13858,15 +18774,15 @@ func (m *VersionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VersionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodSandboxNetworkStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodSandboxNetworkStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13891,42 +18807,13 @@ func (m *VersionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Ip = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RuntimeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalIps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -13936,49 +18823,22 @@ func (m *VersionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RuntimeVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeApiVersion", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.AdditionalIps = append(m.AdditionalIps, &PodIP{})
+			if err := m.AdditionalIps[len(m.AdditionalIps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RuntimeApiVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14001,7 +18861,7 @@ func (m *VersionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DNSConfig) Unmarshal(dAtA []byte) error {
+func (m *PodIP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14024,73 +18884,15 @@ func (m *DNSConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DNSConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodIP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DNSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodIP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Servers", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Servers = append(m.Servers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Searches", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Searches = append(m.Searches, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14115,7 +18917,7 @@ func (m *DNSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, string(dAtA[iNdEx:postIndex]))
+			m.Ip = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14138,7 +18940,7 @@ func (m *DNSConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PortMapping) Unmarshal(dAtA []byte) error {
+func (m *Namespace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14161,17 +18963,17 @@ func (m *PortMapping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PortMapping: wiretype end group for non-group")
+			return fmt.Errorf("proto: Namespace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PortMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Namespace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			m.Protocol = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -14181,54 +18983,80 @@ func (m *PortMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Protocol |= (Protocol(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPort", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.ContainerPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ContainerPort |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &NamespaceOption{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinuxPodSandboxStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostPort", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.HostPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HostPort |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinuxPodSandboxStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinuxPodSandboxStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -14238,20 +19066,24 @@ func (m *PortMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostIp = string(dAtA[iNdEx:postIndex])
+			if m.Namespaces == nil {
+				m.Namespaces = &Namespace{}
+			}
+			if err := m.Namespaces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14274,7 +19106,7 @@ func (m *PortMapping) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Mount) Unmarshal(dAtA []byte) error {
+func (m *PodSandboxStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14297,15 +19129,15 @@ func (m *Mount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Mount: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodSandboxStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodSandboxStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14330,13 +19162,13 @@ func (m *Mount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerPath = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -14346,26 +19178,30 @@ func (m *Mount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostPath = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = &PodSandboxMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Readonly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var v int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -14375,17 +19211,16 @@ func (m *Mount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.State |= (PodSandboxState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Readonly = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelinuxRelabel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var v int
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -14395,17 +19230,16 @@ func (m *Mount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SelinuxRelabel = bool(v != 0)
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Propagation", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
 			}
-			m.Propagation = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -14415,16 +19249,30 @@ func (m *Mount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Propagation |= (MountPropagation(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 100:
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Network == nil {
+				m.Network = &PodSandboxNetworkStatus{}
+			}
+			if err := m.Network.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Linux", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -14434,76 +19282,30 @@ func (m *Mount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NamespaceOption) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Linux == nil {
+				m.Linux = &LinuxPodSandboxStatus{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Linux.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceOption: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceOption: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			m.Network = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -14513,35 +19315,115 @@ func (m *NamespaceOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Network |= (NamespaceMode(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.Pid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Pid |= (NamespaceMode(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ipc", wireType)
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			m.Ipc = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -14551,80 +19433,110 @@ func (m *NamespaceOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ipc |= (NamespaceMode(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Int64Value) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Int64Value: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Int64Value: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-			m.Value = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Value |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -14646,7 +19558,7 @@ func (m *Int64Value) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LinuxSandboxSecurityContext) Unmarshal(dAtA []byte) error {
+func (m *PodSandboxStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14665,52 +19577,19 @@ func (m *LinuxSandboxSecurityContext) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LinuxSandboxSecurityContext: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinuxSandboxSecurityContext: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceOptions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.NamespaceOptions == nil {
-				m.NamespaceOptions = &NamespaceOption{}
-			}
-			if err := m.NamespaceOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodSandboxStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodSandboxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelinuxOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14734,16 +19613,16 @@ func (m *LinuxSandboxSecurityContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SelinuxOptions == nil {
-				m.SelinuxOptions = &SELinuxOption{}
+			if m.Status == nil {
+				m.Status = &PodSandboxStatus{}
 			}
-			if err := m.SelinuxOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunAsUser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14767,36 +19646,14 @@ func (m *LinuxSandboxSecurityContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RunAsUser == nil {
-				m.RunAsUser = &Int64Value{}
-			}
-			if err := m.RunAsUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadonlyRootfs", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Info == nil {
+				m.Info = make(map[string]string)
 			}
-			m.ReadonlyRootfs = bool(v != 0)
-		case 5:
-			if wireType == 0 {
-				var v int64
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowApi
@@ -14806,37 +19663,40 @@ func (m *LinuxSandboxSecurityContext) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= (int64(b) & 0x7F) << shift
+					wire |= (uint64(b) & 0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				m.SupplementalGroups = append(m.SupplementalGroups, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
 					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthApi
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v int64
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowApi
@@ -14846,70 +19706,93 @@ func (m *LinuxSandboxSecurityContext) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= (int64(b) & 0x7F) << shift
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.SupplementalGroups = append(m.SupplementalGroups, v)
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplementalGroups", wireType)
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Privileged", wireType)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Info[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Privileged = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeccompProfilePath", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthApi
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodSandboxStateValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SeccompProfilePath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunAsGroup", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodSandboxStateValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodSandboxStateValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -14919,25 +19802,11 @@ func (m *LinuxSandboxSecurityContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.State |= (PodSandboxState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RunAsGroup == nil {
-				m.RunAsGroup = &Int64Value{}
-			}
-			if err := m.RunAsGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -14959,7 +19828,7 @@ func (m *LinuxSandboxSecurityContext) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LinuxPodSandboxConfig) Unmarshal(dAtA []byte) error {
+func (m *PodSandboxFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14982,15 +19851,15 @@ func (m *LinuxPodSandboxConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinuxPodSandboxConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodSandboxFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinuxPodSandboxConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodSandboxFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CgroupParent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15015,11 +19884,11 @@ func (m *LinuxPodSandboxConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CgroupParent = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15043,16 +19912,16 @@ func (m *LinuxPodSandboxConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &LinuxSandboxSecurityContext{}
+			if m.State == nil {
+				m.State = &PodSandboxStateValue{}
 			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sysctls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15076,8 +19945,8 @@ func (m *LinuxPodSandboxConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sysctls == nil {
-				m.Sysctls = make(map[string]string)
+			if m.LabelSelector == nil {
+				m.LabelSelector = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -15166,7 +20035,7 @@ func (m *LinuxPodSandboxConfig) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Sysctls[mapkey] = mapvalue
+			m.LabelSelector[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15189,7 +20058,7 @@ func (m *LinuxPodSandboxConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodSandboxMetadata) Unmarshal(dAtA []byte) error {
+func (m *ListPodSandboxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15212,46 +20081,17 @@ func (m *PodSandboxMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodSandboxMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPodSandboxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodSandboxMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPodSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -15261,69 +20101,25 @@ func (m *PodSandboxMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
+			if m.Filter == nil {
+				m.Filter = &PodSandboxFilter{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
-			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -15345,7 +20141,7 @@ func (m *PodSandboxMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodSandboxConfig) Unmarshal(dAtA []byte) error {
+func (m *PodSandbox) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15368,48 +20164,15 @@ func (m *PodSandboxConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodSandboxConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodSandbox: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodSandboxConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodSandbox: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &PodSandboxMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15434,13 +20197,13 @@ func (m *PodSandboxConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hostname = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogDirectory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -15450,26 +20213,30 @@ func (m *PodSandboxConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogDirectory = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = &PodSandboxMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DnsConfig", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -15479,30 +20246,16 @@ func (m *PodSandboxConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.State |= (PodSandboxState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DnsConfig == nil {
-				m.DnsConfig = &DNSConfig{}
-			}
-			if err := m.DnsConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortMappings", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var msglen int
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -15512,24 +20265,12 @@ func (m *PodSandboxConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PortMappings = append(m.PortMappings, &PortMapping{})
-			if err := m.PortMappings[len(m.PortMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
@@ -15647,7 +20388,7 @@ func (m *PodSandboxConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
@@ -15759,44 +20500,11 @@ func (m *PodSandboxConfig) Unmarshal(dAtA []byte) error {
 					}
 					if (iNdEx + skippy) > postIndex {
 						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Linux", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Linux == nil {
-				m.Linux = &LinuxPodSandboxConfig{}
-			}
-			if err := m.Linux.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15819,7 +20527,7 @@ func (m *PodSandboxConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RunPodSandboxRequest) Unmarshal(dAtA []byte) error {
+func (m *ListPodSandboxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15842,15 +20550,15 @@ func (m *RunPodSandboxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RunPodSandboxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPodSandboxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunPodSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPodSandboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15874,42 +20582,11 @@ func (m *RunPodSandboxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &PodSandboxConfig{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, &PodSandbox{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeHandler", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RuntimeHandler = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -15931,7 +20608,7 @@ func (m *RunPodSandboxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RunPodSandboxResponse) Unmarshal(dAtA []byte) error {
+func (m *ImageSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15954,15 +20631,15 @@ func (m *RunPodSandboxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RunPodSandboxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunPodSandboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15987,7 +20664,7 @@ func (m *RunPodSandboxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16010,7 +20687,7 @@ func (m *RunPodSandboxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopPodSandboxRequest) Unmarshal(dAtA []byte) error {
+func (m *KeyValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16033,15 +20710,15 @@ func (m *StopPodSandboxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopPodSandboxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopPodSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16066,58 +20743,37 @@ func (m *StopPodSandboxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StopPodSandboxResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StopPodSandboxResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopPodSandboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -16139,7 +20795,7 @@ func (m *StopPodSandboxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemovePodSandboxRequest) Unmarshal(dAtA []byte) error {
+func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16162,17 +20818,17 @@ func (m *RemovePodSandboxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemovePodSandboxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinuxContainerResources: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemovePodSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinuxContainerResources: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuPeriod", wireType)
 			}
-			var stringLen uint64
+			m.CpuPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -16182,124 +20838,119 @@ func (m *RemovePodSandboxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.CpuPeriod |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuQuota", wireType)
 			}
-			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.CpuQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CpuQuota |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuShares", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.CpuShares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CpuShares |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemovePodSandboxResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimitInBytes", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.MemoryLimitInBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryLimitInBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OomScoreAdj", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemovePodSandboxResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemovePodSandboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.OomScoreAdj = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OomScoreAdj |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpusetCpus", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PodSandboxStatusRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PodSandboxStatusRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodSandboxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CpusetCpus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CpusetMems", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16324,13 +20975,13 @@ func (m *PodSandboxStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
+			m.CpusetMems = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verbose", wireType)
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskQuota", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -16340,67 +20991,115 @@ func (m *PodSandboxStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Verbose = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PodSandboxNetworkStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.DiskQuota == nil {
+				m.DiskQuota = make(map[string]string)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PodSandboxNetworkStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodSandboxNetworkStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+			m.DiskQuota[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 101:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlkioWeight", wireType)
 			}
-			var stringLen uint64
+			m.BlkioWeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -16410,74 +21109,14 @@ func (m *PodSandboxNetworkStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.BlkioWeight |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ip = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Namespace) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Namespace: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Namespace: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlkioWeightDevice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16501,66 +21140,14 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Options == nil {
-				m.Options = &NamespaceOption{}
-			}
-			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlkioWeightDevice = append(m.BlkioWeightDevice, &WeightDevice{})
+			if err := m.BlkioWeightDevice[len(m.BlkioWeightDevice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LinuxPodSandboxStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LinuxPodSandboxStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinuxPodSandboxStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlkioDeviceReadBps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16584,68 +21171,16 @@ func (m *LinuxPodSandboxStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Namespaces == nil {
-				m.Namespaces = &Namespace{}
-			}
-			if err := m.Namespaces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlkioDeviceReadBps = append(m.BlkioDeviceReadBps, &ThrottleDevice{})
+			if err := m.BlkioDeviceReadBps[len(m.BlkioDeviceReadBps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PodSandboxStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PodSandboxStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodSandboxStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlkioDeviceWriteBps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -16655,24 +21190,26 @@ func (m *PodSandboxStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.BlkioDeviceWriteBps = append(m.BlkioDeviceWriteBps, &ThrottleDevice{})
+			if err := m.BlkioDeviceWriteBps[len(m.BlkioDeviceWriteBps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 105:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlkioDeviceRead_IOps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16696,18 +21233,16 @@ func (m *PodSandboxStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &PodSandboxMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlkioDeviceRead_IOps = append(m.BlkioDeviceRead_IOps, &ThrottleDevice{})
+			if err := m.BlkioDeviceRead_IOps[len(m.BlkioDeviceRead_IOps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		case 106:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlkioDeviceWrite_IOps", wireType)
 			}
-			m.State = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -16717,35 +21252,28 @@ func (m *PodSandboxStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (PodSandboxState(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.CreatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreatedAt |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+			m.BlkioDeviceWrite_IOps = append(m.BlkioDeviceWrite_IOps, &ThrottleDevice{})
+			if err := m.BlkioDeviceWrite_IOps[len(m.BlkioDeviceWrite_IOps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var msglen int
+			iNdEx = postIndex
+		case 107:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KernelMemory", wireType)
+			}
+			m.KernelMemory = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -16755,30 +21283,16 @@ func (m *PodSandboxStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.KernelMemory |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Network == nil {
-				m.Network = &PodSandboxNetworkStatus{}
-			}
-			if err := m.Network.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Linux", wireType)
+		case 108:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryReservation", wireType)
 			}
-			var msglen int
+			m.MemoryReservation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -16788,28 +21302,14 @@ func (m *PodSandboxStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MemoryReservation |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Linux == nil {
-				m.Linux = &LinuxPodSandboxStatus{}
-			}
-			if err := m.Linux.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 109:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemorySwappiness", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16833,101 +21333,16 @@ func (m *PodSandboxStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.MemorySwappiness == nil {
+				m.MemorySwappiness = &Int64Value{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.MemorySwappiness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
+		case 110:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ulimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16951,98 +21366,109 @@ func (m *PodSandboxStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			m.Ulimits = append(m.Ulimits, &Ulimit{})
+			if err := m.Ulimits[len(m.Ulimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightDevice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightDevice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightDevice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -17064,7 +21490,7 @@ func (m *PodSandboxStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodSandboxStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *ThrottleDevice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17087,17 +21513,17 @@ func (m *PodSandboxStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodSandboxStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ThrottleDevice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodSandboxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ThrottleDevice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -17107,30 +21533,26 @@ func (m *PodSandboxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &PodSandboxStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var msglen int
+			m.Rate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -17140,110 +21562,11 @@ func (m *PodSandboxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Rate |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Info == nil {
-				m.Info = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Info[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -17265,7 +21588,7 @@ func (m *PodSandboxStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodSandboxStateValue) Unmarshal(dAtA []byte) error {
+func (m *Ulimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17288,17 +21611,65 @@ func (m *PodSandboxStateValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodSandboxStateValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: Ulimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodSandboxStateValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Ulimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
+			}
+			m.Hard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hard |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Soft", wireType)
 			}
-			m.State = 0
+			m.Soft = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -17308,7 +21679,7 @@ func (m *PodSandboxStateValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (PodSandboxState(b) & 0x7F) << shift
+				m.Soft |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -17334,7 +21705,7 @@ func (m *PodSandboxStateValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodSandboxFilter) Unmarshal(dAtA []byte) error {
+func (m *SELinuxOption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17357,15 +21728,15 @@ func (m *PodSandboxFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodSandboxFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: SELinuxOption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodSandboxFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SELinuxOption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17390,13 +21761,13 @@ func (m *PodSandboxFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -17406,30 +21777,26 @@ func (m *PodSandboxFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &PodSandboxStateValue{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -17439,109 +21806,49 @@ func (m *PodSandboxFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LabelSelector == nil {
-				m.LabelSelector = make(map[string]string)
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.LabelSelector[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17564,7 +21871,7 @@ func (m *PodSandboxFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListPodSandboxRequest) Unmarshal(dAtA []byte) error {
+func (m *Capability) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17587,17 +21894,17 @@ func (m *ListPodSandboxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListPodSandboxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Capability: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListPodSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Capability: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddCapabilities", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -17607,24 +21914,49 @@ func (m *ListPodSandboxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &PodSandboxFilter{}
+			m.AddCapabilities = append(m.AddCapabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropCapabilities", wireType)
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.DropCapabilities = append(m.DropCapabilities, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17647,7 +21979,7 @@ func (m *ListPodSandboxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodSandbox) Unmarshal(dAtA []byte) error {
+func (m *LinuxContainerSecurityContext) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17670,17 +22002,70 @@ func (m *PodSandbox) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodSandbox: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinuxContainerSecurityContext: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodSandbox: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinuxContainerSecurityContext: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Capabilities == nil {
+				m.Capabilities = &Capability{}
+			}
+			if err := m.Capabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Privileged = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceOptions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -17690,24 +22075,28 @@ func (m *PodSandbox) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.NamespaceOptions == nil {
+				m.NamespaceOptions = &NamespaceOption{}
+			}
+			if err := m.NamespaceOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelinuxOptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17731,18 +22120,18 @@ func (m *PodSandbox) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &PodSandboxMetadata{}
+			if m.SelinuxOptions == nil {
+				m.SelinuxOptions = &SELinuxOption{}
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SelinuxOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAsUser", wireType)
 			}
-			m.State = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -17752,16 +22141,30 @@ func (m *PodSandbox) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (PodSandboxState(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.CreatedAt = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunAsUser == nil {
+				m.RunAsUser = &Int64Value{}
+			}
+			if err := m.RunAsUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAsUsername", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -17771,16 +22174,26 @@ func (m *PodSandbox) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunAsUsername = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadonlyRootfs", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -17790,26 +22203,15 @@ func (m *PodSandbox) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
+			m.ReadonlyRootfs = bool(v != 0)
+		case 8:
+			if wireType == 0 {
+				var v int64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowApi
@@ -17819,40 +22221,37 @@ func (m *PodSandbox) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
+					v |= (int64(b) & 0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
+				m.SupplementalGroups = append(m.SupplementalGroups, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
 					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
+					if iNdEx >= l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowApi
@@ -17862,41 +22261,97 @@ func (m *PodSandbox) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						v |= (int64(b) & 0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+					m.SupplementalGroups = append(m.SupplementalGroups, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplementalGroups", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApparmorProfile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApparmorProfile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeccompProfilePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeccompProfilePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoNewPrivs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 6:
+			m.NoNewPrivs = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAsGroup", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17920,97 +22375,70 @@ func (m *PodSandbox) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.RunAsGroup == nil {
+				m.RunAsGroup = &Int64Value{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.RunAsGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaskedPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaskedPaths = append(m.MaskedPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadonlyPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadonlyPaths = append(m.ReadonlyPaths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18033,7 +22461,7 @@ func (m *PodSandbox) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListPodSandboxResponse) Unmarshal(dAtA []byte) error {
+func (m *LinuxContainerConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18056,15 +22484,15 @@ func (m *ListPodSandboxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListPodSandboxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinuxContainerConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListPodSandboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinuxContainerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18088,8 +22516,43 @@ func (m *ListPodSandboxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &PodSandbox{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Resources == nil {
+				m.Resources = &LinuxContainerResources{}
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecurityContext == nil {
+				m.SecurityContext = &LinuxContainerSecurityContext{}
+			}
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18114,7 +22577,7 @@ func (m *ListPodSandboxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageSpec) Unmarshal(dAtA []byte) error {
+func (m *WindowsContainerSecurityContext) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18137,15 +22600,15 @@ func (m *ImageSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: WindowsContainerSecurityContext: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WindowsContainerSecurityContext: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAsUsername", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18170,7 +22633,7 @@ func (m *ImageSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.RunAsUsername = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18193,7 +22656,7 @@ func (m *ImageSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyValue) Unmarshal(dAtA []byte) error {
+func (m *WindowsContainerConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18216,17 +22679,17 @@ func (m *KeyValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: WindowsContainerConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WindowsContainerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -18236,26 +22699,30 @@ func (m *KeyValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.Resources == nil {
+				m.Resources = &WindowsContainerResources{}
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -18265,20 +22732,24 @@ func (m *KeyValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if m.SecurityContext == nil {
+				m.SecurityContext = &WindowsContainerSecurityContext{}
+			}
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18301,7 +22772,7 @@ func (m *KeyValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
+func (m *WindowsContainerResources) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18324,17 +22795,17 @@ func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinuxContainerResources: wiretype end group for non-group")
+			return fmt.Errorf("proto: WindowsContainerResources: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinuxContainerResources: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WindowsContainerResources: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuShares", wireType)
 			}
-			m.CpuPeriod = 0
+			m.CpuShares = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -18344,16 +22815,16 @@ func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CpuPeriod |= (int64(b) & 0x7F) << shift
+				m.CpuShares |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuQuota", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuCount", wireType)
 			}
-			m.CpuQuota = 0
+			m.CpuCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -18363,16 +22834,16 @@ func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CpuQuota |= (int64(b) & 0x7F) << shift
+				m.CpuCount |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuMaximum", wireType)
 			}
-			m.CpuShares = 0
+			m.CpuMaximum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -18382,7 +22853,7 @@ func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CpuShares |= (int64(b) & 0x7F) << shift
+				m.CpuMaximum |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -18406,206 +22877,90 @@ func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OomScoreAdj", wireType)
-			}
-			m.OomScoreAdj = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OomScoreAdj |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpusetCpus", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CpusetCpus = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpusetMems", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CpusetMems = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 100:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiskQuota", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DiskQuota == nil {
-				m.DiskQuota = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.DiskQuota[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 101:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlkioWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			m.BlkioWeight = 0
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -18615,16 +22970,66 @@ func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlkioWeight |= (uint32(b) & 0x7F) << shift
+				m.Attempt |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 102:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Device) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Device: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Device: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlkioWeightDevice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -18634,28 +23039,55 @@ func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlkioWeightDevice = append(m.BlkioWeightDevice, &WeightDevice{})
-			if err := m.BlkioWeightDevice[len(m.BlkioWeightDevice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ContainerPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.HostPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 103:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlkioDeviceReadBps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -18665,26 +23097,74 @@ func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlkioDeviceReadBps = append(m.BlkioDeviceReadBps, &ThrottleDevice{})
-			if err := m.BlkioDeviceReadBps[len(m.BlkioDeviceReadBps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Permissions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 104:
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlkioDeviceWriteBps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18708,14 +23188,16 @@ func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlkioDeviceWriteBps = append(m.BlkioDeviceWriteBps, &ThrottleDevice{})
-			if err := m.BlkioDeviceWriteBps[len(m.BlkioDeviceWriteBps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Metadata == nil {
+				m.Metadata = &ContainerMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 105:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlkioDeviceRead_IOps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18739,16 +23221,18 @@ func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlkioDeviceRead_IOps = append(m.BlkioDeviceRead_IOps, &ThrottleDevice{})
-			if err := m.BlkioDeviceRead_IOps[len(m.BlkioDeviceRead_IOps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Image == nil {
+				m.Image = &ImageSpec{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 106:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlkioDeviceWrite_IOps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -18758,28 +23242,26 @@ func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlkioDeviceWrite_IOps = append(m.BlkioDeviceWrite_IOps, &ThrottleDevice{})
-			if err := m.BlkioDeviceWrite_IOps[len(m.BlkioDeviceWrite_IOps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 107:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KernelMemory", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			m.KernelMemory = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -18789,16 +23271,26 @@ func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KernelMemory |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 108:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoryReservation", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.MemoryReservation = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkingDir", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -18808,14 +23300,24 @@ func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemoryReservation |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 109:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkingDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemorySwappiness", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Envs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18839,16 +23341,14 @@ func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemorySwappiness == nil {
-				m.MemorySwappiness = &Int64Value{}
-			}
-			if err := m.MemorySwappiness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Envs = append(m.Envs, &KeyValue{})
+			if err := m.Envs[len(m.Envs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 110:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ulimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18872,66 +23372,16 @@ func (m *LinuxContainerResources) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ulimits = append(m.Ulimits, &Ulimit{})
-			if err := m.Ulimits[len(m.Ulimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Mounts = append(m.Mounts, &Mount{})
+			if err := m.Mounts[len(m.Mounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WeightDevice) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WeightDevice: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightDevice: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -18941,26 +23391,28 @@ func (m *WeightDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Devices = append(m.Devices, &Device{})
+			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			m.Weight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -18970,95 +23422,115 @@ func (m *WeightDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ThrottleDevice) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ThrottleDevice: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ThrottleDevice: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			m.Rate = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -19068,64 +23540,113 @@ func (m *ThrottleDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rate |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Ulimit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Ulimit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Ulimit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19150,101 +23671,13 @@ func (m *Ulimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.LogPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
-			}
-			m.Hard = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Hard |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Soft", wireType)
-			}
-			m.Soft = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Soft |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SELinuxOption) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SELinuxOption: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SELinuxOption: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -19254,26 +23687,17 @@ func (m *SELinuxOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.User = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			m.Stdin = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdinOnce", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -19283,26 +23707,37 @@ func (m *SELinuxOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
+			m.StdinOnce = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tty", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Tty = bool(v != 0)
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Linux", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -19312,26 +23747,30 @@ func (m *SELinuxOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if m.Linux == nil {
+				m.Linux = &LinuxContainerConfig{}
+			}
+			if err := m.Linux.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -19341,76 +23780,30 @@ func (m *SELinuxOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Level = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Capability) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Windows == nil {
+				m.Windows = &WindowsContainerConfig{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Capability: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Capability: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddCapabilities", wireType)
+			iNdEx = postIndex
+		case 100:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetPriority", wireType)
 			}
-			var stringLen uint64
+			m.NetPriority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -19420,24 +23813,14 @@ func (m *Capability) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.NetPriority |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AddCapabilities = append(m.AddCapabilities, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropCapabilities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19462,7 +23845,7 @@ func (m *Capability) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DropCapabilities = append(m.DropCapabilities, string(dAtA[iNdEx:postIndex]))
+			m.QuotaId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19485,7 +23868,7 @@ func (m *Capability) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LinuxContainerSecurityContext) Unmarshal(dAtA []byte) error {
+func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19508,17 +23891,17 @@ func (m *LinuxContainerSecurityContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinuxContainerSecurityContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateContainerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinuxContainerSecurityContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -19528,30 +23911,26 @@ func (m *LinuxContainerSecurityContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Capabilities == nil {
-				m.Capabilities = &Capability{}
-			}
-			if err := m.Capabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Privileged", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -19561,15 +23940,28 @@ func (m *LinuxContainerSecurityContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Privileged = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &ContainerConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SandboxConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19593,18 +23985,68 @@ func (m *LinuxContainerSecurityContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NamespaceOptions == nil {
-				m.NamespaceOptions = &NamespaceOption{}
+			if m.SandboxConfig == nil {
+				m.SandboxConfig = &PodSandboxConfig{}
 			}
-			if err := m.NamespaceOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SandboxConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateContainerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateContainerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelinuxOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -19614,61 +24056,74 @@ func (m *LinuxContainerSecurityContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SelinuxOptions == nil {
-				m.SelinuxOptions = &SELinuxOption{}
-			}
-			if err := m.SelinuxOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunAsUser", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RunAsUser == nil {
-				m.RunAsUser = &Int64Value{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-			if err := m.RunAsUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunAsUsername", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19693,93 +24148,111 @@ func (m *LinuxContainerSecurityContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunAsUsername = string(dAtA[iNdEx:postIndex])
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadonlyRootfs", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.ReadonlyRootfs = bool(v != 0)
-		case 8:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (int64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.SupplementalGroups = append(m.SupplementalGroups, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthApi
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (int64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.SupplementalGroups = append(m.SupplementalGroups, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplementalGroups", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartContainerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartContainerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-		case 9:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApparmorProfile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19804,13 +24277,13 @@ func (m *LinuxContainerSecurityContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApparmorProfile = string(dAtA[iNdEx:postIndex])
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeccompProfilePath", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var stringLen uint64
+			m.Timeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -19820,77 +24293,114 @@ func (m *LinuxContainerSecurityContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Timeout |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SeccompProfilePath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoNewPrivs", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopContainerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.NoNewPrivs = bool(v != 0)
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunAsGroup", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopContainerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RunAsGroup == nil {
-				m.RunAsGroup = &Int64Value{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-			if err := m.RunAsGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 13:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaskedPaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19911,17 +24421,117 @@ func (m *LinuxContainerSecurityContext) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveContainerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveContainerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerStateValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaskedPaths = append(m.MaskedPaths, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadonlyPaths", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerStateValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerStateValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -19931,21 +24541,11 @@ func (m *LinuxContainerSecurityContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.State |= (ContainerState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReadonlyPaths = append(m.ReadonlyPaths, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -19967,7 +24567,7 @@ func (m *LinuxContainerSecurityContext) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LinuxContainerConfig) Unmarshal(dAtA []byte) error {
+func (m *ContainerFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19990,17 +24590,17 @@ func (m *LinuxContainerConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinuxContainerConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinuxContainerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -20010,28 +24610,24 @@ func (m *LinuxContainerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resources == nil {
-				m.Resources = &LinuxContainerResources{}
-			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20055,66 +24651,16 @@ func (m *LinuxContainerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &LinuxContainerSecurityContext{}
+			if m.State == nil {
+				m.State = &ContainerStateValue{}
 			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WindowsContainerSecurityContext) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WindowsContainerSecurityContext: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WindowsContainerSecurityContext: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunAsUsername", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20139,7 +24685,125 @@ func (m *WindowsContainerSecurityContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunAsUsername = string(dAtA[iNdEx:postIndex])
+			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LabelSelector == nil {
+				m.LabelSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.LabelSelector[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20162,7 +24826,7 @@ func (m *WindowsContainerSecurityContext) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WindowsContainerConfig) Unmarshal(dAtA []byte) error {
+func (m *ListContainersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20185,48 +24849,15 @@ func (m *WindowsContainerConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WindowsContainerConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListContainersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WindowsContainerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListContainersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Resources == nil {
-				m.Resources = &WindowsContainerResources{}
-			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20250,10 +24881,10 @@ func (m *WindowsContainerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &WindowsContainerSecurityContext{}
+			if m.Filter == nil {
+				m.Filter = &ContainerFilter{}
 			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20278,7 +24909,7 @@ func (m *WindowsContainerConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WindowsContainerResources) Unmarshal(dAtA []byte) error {
+func (m *Container) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20301,36 +24932,17 @@ func (m *WindowsContainerResources) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WindowsContainerResources: wiretype end group for non-group")
+			return fmt.Errorf("proto: Container: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WindowsContainerResources: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Container: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuShares", wireType)
-			}
-			m.CpuShares = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CpuShares |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.CpuCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -20340,35 +24952,26 @@ func (m *WindowsContainerResources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CpuCount |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuMaximum", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.CpuMaximum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CpuMaximum |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimitInBytes", wireType)
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
 			}
-			m.MemoryLimitInBytes = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -20378,66 +24981,26 @@ func (m *WindowsContainerResources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemoryLimitInBytes |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContainerMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -20447,26 +25010,30 @@ func (m *ContainerMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = &ContainerMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			m.Attempt = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -20476,64 +25043,28 @@ func (m *ContainerMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Device) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Device: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Device: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Image == nil {
+				m.Image = &ImageSpec{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageRef", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20558,13 +25089,51 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerPath = string(dAtA[iNdEx:postIndex])
+			m.ImageRef = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (ContainerState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -20574,26 +25143,115 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostPath = string(dAtA[iNdEx:postIndex])
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -20603,20 +25261,109 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Permissions = string(dAtA[iNdEx:postIndex])
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20639,7 +25386,7 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerConfig) Unmarshal(dAtA []byte) error {
+func (m *ListContainersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20662,15 +25409,15 @@ func (m *ContainerConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListContainersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListContainersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20694,107 +25441,64 @@ func (m *ContainerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &ContainerMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Containers = append(m.Containers, &Container{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Image == nil {
-				m.Image = &ImageSpec{}
-			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkingDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20819,106 +25523,13 @@ func (m *ContainerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkingDir = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Envs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Envs = append(m.Envs, &KeyValue{})
-			if err := m.Envs[len(m.Envs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mounts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Mounts = append(m.Mounts, &Mount{})
-			if err := m.Mounts[len(m.Mounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Devices = append(m.Devices, &Device{})
-			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verbose", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -20928,115 +25539,67 @@ func (m *ContainerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.Verbose = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -21046,115 +25609,26 @@ func (m *ContainerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Annotations[mapkey] = mapvalue
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -21164,26 +25638,30 @@ func (m *ContainerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogPath = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = &ContainerMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var v int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -21193,17 +25671,16 @@ func (m *ContainerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.State |= (ContainerState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Stdin = bool(v != 0)
-		case 13:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StdinOnce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var v int
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -21213,17 +25690,16 @@ func (m *ContainerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StdinOnce = bool(v != 0)
-		case 14:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var v int
+			m.StartedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -21233,17 +25709,16 @@ func (m *ContainerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.StartedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tty = bool(v != 0)
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Linux", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var msglen int
+			m.FinishedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -21253,28 +25728,33 @@ func (m *ContainerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.FinishedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Linux == nil {
-				m.Linux = &LinuxContainerConfig{}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
 			}
-			if err := m.Linux.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 16:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21298,18 +25778,18 @@ func (m *ContainerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Windows == nil {
-				m.Windows = &WindowsContainerConfig{}
+			if m.Image == nil {
+				m.Image = &ImageSpec{}
 			}
-			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 100:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetPriority", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageRef", wireType)
 			}
-			m.NetPriority = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -21319,14 +25799,24 @@ func (m *ContainerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NetPriority |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 101:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuotaId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21351,61 +25841,11 @@ func (m *ContainerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuotaId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateContainerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21430,11 +25870,11 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21451,23 +25891,108 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Config == nil {
-				m.Config = &ContainerConfig{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SandboxConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21491,147 +26016,103 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SandboxConfig == nil {
-				m.SandboxConfig = &PodSandboxConfig{}
-			}
-			if err := m.SandboxConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateContainerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateContainerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StartContainerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartContainerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -21641,124 +26122,26 @@ func (m *StartContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StartContainerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartContainerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
+			m.Mounts = append(m.Mounts, &Mount{})
+			if err := m.Mounts[len(m.Mounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StopContainerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StopContainerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21783,13 +26166,13 @@ func (m *StopContainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.LogPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
 			}
-			m.Timeout = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -21799,114 +26182,151 @@ func (m *StopContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StopContainerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			if m.Volumes == nil {
+				m.Volumes = make(map[string]*Volume)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue *Volume
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Volume{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Volumes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StopContainerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveContainerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Resources == nil {
+				m.Resources = &LinuxContainerResources{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveContainerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21931,58 +26351,39 @@ func (m *RemoveContainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.QuotaId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envs", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveContainerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			if msglen < 0 {
+				return ErrInvalidLengthApi
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Envs = append(m.Envs, &KeyValue{})
+			if err := m.Envs[len(m.Envs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveContainerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -22004,7 +26405,7 @@ func (m *RemoveContainerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerStateValue) Unmarshal(dAtA []byte) error {
+func (m *Volume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22027,31 +26428,12 @@ func (m *ContainerStateValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStateValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: Volume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStateValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Volume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= (ContainerState(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -22073,7 +26455,7 @@ func (m *ContainerStateValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerFilter) Unmarshal(dAtA []byte) error {
+func (m *ContainerStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22096,44 +26478,15 @@ func (m *ContainerFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22157,45 +26510,16 @@ func (m *ContainerFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &ContainerStateValue{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
+			if m.Status == nil {
+				m.Status = &ContainerStatus{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22219,8 +26543,8 @@ func (m *ContainerFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LabelSelector == nil {
-				m.LabelSelector = make(map[string]string)
+			if m.Info == nil {
+				m.Info = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -22309,90 +26633,7 @@ func (m *ContainerFilter) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.LabelSelector[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListContainersRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListContainersRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListContainersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Filter == nil {
-				m.Filter = &ContainerFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Info[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22415,7 +26656,7 @@ func (m *ListContainersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Container) Unmarshal(dAtA []byte) error {
+func (m *UpdateContainerResourcesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22438,139 +26679,15 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Container: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateContainerResourcesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Container: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateContainerResourcesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &ContainerMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Image == nil {
-				m.Image = &ImageSpec{}
-			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22595,32 +26712,13 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageRef = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= (ContainerState(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			m.CreatedAt = 0
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Linux", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -22630,14 +26728,28 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Linux == nil {
+				m.Linux = &LinuxContainerResources{}
+			}
+			if err := m.Linux.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecAnnotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22661,8 +26773,8 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.SpecAnnotations == nil {
+				m.SpecAnnotations = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -22751,13 +26863,271 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.SpecAnnotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateContainerResourcesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateContainerResourcesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateContainerResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecSyncRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecSyncRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecSyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecSyncResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecSyncResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecSyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdout = append(m.Stdout[:0], dAtA[iNdEx:postIndex]...)
+			if m.Stdout == nil {
+				m.Stdout = []byte{}
+			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -22767,110 +27137,42 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			m.Stderr = append(m.Stderr[:0], dAtA[iNdEx:postIndex]...)
+			if m.Stderr == nil {
+				m.Stderr = []byte{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -22892,7 +27194,7 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListContainersResponse) Unmarshal(dAtA []byte) error {
+func (m *ExecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22915,17 +27217,115 @@ func (m *ListContainersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListContainersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListContainersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tty = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+			m.Stdin = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -22935,23 +27335,32 @@ func (m *ListContainersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Stdout = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
 			}
-			m.Containers = append(m.Containers, &Container{})
-			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Stderr = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -22973,7 +27382,7 @@ func (m *ListContainersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *ExecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22996,15 +27405,15 @@ func (m *ContainerStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23029,28 +27438,8 @@ func (m *ContainerStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verbose", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Verbose = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -23072,7 +27461,7 @@ func (m *ContainerStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
+func (m *AttachRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23095,15 +27484,15 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttachRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttachRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23128,13 +27517,13 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -23144,30 +27533,17 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &ContainerMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Stdin = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tty", wireType)
 			}
-			m.State = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -23177,16 +27553,17 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (ContainerState(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Tty = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
 			}
-			m.CreatedAt = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -23196,16 +27573,17 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= (int64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Stdout = bool(v != 0)
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
 			}
-			m.StartedAt = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -23215,54 +27593,67 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartedAt |= (int64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			m.Stderr = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.FinishedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FinishedAt |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ExitCode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExitCode |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -23272,28 +27663,74 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &ImageSpec{}
-			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortForwardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortForwardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortForwardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23318,11 +27755,123 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageRef = string(dAtA[iNdEx:postIndex])
+			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Port = append(m.Port, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Port = append(m.Port, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortForwardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortForwardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortForwardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23347,13 +27896,63 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -23363,24 +27962,78 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Image == nil {
+				m.Image = &ImageSpec{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListImagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListImagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListImagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23404,103 +28057,68 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.Filter == nil {
+				m.Filter = &ImageFilter{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Image) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Image: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Image: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -23510,113 +28128,101 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoTags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoTags = append(m.RepoTags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoDigests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoDigests = append(m.RepoDigests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23640,14 +28246,16 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mounts = append(m.Mounts, &Mount{})
-			if err := m.Mounts[len(m.Mounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Uid == nil {
+				m.Uid = &Int64Value{}
+			}
+			if err := m.Uid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23672,7 +28280,7 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogPath = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
@@ -23797,71 +28405,59 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.Volumes[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 101:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resources == nil {
-				m.Resources = &LinuxContainerResources{}
-			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 102:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuotaId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListImagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuotaId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 103:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListImagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListImagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Envs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23885,8 +28481,8 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Envs = append(m.Envs, &KeyValue{})
-			if err := m.Envs[len(m.Envs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Images = append(m.Images, &Image{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23911,7 +28507,7 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Volume) Unmarshal(dAtA []byte) error {
+func (m *ImageStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23930,16 +28526,69 @@ func (m *Volume) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Volume: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Volume: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Image == nil {
+				m.Image = &ImageSpec{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verbose", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verbose = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -23961,7 +28610,7 @@ func (m *Volume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23984,15 +28633,15 @@ func (m *ContainerStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24016,10 +28665,10 @@ func (m *ContainerStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &ContainerStatus{}
+			if m.Image == nil {
+				m.Image = &Image{}
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -24162,7 +28811,7 @@ func (m *ContainerStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateContainerResourcesRequest) Unmarshal(dAtA []byte) error {
+func (m *AuthConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24185,15 +28834,160 @@ func (m *UpdateContainerResourcesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateContainerResourcesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateContainerResourcesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auth = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24218,11 +29012,94 @@ func (m *UpdateContainerResourcesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.RegistryToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullImageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullImageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullImageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Image == nil {
+				m.Image = &ImageSpec{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Linux", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24246,16 +29123,16 @@ func (m *UpdateContainerResourcesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Linux == nil {
-				m.Linux = &LinuxContainerResources{}
+			if m.Auth == nil {
+				m.Auth = &AuthConfig{}
 			}
-			if err := m.Linux.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 100:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecAnnotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SandboxConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24279,97 +29156,12 @@ func (m *UpdateContainerResourcesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SpecAnnotations == nil {
-				m.SpecAnnotations = make(map[string]string)
+			if m.SandboxConfig == nil {
+				m.SandboxConfig = &PodSandboxConfig{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.SandboxConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SpecAnnotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24392,7 +29184,7 @@ func (m *UpdateContainerResourcesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateContainerResourcesResponse) Unmarshal(dAtA []byte) error {
+func (m *PullImageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24415,12 +29207,41 @@ func (m *UpdateContainerResourcesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateContainerResourcesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullImageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateContainerResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullImageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -24442,7 +29263,7 @@ func (m *UpdateContainerResourcesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecSyncRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveImageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24465,17 +29286,17 @@ func (m *ExecSyncRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecSyncRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveImageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecSyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveImageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -24485,69 +29306,75 @@ func (m *ExecSyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+			if m.Image == nil {
+				m.Image = &ImageSpec{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveImageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-			m.Timeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timeout |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveImageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveImageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -24569,7 +29396,7 @@ func (m *ExecSyncRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecSyncResponse) Unmarshal(dAtA []byte) error {
+func (m *NetworkConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24592,48 +29419,17 @@ func (m *ExecSyncResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecSyncResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecSyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stdout = append(m.Stdout[:0], dAtA[iNdEx:postIndex]...)
-			if m.Stdout == nil {
-				m.Stdout = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodCidr", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -24643,42 +29439,21 @@ func (m *ExecSyncResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stderr = append(m.Stderr[:0], dAtA[iNdEx:postIndex]...)
-			if m.Stderr == nil {
-				m.Stderr = []byte{}
-			}
+			m.PodCidr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
-			}
-			m.ExitCode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExitCode |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -24700,7 +29475,7 @@ func (m *ExecSyncResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecRequest) Unmarshal(dAtA []byte) error {
+func (m *RuntimeConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24723,17 +29498,17 @@ func (m *ExecRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RuntimeConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RuntimeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -24743,130 +29518,25 @@ func (m *ExecRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
+			if m.NetworkConfig == nil {
+				m.NetworkConfig = &NetworkConfig{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.NetworkConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tty", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Tty = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Stdin = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Stdout = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Stderr = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -24888,7 +29558,7 @@ func (m *ExecRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateRuntimeConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24911,17 +29581,17 @@ func (m *ExecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateRuntimeConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateRuntimeConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -24931,21 +29601,75 @@ func (m *ExecResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RuntimeConfig == nil {
+				m.RuntimeConfig = &RuntimeConfig{}
+			}
+			if err := m.RuntimeConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRuntimeConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRuntimeConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRuntimeConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -24967,7 +29691,7 @@ func (m *ExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttachRequest) Unmarshal(dAtA []byte) error {
+func (m *RuntimeCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24990,15 +29714,15 @@ func (m *AttachRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttachRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RuntimeCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttachRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RuntimeCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25023,11 +29747,11 @@ func (m *AttachRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -25044,12 +29768,12 @@ func (m *AttachRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Stdin = bool(v != 0)
+			m.Status = bool(v != 0)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tty", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25059,17 +29783,26 @@ func (m *AttachRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tty = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25079,17 +29812,76 @@ func (m *AttachRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Stdout = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuntimeStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuntimeStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuntimeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25099,12 +29891,23 @@ func (m *AttachRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Stderr = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, &RuntimeCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -25126,7 +29929,7 @@ func (m *AttachRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttachResponse) Unmarshal(dAtA []byte) error {
+func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25149,17 +29952,17 @@ func (m *AttachResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttachResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttachResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verbose", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25169,21 +29972,12 @@ func (m *AttachResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Verbose = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -25205,7 +29999,7 @@ func (m *AttachResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PortForwardRequest) Unmarshal(dAtA []byte) error {
+func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25228,17 +30022,17 @@ func (m *PortForwardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PortForwardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PortForwardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25248,24 +30042,59 @@ func (m *PortForwardRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
+			if m.Status == nil {
+				m.Status = &RuntimeStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v int32
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowApi
@@ -25275,37 +30104,40 @@ func (m *PortForwardRequest) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= (int32(b) & 0x7F) << shift
+					wire |= (uint64(b) & 0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				m.Port = append(m.Port, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
 					}
-					if iNdEx >= l {
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthApi
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v int32
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowApi
@@ -25315,16 +30147,38 @@ func (m *PortForwardRequest) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= (int32(b) & 0x7F) << shift
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.Port = append(m.Port, v)
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
+			m.Info[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -25346,7 +30200,7 @@ func (m *PortForwardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PortForwardResponse) Unmarshal(dAtA []byte) error {
+func (m *ImageFsInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25369,41 +30223,12 @@ func (m *PortForwardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PortForwardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageFsInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PortForwardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageFsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -25425,7 +30250,7 @@ func (m *PortForwardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageFilter) Unmarshal(dAtA []byte) error {
+func (m *UInt64Value) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25448,17 +30273,17 @@ func (m *ImageFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: UInt64Value: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UInt64Value: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25468,25 +30293,11 @@ func (m *ImageFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Value |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Image == nil {
-				m.Image = &ImageSpec{}
-			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -25508,7 +30319,7 @@ func (m *ImageFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListImagesRequest) Unmarshal(dAtA []byte) error {
+func (m *FilesystemIdentifier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25531,17 +30342,17 @@ func (m *ListImagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListImagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FilesystemIdentifier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListImagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FilesystemIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mountpoint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25551,24 +30362,20 @@ func (m *ListImagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &ImageFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Mountpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25591,7 +30398,7 @@ func (m *ListImagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Image) Unmarshal(dAtA []byte) error {
+func (m *FilesystemUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25614,17 +30421,17 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Image: wiretype end group for non-group")
+			return fmt.Errorf("proto: FilesystemUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Image: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FilesystemUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25634,26 +30441,16 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Timestamp |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoTags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FsId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25663,26 +30460,30 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoTags = append(m.RepoTags, string(dAtA[iNdEx:postIndex]))
+			if m.FsId == nil {
+				m.FsId = &FilesystemIdentifier{}
+			}
+			if err := m.FsId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoDigests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedBytes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25692,26 +30493,30 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoDigests = append(m.RepoDigests, string(dAtA[iNdEx:postIndex]))
+			if m.UsedBytes == nil {
+				m.UsedBytes = &UInt64Value{}
+			}
+			if err := m.UsedBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InodesUsed", wireType)
 			}
-			m.Size_ = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25721,14 +30526,78 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InodesUsed == nil {
+				m.InodesUsed = &UInt64Value{}
+			}
+			if err := m.InodesUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageFsInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageFsInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageFsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageFilesystems", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25752,47 +30621,66 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Uid == nil {
-				m.Uid = &Int64Value{}
-			}
-			if err := m.Uid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ImageFilesystems = append(m.ImageFilesystems, &FilesystemUsage{})
+			if err := m.ImageFilesystems[len(m.ImageFilesystems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 100:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -25802,114 +30690,20 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Volumes == nil {
-				m.Volumes = make(map[string]*Volume)
-			}
-			var mapkey string
-			var mapvalue *Volume
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthApi
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
-						return ErrInvalidLengthApi
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Volume{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Volumes[mapkey] = mapvalue
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25932,7 +30726,7 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListImagesResponse) Unmarshal(dAtA []byte) error {
+func (m *ContainerStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25955,15 +30749,15 @@ func (m *ListImagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListImagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListImagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25987,8 +30781,10 @@ func (m *ListImagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, &Image{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Stats == nil {
+				m.Stats = &ContainerStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26013,7 +30809,7 @@ func (m *ListImagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *ListContainerStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26036,15 +30832,15 @@ func (m *ImageStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListContainerStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListContainerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26068,33 +30864,13 @@ func (m *ImageStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &ImageSpec{}
-			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verbose", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Filter == nil {
+				m.Filter = &ContainerStatsFilter{}
 			}
-			m.Verbose = bool(v != 0)
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -26116,7 +30892,7 @@ func (m *ImageStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *ContainerStatsFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26139,17 +30915,17 @@ func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerStatsFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerStatsFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -26159,28 +30935,53 @@ func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &Image{}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
 			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26204,8 +31005,8 @@ func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = make(map[string]string)
+			if m.LabelSelector == nil {
+				m.LabelSelector = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -26286,216 +31087,71 @@ func (m *ImageStatusResponse) Unmarshal(dAtA []byte) error {
 						return err
 					}
 					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Info[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AuthConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AuthConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Username = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Password = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Auth = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentityToken", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.LabelSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdentityToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListContainerStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListContainerStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListContainerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistryToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -26505,20 +31161,22 @@ func (m *AuthConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegistryToken = string(dAtA[iNdEx:postIndex])
+			m.Stats = append(m.Stats, &ContainerStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26541,7 +31199,7 @@ func (m *AuthConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullImageRequest) Unmarshal(dAtA []byte) error {
+func (m *ContainerAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26564,17 +31222,17 @@ func (m *PullImageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullImageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullImageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -26584,28 +31242,24 @@ func (m *PullImageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &ImageSpec{}
-			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26629,16 +31283,16 @@ func (m *PullImageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &AuthConfig{}
+			if m.Metadata == nil {
+				m.Metadata = &ContainerMetadata{}
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SandboxConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26662,68 +31316,103 @@ func (m *PullImageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SandboxConfig == nil {
-				m.SandboxConfig = &PodSandboxConfig{}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			if err := m.SandboxConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PullImageResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PullImageResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullImageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -26733,20 +31422,109 @@ func (m *PullImageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageRef = string(dAtA[iNdEx:postIndex])
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26769,7 +31547,7 @@ func (m *PullImageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveImageRequest) Unmarshal(dAtA []byte) error {
+func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26792,15 +31570,15 @@ func (m *RemoveImageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveImageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveImageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26824,118 +31602,18 @@ func (m *RemoveImageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &ImageSpec{}
+			if m.Attributes == nil {
+				m.Attributes = &ContainerAttributes{}
 			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Attributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveImageResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveImageResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveImageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NetworkConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodCidr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -26945,74 +31623,61 @@ func (m *NetworkConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodCidr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
+			if m.Cpu == nil {
+				m.Cpu = &CpuUsage{}
+			}
+			if err := m.Cpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RuntimeConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Memory == nil {
+				m.Memory = &MemoryUsage{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Memory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RuntimeConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RuntimeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WritableLayer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27036,10 +31701,10 @@ func (m *RuntimeConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NetworkConfig == nil {
-				m.NetworkConfig = &NetworkConfig{}
+			if m.WritableLayer == nil {
+				m.WritableLayer = &FilesystemUsage{}
 			}
-			if err := m.NetworkConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WritableLayer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27064,7 +31729,7 @@ func (m *RuntimeConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRuntimeConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *CpuUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27087,15 +31752,34 @@ func (m *UpdateRuntimeConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRuntimeConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CpuUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRuntimeConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CpuUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageCoreNanoSeconds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27119,10 +31803,10 @@ func (m *UpdateRuntimeConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RuntimeConfig == nil {
-				m.RuntimeConfig = &RuntimeConfig{}
+			if m.UsageCoreNanoSeconds == nil {
+				m.UsageCoreNanoSeconds = &UInt64Value{}
 			}
-			if err := m.RuntimeConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UsageCoreNanoSeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27147,57 +31831,7 @@ func (m *UpdateRuntimeConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRuntimeConfigResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRuntimeConfigResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRuntimeConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RuntimeCondition) Unmarshal(dAtA []byte) error {
+func (m *MemoryUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27220,46 +31854,17 @@ func (m *RuntimeCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RuntimeCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemoryUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RuntimeCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemoryUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var v int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -27269,17 +31874,16 @@ func (m *RuntimeCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Timestamp |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkingSetBytes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -27289,49 +31893,24 @@ func (m *RuntimeCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
+			if m.WorkingSetBytes == nil {
+				m.WorkingSetBytes = &UInt64Value{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.WorkingSetBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27354,7 +31933,7 @@ func (m *RuntimeCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RuntimeStatus) Unmarshal(dAtA []byte) error {
+func (m *PodSandboxStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27377,17 +31956,17 @@ func (m *RuntimeStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RuntimeStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodSandboxStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RuntimeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodSandboxStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -27397,22 +31976,20 @@ func (m *RuntimeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, &RuntimeCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27435,7 +32012,7 @@ func (m *RuntimeStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatusRequest) Unmarshal(dAtA []byte) error {
+func (m *PodSandboxStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27458,17 +32035,17 @@ func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodSandboxStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodSandboxStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verbose", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -27478,12 +32055,25 @@ func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Verbose = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &PodSandboxStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -27505,7 +32095,7 @@ func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatusResponse) Unmarshal(dAtA []byte) error {
+func (m *PodSandboxStatsFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27528,17 +32118,17 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodSandboxStatsFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodSandboxStatsFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -27548,28 +32138,24 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &RuntimeStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27593,8 +32179,8 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = make(map[string]string)
+			if m.LabelSelector == nil {
+				m.LabelSelector = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -27683,7 +32269,7 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Info[mapkey] = mapvalue
+			m.LabelSelector[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27706,57 +32292,7 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageFsInfoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ImageFsInfoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageFsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UInt64Value) Unmarshal(dAtA []byte) error {
+func (m *ListPodSandboxStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27779,17 +32315,17 @@ func (m *UInt64Value) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UInt64Value: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPodSandboxStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UInt64Value: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPodSandboxStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			m.Value = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -27799,11 +32335,25 @@ func (m *UInt64Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &PodSandboxStatsFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -27825,7 +32375,7 @@ func (m *UInt64Value) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FilesystemIdentifier) Unmarshal(dAtA []byte) error {
+func (m *ListPodSandboxStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27848,17 +32398,17 @@ func (m *FilesystemIdentifier) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FilesystemIdentifier: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPodSandboxStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FilesystemIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPodSandboxStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mountpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -27868,20 +32418,22 @@ func (m *FilesystemIdentifier) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mountpoint = string(dAtA[iNdEx:postIndex])
+			m.Stats = append(m.Stats, &PodSandboxStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27904,7 +32456,7 @@ func (m *FilesystemIdentifier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FilesystemUsage) Unmarshal(dAtA []byte) error {
+func (m *PodSandboxAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27927,17 +32479,17 @@ func (m *FilesystemUsage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FilesystemUsage: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodSandboxAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FilesystemUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodSandboxAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -27947,14 +32499,24 @@ func (m *FilesystemUsage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27978,16 +32540,16 @@ func (m *FilesystemUsage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FsId == nil {
-				m.FsId = &FilesystemIdentifier{}
+			if m.Metadata == nil {
+				m.Metadata = &PodSandboxMetadata{}
 			}
-			if err := m.FsId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsedBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28004,23 +32566,108 @@ func (m *FilesystemUsage) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UsedBytes == nil {
-				m.UsedBytes = &UInt64Value{}
-			}
-			if err := m.UsedBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InodesUsed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28044,12 +32691,97 @@ func (m *FilesystemUsage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InodesUsed == nil {
-				m.InodesUsed = &UInt64Value{}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-			if err := m.InodesUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28072,7 +32804,7 @@ func (m *FilesystemUsage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageFsInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *PodSandboxStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28095,15 +32827,15 @@ func (m *ImageFsInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageFsInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodSandboxStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageFsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodSandboxStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageFilesystems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28127,66 +32859,18 @@ func (m *ImageFsInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageFilesystems = append(m.ImageFilesystems, &FilesystemUsage{})
-			if err := m.ImageFilesystems[len(m.ImageFilesystems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Attributes == nil {
+				m.Attributes = &PodSandboxAttributes{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Attributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContainerStatsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStatsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Linux", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -28196,74 +32880,28 @@ func (m *ContainerStatsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContainerStatsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Linux == nil {
+				m.Linux = &LinuxPodSandboxStats{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Linux.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStatsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28287,10 +32925,10 @@ func (m *ContainerStatsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ContainerStats{}
+			if m.Windows == nil {
+				m.Windows = &WindowsPodSandboxStats{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28315,7 +32953,7 @@ func (m *ContainerStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListContainerStatsRequest) Unmarshal(dAtA []byte) error {
+func (m *LinuxPodSandboxStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28338,15 +32976,15 @@ func (m *ListContainerStatsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListContainerStatsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinuxPodSandboxStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListContainerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinuxPodSandboxStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28370,68 +33008,51 @@ func (m *ListContainerStatsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &ContainerStatsFilter{}
+			if m.Cpu == nil {
+				m.Cpu = &CpuUsage{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContainerStatsFilter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Memory == nil {
+				m.Memory = &MemoryUsage{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Memory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStatsFilter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStatsFilter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -28441,26 +33062,30 @@ func (m *ContainerStatsFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.Network == nil {
+				m.Network = &NetworkUsage{}
+			}
+			if err := m.Network.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -28470,24 +33095,28 @@ func (m *ContainerStatsFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
+			if m.Process == nil {
+				m.Process = &ProcessUsage{}
+			}
+			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28511,97 +33140,10 @@ func (m *ContainerStatsFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LabelSelector == nil {
-				m.LabelSelector = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Containers = append(m.Containers, &ContainerStats{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.LabelSelector[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28624,66 +33166,35 @@ func (m *ContainerStatsFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListContainerStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *WindowsPodSandboxStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListContainerStatsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListContainerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
+			if shift >= 64 {
+				return ErrIntOverflowApi
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stats = append(m.Stats, &ContainerStats{})
-			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindowsPodSandboxStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindowsPodSandboxStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -28705,7 +33216,7 @@ func (m *ListContainerStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerAttributes) Unmarshal(dAtA []byte) error {
+func (m *NetworkUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28728,17 +33239,17 @@ func (m *ContainerAttributes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerAttributes: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -28748,24 +33259,14 @@ func (m *ContainerAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Timestamp |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultInterface", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28789,134 +33290,16 @@ func (m *ContainerAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &ContainerMetadata{}
+			if m.DefaultInterface == nil {
+				m.DefaultInterface = &NetworkInterfaceUsage{}
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DefaultInterface.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interfaces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28940,97 +33323,10 @@ func (m *ContainerAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Annotations[mapkey] = mapvalue
+			m.Interfaces = append(m.Interfaces, &NetworkInterfaceUsage{})
+			if err := m.Interfaces[len(m.Interfaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29053,7 +33349,7 @@ func (m *ContainerAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerStats) Unmarshal(dAtA []byte) error {
+func (m *NetworkInterfaceUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29076,15 +33372,44 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkInterfaceUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkInterfaceUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxBytes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29108,16 +33433,16 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attributes == nil {
-				m.Attributes = &ContainerAttributes{}
+			if m.RxBytes == nil {
+				m.RxBytes = &UInt64Value{}
 			}
-			if err := m.Attributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RxBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RxErrors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29141,16 +33466,16 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cpu == nil {
-				m.Cpu = &CpuUsage{}
+			if m.RxErrors == nil {
+				m.RxErrors = &UInt64Value{}
 			}
-			if err := m.Cpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RxErrors.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29174,16 +33499,16 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Memory == nil {
-				m.Memory = &MemoryUsage{}
+			if m.TxBytes == nil {
+				m.TxBytes = &UInt64Value{}
 			}
-			if err := m.Memory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TxBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WritableLayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxErrors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29207,10 +33532,10 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WritableLayer == nil {
-				m.WritableLayer = &FilesystemUsage{}
+			if m.TxErrors == nil {
+				m.TxErrors = &UInt64Value{}
 			}
-			if err := m.WritableLayer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TxErrors.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29235,7 +33560,7 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CpuUsage) Unmarshal(dAtA []byte) error {
+func (m *ProcessUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29258,10 +33583,10 @@ func (m *CpuUsage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CpuUsage: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CpuUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -29285,7 +33610,7 @@ func (m *CpuUsage) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsageCoreNanoSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessCount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29309,10 +33634,10 @@ func (m *CpuUsage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsageCoreNanoSeconds == nil {
-				m.UsageCoreNanoSeconds = &UInt64Value{}
+			if m.ProcessCount == nil {
+				m.ProcessCount = &UInt64Value{}
 			}
-			if err := m.UsageCoreNanoSeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProcessCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29337,7 +33662,7 @@ func (m *CpuUsage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemoryUsage) Unmarshal(dAtA []byte) error {
+func (m *ReopenContainerLogRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29360,17 +33685,17 @@ func (m *MemoryUsage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemoryUsage: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReopenContainerLogRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemoryUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReopenContainerLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -29380,16 +33705,126 @@ func (m *MemoryUsage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReopenContainerLogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReopenContainerLogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReopenContainerLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkingSetBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -29399,25 +33834,71 @@ func (m *MemoryUsage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkingSetBytes == nil {
-				m.WorkingSetBytes = &UInt64Value{}
-			}
-			if err := m.WorkingSetBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseContainerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseContainerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -29439,7 +33920,7 @@ func (m *MemoryUsage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReopenContainerLogRequest) Unmarshal(dAtA []byte) error {
+func (m *UnpauseContainerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29462,10 +33943,10 @@ func (m *ReopenContainerLogRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReopenContainerLogRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseContainerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReopenContainerLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -29518,7 +33999,7 @@ func (m *ReopenContainerLogRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReopenContainerLogResponse) Unmarshal(dAtA []byte) error {
+func (m *UnpauseContainerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29541,10 +34022,10 @@ func (m *ReopenContainerLogResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReopenContainerLogResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseContainerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReopenContainerLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -29568,7 +34049,7 @@ func (m *ReopenContainerLogResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveVolumeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29591,15 +34072,15 @@ func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PauseContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveVolumeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PauseContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29624,7 +34105,7 @@ func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.VolumeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29647,7 +34128,7 @@ func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PauseContainerResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveVolumeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29670,10 +34151,10 @@ func (m *PauseContainerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PauseContainerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveVolumeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PauseContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveVolumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -29697,7 +34178,7 @@ func (m *PauseContainerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnpauseContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *StartPodSandboxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29720,15 +34201,15 @@ func (m *UnpauseContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnpauseContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartPodSandboxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnpauseContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartPodSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29753,7 +34234,7 @@ func (m *UnpauseContainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29776,7 +34257,7 @@ func (m *UnpauseContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnpauseContainerResponse) Unmarshal(dAtA []byte) error {
+func (m *StartPodSandboxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29799,10 +34280,10 @@ func (m *UnpauseContainerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnpauseContainerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartPodSandboxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnpauseContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartPodSandboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -29826,7 +34307,7 @@ func (m *UnpauseContainerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveVolumeRequest) Unmarshal(dAtA []byte) error {
+func (m *GetEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29849,41 +34330,12 @@ func (m *RemoveVolumeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveVolumeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VolumeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -29905,7 +34357,7 @@ func (m *RemoveVolumeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveVolumeResponse) Unmarshal(dAtA []byte) error {
+func (m *ContainerEventResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29928,12 +34380,143 @@ func (m *RemoveVolumeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveVolumeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerEventResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveVolumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerEventType", wireType)
+			}
+			m.ContainerEventType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContainerEventType |= (ContainerEventType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PodSandboxStatus == nil {
+				m.PodSandboxStatus = &PodSandboxStatus{}
+			}
+			if err := m.PodSandboxStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainersStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainersStatuses = append(m.ContainersStatuses, &ContainerStatus{})
+			if err := m.ContainersStatuses[len(m.ContainersStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -29955,7 +34538,7 @@ func (m *RemoveVolumeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartPodSandboxRequest) Unmarshal(dAtA []byte) error {
+func (m *CheckpointContainerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29978,15 +34561,15 @@ func (m *StartPodSandboxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartPodSandboxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointContainerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartPodSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSandboxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30011,8 +34594,56 @@ func (m *StartPodSandboxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSandboxId = string(dAtA[iNdEx:postIndex])
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Location = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -30034,7 +34665,7 @@ func (m *StartPodSandboxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartPodSandboxResponse) Unmarshal(dAtA []byte) error {
+func (m *CheckpointContainerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30057,10 +34688,10 @@ func (m *StartPodSandboxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartPodSandboxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointContainerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartPodSandboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -30192,339 +34823,382 @@ var (
 func init() { proto.RegisterFile("api.proto", fileDescriptorApi) }
 
 var fileDescriptorApi = []byte{
-	// 5333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7c, 0x4b, 0x73, 0x1b, 0x57,
-	0x76, 0x30, 0xf1, 0x20, 0x09, 0x1c, 0x10, 0x24, 0x78, 0x49, 0x89, 0x10, 0x64, 0xbd, 0x5a, 0x6f,
-	0xd9, 0xa2, 0x2c, 0x7a, 0x46, 0xb6, 0x1e, 0x23, 0x9b, 0x22, 0x29, 0x09, 0xdf, 0x48, 0x20, 0xbe,
-	0x06, 0x69, 0xd9, 0x63, 0x57, 0xf5, 0x34, 0xd1, 0x97, 0x60, 0x5b, 0x40, 0x77, 0xbb, 0x6f, 0x43,
-	0x14, 0x93, 0xaa, 0x94, 0xab, 0x52, 0x35, 0x8b, 0xac, 0xb2, 0xce, 0x72, 0x66, 0x91, 0x45, 0x36,
-	0xa9, 0x54, 0x65, 0x95, 0x6c, 0x92, 0x9a, 0x4a, 0xcd, 0x66, 0xaa, 0xb2, 0x4a, 0xe5, 0xb1, 0x89,
-	0x9d, 0xac, 0x66, 0x91, 0xca, 0x3f, 0x48, 0xea, 0xbe, 0x1a, 0xfd, 0xc4, 0x83, 0x96, 0xc7, 0xce,
-	0x8a, 0xb8, 0xa7, 0xcf, 0x39, 0xf7, 0xdc, 0x73, 0x4f, 0x9f, 0x7b, 0x1e, 0xb7, 0x09, 0x45, 0xdd,
-	0x31, 0x57, 0x1d, 0xd7, 0xf6, 0x6c, 0x54, 0x71, 0xfb, 0x96, 0x67, 0xf6, 0xf0, 0xea, 0xab, 0xdb,
-	0x7a, 0xd7, 0x39, 0xd0, 0xd7, 0x6a, 0x37, 0x3b, 0xa6, 0x77, 0xd0, 0xdf, 0x5b, 0x6d, 0xdb, 0xbd,
-	0x5b, 0x1d, 0xbb, 0x63, 0xdf, 0x62, 0x88, 0x7b, 0xfd, 0x7d, 0x36, 0x62, 0x03, 0xf6, 0x8b, 0x33,
-	0x50, 0x6e, 0xc0, 0xfc, 0xc7, 0xd8, 0x25, 0xa6, 0x6d, 0xa9, 0xf8, 0xcb, 0x3e, 0x26, 0x1e, 0xaa,
-	0xc2, 0xec, 0x2b, 0x0e, 0xa9, 0x66, 0xce, 0x67, 0xae, 0x15, 0x55, 0x39, 0x54, 0xfe, 0x3c, 0x03,
-	0x0b, 0x3e, 0x32, 0x71, 0x6c, 0x8b, 0xe0, 0x74, 0x6c, 0x74, 0x01, 0xe6, 0x84, 0x70, 0x9a, 0xa5,
-	0xf7, 0x70, 0x35, 0xcb, 0x1e, 0x97, 0x04, 0xac, 0xa1, 0xf7, 0x30, 0xba, 0x0a, 0x0b, 0x12, 0x45,
-	0x32, 0xc9, 0x31, 0xac, 0x79, 0x01, 0x16, 0xb3, 0xa1, 0x55, 0x58, 0x92, 0x88, 0xba, 0x63, 0xfa,
-	0xc8, 0x79, 0x86, 0xbc, 0x28, 0x1e, 0xad, 0x3b, 0xa6, 0xc0, 0x57, 0x3e, 0x83, 0xe2, 0x66, 0xa3,
-	0xb5, 0x61, 0x5b, 0xfb, 0x66, 0x87, 0x8a, 0x48, 0xb0, 0x4b, 0x69, 0xaa, 0x99, 0xf3, 0x39, 0x2a,
-	0xa2, 0x18, 0xa2, 0x1a, 0x14, 0x08, 0xd6, 0xdd, 0xf6, 0x01, 0x26, 0xd5, 0x2c, 0x7b, 0xe4, 0x8f,
-	0x29, 0x95, 0xed, 0x78, 0xa6, 0x6d, 0x91, 0x6a, 0x8e, 0x53, 0x89, 0xa1, 0xf2, 0xcb, 0x0c, 0x94,
-	0x9a, 0xb6, 0xeb, 0x3d, 0xd7, 0x1d, 0xc7, 0xb4, 0x3a, 0xe8, 0x0e, 0x14, 0x98, 0x2e, 0xdb, 0x76,
-	0x97, 0xe9, 0x60, 0x7e, 0xad, 0xb6, 0x1a, 0xdd, 0x96, 0xd5, 0xa6, 0xc0, 0x50, 0x7d, 0x5c, 0x74,
-	0x19, 0xe6, 0xdb, 0xb6, 0xe5, 0xe9, 0xa6, 0x85, 0x5d, 0xcd, 0xb1, 0x5d, 0x8f, 0xa9, 0x68, 0x5a,
-	0x2d, 0xfb, 0x50, 0x3a, 0x0b, 0x3a, 0x0d, 0xc5, 0x03, 0x9b, 0x78, 0x1c, 0x23, 0xc7, 0x30, 0x0a,
-	0x14, 0xc0, 0x1e, 0xae, 0xc0, 0x2c, 0x7b, 0x68, 0x3a, 0x42, 0x19, 0x33, 0x74, 0x58, 0x77, 0x94,
-	0xdf, 0x65, 0x60, 0xfa, 0xb9, 0xdd, 0xb7, 0xbc, 0xc8, 0x34, 0xba, 0x77, 0x20, 0x36, 0x2a, 0x30,
-	0x8d, 0xee, 0x1d, 0x0c, 0xa6, 0xa1, 0x18, 0x7c, 0xaf, 0xf8, 0x34, 0xf4, 0x61, 0x0d, 0x0a, 0x2e,
-	0xd6, 0x0d, 0xdb, 0xea, 0x1e, 0x31, 0x11, 0x0a, 0xaa, 0x3f, 0xa6, 0x9b, 0x48, 0x70, 0xd7, 0xb4,
-	0xfa, 0xaf, 0x35, 0x17, 0x77, 0xf5, 0x3d, 0xdc, 0x65, 0xa2, 0x14, 0xd4, 0x79, 0x01, 0x56, 0x39,
-	0x14, 0x6d, 0x42, 0xc9, 0x71, 0x6d, 0x47, 0xef, 0xe8, 0x54, 0x8f, 0xd5, 0x69, 0xa6, 0x2a, 0x25,
-	0xae, 0x2a, 0x26, 0x76, 0x73, 0x80, 0xa9, 0x06, 0xc9, 0x10, 0x82, 0x3c, 0x33, 0x27, 0x83, 0x89,
-	0xc8, 0x7e, 0x2b, 0x7f, 0x95, 0x81, 0x05, 0x6a, 0x50, 0xc4, 0xd1, 0xdb, 0x78, 0x9b, 0x6d, 0x13,
-	0xba, 0x0b, 0xb3, 0x16, 0xf6, 0x0e, 0x6d, 0xf7, 0xa5, 0xd8, 0x94, 0x73, 0xf1, 0x99, 0x7c, 0x9a,
-	0xe7, 0xb6, 0x81, 0x55, 0x89, 0x8f, 0x6e, 0x43, 0xce, 0x31, 0x0d, 0xa6, 0x84, 0x31, 0xc8, 0x28,
-	0x2e, 0x25, 0x31, 0x9d, 0x36, 0xd3, 0xcd, 0x38, 0x24, 0xa6, 0xd3, 0x56, 0x14, 0x80, 0xba, 0xe5,
-	0xdd, 0xf9, 0xd1, 0xc7, 0x7a, 0xb7, 0x8f, 0xd1, 0x32, 0x4c, 0xbf, 0xa2, 0x3f, 0x98, 0xb0, 0x39,
-	0x95, 0x0f, 0x94, 0xaf, 0x73, 0x70, 0xfa, 0x19, 0xd5, 0x61, 0x4b, 0xb7, 0x8c, 0x3d, 0xfb, 0x75,
-	0x0b, 0xb7, 0xfb, 0xae, 0xe9, 0x1d, 0x6d, 0xd8, 0x96, 0x87, 0x5f, 0x7b, 0xa8, 0x01, 0x8b, 0x96,
-	0xe4, 0xac, 0x49, 0x73, 0xa5, 0x1c, 0x4a, 0x6b, 0x17, 0x86, 0x08, 0xc1, 0x55, 0xa4, 0x56, 0xac,
-	0x30, 0x80, 0xa0, 0xa7, 0x83, 0xbd, 0x94, 0xdc, 0xb2, 0x8c, 0x5b, 0xc2, 0x92, 0x5a, 0x5b, 0x4c,
-	0x32, 0xc1, 0x4b, 0x6e, 0xb6, 0xe4, 0xf4, 0x00, 0xe8, 0x9b, 0xae, 0xe9, 0x44, 0xeb, 0x13, 0xec,
-	0x32, 0xc5, 0x94, 0xd6, 0xde, 0x8a, 0x73, 0x19, 0xa8, 0x40, 0x2d, 0xba, 0x7d, 0x6b, 0x9d, 0xec,
-	0x12, 0xec, 0x32, 0xc7, 0x20, 0xec, 0x4b, 0x73, 0x6d, 0xdb, 0xdb, 0x27, 0xd2, 0xa6, 0x24, 0x58,
-	0x65, 0x50, 0x74, 0x0b, 0x96, 0x48, 0xdf, 0x71, 0xba, 0xb8, 0x87, 0x2d, 0x4f, 0xef, 0x6a, 0x1d,
-	0xd7, 0xee, 0x3b, 0xa4, 0x3a, 0x7d, 0x3e, 0x77, 0x2d, 0xa7, 0xa2, 0xe0, 0xa3, 0x27, 0xec, 0x09,
-	0x3a, 0x0b, 0xe0, 0xb8, 0xe6, 0x2b, 0xb3, 0x8b, 0x3b, 0xd8, 0xa8, 0xce, 0x30, 0xa6, 0x01, 0x08,
-	0x7a, 0x17, 0x96, 0x09, 0x6e, 0xb7, 0xed, 0x9e, 0xa3, 0x39, 0xae, 0xbd, 0x6f, 0x76, 0x31, 0x7f,
-	0x23, 0x66, 0x99, 0xb9, 0x21, 0xf1, 0xac, 0xc9, 0x1f, 0xb1, 0x77, 0xe3, 0x21, 0xf3, 0x73, 0x74,
-	0xa5, 0x6c, 0xf2, 0x6a, 0x61, 0x8c, 0xa5, 0x02, 0x5b, 0x2a, 0x13, 0x49, 0xf9, 0x65, 0x16, 0x4e,
-	0x30, 0x4d, 0x36, 0x6d, 0x43, 0x6c, 0xb3, 0x70, 0x5c, 0x17, 0xa1, 0xdc, 0x66, 0x3c, 0x35, 0x47,
-	0x77, 0xb1, 0xe5, 0x89, 0x17, 0x77, 0x8e, 0x03, 0x9b, 0x0c, 0x86, 0x3e, 0x81, 0x0a, 0x11, 0x56,
-	0xa1, 0xb5, 0xb9, 0x59, 0x88, 0x3d, 0xbb, 0x19, 0x17, 0x61, 0x88, 0x2d, 0xa9, 0x0b, 0x24, 0x66,
-	0x5c, 0xb3, 0xe4, 0x88, 0xb4, 0xbd, 0x2e, 0xf7, 0x80, 0xa5, 0xb5, 0x1f, 0xa5, 0x30, 0x8c, 0x0a,
-	0xbe, 0xda, 0xe2, 0x64, 0x5b, 0x96, 0xe7, 0x1e, 0xa9, 0x92, 0x49, 0xed, 0x1e, 0xcc, 0x05, 0x1f,
-	0xa0, 0x0a, 0xe4, 0x5e, 0xe2, 0x23, 0xb1, 0x28, 0xfa, 0x73, 0xf0, 0x12, 0x70, 0xff, 0xc3, 0x07,
-	0xf7, 0xb2, 0x1f, 0x64, 0x14, 0x17, 0xd0, 0x60, 0x96, 0xe7, 0xd8, 0xd3, 0x0d, 0xdd, 0xd3, 0x7d,
-	0x5f, 0x90, 0x19, 0xf8, 0x02, 0xca, 0xb5, 0x2f, 0x5e, 0xde, 0xa2, 0x4a, 0x7f, 0xa2, 0xb7, 0xa0,
-	0xe8, 0x1b, 0xba, 0x38, 0x5f, 0x06, 0x00, 0xea, 0xe7, 0x75, 0xcf, 0xc3, 0x3d, 0xc7, 0x63, 0x26,
-	0x56, 0x56, 0xe5, 0x50, 0xf9, 0xaf, 0x3c, 0x54, 0x62, 0x7b, 0xf2, 0x11, 0x14, 0x7a, 0x62, 0x7a,
-	0xf1, 0xa2, 0x5d, 0x4a, 0x70, 0xf6, 0x31, 0x51, 0x55, 0x9f, 0x8a, 0xfa, 0x52, 0xea, 0x57, 0x03,
-	0x67, 0xa2, 0x3f, 0xa6, 0x3b, 0xde, 0xb5, 0x3b, 0x9a, 0x61, 0xba, 0xb8, 0xed, 0xd9, 0xee, 0x91,
-	0x10, 0x77, 0xae, 0x6b, 0x77, 0x36, 0x25, 0x0c, 0xdd, 0x03, 0x30, 0x2c, 0x42, 0x37, 0x7b, 0xdf,
-	0xec, 0x30, 0xa1, 0x4b, 0x6b, 0xa7, 0xe3, 0x42, 0xf8, 0x07, 0xa0, 0x5a, 0x34, 0x2c, 0x22, 0xc4,
-	0x7f, 0x04, 0x65, 0x7a, 0x8e, 0x68, 0x3d, 0x7e, 0x76, 0xf1, 0x37, 0xa5, 0xb4, 0x76, 0x26, 0x69,
-	0x0d, 0xfe, 0x09, 0xa7, 0xce, 0x39, 0x83, 0x01, 0x41, 0x8f, 0x61, 0x86, 0x39, 0x74, 0x52, 0x9d,
-	0x61, 0xc4, 0xab, 0xc3, 0x14, 0x20, 0x2c, 0xe2, 0x19, 0x23, 0xe0, 0x06, 0x21, 0xa8, 0xd1, 0x2e,
-	0x94, 0x74, 0xcb, 0xb2, 0x3d, 0x9d, 0x3b, 0x9a, 0x59, 0xc6, 0xec, 0xbd, 0x31, 0x98, 0xad, 0x0f,
-	0xa8, 0x38, 0xc7, 0x20, 0x1f, 0xf4, 0x13, 0x98, 0x66, 0x9e, 0x48, 0xbc, 0x88, 0x57, 0xc7, 0x34,
-	0x5a, 0x95, 0x53, 0xd5, 0xee, 0x42, 0x29, 0x20, 0xec, 0x24, 0x46, 0x5a, 0x7b, 0x08, 0x95, 0xa8,
-	0x68, 0x13, 0x19, 0xf9, 0x1f, 0xc2, 0xb2, 0xda, 0xb7, 0x06, 0x82, 0xc9, 0x88, 0xec, 0x1e, 0xcc,
-	0x88, 0xcd, 0xe6, 0x16, 0xa7, 0x8c, 0xd6, 0x91, 0x2a, 0x28, 0x82, 0x21, 0xd6, 0x81, 0x6e, 0x19,
-	0x5d, 0xec, 0x8a, 0x79, 0x65, 0x88, 0xf5, 0x94, 0x43, 0x95, 0x9f, 0xc0, 0x89, 0xc8, 0xe4, 0x22,
-	0xc2, 0xbb, 0x04, 0xf3, 0x8e, 0x6d, 0x68, 0x84, 0x83, 0x35, 0xd3, 0x90, 0x6e, 0xc8, 0xf1, 0x71,
-	0xeb, 0x06, 0x25, 0x6f, 0x79, 0xb6, 0x13, 0x17, 0x7e, 0x3c, 0xf2, 0x2a, 0x9c, 0x8c, 0x92, 0xf3,
-	0xe9, 0x95, 0x0f, 0x61, 0x45, 0xc5, 0x3d, 0xfb, 0x15, 0x3e, 0x2e, 0xeb, 0x1a, 0x54, 0xe3, 0x0c,
-	0x04, 0xf3, 0x4f, 0x61, 0x65, 0x00, 0x6d, 0x79, 0xba, 0xd7, 0x27, 0x13, 0x31, 0x17, 0xe1, 0xef,
-	0x9e, 0x4d, 0xf8, 0x76, 0x16, 0x54, 0x39, 0x54, 0xae, 0x07, 0x59, 0x37, 0x78, 0x64, 0xc1, 0x67,
-	0x40, 0xf3, 0x90, 0x35, 0x1d, 0xc1, 0x2e, 0x6b, 0x3a, 0xca, 0x53, 0x28, 0xfa, 0x47, 0x33, 0xba,
-	0x3f, 0x88, 0x3b, 0xb3, 0xe3, 0x1e, 0xe4, 0x7e, 0x68, 0xba, 0x13, 0x3b, 0x4a, 0xc4, 0x94, 0xf7,
-	0x01, 0x7c, 0x97, 0x27, 0x23, 0x84, 0xd3, 0x43, 0x18, 0xab, 0x01, 0x74, 0xe5, 0x5f, 0x43, 0x8e,
-	0x30, 0xb0, 0x08, 0xc3, 0x5f, 0x84, 0x11, 0x72, 0x8c, 0xd9, 0x63, 0x39, 0xc6, 0xf7, 0x61, 0x9a,
-	0x78, 0xba, 0x87, 0x45, 0x14, 0x75, 0x61, 0x18, 0x39, 0x15, 0x02, 0xab, 0x1c, 0x1f, 0x9d, 0x01,
-	0x68, 0xbb, 0x58, 0xf7, 0xb0, 0xa1, 0xe9, 0xdc, 0x8b, 0xe7, 0xd4, 0xa2, 0x80, 0xac, 0x7b, 0x68,
-	0x63, 0x10, 0x09, 0x4e, 0x33, 0xc1, 0xae, 0x0f, 0xe3, 0x1c, 0xda, 0xaa, 0x41, 0x4c, 0xe8, 0x7b,
-	0x95, 0x99, 0x31, 0xbd, 0x8a, 0x60, 0xc0, 0xa9, 0x02, 0x3e, 0x73, 0x76, 0xb4, 0xcf, 0xe4, 0xa4,
-	0xe3, 0xf8, 0xcc, 0xc2, 0x68, 0x9f, 0x29, 0x98, 0x0d, 0xf5, 0x99, 0xdf, 0xa7, 0xd3, 0xfb, 0x97,
-	0x0c, 0x54, 0xe3, 0xef, 0xa0, 0xf0, 0x3d, 0xf7, 0x60, 0x86, 0x30, 0xc8, 0x38, 0x9e, 0x4f, 0xd0,
-	0x0a, 0x0a, 0xf4, 0x14, 0xf2, 0xa6, 0xb5, 0x6f, 0xb3, 0xc4, 0x2e, 0x31, 0x76, 0x49, 0x9b, 0x75,
-	0xb5, 0x6e, 0xed, 0xdb, 0x5c, 0x49, 0x8c, 0x43, 0xed, 0x7d, 0x28, 0xfa, 0xa0, 0x89, 0xd6, 0xb6,
-	0x0d, 0xcb, 0x11, 0x93, 0xe5, 0xc1, 0xbe, 0x6f, 0xe9, 0x99, 0xc9, 0x2c, 0x5d, 0xf9, 0x2a, 0x1b,
-	0x7c, 0x13, 0x1f, 0x9b, 0x5d, 0x0f, 0xbb, 0xb1, 0x37, 0xf1, 0x81, 0xe4, 0xce, 0x5f, 0xc3, 0x2b,
-	0x23, 0xb9, 0xf3, 0x98, 0x54, 0xbc, 0x4c, 0x9f, 0xc3, 0x3c, 0xb3, 0x35, 0x8d, 0xe0, 0x2e, 0x0b,
-	0x38, 0x44, 0xf0, 0xf7, 0xe3, 0x61, 0x6c, 0xb8, 0x24, 0xdc, 0x62, 0x5b, 0x82, 0x8e, 0x6b, 0xb0,
-	0xdc, 0x0d, 0xc2, 0x6a, 0x1f, 0x01, 0x8a, 0x23, 0x4d, 0xa4, 0xd3, 0x16, 0x75, 0x71, 0x34, 0xfb,
-	0x4d, 0x38, 0x25, 0xf7, 0x99, 0x18, 0xe3, 0xd8, 0x0a, 0x17, 0x58, 0x15, 0x14, 0xca, 0xaf, 0x73,
-	0x00, 0x83, 0x87, 0xff, 0x87, 0x7c, 0xdb, 0x47, 0xbe, 0x5f, 0xe1, 0x81, 0xdc, 0xb5, 0x61, 0x8c,
-	0x13, 0x3d, 0xca, 0x76, 0xd8, 0xa3, 0xf0, 0x90, 0xee, 0xe6, 0x50, 0x36, 0x3f, 0x58, 0x5f, 0xf2,
-	0x0c, 0x4e, 0x46, 0x6d, 0x43, 0x38, 0x92, 0x35, 0x98, 0x36, 0x3d, 0xdc, 0xe3, 0x15, 0xa0, 0xc4,
-	0xec, 0x2c, 0x40, 0xc4, 0x51, 0x95, 0x0b, 0x50, 0xac, 0xf7, 0xf4, 0x0e, 0x6e, 0x39, 0xb8, 0x4d,
-	0x27, 0x35, 0xe9, 0x40, 0x08, 0xc2, 0x07, 0xca, 0x1a, 0x14, 0x7e, 0x8a, 0x8f, 0xf8, 0x4b, 0x3d,
-	0xa6, 0xa0, 0xca, 0x3f, 0x14, 0x60, 0x85, 0x9d, 0x15, 0x1b, 0xb2, 0xfe, 0xa2, 0x62, 0x62, 0xf7,
-	0xdd, 0x36, 0x26, 0x6c, 0xb7, 0x9d, 0xbe, 0xe6, 0x60, 0xd7, 0xb4, 0x0d, 0x51, 0x0a, 0x28, 0xb6,
-	0x9d, 0x7e, 0x93, 0x01, 0xd0, 0x69, 0xa0, 0x03, 0xed, 0xcb, 0xbe, 0x2d, 0x0c, 0x31, 0xa7, 0x16,
-	0xda, 0x4e, 0xff, 0xff, 0xd3, 0xb1, 0xa4, 0x25, 0x07, 0xba, 0x8b, 0x09, 0xb3, 0x33, 0x4e, 0xdb,
-	0x62, 0x00, 0x74, 0x1b, 0x4e, 0xf4, 0x70, 0xcf, 0x76, 0x8f, 0xb4, 0xae, 0xd9, 0x33, 0x3d, 0xcd,
-	0xb4, 0xb4, 0xbd, 0x23, 0x0f, 0x13, 0x61, 0x53, 0x88, 0x3f, 0x7c, 0x46, 0x9f, 0xd5, 0xad, 0x47,
-	0xf4, 0x09, 0x52, 0xa0, 0x6c, 0xdb, 0x3d, 0x8d, 0xb4, 0x6d, 0x17, 0x6b, 0xba, 0xf1, 0x05, 0x3b,
-	0x3e, 0x73, 0x6a, 0xc9, 0xb6, 0x7b, 0x2d, 0x0a, 0x5b, 0x37, 0xbe, 0x40, 0xe7, 0xa0, 0xd4, 0x76,
-	0xfa, 0x04, 0x7b, 0x1a, 0xfd, 0xc3, 0x4e, 0xc7, 0xa2, 0x0a, 0x1c, 0xb4, 0xe1, 0xf4, 0x49, 0x00,
-	0xa1, 0x47, 0xf5, 0x3f, 0x1b, 0x44, 0x78, 0x8e, 0x7b, 0x04, 0xbd, 0x00, 0x30, 0x4c, 0xf2, 0x52,
-	0xac, 0xca, 0x60, 0xfb, 0xf3, 0x41, 0xca, 0xf1, 0x1a, 0x57, 0xd9, 0xea, 0xa6, 0x49, 0x5e, 0x32,
-	0x05, 0x70, 0x53, 0x2c, 0x1a, 0x72, 0x8c, 0x2e, 0xc0, 0xdc, 0x5e, 0xf7, 0xa5, 0x69, 0x6b, 0x87,
-	0xd8, 0xec, 0x1c, 0x78, 0x55, 0xcc, 0xd2, 0xbb, 0x12, 0x83, 0xbd, 0x60, 0x20, 0xd4, 0x80, 0xa5,
-	0x20, 0x8a, 0x66, 0xe0, 0x57, 0x66, 0x1b, 0x57, 0xf7, 0x99, 0x10, 0x67, 0xe3, 0x42, 0x70, 0xb2,
-	0x4d, 0x86, 0xa5, 0x2e, 0x06, 0x38, 0x71, 0x10, 0x6a, 0xc1, 0x09, 0xce, 0x8f, 0x33, 0xd2, 0x5c,
-	0xac, 0x1b, 0xda, 0x9e, 0x43, 0xaa, 0x1d, 0xc6, 0xf1, 0x7c, 0x9c, 0xe3, 0xce, 0x81, 0x6b, 0x7b,
-	0x5e, 0x17, 0x0b, 0x9e, 0x88, 0x91, 0x8b, 0x01, 0xd6, 0x8d, 0x47, 0x0e, 0x3d, 0xf3, 0x4f, 0x86,
-	0x98, 0x1e, 0xba, 0xa6, 0x87, 0x19, 0xd7, 0x83, 0x31, 0xb9, 0x2e, 0x05, 0xb8, 0xbe, 0xa0, 0xd4,
-	0x49, 0x6c, 0x99, 0xac, 0xf5, 0x6d, 0x87, 0x54, 0xcd, 0x63, 0xb0, 0xa5, 0xc2, 0x52, 0x62, 0xf4,
-	0x02, 0x56, 0x12, 0xa4, 0x65, 0x7c, 0xbf, 0x18, 0x93, 0xef, 0x72, 0x54, 0x5c, 0xc6, 0xf8, 0x22,
-	0x94, 0x5f, 0x62, 0xd7, 0xc2, 0x5d, 0x8d, 0x9b, 0x6a, 0xf5, 0x25, 0xb3, 0xc6, 0x39, 0x0e, 0x7c,
-	0xce, 0x60, 0xe8, 0x26, 0x08, 0x43, 0xd6, 0x5c, 0x4c, 0xb0, 0xfb, 0x8a, 0x97, 0x1a, 0xbb, 0x0c,
-	0x73, 0x91, 0x3f, 0x51, 0x07, 0x0f, 0x50, 0x1d, 0x04, 0x50, 0x23, 0x87, 0x2c, 0xbd, 0xc5, 0x84,
-	0x54, 0x7b, 0x63, 0x14, 0x70, 0x2a, 0x9c, 0xac, 0xe5, 0x53, 0xa1, 0x35, 0x98, 0xed, 0xb3, 0x37,
-	0x8b, 0x54, 0x2d, 0xb6, 0xce, 0x6a, 0x9c, 0xc1, 0x2e, 0x43, 0x50, 0x25, 0x62, 0xed, 0x01, 0xcc,
-	0x87, 0xcd, 0x77, 0x22, 0x6f, 0x77, 0x0f, 0xe6, 0x42, 0xc6, 0x87, 0x20, 0x1f, 0x28, 0xef, 0xb2,
-	0xdf, 0xe8, 0x24, 0xcc, 0x70, 0x1c, 0x46, 0x5e, 0x56, 0xc5, 0x48, 0xf9, 0x00, 0xe6, 0xc3, 0x4a,
-	0x4f, 0xa4, 0x46, 0x90, 0x77, 0x65, 0x20, 0x91, 0x57, 0xd9, 0x6f, 0x65, 0x13, 0x66, 0xf8, 0x32,
-	0x12, 0xab, 0x2f, 0x08, 0xf2, 0x07, 0xba, 0x6b, 0x08, 0xe7, 0xc4, 0x7e, 0x53, 0x18, 0xb1, 0xf7,
-	0x3d, 0xe1, 0x92, 0xd8, 0x6f, 0x45, 0x87, 0x72, 0xa8, 0x7e, 0x48, 0x91, 0x58, 0xa1, 0x50, 0x30,
-	0xa3, 0xbf, 0xd9, 0xf4, 0x76, 0x57, 0xae, 0x9c, 0xfd, 0xa6, 0x30, 0xef, 0xc8, 0x91, 0x75, 0x1c,
-	0xf6, 0x9b, 0xaa, 0xa8, 0x8b, 0x5f, 0x89, 0xba, 0x73, 0x51, 0xe5, 0x03, 0xc5, 0x00, 0xd8, 0xd0,
-	0x1d, 0x7d, 0xcf, 0xec, 0x9a, 0xde, 0x11, 0xba, 0x0e, 0x15, 0xdd, 0x30, 0xb4, 0xb6, 0x84, 0x98,
-	0x58, 0x76, 0x03, 0x16, 0x74, 0xc3, 0xd8, 0x08, 0x80, 0xd1, 0xdb, 0xb0, 0x68, 0xb8, 0xb6, 0x13,
-	0xc6, 0xe5, 0xed, 0x81, 0x0a, 0x7d, 0x10, 0x44, 0x56, 0xfe, 0x73, 0x1a, 0xce, 0x84, 0x5d, 0x53,
-	0xb4, 0x46, 0xfb, 0x11, 0xcc, 0x45, 0x66, 0x4d, 0x31, 0xaf, 0x81, 0xb4, 0x6a, 0x88, 0x22, 0x52,
-	0xb3, 0xcc, 0xc6, 0x6a, 0x96, 0x89, 0x55, 0xe0, 0xdc, 0x1b, 0xad, 0x02, 0xe7, 0xdf, 0x48, 0x15,
-	0x78, 0x7a, 0xb2, 0x2a, 0xf0, 0x15, 0x56, 0xbb, 0x90, 0xd4, 0xcc, 0xd6, 0xf8, 0xf9, 0x52, 0xf6,
-	0x71, 0x2c, 0xd9, 0x46, 0x8a, 0x54, 0x8b, 0x67, 0x27, 0xa9, 0x16, 0x17, 0x52, 0xab, 0xc5, 0xd4,
-	0x6a, 0x1c, 0x47, 0x77, 0x7b, 0xb6, 0x2b, 0xcb, 0xc1, 0xd5, 0x22, 0x13, 0x61, 0x41, 0xc2, 0x45,
-	0x29, 0x38, 0xb5, 0x70, 0x0c, 0xa9, 0x85, 0xe3, 0xf3, 0x30, 0x67, 0xd9, 0x9a, 0x85, 0x0f, 0x35,
-	0xba, 0x97, 0xa4, 0x5a, 0xe2, 0x1b, 0x6b, 0xd9, 0x0d, 0x7c, 0xd8, 0xa4, 0x90, 0x58, 0x69, 0x79,
-	0x6e, 0xb2, 0xd2, 0x32, 0x3d, 0x01, 0x7b, 0x3a, 0x79, 0x89, 0x0d, 0x26, 0x0a, 0xa9, 0x96, 0x99,
-	0x11, 0x97, 0x38, 0x8c, 0xca, 0x40, 0xd0, 0x65, 0xf0, 0x95, 0x24, 0x90, 0xe6, 0x19, 0x52, 0x59,
-	0x42, 0x19, 0x9a, 0xf2, 0x37, 0x19, 0x58, 0x0e, 0x9b, 0xb9, 0x28, 0x28, 0x3e, 0x81, 0xa2, 0x2b,
-	0xcf, 0x62, 0x61, 0xda, 0xd7, 0xc7, 0x3e, 0xbc, 0xd5, 0x01, 0x2d, 0xfa, 0x59, 0x6a, 0x1d, 0xfb,
-	0xd6, 0x28, 0x7e, 0xa3, 0x2a, 0xd9, 0x4a, 0x1d, 0xce, 0xbd, 0x30, 0x2d, 0xc3, 0x3e, 0x24, 0xa9,
-	0x6f, 0x69, 0x82, 0xad, 0x65, 0x12, 0x6c, 0x4d, 0xf9, 0xbb, 0x0c, 0x9c, 0x8c, 0xf2, 0x12, 0xaa,
-	0xa8, 0xc7, 0x55, 0xf1, 0x76, 0x42, 0x08, 0x11, 0x21, 0x4e, 0x54, 0xc6, 0xe7, 0xa9, 0xca, 0xb8,
-	0x3d, 0x9a, 0xe3, 0x48, 0x75, 0xfc, 0x45, 0x06, 0x4e, 0xa5, 0x8a, 0x11, 0x89, 0x23, 0x33, 0xd1,
-	0x38, 0x52, 0xc4, 0xa0, 0x6d, 0xbb, 0x6f, 0x79, 0x81, 0x18, 0x74, 0x83, 0xf5, 0x1a, 0x79, 0xb0,
-	0xa7, 0xf5, 0xf4, 0xd7, 0x66, 0xaf, 0xdf, 0x13, 0x1e, 0x9f, 0xb2, 0x7b, 0xce, 0x21, 0xc7, 0x88,
-	0x42, 0x95, 0x75, 0x58, 0xf4, 0xa5, 0x1c, 0x5a, 0xf9, 0x0f, 0x54, 0xf2, 0xb3, 0xe1, 0x4a, 0xbe,
-	0x05, 0x33, 0xe2, 0x94, 0x7b, 0x13, 0xcd, 0xd0, 0xf3, 0x50, 0x72, 0xb0, 0xdb, 0x33, 0x09, 0xf1,
-	0x1d, 0x6d, 0x51, 0x0d, 0x82, 0x94, 0x5f, 0xcd, 0xc2, 0x42, 0xd4, 0x3a, 0x3e, 0x8c, 0x35, 0x0e,
-	0x2e, 0x26, 0x1c, 0x01, 0xd1, 0x85, 0x06, 0x52, 0xc8, 0xdb, 0x32, 0x03, 0xc9, 0xa6, 0x55, 0xef,
-	0xfc, 0x6c, 0x45, 0xa4, 0x27, 0x54, 0x23, 0x6d, 0xbb, 0xd7, 0xd3, 0x2d, 0x43, 0xf6, 0xb0, 0xc5,
-	0x90, 0xea, 0x4f, 0x77, 0x3b, 0x54, 0xed, 0x14, 0xcc, 0x7e, 0xd3, 0xcd, 0x3b, 0xb4, 0xdd, 0x97,
-	0xa6, 0xc5, 0x1a, 0x10, 0xcc, 0x59, 0x17, 0x55, 0x10, 0xa0, 0x4d, 0xd3, 0x45, 0xab, 0x90, 0xc7,
-	0xd6, 0x2b, 0x99, 0x23, 0x26, 0x34, 0xb9, 0x65, 0x2e, 0xa4, 0x32, 0x3c, 0x74, 0x0b, 0x66, 0x7a,
-	0xd4, 0x2c, 0x64, 0xd1, 0x6b, 0x25, 0xa5, 0xd7, 0xab, 0x0a, 0x34, 0x1a, 0x43, 0xf1, 0xa8, 0x51,
-	0x56, 0xb6, 0x12, 0x62, 0x28, 0x11, 0x23, 0x4a, 0x44, 0xb4, 0xe5, 0x67, 0xc0, 0xc5, 0xb4, 0xd4,
-	0x35, 0xb2, 0x15, 0x89, 0x69, 0xf0, 0x4e, 0x38, 0x0d, 0x06, 0xc6, 0x6b, 0x6d, 0x34, 0xaf, 0xe1,
-	0xbd, 0x88, 0x53, 0x50, 0xe8, 0xda, 0x1d, 0x6e, 0x46, 0x25, 0x7e, 0x3d, 0xa2, 0x6b, 0x77, 0x98,
-	0x15, 0x2d, 0xc3, 0x34, 0xf1, 0x0c, 0xd3, 0x62, 0x4e, 0xbd, 0xa0, 0xf2, 0x01, 0x7d, 0xf9, 0xd8,
-	0x0f, 0xcd, 0xb6, 0xda, 0xb8, 0x5a, 0x66, 0x8f, 0x8a, 0x0c, 0xb2, 0x6d, 0xb5, 0x59, 0x8e, 0xe9,
-	0x79, 0x47, 0xd5, 0x79, 0x06, 0xa7, 0x3f, 0xd1, 0x03, 0x59, 0x97, 0x5c, 0x48, 0x2b, 0xf6, 0x24,
-	0xb9, 0x6d, 0x59, 0x96, 0x7c, 0x04, 0xb3, 0x87, 0xdc, 0x11, 0x54, 0x2b, 0x8c, 0xfe, 0xda, 0x68,
-	0xf7, 0x22, 0x38, 0x48, 0x42, 0x7a, 0xc8, 0x58, 0xd8, 0xa3, 0x67, 0x98, 0x4d, 0x9d, 0x0c, 0x6b,
-	0xcc, 0xe7, 0xd4, 0x92, 0x85, 0xbd, 0xa6, 0x00, 0x51, 0x35, 0xb0, 0xec, 0x4e, 0x33, 0x0d, 0x96,
-	0x85, 0x15, 0xd5, 0x59, 0x36, 0xae, 0x1b, 0xdf, 0x67, 0xb5, 0xe0, 0xd7, 0x19, 0x38, 0xb9, 0xc1,
-	0x2a, 0x29, 0x01, 0x2f, 0x38, 0x49, 0xf1, 0xff, 0xae, 0xdf, 0x97, 0x49, 0xad, 0xd4, 0x47, 0xb5,
-	0x26, 0xdb, 0x32, 0x75, 0x98, 0x97, 0xcc, 0x05, 0x8b, 0xdc, 0xd8, 0xad, 0x9d, 0x32, 0x09, 0x0e,
-	0x95, 0x07, 0xb0, 0x12, 0x5b, 0x85, 0xa8, 0x7a, 0x5c, 0x80, 0xb9, 0x81, 0xb7, 0xf3, 0x17, 0x51,
-	0xf2, 0x61, 0x75, 0x43, 0xb9, 0x07, 0x27, 0x5a, 0x9e, 0xee, 0x7a, 0x31, 0x15, 0x8c, 0x41, 0xcb,
-	0x9a, 0x36, 0x61, 0x5a, 0xd1, 0x57, 0x69, 0xc1, 0x72, 0xcb, 0xb3, 0x9d, 0x63, 0x30, 0xa5, 0x3e,
-	0x8b, 0xae, 0xdf, 0xee, 0xcb, 0xd3, 0x45, 0x0e, 0x95, 0x15, 0xde, 0x62, 0x8a, 0xcf, 0x76, 0x1f,
-	0x4e, 0xf2, 0x0e, 0xcf, 0x71, 0x16, 0x71, 0x4a, 0xf6, 0x97, 0xe2, 0x7c, 0x9f, 0xc3, 0xd2, 0xe0,
-	0x50, 0x1d, 0x54, 0x6f, 0xef, 0x84, 0xab, 0xb7, 0xe7, 0x87, 0xec, 0x7a, 0xa8, 0x78, 0xfb, 0xab,
-	0x6c, 0xe0, 0x54, 0x48, 0xa9, 0xdd, 0xde, 0x0f, 0xd7, 0x6e, 0x2f, 0x8f, 0xe2, 0x1d, 0x2a, 0xdd,
-	0xc6, 0xad, 0x36, 0x97, 0x60, 0xb5, 0x9f, 0xc5, 0x0a, 0xbc, 0xf9, 0xb4, 0x0a, 0x79, 0x44, 0xda,
-	0xdf, 0x4b, 0x7d, 0x57, 0xe5, 0xf5, 0x5d, 0x7f, 0x6a, 0xbf, 0x21, 0x77, 0x37, 0x52, 0xdf, 0xbd,
-	0x30, 0x52, 0x5e, 0xbf, 0xbc, 0xfb, 0xd7, 0x79, 0x28, 0xfa, 0xcf, 0x62, 0x3a, 0x8f, 0xab, 0x2d,
-	0x9b, 0xa0, 0xb6, 0xe0, 0xf9, 0x9d, 0xfb, 0x56, 0xe7, 0x77, 0x7e, 0xec, 0xf3, 0xfb, 0x34, 0x14,
-	0xd9, 0x0f, 0xcd, 0xc5, 0xfb, 0xe2, 0x3c, 0x2e, 0x30, 0x80, 0x8a, 0xf7, 0x07, 0x66, 0x38, 0x33,
-	0x91, 0x19, 0x46, 0x2a, 0xca, 0xb3, 0xd1, 0x8a, 0xf2, 0x87, 0xfe, 0x79, 0xca, 0x8f, 0xe0, 0xab,
-	0x43, 0xf8, 0x26, 0x9e, 0xa4, 0x8d, 0xf0, 0x49, 0xca, 0x4f, 0xe5, 0x77, 0x86, 0x71, 0xf9, 0xc1,
-	0xd6, 0x93, 0x77, 0x79, 0x3d, 0x39, 0x68, 0x8b, 0xc2, 0xb3, 0xde, 0x07, 0xf0, 0x9d, 0x88, 0x2c,
-	0x2a, 0x9f, 0x1e, 0xb2, 0x46, 0x35, 0x80, 0x4e, 0xd9, 0x86, 0xb6, 0x66, 0xd0, 0x74, 0x1e, 0xcf,
-	0x3f, 0xa6, 0x74, 0x9c, 0xff, 0xb2, 0x10, 0xf0, 0x2f, 0x29, 0x5d, 0xda, 0x0f, 0x63, 0x9d, 0x8c,
-	0x09, 0xad, 0xf8, 0x4e, 0xb8, 0x91, 0x71, 0x4c, 0xab, 0x8b, 0xf5, 0x31, 0x58, 0xdc, 0xa3, 0xbb,
-	0xe2, 0x31, 0xaf, 0x33, 0x17, 0x05, 0x64, 0x9d, 0xe5, 0x15, 0xfb, 0xa6, 0x65, 0x92, 0x03, 0xfe,
-	0x7c, 0x86, 0xe7, 0x15, 0x12, 0xb4, 0xce, 0x2e, 0x49, 0xe2, 0xd7, 0xa6, 0xa7, 0xb5, 0x6d, 0x03,
-	0x33, 0x9b, 0x9e, 0x56, 0x0b, 0x14, 0xb0, 0x61, 0x1b, 0x78, 0xf0, 0xe6, 0x15, 0x8e, 0xf7, 0xe6,
-	0x15, 0x23, 0x6f, 0xde, 0x49, 0x98, 0x71, 0xb1, 0x4e, 0x6c, 0x4b, 0x24, 0xf7, 0x62, 0x44, 0xb7,
-	0xa6, 0x87, 0x09, 0xa1, 0x33, 0x89, 0x60, 0x4f, 0x0c, 0x03, 0x41, 0xea, 0xdc, 0xc8, 0x20, 0x75,
-	0x48, 0xf7, 0x37, 0x12, 0xa4, 0x96, 0x47, 0x06, 0xa9, 0xe3, 0x34, 0x7f, 0x03, 0x61, 0xfa, 0xfc,
-	0x78, 0x61, 0x7a, 0x30, 0xaa, 0x5d, 0x08, 0x47, 0xb5, 0x4f, 0x61, 0xf6, 0x95, 0xdd, 0xed, 0xf7,
-	0x30, 0x11, 0x95, 0xfc, 0xd5, 0xd1, 0xd2, 0x7d, 0xcc, 0x09, 0xc4, 0x6d, 0x31, 0x41, 0x1e, 0x2e,
-	0x2c, 0xe0, 0x6f, 0x51, 0x58, 0x08, 0x06, 0x9f, 0xfb, 0xa1, 0xe0, 0xd3, 0x4f, 0x68, 0x3a, 0xe3,
-	0x25, 0x34, 0xdf, 0xa3, 0x2b, 0xaa, 0xed, 0xc0, 0x5c, 0x50, 0x4f, 0x09, 0xb4, 0xab, 0x41, 0xda,
-	0xc4, 0xd4, 0x89, 0x33, 0x08, 0x3a, 0xb8, 0x02, 0xcc, 0x70, 0xa0, 0xf2, 0x4f, 0x19, 0x58, 0x89,
-	0x39, 0x25, 0xe1, 0xec, 0xee, 0x46, 0xba, 0xf0, 0x17, 0x46, 0xee, 0xa9, 0xdf, 0x84, 0x7f, 0x12,
-	0x6a, 0xc2, 0xbf, 0x37, 0x9a, 0xf0, 0x8d, 0xf7, 0xe0, 0xff, 0x36, 0x0b, 0xe7, 0x76, 0x1d, 0x23,
-	0x12, 0x1f, 0x0b, 0x33, 0x19, 0xdf, 0xed, 0x7e, 0x28, 0xf3, 0xac, 0xec, 0xa4, 0xa6, 0x28, 0x52,
-	0xad, 0x2f, 0xa1, 0x42, 0x1c, 0xdc, 0xd6, 0x82, 0x2f, 0x30, 0x7f, 0x45, 0x1e, 0x27, 0x34, 0x0a,
-	0x86, 0x0b, 0xbc, 0x4a, 0x5d, 0x55, 0xec, 0xa5, 0x5e, 0x20, 0x61, 0x68, 0xed, 0x11, 0x2c, 0x27,
-	0x21, 0x4e, 0xa4, 0x3e, 0x05, 0xce, 0xa7, 0x0b, 0x23, 0xe2, 0xe4, 0x9f, 0xc3, 0xc2, 0xd6, 0x6b,
-	0xdc, 0x6e, 0x1d, 0x59, 0xed, 0x09, 0x34, 0x5a, 0x81, 0x5c, 0xbb, 0x67, 0x88, 0xc2, 0x3a, 0xfd,
-	0x19, 0x0c, 0xfd, 0x73, 0xe1, 0xd0, 0x5f, 0x83, 0xca, 0x60, 0x06, 0x61, 0x95, 0x27, 0xa9, 0x55,
-	0x1a, 0x14, 0x99, 0x32, 0x9f, 0x53, 0xc5, 0x48, 0xc0, 0xb1, 0xcb, 0x2f, 0xba, 0x71, 0x38, 0x76,
-	0xdd, 0xf0, 0x11, 0x91, 0x0b, 0x1f, 0x11, 0xca, 0x9f, 0x65, 0xa0, 0x44, 0x67, 0xf8, 0x56, 0xf2,
-	0x8b, 0xec, 0x3c, 0x37, 0xc8, 0xce, 0xfd, 0x24, 0x3f, 0x1f, 0x4c, 0xf2, 0x07, 0x92, 0x4f, 0x33,
-	0x70, 0x5c, 0xf2, 0x19, 0x1f, 0x8e, 0x5d, 0x57, 0x39, 0x0f, 0x73, 0x5c, 0x36, 0xb1, 0xf2, 0x0a,
-	0xe4, 0xfa, 0x6e, 0x57, 0xee, 0x5f, 0xdf, 0xed, 0x2a, 0x7f, 0x92, 0x81, 0xf2, 0xba, 0xe7, 0xe9,
-	0xed, 0x83, 0x09, 0x16, 0xe0, 0x0b, 0x97, 0x0d, 0x0a, 0x17, 0x5f, 0xc4, 0x40, 0xdc, 0x7c, 0x8a,
-	0xb8, 0xd3, 0x21, 0x71, 0x15, 0x98, 0x97, 0xb2, 0xa4, 0x0a, 0xdc, 0x00, 0xd4, 0xb4, 0x5d, 0xef,
-	0xb1, 0xed, 0x1e, 0xea, 0xae, 0x31, 0x59, 0xda, 0x8d, 0x20, 0x2f, 0xbe, 0x96, 0xc8, 0x5d, 0x9b,
-	0x56, 0xd9, 0x6f, 0xe5, 0x2a, 0x2c, 0x85, 0xf8, 0xa5, 0x4e, 0xfc, 0x11, 0x94, 0xd8, 0x61, 0x2f,
-	0xf2, 0xaf, 0xdb, 0xc1, 0xb6, 0xfe, 0x58, 0xa1, 0x81, 0xf2, 0xff, 0x60, 0x91, 0x06, 0x85, 0x0c,
-	0xee, 0x7b, 0x90, 0x1f, 0x47, 0x92, 0x93, 0x33, 0x29, 0x8c, 0x22, 0x89, 0xc9, 0x6f, 0xb3, 0x30,
-	0xcd, 0xe0, 0xb1, 0x40, 0xed, 0x34, 0x3d, 0xfe, 0x1c, 0x5b, 0xf3, 0xf4, 0x8e, 0xff, 0x6d, 0x0a,
-	0x05, 0xec, 0xe8, 0x1d, 0x56, 0x72, 0x61, 0x0f, 0x0d, 0xb3, 0x83, 0x89, 0x27, 0x3f, 0x50, 0x29,
-	0x51, 0xd8, 0x26, 0x07, 0xb1, 0xa6, 0x9b, 0xf9, 0x07, 0x3c, 0xd9, 0xc8, 0xab, 0xec, 0x37, 0x5a,
-	0xe5, 0x57, 0xa3, 0xc7, 0xe9, 0xc2, 0xb0, 0x8b, 0xd3, 0x35, 0x28, 0x44, 0x1a, 0x2f, 0xfe, 0x18,
-	0x3d, 0x8c, 0x1e, 0xf4, 0x97, 0x52, 0x56, 0x9c, 0x7c, 0xbc, 0x7f, 0x47, 0xe7, 0xd9, 0x16, 0xa0,
-	0xe0, 0xde, 0x08, 0x2b, 0xb8, 0x05, 0x33, 0x6c, 0xeb, 0x64, 0xa0, 0xbe, 0x92, 0x22, 0xaa, 0x2a,
-	0xd0, 0x14, 0x1d, 0x10, 0xdf, 0xf6, 0x50, 0x70, 0x3e, 0xb9, 0xad, 0x0c, 0x09, 0xd6, 0xff, 0x3e,
-	0x03, 0x4b, 0xa1, 0x39, 0x84, 0xac, 0x37, 0xc3, 0x93, 0xa4, 0x8a, 0x2a, 0x26, 0xd8, 0x08, 0x9d,
-	0xaf, 0xb7, 0xd2, 0x44, 0xfa, 0x8e, 0xce, 0xd6, 0xdf, 0x66, 0x00, 0xd6, 0xfb, 0xde, 0x81, 0x28,
-	0x71, 0x07, 0xed, 0x25, 0x13, 0xb1, 0x97, 0x1a, 0x14, 0x1c, 0x9d, 0x90, 0x43, 0xdb, 0x95, 0xe9,
-	0xb5, 0x3f, 0x66, 0xc5, 0xe8, 0xbe, 0x77, 0x20, 0x7b, 0xba, 0xf4, 0x37, 0xba, 0x0c, 0xf3, 0xfc,
-	0x2b, 0x2d, 0x4d, 0x37, 0x0c, 0x17, 0x13, 0x22, 0x9a, 0xbb, 0x65, 0x0e, 0x5d, 0xe7, 0x40, 0x8a,
-	0x66, 0x1a, 0xd8, 0xf2, 0x4c, 0xef, 0x48, 0xf3, 0xec, 0x97, 0xd8, 0x12, 0x69, 0x72, 0x59, 0x42,
-	0x77, 0x28, 0x90, 0x77, 0xb9, 0x3a, 0x26, 0xf1, 0x5c, 0x89, 0x26, 0x1b, 0x89, 0x02, 0xca, 0xd0,
-	0xe8, 0xa6, 0x54, 0x9a, 0xfd, 0x6e, 0x97, 0xab, 0xf8, 0xf8, 0xdb, 0xfe, 0xae, 0x58, 0x50, 0x36,
-	0xed, 0x4d, 0x1b, 0x28, 0x4d, 0x2c, 0xf7, 0x0d, 0xd6, 0x03, 0xdf, 0x85, 0xc5, 0xc0, 0x1a, 0x84,
-	0x59, 0x85, 0xf2, 0x99, 0x4c, 0x38, 0x9f, 0x51, 0x9e, 0x00, 0xe2, 0x25, 0xb0, 0x6f, 0xb9, 0x6e,
-	0xe5, 0x04, 0x2c, 0x85, 0x18, 0x89, 0xf8, 0xe0, 0x06, 0x94, 0xc5, 0xad, 0x5a, 0x61, 0x28, 0xa7,
-	0xa0, 0x40, 0xfd, 0x7c, 0xdb, 0x34, 0x64, 0xc3, 0x7f, 0xd6, 0xb1, 0x8d, 0x0d, 0xd3, 0x70, 0x95,
-	0x17, 0x50, 0x56, 0xf9, 0x3c, 0x02, 0xf7, 0x31, 0xcc, 0x8b, 0x3b, 0xb8, 0x5a, 0xe8, 0x12, 0x7c,
-	0xd2, 0x47, 0x56, 0xc1, 0x49, 0xd4, 0xb2, 0x15, 0x1c, 0x2a, 0x06, 0xd4, 0x78, 0x20, 0x13, 0x62,
-	0x2f, 0x17, 0xfb, 0x18, 0xe4, 0x7d, 0xf8, 0x91, 0xb3, 0x84, 0xe9, 0xcb, 0x6e, 0x70, 0xa8, 0x9c,
-	0x81, 0xd3, 0x89, 0xb3, 0x08, 0x4d, 0x38, 0x50, 0x19, 0x3c, 0x30, 0x4c, 0x79, 0xf3, 0x81, 0xdd,
-	0x68, 0xc8, 0x04, 0x6e, 0x34, 0x9c, 0xf4, 0x23, 0xee, 0xac, 0x3c, 0x5a, 0x59, 0x38, 0x3d, 0xc8,
-	0x3c, 0x73, 0x69, 0x99, 0x67, 0x3e, 0x94, 0x79, 0x2a, 0x2d, 0x5f, 0x9f, 0xa2, 0x22, 0xf0, 0x88,
-	0x55, 0x2e, 0xf8, 0xdc, 0xd2, 0x21, 0x2a, 0xc3, 0x56, 0xc9, 0x51, 0xd5, 0x00, 0x95, 0x72, 0x1d,
-	0xca, 0x61, 0xd7, 0x18, 0xf0, 0x73, 0x99, 0x98, 0x9f, 0x9b, 0x8f, 0xb8, 0xb8, 0xf7, 0x23, 0xe9,
-	0x44, 0xba, 0x8e, 0x23, 0xc9, 0xc4, 0xc3, 0x90, 0xb3, 0xbb, 0x91, 0x70, 0x19, 0xe1, 0x3b, 0xf2,
-	0x73, 0xcb, 0xe2, 0x3c, 0x78, 0x4c, 0x28, 0xbd, 0x58, 0xb4, 0x72, 0x11, 0x4a, 0xbb, 0x69, 0x5f,
-	0xf0, 0xe5, 0xe5, 0x6d, 0xbf, 0x3b, 0xb0, 0xfc, 0xd8, 0xec, 0x62, 0x72, 0x44, 0x3c, 0xdc, 0xab,
-	0x33, 0xa7, 0xb4, 0x6f, 0x62, 0x17, 0x9d, 0x05, 0x60, 0xd9, 0xb4, 0x63, 0x9b, 0xfe, 0x87, 0x5d,
-	0x01, 0x88, 0xf2, 0xbb, 0x0c, 0x2c, 0x0c, 0x08, 0x77, 0x59, 0x15, 0xe1, 0x2d, 0x28, 0xd2, 0xf5,
-	0x12, 0x4f, 0xef, 0x39, 0xb2, 0x31, 0xeb, 0x03, 0xd0, 0x7d, 0x98, 0xde, 0x27, 0xb2, 0x7a, 0x99,
-	0xd8, 0x09, 0x4a, 0x12, 0x44, 0xcd, 0xef, 0x93, 0xba, 0x81, 0x1e, 0x00, 0xf4, 0x09, 0x36, 0x44,
-	0x33, 0x36, 0x97, 0x16, 0xc3, 0xec, 0x06, 0x2f, 0x6a, 0x50, 0x02, 0x7e, 0x51, 0xf0, 0x21, 0x94,
-	0x4c, 0xcb, 0x36, 0x30, 0x6b, 0x9e, 0x1b, 0xa2, 0xc0, 0x39, 0x82, 0x1c, 0x38, 0xc5, 0x2e, 0xc1,
-	0x86, 0x82, 0xc5, 0x59, 0x28, 0xf5, 0x2b, 0x0c, 0xa5, 0x01, 0x8b, 0xdc, 0x69, 0xed, 0xfb, 0x82,
-	0x4b, 0x8b, 0xbd, 0x30, 0x6c, 0x75, 0x4c, 0x5b, 0x6a, 0xc5, 0x14, 0x01, 0x97, 0x24, 0x55, 0xee,
-	0xc1, 0x89, 0x50, 0xba, 0x39, 0x41, 0xfe, 0xa7, 0x34, 0x23, 0x35, 0xbb, 0x81, 0x39, 0x8b, 0x8a,
-	0x98, 0xb4, 0xe6, 0x51, 0x15, 0x31, 0xc2, 0x2b, 0x62, 0x44, 0xf9, 0x0c, 0x4e, 0x85, 0x8a, 0x8b,
-	0x21, 0x89, 0x1e, 0x46, 0xe2, 0xc9, 0x2b, 0xa3, 0xb8, 0x46, 0x02, 0xcb, 0xff, 0xce, 0xc0, 0x72,
-	0x12, 0xc2, 0x31, 0x8b, 0xdf, 0x3f, 0x4f, 0xb9, 0x14, 0x7e, 0x77, 0x3c, 0xb1, 0x7e, 0x2f, 0x8d,
-	0x83, 0x1d, 0xa8, 0x25, 0xe9, 0x33, 0xbe, 0x4b, 0xb9, 0x49, 0x76, 0xe9, 0x17, 0xb9, 0x40, 0x13,
-	0x68, 0xdd, 0xf3, 0x5c, 0x73, 0xaf, 0x4f, 0x4d, 0xfe, 0x8d, 0x17, 0x56, 0xeb, 0x7e, 0x89, 0x90,
-	0xab, 0xf6, 0xf6, 0x10, 0xf2, 0x81, 0x1c, 0x89, 0x65, 0xc2, 0x4f, 0xc2, 0x65, 0x42, 0xde, 0xde,
-	0xb9, 0x33, 0x1e, 0xbf, 0x1f, 0x6c, 0x2d, 0xfe, 0x17, 0x59, 0x98, 0x0f, 0x6f, 0x11, 0xda, 0x02,
-	0xd0, 0x7d, 0xc9, 0xc5, 0x8b, 0x72, 0x79, 0xac, 0x65, 0xaa, 0x01, 0x42, 0xf4, 0x0e, 0xe4, 0xda,
-	0x4e, 0x5f, 0xec, 0x5a, 0x42, 0x11, 0x70, 0xc3, 0xe9, 0x73, 0x8f, 0x42, 0xd1, 0x68, 0xa6, 0x27,
-	0xee, 0x9f, 0xa6, 0x7a, 0x49, 0x7e, 0x17, 0x95, 0xd3, 0x08, 0x64, 0xf4, 0x14, 0xe6, 0x0f, 0x5d,
-	0xd3, 0xd3, 0xf7, 0xba, 0x58, 0xeb, 0xea, 0x47, 0xd8, 0x15, 0x5e, 0x72, 0x0c, 0x47, 0x56, 0x96,
-	0x84, 0xcf, 0x28, 0x9d, 0xf2, 0x47, 0x50, 0x90, 0x12, 0x8d, 0x38, 0x11, 0x76, 0x60, 0xa5, 0x4f,
-	0xd1, 0x34, 0x76, 0x81, 0xdb, 0xd2, 0x2d, 0x5b, 0x23, 0x98, 0x1e, 0xe3, 0xf2, 0xd3, 0xb2, 0x11,
-	0x2e, 0x7a, 0x99, 0x51, 0x6f, 0xd8, 0x2e, 0x6e, 0xe8, 0x96, 0xdd, 0xe2, 0xa4, 0xca, 0x2b, 0x28,
-	0x05, 0x16, 0x38, 0x42, 0x84, 0x3a, 0x2c, 0xca, 0x3b, 0x25, 0x04, 0x7b, 0xe2, 0x78, 0x19, 0x6b,
-	0xf2, 0x05, 0x41, 0xd7, 0xc2, 0x1e, 0xbf, 0x07, 0xf4, 0x10, 0x4e, 0xa9, 0xd8, 0x76, 0xb0, 0xe5,
-	0xef, 0xe7, 0x33, 0xbb, 0x33, 0x81, 0x07, 0x7f, 0x0b, 0x6a, 0x49, 0xf4, 0x22, 0x32, 0xbb, 0x07,
-	0x27, 0x9a, 0x7a, 0x9f, 0xe0, 0x63, 0xf6, 0xc1, 0xa3, 0xb4, 0x82, 0xeb, 0x03, 0x58, 0xd9, 0xb5,
-	0x9c, 0xe3, 0xf2, 0xad, 0x41, 0x35, 0x4e, 0x2d, 0x38, 0xdf, 0x91, 0xa1, 0xb6, 0x48, 0x82, 0x05,
-	0xd7, 0x73, 0x50, 0xe2, 0x19, 0xb6, 0x16, 0xc8, 0xc2, 0x80, 0x83, 0x1a, 0x7a, 0x0f, 0x2b, 0x27,
-	0x61, 0x39, 0x4c, 0x27, 0xf8, 0x3d, 0x14, 0xbd, 0xfc, 0xe3, 0x7e, 0x65, 0x79, 0x0a, 0x56, 0x62,
-	0xf4, 0x9c, 0xf5, 0x8d, 0x2b, 0x50, 0x90, 0xff, 0xfd, 0x02, 0xcd, 0x42, 0x6e, 0x67, 0xa3, 0x59,
-	0x99, 0xa2, 0x3f, 0x76, 0x37, 0x9b, 0x95, 0x0c, 0x2a, 0x40, 0xbe, 0xb5, 0xb1, 0xd3, 0xac, 0x64,
-	0x6f, 0xf4, 0xa0, 0x12, 0xfd, 0xd7, 0x0f, 0x68, 0x05, 0x96, 0x9a, 0xea, 0x76, 0x73, 0xfd, 0xc9,
-	0xfa, 0x4e, 0x7d, 0xbb, 0xa1, 0x35, 0xd5, 0xfa, 0xc7, 0xeb, 0x3b, 0x5b, 0x95, 0x29, 0x74, 0x01,
-	0xce, 0x04, 0x1f, 0x3c, 0xdd, 0x6e, 0xed, 0x68, 0x3b, 0xdb, 0xda, 0xc6, 0x76, 0x63, 0x67, 0xbd,
-	0xde, 0xd8, 0x52, 0x2b, 0x19, 0x74, 0x06, 0x4e, 0x05, 0x51, 0x1e, 0xd5, 0x37, 0xeb, 0xea, 0xd6,
-	0x06, 0xfd, 0xbd, 0xfe, 0xac, 0x92, 0xbd, 0x71, 0x1b, 0xca, 0xa1, 0xff, 0xca, 0x40, 0x45, 0x6a,
-	0x6e, 0x6f, 0x56, 0xa6, 0x50, 0x19, 0x8a, 0x41, 0x3e, 0x05, 0xc8, 0x37, 0xb6, 0x37, 0xb7, 0x2a,
-	0xd9, 0x1b, 0x4d, 0x58, 0x88, 0x7c, 0xa6, 0x83, 0x16, 0xa1, 0xdc, 0x5a, 0x6f, 0x6c, 0x3e, 0xda,
-	0xfe, 0x44, 0x53, 0xb7, 0xd6, 0x37, 0x3f, 0xad, 0x4c, 0xa1, 0x65, 0xa8, 0x48, 0x50, 0x63, 0x7b,
-	0x87, 0x43, 0x33, 0x11, 0xe8, 0xe3, 0xed, 0xdd, 0xc6, 0x66, 0xc5, 0xb8, 0xf1, 0x55, 0x26, 0xe2,
-	0xd5, 0x30, 0x3a, 0x01, 0x8b, 0xfe, 0xec, 0xda, 0x86, 0xba, 0xb5, 0xbe, 0xb3, 0x45, 0x85, 0x0a,
-	0x81, 0xd5, 0xdd, 0x46, 0xa3, 0xde, 0x78, 0xc2, 0xd9, 0x0e, 0xc0, 0x5b, 0x9f, 0xd4, 0x29, 0x72,
-	0x36, 0x8c, 0xbc, 0xdb, 0xf8, 0x69, 0x63, 0xfb, 0x45, 0xa3, 0x92, 0x43, 0x4b, 0xb0, 0x30, 0x00,
-	0x37, 0xd7, 0x77, 0x5b, 0x5b, 0x95, 0xfc, 0xda, 0xff, 0x2c, 0xc1, 0xbc, 0x8c, 0xb7, 0xb1, 0xcb,
-	0x6e, 0xc9, 0x35, 0x61, 0x56, 0xfe, 0xe7, 0x95, 0x84, 0x83, 0x32, 0xfc, 0xff, 0x62, 0x6a, 0x17,
-	0x86, 0x60, 0x08, 0xe3, 0x9a, 0x42, 0x7b, 0x2c, 0x0d, 0x09, 0x7c, 0x62, 0x75, 0x25, 0x31, 0xe8,
-	0x8f, 0x59, 0x5f, 0xed, 0xea, 0x48, 0x3c, 0x7f, 0x0e, 0x4c, 0x33, 0x8d, 0xe0, 0x37, 0xc4, 0xe8,
-	0x6a, 0x52, 0x8a, 0x90, 0xf0, 0x91, 0x72, 0xed, 0xda, 0x68, 0x44, 0x7f, 0x9a, 0x97, 0x50, 0x89,
-	0x7e, 0x4f, 0x8c, 0x12, 0xda, 0x01, 0x29, 0x1f, 0x2d, 0xd7, 0x6e, 0x8c, 0x83, 0x1a, 0x9c, 0x2c,
-	0xf6, 0xe5, 0xed, 0xf5, 0x71, 0x3e, 0x65, 0x4c, 0x9d, 0x2c, 0xed, 0xab, 0x47, 0xae, 0xc0, 0xf0,
-	0xe7, 0x53, 0x28, 0xf1, 0x33, 0xd7, 0x84, 0x8f, 0xef, 0x92, 0x14, 0x98, 0xfc, 0x25, 0x96, 0x32,
-	0x85, 0x0e, 0x60, 0x21, 0x72, 0x61, 0x09, 0x25, 0x90, 0x27, 0xdf, 0xcc, 0xaa, 0x5d, 0x1f, 0x03,
-	0x33, 0x6c, 0x11, 0xc1, 0x0b, 0x4a, 0xc9, 0x16, 0x91, 0x70, 0xfd, 0x29, 0xd9, 0x22, 0x12, 0xef,
-	0x3a, 0x31, 0xe3, 0x0e, 0x5d, 0x4c, 0x4a, 0x32, 0xee, 0xa4, 0xeb, 0x50, 0xb5, 0xab, 0x23, 0xf1,
-	0x82, 0x4a, 0x8b, 0x5c, 0x53, 0x4a, 0x52, 0x5a, 0xf2, 0x35, 0xa8, 0xda, 0xf5, 0x31, 0x30, 0xa3,
-	0x56, 0x30, 0xb8, 0xf4, 0x90, 0x66, 0x05, 0xb1, 0x2b, 0x3a, 0x69, 0x56, 0x10, 0xbf, 0x3f, 0x21,
-	0xac, 0x20, 0x72, 0x59, 0xe1, 0xda, 0x18, 0xed, 0xc1, 0x74, 0x2b, 0x48, 0x6e, 0x24, 0x2a, 0x53,
-	0xe8, 0x8f, 0x33, 0x50, 0x4d, 0xeb, 0x61, 0xa1, 0xdb, 0x13, 0x37, 0xdf, 0x6a, 0x6b, 0x93, 0x90,
-	0xf8, 0x52, 0x7c, 0x09, 0x28, 0x1e, 0x7e, 0xa0, 0xb7, 0x93, 0x76, 0x26, 0x25, 0xc8, 0xa9, 0xbd,
-	0x33, 0x1e, 0x72, 0x70, 0x27, 0xc3, 0x71, 0x49, 0xd2, 0x4e, 0x26, 0x46, 0x3d, 0x49, 0x3b, 0x99,
-	0x12, 0xe2, 0x30, 0x1f, 0x15, 0x0d, 0x53, 0x92, 0x7c, 0x54, 0x4a, 0x20, 0x94, 0xe4, 0xa3, 0x52,
-	0xa3, 0x9e, 0x29, 0xd4, 0x82, 0x82, 0xec, 0x04, 0xa2, 0x84, 0x93, 0x27, 0xd2, 0x87, 0xac, 0x29,
-	0xc3, 0x50, 0x7c, 0xa6, 0x4f, 0x20, 0x4f, 0xa1, 0xe8, 0x4c, 0x32, 0xb6, 0x64, 0x76, 0x36, 0xed,
-	0xb1, 0xcf, 0xe8, 0x39, 0xcc, 0xf0, 0xd6, 0x17, 0x4a, 0x28, 0x6a, 0x85, 0x1a, 0x74, 0xb5, 0xf3,
-	0xe9, 0x08, 0x3e, 0xbb, 0xcf, 0xf9, 0x3f, 0x1a, 0x13, 0x5d, 0x2d, 0x74, 0x29, 0xf9, 0xbf, 0xb4,
-	0x84, 0x9b, 0x68, 0xb5, 0xcb, 0x23, 0xb0, 0x82, 0xe6, 0x11, 0x49, 0xa8, 0xae, 0x8e, 0xcc, 0x8a,
-	0xd3, 0xcd, 0x23, 0x39, 0xef, 0xe6, 0x86, 0x1f, 0xcf, 0xcb, 0x93, 0x0c, 0x3f, 0xb5, 0x1a, 0x92,
-	0x64, 0xf8, 0xe9, 0xa9, 0xbe, 0x32, 0x85, 0x3c, 0x58, 0x4a, 0xa8, 0xc2, 0xa2, 0x77, 0xd2, 0x5e,
-	0xdc, 0xa4, 0x92, 0x70, 0xed, 0xe6, 0x98, 0xd8, 0xc1, 0xcd, 0x17, 0x8e, 0xec, 0x5c, 0x7a, 0x69,
-	0x32, 0x75, 0xf3, 0x63, 0x6e, 0xeb, 0x00, 0x16, 0x22, 0x11, 0x35, 0x4a, 0x3b, 0x94, 0xe2, 0xe7,
-	0xf1, 0xf5, 0x31, 0x30, 0xe5, 0x4c, 0x6b, 0xff, 0x96, 0x83, 0x39, 0x5e, 0xcb, 0x17, 0xf1, 0xdf,
-	0xa7, 0x00, 0x83, 0x36, 0x1a, 0xba, 0x98, 0xac, 0xfd, 0x50, 0x03, 0xb4, 0x76, 0x69, 0x38, 0x52,
-	0xd0, 0xa4, 0x03, 0x2d, 0x29, 0x74, 0x69, 0x44, 0xc7, 0x2a, 0xd5, 0xa4, 0x13, 0xfa, 0x5a, 0xca,
-	0x14, 0xfa, 0x18, 0x8a, 0x7e, 0xef, 0x03, 0x25, 0xf5, 0x4e, 0x22, 0xcd, 0x9d, 0xda, 0xc5, 0xa1,
-	0x38, 0x41, 0xa9, 0x03, 0x8d, 0x8d, 0x24, 0xa9, 0xe3, 0x0d, 0x94, 0x24, 0xa9, 0x93, 0xba, 0x23,
-	0x03, 0x9d, 0xf0, 0xf2, 0x67, 0xaa, 0x4e, 0x42, 0xd5, 0xe7, 0x54, 0x9d, 0x84, 0x6b, 0xa8, 0xca,
-	0xd4, 0x9a, 0x03, 0x65, 0x9e, 0xeb, 0xc9, 0xdd, 0xd5, 0x60, 0x2e, 0x98, 0x02, 0xa2, 0x54, 0x39,
-	0x43, 0xa9, 0x65, 0xed, 0xca, 0x28, 0x34, 0x39, 0xe3, 0xa3, 0x2b, 0xbf, 0xf9, 0xfa, 0x6c, 0xe6,
-	0x9f, 0xbf, 0x3e, 0x3b, 0xf5, 0xd5, 0x37, 0x67, 0x33, 0xbf, 0xf9, 0xe6, 0x6c, 0xe6, 0x1f, 0xbf,
-	0x39, 0x9b, 0xf9, 0xf7, 0x6f, 0xce, 0x66, 0xfe, 0xf4, 0x3f, 0xce, 0x4e, 0xfd, 0xac, 0x20, 0xc9,
-	0xf7, 0x66, 0xd8, 0xbf, 0x42, 0x7c, 0xef, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x3f, 0x1a,
-	0x13, 0xd0, 0x52, 0x00, 0x00,
+	// 6020 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7c, 0x4d, 0x70, 0x1b, 0xc9,
+	0x75, 0x30, 0x07, 0x00, 0x49, 0xe0, 0x81, 0x20, 0xc1, 0x26, 0x45, 0x42, 0xd0, 0xea, 0x6f, 0xa4,
+	0xd5, 0x9f, 0x57, 0xd4, 0x8a, 0x6b, 0x6b, 0x57, 0x5a, 0x59, 0x12, 0x45, 0x52, 0x12, 0x6c, 0x09,
+	0xc4, 0x37, 0x20, 0xa5, 0x5d, 0xdb, 0x5f, 0x8d, 0x87, 0x98, 0x26, 0x38, 0x16, 0x30, 0x33, 0x3b,
+	0x33, 0x90, 0xc4, 0xa4, 0x2a, 0xe5, 0xaa, 0x54, 0xb9, 0x52, 0x3e, 0xe5, 0x96, 0xaa, 0x1c, 0xed,
+	0xaa, 0xe4, 0x90, 0x4b, 0x72, 0xc8, 0x29, 0xc9, 0x21, 0x29, 0x57, 0xca, 0x55, 0x89, 0xab, 0x72,
+	0x4a, 0x39, 0xf1, 0x25, 0xde, 0xe4, 0x94, 0x43, 0x2a, 0xe7, 0x9c, 0x52, 0xfd, 0x33, 0x83, 0x9e,
+	0x3f, 0x60, 0xc0, 0x95, 0xbd, 0x9b, 0x13, 0xa6, 0x5f, 0xbf, 0xf7, 0xfa, 0x75, 0xf7, 0x9b, 0xd7,
+	0xaf, 0xdf, 0x7b, 0x03, 0x28, 0x69, 0xb6, 0xb1, 0x66, 0x3b, 0x96, 0x67, 0xa1, 0xaa, 0x33, 0x30,
+	0x3d, 0xa3, 0x8f, 0xd7, 0x5e, 0xdd, 0xd4, 0x7a, 0xf6, 0xa1, 0xb6, 0x5e, 0xbf, 0xde, 0x35, 0xbc,
+	0xc3, 0xc1, 0xfe, 0x5a, 0xc7, 0xea, 0xdf, 0xe8, 0x5a, 0x5d, 0xeb, 0x06, 0x45, 0xdc, 0x1f, 0x1c,
+	0xd0, 0x16, 0x6d, 0xd0, 0x27, 0xc6, 0x40, 0xbe, 0x06, 0xf3, 0xcf, 0xb1, 0xe3, 0x1a, 0x96, 0xa9,
+	0xe0, 0xcf, 0x06, 0xd8, 0xf5, 0x50, 0x0d, 0x66, 0x5f, 0x31, 0x48, 0x4d, 0x3a, 0x27, 0x5d, 0x29,
+	0x29, 0x7e, 0x53, 0xfe, 0x53, 0x09, 0x16, 0x02, 0x64, 0xd7, 0xb6, 0x4c, 0x17, 0xa7, 0x63, 0xa3,
+	0xf3, 0x30, 0xc7, 0x85, 0x53, 0x4d, 0xad, 0x8f, 0x6b, 0x39, 0xda, 0x5d, 0xe6, 0xb0, 0xa6, 0xd6,
+	0xc7, 0xe8, 0x32, 0x2c, 0xf8, 0x28, 0x3e, 0x93, 0x3c, 0xc5, 0x9a, 0xe7, 0x60, 0x3e, 0x1a, 0x5a,
+	0x83, 0x25, 0x1f, 0x51, 0xb3, 0x8d, 0x00, 0xb9, 0x40, 0x91, 0x17, 0x79, 0xd7, 0x86, 0x6d, 0x70,
+	0x7c, 0xf9, 0xbb, 0x50, 0xda, 0x6a, 0xb6, 0x37, 0x2d, 0xf3, 0xc0, 0xe8, 0x12, 0x11, 0x5d, 0xec,
+	0x10, 0x9a, 0x9a, 0x74, 0x2e, 0x4f, 0x44, 0xe4, 0x4d, 0x54, 0x87, 0xa2, 0x8b, 0x35, 0xa7, 0x73,
+	0x88, 0xdd, 0x5a, 0x8e, 0x76, 0x05, 0x6d, 0x42, 0x65, 0xd9, 0x9e, 0x61, 0x99, 0x6e, 0x2d, 0xcf,
+	0xa8, 0x78, 0x53, 0xfe, 0x89, 0x04, 0xe5, 0x96, 0xe5, 0x78, 0xcf, 0x34, 0xdb, 0x36, 0xcc, 0x2e,
+	0xba, 0x05, 0x45, 0xba, 0x96, 0x1d, 0xab, 0x47, 0xd7, 0x60, 0x7e, 0xbd, 0xbe, 0x16, 0xdd, 0x96,
+	0xb5, 0x16, 0xc7, 0x50, 0x02, 0x5c, 0xf4, 0x2e, 0xcc, 0x77, 0x2c, 0xd3, 0xd3, 0x0c, 0x13, 0x3b,
+	0xaa, 0x6d, 0x39, 0x1e, 0x5d, 0xa2, 0x69, 0xa5, 0x12, 0x40, 0xc9, 0x28, 0xe8, 0x14, 0x94, 0x0e,
+	0x2d, 0xd7, 0x63, 0x18, 0x79, 0x8a, 0x51, 0x24, 0x00, 0xda, 0xb9, 0x0a, 0xb3, 0xb4, 0xd3, 0xb0,
+	0xf9, 0x62, 0xcc, 0x90, 0x66, 0xc3, 0x96, 0xff, 0x53, 0x82, 0xe9, 0x67, 0xd6, 0xc0, 0xf4, 0x22,
+	0xc3, 0x68, 0xde, 0x21, 0xdf, 0x28, 0x61, 0x18, 0xcd, 0x3b, 0x1c, 0x0e, 0x43, 0x30, 0xd8, 0x5e,
+	0xb1, 0x61, 0x48, 0x67, 0x1d, 0x8a, 0x0e, 0xd6, 0x74, 0xcb, 0xec, 0x1d, 0x51, 0x11, 0x8a, 0x4a,
+	0xd0, 0x26, 0x9b, 0xe8, 0xe2, 0x9e, 0x61, 0x0e, 0xde, 0xa8, 0x0e, 0xee, 0x69, 0xfb, 0xb8, 0x47,
+	0x45, 0x29, 0x2a, 0xf3, 0x1c, 0xac, 0x30, 0x28, 0xda, 0x82, 0xb2, 0xed, 0x58, 0xb6, 0xd6, 0xd5,
+	0xc8, 0x3a, 0xd6, 0xa6, 0xe9, 0x52, 0xc9, 0xf1, 0xa5, 0xa2, 0x62, 0xb7, 0x86, 0x98, 0x8a, 0x48,
+	0x86, 0x10, 0x14, 0xa8, 0x3a, 0xe9, 0x54, 0x44, 0xfa, 0x2c, 0xff, 0x8f, 0x04, 0x0b, 0x44, 0xa1,
+	0x5c, 0x5b, 0xeb, 0xe0, 0x1d, 0xba, 0x4d, 0xe8, 0x36, 0xcc, 0x9a, 0xd8, 0x7b, 0x6d, 0x39, 0x2f,
+	0xf9, 0xa6, 0x9c, 0x8d, 0x8f, 0x14, 0xd0, 0x3c, 0xb3, 0x74, 0xac, 0xf8, 0xf8, 0xe8, 0x26, 0xe4,
+	0x6d, 0x43, 0xaf, 0xe5, 0xb2, 0x91, 0x11, 0x5c, 0x42, 0x62, 0xd8, 0x9d, 0x5a, 0x3e, 0x23, 0x89,
+	0x61, 0x77, 0xd0, 0x23, 0x98, 0x1f, 0xb8, 0xd8, 0x31, 0x5d, 0xd5, 0xd7, 0x33, 0xb2, 0x22, 0xe5,
+	0x24, 0xea, 0x3d, 0x17, 0x3b, 0x01, 0x07, 0xa5, 0xc2, 0xc8, 0x76, 0xb8, 0x3a, 0xaa, 0x50, 0x6a,
+	0x6c, 0xf9, 0xba, 0x18, 0xe8, 0x83, 0x4e, 0x67, 0x5d, 0xe1, 0xfa, 0xa0, 0x93, 0xb7, 0x71, 0xa8,
+	0x05, 0x7c, 0x72, 0x15, 0xa5, 0x1c, 0xc0, 0x1a, 0x3a, 0x5a, 0x81, 0x99, 0x1e, 0x36, 0xbb, 0xde,
+	0x21, 0x9d, 0x46, 0x45, 0xe1, 0x2d, 0xf9, 0x4f, 0x24, 0xa8, 0x84, 0x24, 0x40, 0x1f, 0x40, 0xa1,
+	0x6f, 0xe9, 0x38, 0xeb, 0xc2, 0x52, 0x64, 0x74, 0x03, 0x0a, 0x03, 0x43, 0x67, 0x2f, 0x5a, 0x79,
+	0xfd, 0x54, 0x9c, 0x28, 0x98, 0x85, 0x42, 0x11, 0x09, 0x41, 0xd7, 0xd0, 0xd9, 0xeb, 0x37, 0x8e,
+	0x80, 0x20, 0xca, 0x32, 0x40, 0xc3, 0xf4, 0x6e, 0x7d, 0xfd, 0xb9, 0xd6, 0x1b, 0x60, 0xb4, 0x0c,
+	0xd3, 0xaf, 0xc8, 0x03, 0x95, 0x32, 0xaf, 0xb0, 0x86, 0xfc, 0xeb, 0x3c, 0x9c, 0x7a, 0x4a, 0xb4,
+	0xb2, 0xad, 0x99, 0xfa, 0xbe, 0xf5, 0xa6, 0x8d, 0x3b, 0x03, 0xc7, 0xf0, 0x8e, 0x36, 0x2d, 0xd3,
+	0xc3, 0x6f, 0x3c, 0xd4, 0x84, 0x45, 0xd3, 0x17, 0x3e, 0xd8, 0x18, 0x89, 0x6e, 0xcc, 0xf9, 0x11,
+	0xf3, 0x64, 0x9b, 0xa1, 0x54, 0xcd, 0x30, 0xc0, 0x45, 0x4f, 0x86, 0x6f, 0x87, 0xcf, 0x2d, 0x97,
+	0xb6, 0xcd, 0xed, 0x6d, 0x2a, 0x19, 0xe7, 0xe5, 0xbf, 0x3e, 0x3e, 0xa7, 0xbb, 0x40, 0x6c, 0xa7,
+	0xaa, 0xb9, 0x2a, 0xd9, 0x7f, 0xba, 0x47, 0xe5, 0xf5, 0x77, 0x12, 0x56, 0x25, 0x58, 0x02, 0xa5,
+	0xe4, 0x0c, 0xcc, 0x0d, 0x97, 0xec, 0x1d, 0x35, 0xb5, 0xfc, 0x8d, 0x55, 0x1d, 0xcb, 0xf2, 0x0e,
+	0x5c, 0xff, 0x2d, 0xf5, 0xc1, 0x0a, 0x85, 0xa2, 0x1b, 0xb0, 0xe4, 0x0e, 0x6c, 0xbb, 0x87, 0xfb,
+	0xd8, 0xf4, 0xb4, 0x9e, 0xda, 0x75, 0xac, 0x81, 0x4d, 0x74, 0x33, 0x7f, 0x25, 0xaf, 0x20, 0xb1,
+	0xeb, 0x31, 0xed, 0x41, 0x67, 0x00, 0x6c, 0xc7, 0x78, 0x65, 0xf4, 0x70, 0x17, 0xeb, 0xb5, 0x19,
+	0xca, 0x54, 0x80, 0xa0, 0xf7, 0x61, 0xd9, 0xc5, 0x9d, 0x8e, 0xd5, 0xb7, 0x55, 0xdb, 0xb1, 0x0e,
+	0x8c, 0x1e, 0x66, 0x36, 0x66, 0x96, 0xbe, 0xc0, 0x88, 0xf7, 0xb5, 0x58, 0x17, 0xb5, 0x36, 0xf7,
+	0x60, 0x8e, 0xcf, 0x94, 0x0e, 0x5e, 0x2b, 0x66, 0x98, 0x2a, 0xd0, 0xa9, 0x52, 0x91, 0xe4, 0x9f,
+	0xe4, 0xe0, 0x04, 0x5d, 0xc9, 0x96, 0xa5, 0xf3, 0x6d, 0xe6, 0x47, 0xc1, 0x05, 0xa8, 0x74, 0x28,
+	0x4f, 0xd5, 0xd6, 0x1c, 0x6c, 0x7a, 0xdc, 0x14, 0xce, 0x31, 0x60, 0x8b, 0xc2, 0xd0, 0x27, 0x50,
+	0x75, 0xb9, 0x56, 0xa8, 0x1d, 0xa6, 0x16, 0x7c, 0xcf, 0xae, 0xc7, 0x45, 0x18, 0xa1, 0x4b, 0xca,
+	0x82, 0x1b, 0x53, 0xae, 0x59, 0xf7, 0xc8, 0xed, 0x78, 0x3d, 0x5f, 0xa9, 0xbf, 0x9e, 0xc2, 0x30,
+	0x2a, 0xf8, 0x5a, 0x9b, 0x91, 0x6d, 0x9b, 0x9e, 0x73, 0xa4, 0xf8, 0x4c, 0xea, 0x77, 0x60, 0x4e,
+	0xec, 0x40, 0x55, 0xc8, 0xbf, 0xc4, 0x47, 0x7c, 0x52, 0xe4, 0x71, 0xf8, 0x12, 0x30, 0x8b, 0xce,
+	0x1a, 0x77, 0x72, 0x1f, 0x49, 0xb2, 0x03, 0x68, 0x38, 0xca, 0x33, 0xec, 0x69, 0xba, 0xe6, 0x69,
+	0x81, 0x75, 0x95, 0x86, 0xd6, 0x95, 0x70, 0x1d, 0x70, 0x8b, 0x51, 0x52, 0xc8, 0x23, 0x7a, 0x07,
+	0x4a, 0x81, 0xa2, 0xf3, 0x13, 0x7b, 0x08, 0x20, 0x27, 0xa7, 0xe6, 0x79, 0xb8, 0x6f, 0x7b, 0x54,
+	0xc5, 0x2a, 0x8a, 0xdf, 0x94, 0xff, 0xab, 0x00, 0xd5, 0xd8, 0x9e, 0x3c, 0x80, 0x62, 0x9f, 0x0f,
+	0xcf, 0x5f, 0xb4, 0x8b, 0x09, 0xc7, 0x67, 0x4c, 0x54, 0x25, 0xa0, 0x22, 0xa7, 0x13, 0xb1, 0x72,
+	0x82, 0x97, 0x11, 0xb4, 0xc9, 0x8e, 0xf7, 0xac, 0xae, 0xaa, 0x1b, 0x0e, 0xee, 0x78, 0x96, 0x73,
+	0xc4, 0xc5, 0x9d, 0xeb, 0x59, 0xdd, 0x2d, 0x1f, 0x86, 0xee, 0x00, 0xe8, 0xa6, 0x4b, 0x36, 0xfb,
+	0xc0, 0xe8, 0x52, 0xa1, 0x13, 0xed, 0x4d, 0xe0, 0x52, 0x28, 0x25, 0xdd, 0x74, 0xb9, 0xf8, 0x0f,
+	0xa1, 0x42, 0x4e, 0x66, 0xb5, 0xcf, 0x4c, 0x11, 0x7b, 0x53, 0xca, 0xeb, 0xa7, 0x93, 0xe6, 0x10,
+	0xf8, 0x0c, 0xca, 0x9c, 0x3d, 0x6c, 0xb8, 0xe8, 0x11, 0xcc, 0xd0, 0x23, 0xd2, 0xad, 0xcd, 0x50,
+	0xe2, 0xb5, 0x51, 0x0b, 0xc0, 0x35, 0xe2, 0x29, 0x25, 0x60, 0x0a, 0xc1, 0xa9, 0xd1, 0x1e, 0x94,
+	0x35, 0xd3, 0xb4, 0x3c, 0x8d, 0x19, 0x9a, 0x59, 0xca, 0xec, 0x83, 0x0c, 0xcc, 0x36, 0x86, 0x54,
+	0x8c, 0xa3, 0xc8, 0x07, 0x7d, 0x13, 0xa6, 0xa9, 0x25, 0xe2, 0x2f, 0xe2, 0xe5, 0x8c, 0x4a, 0xab,
+	0x30, 0xaa, 0xfa, 0x6d, 0x28, 0x0b, 0xc2, 0x4e, 0xa2, 0xa4, 0xf5, 0x7b, 0x50, 0x8d, 0x8a, 0x36,
+	0x91, 0x92, 0xff, 0x2e, 0x2c, 0x2b, 0x03, 0x73, 0x28, 0x98, 0xef, 0xe3, 0xde, 0x81, 0x19, 0xbe,
+	0xd9, 0x4c, 0xe3, 0xe4, 0xf1, 0x6b, 0xa4, 0x70, 0x0a, 0xd1, 0x69, 0x3d, 0xd4, 0x4c, 0xbd, 0x87,
+	0x9d, 0x5a, 0x2e, 0xe4, 0xb4, 0x3e, 0x61, 0x50, 0xf9, 0x9b, 0x70, 0x22, 0x32, 0x38, 0xf7, 0x99,
+	0x2f, 0xc2, 0xbc, 0x6d, 0xe9, 0xaa, 0xcb, 0xc0, 0xfe, 0x59, 0x5d, 0x22, 0x4a, 0xe1, 0xe3, 0x36,
+	0x74, 0x42, 0xde, 0xf6, 0x2c, 0x3b, 0x2e, 0x7c, 0x36, 0xf2, 0x1a, 0xac, 0x44, 0xc9, 0xd9, 0xf0,
+	0xf2, 0x7d, 0x58, 0x55, 0x70, 0xdf, 0x7a, 0x85, 0x8f, 0xcb, 0xba, 0x0e, 0xb5, 0x38, 0x03, 0xce,
+	0xfc, 0x53, 0x58, 0x1d, 0x42, 0xdb, 0x9e, 0xe6, 0x0d, 0xdc, 0x89, 0x98, 0xf3, 0x0b, 0xc5, 0xbe,
+	0xe5, 0xb2, 0xed, 0x2c, 0x2a, 0x7e, 0x53, 0x36, 0x44, 0xd6, 0x4d, 0xe6, 0xab, 0xb1, 0x11, 0xd0,
+	0x3c, 0xe4, 0x0c, 0x9b, 0xb3, 0xcb, 0x19, 0x36, 0xba, 0x07, 0xf3, 0x9a, 0xae, 0x1b, 0x44, 0x6b,
+	0xb4, 0x9e, 0x6a, 0xd8, 0xbe, 0xd7, 0xb1, 0x9a, 0xb8, 0xcf, 0x8d, 0x96, 0x52, 0x19, 0xa2, 0x37,
+	0x6c, 0x57, 0x5e, 0x85, 0x69, 0x0a, 0x8f, 0x32, 0x96, 0x9f, 0x40, 0x69, 0xe8, 0x06, 0x7d, 0x3c,
+	0xbc, 0x22, 0xe4, 0xb2, 0x7a, 0x08, 0xc1, 0x2d, 0x62, 0x37, 0x76, 0x46, 0xf1, 0xb9, 0x7c, 0x0c,
+	0x10, 0xd8, 0x52, 0xdf, 0xf5, 0x38, 0x35, 0x82, 0xb1, 0x22, 0xa0, 0xcb, 0xff, 0x1a, 0xb2, 0xb0,
+	0xc2, 0xea, 0xe8, 0xc1, 0x24, 0xf4, 0x90, 0xc5, 0xcd, 0x1d, 0xcb, 0xe2, 0x7e, 0x08, 0xd3, 0xae,
+	0xa7, 0x79, 0x98, 0x3b, 0xbc, 0xe7, 0x47, 0x91, 0x13, 0x21, 0xb0, 0xc2, 0xf0, 0xd1, 0x69, 0x80,
+	0x8e, 0x83, 0x35, 0x0f, 0xeb, 0xaa, 0xc6, 0x8e, 0x87, 0xbc, 0x52, 0xe2, 0x90, 0x0d, 0x0f, 0x6d,
+	0x0e, 0x9d, 0x76, 0xe6, 0x0c, 0x5f, 0x1d, 0xc5, 0x39, 0xa4, 0x03, 0x43, 0xf7, 0x3d, 0x30, 0x57,
+	0x33, 0x19, 0xcd, 0x15, 0x67, 0xc0, 0xa8, 0x04, 0x63, 0x3c, 0x3b, 0xde, 0x18, 0x33, 0xd2, 0x2c,
+	0xc6, 0xb8, 0x38, 0xde, 0x18, 0x73, 0x66, 0x23, 0x8d, 0xf1, 0x97, 0x69, 0x4d, 0xff, 0x45, 0x82,
+	0x5a, 0xfc, 0xe5, 0xe6, 0x46, 0xed, 0x0e, 0xcc, 0xb8, 0x14, 0x92, 0xc5, 0xa4, 0x72, 0x5a, 0x4e,
+	0x81, 0x9e, 0x40, 0xc1, 0x30, 0x0f, 0xac, 0x5a, 0x2e, 0xcd, 0x29, 0x4a, 0x1b, 0x75, 0xad, 0x61,
+	0x1e, 0x58, 0x6c, 0x91, 0x28, 0x87, 0xfa, 0x87, 0x50, 0x0a, 0x40, 0x13, 0xcd, 0x6d, 0x07, 0x96,
+	0x23, 0x2a, 0xcb, 0x6e, 0x11, 0x81, 0xa6, 0x4b, 0x93, 0x69, 0xba, 0xfc, 0xc3, 0x9c, 0xf8, 0x26,
+	0x3e, 0x32, 0x7a, 0x1e, 0x76, 0x62, 0x6f, 0xe2, 0x5d, 0x9f, 0x3b, 0x7b, 0x0d, 0x2f, 0x8d, 0xe5,
+	0xce, 0x9c, 0x5d, 0xfe, 0x32, 0x7d, 0x0f, 0xe6, 0xa9, 0xae, 0xa9, 0x2e, 0xee, 0x51, 0x4f, 0x86,
+	0x7b, 0x95, 0xdf, 0x18, 0xc5, 0x86, 0x49, 0xc2, 0x34, 0xb6, 0xcd, 0xe9, 0xd8, 0x0a, 0x56, 0x7a,
+	0x22, 0xac, 0xfe, 0x00, 0x50, 0x1c, 0x69, 0xa2, 0x35, 0x6d, 0x13, 0x13, 0xe7, 0x7a, 0xc3, 0xb1,
+	0x85, 0xe3, 0xf7, 0x80, 0x8a, 0x91, 0x45, 0x57, 0x98, 0xc0, 0x0a, 0xa7, 0x90, 0x7f, 0x96, 0x07,
+	0x18, 0x76, 0xfe, 0x1f, 0xb2, 0x6d, 0x0f, 0x02, 0xbb, 0xc2, 0x3c, 0xc4, 0x2b, 0xa3, 0x18, 0x27,
+	0x5a, 0x94, 0x9d, 0xb0, 0x45, 0x61, 0xbe, 0xe2, 0xf5, 0x91, 0x6c, 0xbe, 0xb2, 0xb6, 0xe4, 0x29,
+	0xac, 0x44, 0x75, 0x83, 0x1b, 0x92, 0x75, 0x98, 0x36, 0x3c, 0xdc, 0x67, 0xc1, 0xba, 0xc4, 0x6b,
+	0x9f, 0x40, 0xc4, 0x50, 0xe5, 0xf3, 0x50, 0x6a, 0xf4, 0xb5, 0x2e, 0x6e, 0xdb, 0xb8, 0x43, 0x06,
+	0x35, 0x48, 0x83, 0x0b, 0xc2, 0x1a, 0xf2, 0x3a, 0x14, 0xbf, 0x8d, 0x8f, 0xd8, 0x4b, 0x9d, 0x51,
+	0x50, 0xf9, 0xef, 0x8b, 0xb0, 0x4a, 0xcf, 0x8a, 0x4d, 0x3f, 0x4c, 0xa2, 0x60, 0xd7, 0x1a, 0x38,
+	0x1d, 0xec, 0xd2, 0xdd, 0xb6, 0x07, 0xaa, 0x8d, 0x1d, 0xc3, 0xd2, 0x79, 0x8c, 0xa1, 0xd4, 0xb1,
+	0x07, 0x2d, 0x0a, 0x20, 0xe1, 0x34, 0xd2, 0xfd, 0xd9, 0xc0, 0xe2, 0x8a, 0x98, 0x57, 0x8a, 0x1d,
+	0x7b, 0xf0, 0xff, 0x48, 0xdb, 0xa7, 0x75, 0x0f, 0x35, 0x07, 0xbb, 0xb5, 0x7c, 0x40, 0xdb, 0xa6,
+	0x00, 0x74, 0x13, 0x4e, 0xf4, 0x71, 0xdf, 0x72, 0x8e, 0xd4, 0x9e, 0xd1, 0x37, 0x3c, 0xd5, 0x30,
+	0xd5, 0xfd, 0x23, 0x0f, 0xbb, 0x5c, 0xa7, 0x10, 0xeb, 0x7c, 0x4a, 0xfa, 0x1a, 0xe6, 0x43, 0xd2,
+	0x83, 0x64, 0xa8, 0x58, 0x56, 0x5f, 0x75, 0x3b, 0x96, 0x83, 0x55, 0x4d, 0xff, 0x01, 0x3d, 0x3e,
+	0xf3, 0x4a, 0xd9, 0xb2, 0xfa, 0x6d, 0x02, 0xdb, 0xd0, 0x7f, 0x80, 0xce, 0x42, 0xb9, 0x63, 0x0f,
+	0x5c, 0xec, 0xa9, 0xe4, 0x87, 0x9e, 0x8e, 0x25, 0x05, 0x18, 0x68, 0xd3, 0x1e, 0xb8, 0x02, 0x42,
+	0x9f, 0xac, 0xff, 0xac, 0x88, 0xf0, 0x0c, 0xf7, 0x5d, 0xf4, 0x02, 0x40, 0x37, 0xdc, 0x97, 0x7c,
+	0x56, 0x3a, 0xdd, 0x9f, 0x8f, 0x52, 0x8e, 0xd7, 0xf8, 0x92, 0xad, 0x6d, 0x19, 0xee, 0x4b, 0xba,
+	0x00, 0x4c, 0x15, 0x4b, 0xba, 0xdf, 0x26, 0xd1, 0xa9, 0xfd, 0xde, 0x4b, 0xc3, 0x52, 0x5f, 0x63,
+	0xa3, 0x7b, 0xe8, 0xd5, 0x30, 0x8b, 0x4e, 0x51, 0xd8, 0x0b, 0x0a, 0x42, 0x4d, 0x58, 0x12, 0x51,
+	0x54, 0x1d, 0xbf, 0x32, 0x3a, 0xb8, 0x76, 0x40, 0x85, 0x38, 0x13, 0x17, 0x82, 0x91, 0x6d, 0x51,
+	0x2c, 0x65, 0x51, 0xe0, 0xc4, 0x40, 0xa8, 0x0d, 0x27, 0x18, 0x3f, 0xc6, 0x48, 0x25, 0x61, 0x10,
+	0x75, 0xdf, 0x76, 0x6b, 0x5d, 0xca, 0xf1, 0x5c, 0x9c, 0xe3, 0xee, 0xa1, 0x63, 0x79, 0x5e, 0x0f,
+	0x73, 0x9e, 0x88, 0x92, 0xf3, 0x06, 0xd6, 0xf4, 0x87, 0x36, 0x39, 0xf3, 0x57, 0x42, 0x4c, 0x5f,
+	0x3b, 0x86, 0x87, 0x29, 0xd7, 0xc3, 0x8c, 0x5c, 0x97, 0x04, 0xae, 0x2f, 0x08, 0x75, 0x12, 0x5b,
+	0x2a, 0x6b, 0x63, 0xc7, 0x76, 0x6b, 0xc6, 0x31, 0xd8, 0x12, 0x61, 0x09, 0x31, 0x7a, 0x01, 0xab,
+	0x09, 0xd2, 0x52, 0xbe, 0x3f, 0xc8, 0xc8, 0x77, 0x39, 0x2a, 0x2e, 0x65, 0x7c, 0x01, 0x2a, 0x2f,
+	0xb1, 0x63, 0xe2, 0x9e, 0xca, 0x54, 0xb5, 0xf6, 0x92, 0x6a, 0xe3, 0x1c, 0x03, 0x3e, 0xa3, 0x30,
+	0x74, 0x1d, 0xb8, 0x22, 0xab, 0x0e, 0x26, 0x01, 0x79, 0x16, 0x15, 0xee, 0x51, 0xcc, 0x45, 0xd6,
+	0xa3, 0x0c, 0x3b, 0x50, 0x03, 0x38, 0x50, 0x75, 0x5f, 0xd3, 0x7b, 0x33, 0x76, 0xdd, 0x5a, 0x3f,
+	0x43, 0x64, 0xa8, 0xca, 0xc8, 0xda, 0x01, 0x15, 0x5a, 0x87, 0xd9, 0x01, 0x7d, 0xb3, 0xdc, 0x9a,
+	0x49, 0xe7, 0x59, 0x4b, 0x08, 0xb9, 0x52, 0x04, 0xc5, 0x47, 0xac, 0xdf, 0x85, 0xf9, 0xb0, 0xfa,
+	0x4e, 0x64, 0xed, 0xee, 0xc0, 0x5c, 0x48, 0xf9, 0x10, 0x14, 0x84, 0x48, 0x3c, 0x7d, 0x26, 0xe1,
+	0x57, 0x86, 0xc3, 0x63, 0xb3, 0xbc, 0x25, 0x7f, 0x04, 0xf3, 0xe1, 0x45, 0x4f, 0xa4, 0x46, 0x50,
+	0x70, 0x7c, 0x47, 0xa2, 0xa0, 0xd0, 0x67, 0x79, 0x0b, 0x66, 0xd8, 0x34, 0x12, 0xc3, 0x3a, 0x08,
+	0x0a, 0x87, 0x9a, 0xa3, 0x73, 0xe3, 0x44, 0x9f, 0x09, 0xcc, 0xb5, 0x0e, 0x3c, 0x6e, 0x92, 0xe8,
+	0xb3, 0xac, 0x41, 0x25, 0x14, 0x98, 0x24, 0x48, 0x34, 0x02, 0xc9, 0x99, 0x91, 0x67, 0x3a, 0xbc,
+	0xd5, 0xf3, 0x67, 0x4e, 0x9f, 0x09, 0xcc, 0x3b, 0xb2, 0xfd, 0x00, 0x11, 0x7d, 0x26, 0x4b, 0xd4,
+	0xc3, 0xaf, 0x78, 0x8a, 0xa0, 0xa4, 0xb0, 0x86, 0xac, 0x03, 0x6c, 0x6a, 0xb6, 0xb6, 0x6f, 0xf4,
+	0x0c, 0xef, 0x08, 0x5d, 0x85, 0xaa, 0xa6, 0xeb, 0x6a, 0xc7, 0x87, 0x18, 0xd8, 0x4f, 0xdc, 0x2c,
+	0x68, 0xba, 0xbe, 0x29, 0x80, 0xd1, 0xd7, 0x60, 0x51, 0x77, 0x2c, 0x3b, 0x8c, 0xcb, 0x32, 0x39,
+	0x55, 0xd2, 0x21, 0x22, 0xcb, 0xff, 0x31, 0x0d, 0xa7, 0xc3, 0xa6, 0x29, 0x1a, 0xfc, 0x7d, 0x00,
+	0x73, 0x91, 0x51, 0x53, 0xd4, 0x6b, 0x28, 0xad, 0x12, 0xa2, 0x88, 0x04, 0x43, 0x73, 0xb1, 0x60,
+	0x68, 0x62, 0x78, 0x39, 0xff, 0x56, 0xc3, 0xcb, 0x85, 0xb7, 0x12, 0x5e, 0x9e, 0x9e, 0x2c, 0xbc,
+	0x7c, 0x09, 0x16, 0x04, 0x6a, 0xaa, 0x6b, 0xec, 0x7c, 0xa9, 0x04, 0x38, 0xa6, 0x9f, 0xf1, 0x8b,
+	0x84, 0xa1, 0x67, 0x27, 0x09, 0x43, 0x17, 0x53, 0xc3, 0xd0, 0x44, 0x6b, 0x6c, 0x5b, 0x73, 0xfa,
+	0x96, 0xe3, 0xc7, 0x99, 0x6b, 0x25, 0x2a, 0xc2, 0x82, 0x0f, 0xe7, 0x31, 0xe6, 0xd4, 0x88, 0x34,
+	0xa4, 0x46, 0xa4, 0xcf, 0xc1, 0x9c, 0x69, 0xa9, 0x26, 0x7e, 0xad, 0x92, 0xbd, 0x74, 0x6b, 0x65,
+	0xb6, 0xb1, 0xa6, 0xd5, 0xc4, 0xaf, 0x5b, 0x04, 0x12, 0x8b, 0x59, 0xcf, 0x4d, 0x16, 0xb3, 0x26,
+	0x27, 0x60, 0x5f, 0x73, 0x5f, 0x62, 0x9d, 0x8a, 0xe2, 0xd6, 0x2a, 0x54, 0x89, 0xcb, 0x0c, 0x46,
+	0x64, 0x70, 0x49, 0x22, 0x2f, 0x58, 0x3b, 0x86, 0x34, 0x4f, 0x91, 0x2a, 0x3e, 0x94, 0xa2, 0xc9,
+	0x7f, 0x25, 0xc1, 0x72, 0x58, 0xcd, 0x79, 0xa4, 0xf2, 0x31, 0x94, 0x1c, 0xff, 0x2c, 0xae, 0x49,
+	0x69, 0xd7, 0xeb, 0x94, 0xc3, 0x5b, 0x19, 0xd2, 0xa2, 0xef, 0xa4, 0x06, 0xc8, 0x6f, 0x8c, 0xe3,
+	0x37, 0x2e, 0x44, 0x2e, 0x37, 0xe0, 0xec, 0x0b, 0xc3, 0xd4, 0xad, 0xd7, 0x6e, 0xea, 0x5b, 0x9a,
+	0xa0, 0x6b, 0x52, 0x82, 0xae, 0xc9, 0x7f, 0x2b, 0xc1, 0x4a, 0x94, 0x17, 0x5f, 0x8a, 0x46, 0x7c,
+	0x29, 0xbe, 0x96, 0xe0, 0x42, 0x44, 0x88, 0x13, 0x17, 0xe3, 0x7b, 0xa9, 0x8b, 0x71, 0x73, 0x3c,
+	0xc7, 0xb1, 0xcb, 0xf1, 0x67, 0x12, 0x9c, 0x4c, 0x15, 0x23, 0xe2, 0x47, 0x4a, 0x51, 0x3f, 0x92,
+	0xfb, 0xa0, 0x1d, 0x6b, 0x60, 0x7a, 0x82, 0x0f, 0xba, 0x49, 0xda, 0xdc, 0xd9, 0x53, 0xfb, 0xda,
+	0x1b, 0xa3, 0x3f, 0xe8, 0x73, 0x8b, 0x4f, 0xd8, 0x3d, 0x63, 0x90, 0x63, 0x78, 0xa1, 0xf2, 0x06,
+	0x2c, 0x06, 0x52, 0x8e, 0x4c, 0x29, 0x08, 0x29, 0x82, 0x5c, 0x38, 0x45, 0x60, 0xc2, 0x0c, 0x3f,
+	0xe5, 0xde, 0x46, 0xde, 0xfa, 0x1c, 0x94, 0x6d, 0xec, 0xf4, 0x0d, 0xd7, 0x0d, 0x0c, 0x6d, 0x49,
+	0x11, 0x41, 0xf2, 0x4f, 0x67, 0x61, 0x21, 0xaa, 0x1d, 0xf7, 0x63, 0x19, 0x89, 0x0b, 0x09, 0x47,
+	0x40, 0x74, 0xa2, 0xc2, 0x15, 0xf2, 0xa6, 0x7f, 0x03, 0xc9, 0xa5, 0x45, 0xef, 0x82, 0xdb, 0x0a,
+	0xbf, 0x9e, 0x90, 0x15, 0xe9, 0x58, 0xfd, 0xbe, 0x66, 0xea, 0x7e, 0xb9, 0x01, 0x6f, 0x92, 0xf5,
+	0xd3, 0x9c, 0x2e, 0x59, 0x76, 0x02, 0xa6, 0xcf, 0x64, 0xf3, 0x48, 0xa8, 0xcb, 0x30, 0x69, 0x66,
+	0x83, 0x1a, 0xeb, 0x92, 0x02, 0x1c, 0xb4, 0x65, 0x38, 0x68, 0x0d, 0x0a, 0xd8, 0x7c, 0xe5, 0xdf,
+	0x11, 0x13, 0xea, 0x11, 0xfc, 0xbb, 0x90, 0x42, 0xf1, 0xd0, 0x0d, 0x98, 0xe9, 0x13, 0xb5, 0xf0,
+	0x83, 0x5e, 0xab, 0x29, 0x69, 0x79, 0x85, 0xa3, 0x11, 0x1f, 0x8a, 0x79, 0x8d, 0x7e, 0x64, 0x2b,
+	0xc1, 0x87, 0xe2, 0x3e, 0xa2, 0x8f, 0x88, 0xb6, 0x83, 0x1b, 0x70, 0x29, 0xed, 0xea, 0x1a, 0xd9,
+	0x8a, 0xc4, 0x6b, 0xf0, 0x6e, 0xf8, 0x1a, 0x0c, 0x94, 0xd7, 0xfa, 0x78, 0x5e, 0xa3, 0x93, 0x1c,
+	0x27, 0xa1, 0x48, 0x12, 0x45, 0x54, 0x8d, 0xca, 0xac, 0x92, 0xa5, 0x67, 0x75, 0xa9, 0x16, 0x2d,
+	0x93, 0x88, 0x80, 0x6e, 0x98, 0xd4, 0xa8, 0x17, 0x15, 0xd6, 0x20, 0x2f, 0x1f, 0x7d, 0x50, 0x2d,
+	0xb3, 0x83, 0x6b, 0x15, 0xda, 0x55, 0xa2, 0x90, 0x1d, 0xb3, 0x43, 0xef, 0x98, 0x9e, 0x77, 0x54,
+	0x9b, 0xa7, 0x70, 0xf2, 0x48, 0x82, 0x3d, 0x2c, 0x2e, 0xb9, 0x90, 0x16, 0xec, 0x49, 0x32, 0xdb,
+	0x7e, 0x58, 0xf2, 0x21, 0xcc, 0xbe, 0x66, 0x86, 0xa0, 0x56, 0x3d, 0x27, 0x25, 0xc7, 0x0f, 0x92,
+	0xad, 0x9d, 0xe2, 0x13, 0x92, 0x43, 0xc6, 0xc4, 0x1e, 0x39, 0xc3, 0x2c, 0x62, 0x64, 0x68, 0x0d,
+	0x45, 0x5e, 0x29, 0x9b, 0xd8, 0x6b, 0x71, 0x10, 0x59, 0x06, 0x7a, 0xbb, 0x23, 0xe1, 0x79, 0xcc,
+	0x96, 0x81, 0xb6, 0x1b, 0xfa, 0x97, 0x19, 0x2d, 0xf8, 0x99, 0x04, 0x2b, 0x9b, 0x34, 0x92, 0x22,
+	0x58, 0xc1, 0x49, 0xb2, 0x0a, 0xb7, 0x83, 0x84, 0x4f, 0x6a, 0xa4, 0x3e, 0xba, 0x6a, 0x9c, 0x00,
+	0x35, 0x60, 0xde, 0x67, 0xce, 0x59, 0xe4, 0x33, 0xe7, 0x8c, 0x2a, 0xae, 0xd8, 0x94, 0xef, 0xc2,
+	0x6a, 0x6c, 0x16, 0x3c, 0xea, 0x11, 0xad, 0xcf, 0x60, 0x93, 0x10, 0xeb, 0x33, 0xe4, 0x3b, 0x24,
+	0x21, 0xa4, 0x39, 0x5e, 0x6c, 0x09, 0x32, 0xd0, 0xd2, 0x6c, 0x50, 0x98, 0x96, 0x27, 0x6c, 0xda,
+	0xb0, 0x4c, 0xf2, 0x44, 0xc7, 0x60, 0x4a, 0x6c, 0x16, 0x99, 0xbf, 0x35, 0xf0, 0x4f, 0x17, 0xbf,
+	0x29, 0xaf, 0xc2, 0x89, 0x08, 0x53, 0x3e, 0xda, 0xc7, 0xb0, 0xc2, 0x52, 0x47, 0xc7, 0x99, 0xc4,
+	0x49, 0x58, 0x8d, 0x11, 0x73, 0xbe, 0xcf, 0x60, 0x69, 0x78, 0xa8, 0x0e, 0xa3, 0xb7, 0xb7, 0xc2,
+	0xd1, 0xdb, 0x73, 0x23, 0x76, 0x3d, 0x14, 0xbc, 0xfd, 0x69, 0x4e, 0x38, 0x15, 0x52, 0x62, 0xb7,
+	0x1f, 0x87, 0x63, 0xb7, 0xef, 0x8e, 0xe3, 0x1d, 0x0a, 0xdd, 0xc6, 0xb5, 0x36, 0x9f, 0xa0, 0xb5,
+	0xdf, 0x8d, 0x05, 0x78, 0x0b, 0x69, 0x11, 0xf2, 0x88, 0xb4, 0xbf, 0x95, 0xf8, 0xae, 0xc2, 0xe2,
+	0xbb, 0xc1, 0xd0, 0x41, 0xa6, 0xef, 0x76, 0x24, 0xbe, 0x7b, 0x7e, 0xac, 0xbc, 0x41, 0x78, 0xf7,
+	0x2f, 0x0b, 0x50, 0x0a, 0xfa, 0x62, 0x6b, 0x1e, 0x5f, 0xb6, 0x5c, 0xc2, 0xb2, 0x89, 0xe7, 0x77,
+	0xfe, 0x0b, 0x9d, 0xdf, 0x85, 0xcc, 0xe7, 0xf7, 0x29, 0x28, 0xd1, 0x07, 0xd5, 0xc1, 0x07, 0xfc,
+	0x3c, 0x2e, 0x52, 0x80, 0x82, 0x0f, 0x86, 0x6a, 0x38, 0x33, 0x91, 0x1a, 0x46, 0x22, 0xca, 0xb3,
+	0xd1, 0x88, 0xf2, 0xfd, 0xe0, 0x3c, 0x65, 0x47, 0xf0, 0xe5, 0x11, 0x7c, 0x13, 0x4f, 0xd2, 0x66,
+	0xf8, 0x24, 0x65, 0xa7, 0xf2, 0x7b, 0xa3, 0xb8, 0x7c, 0x65, 0xe3, 0xc9, 0x7b, 0x2c, 0x9e, 0x2c,
+	0xea, 0x22, 0xb7, 0xac, 0x1f, 0x03, 0x04, 0x46, 0xc4, 0x0f, 0x2a, 0x9f, 0x1a, 0x31, 0x47, 0x45,
+	0x40, 0x27, 0x6c, 0x43, 0x5b, 0x33, 0x70, 0xb3, 0xdb, 0xab, 0x11, 0xa9, 0xec, 0x3f, 0x2f, 0xc2,
+	0x42, 0x84, 0x6f, 0x4c, 0xd7, 0xef, 0xc7, 0x32, 0x19, 0x13, 0x6a, 0xf1, 0xad, 0x70, 0x22, 0xe3,
+	0x98, 0x5a, 0x17, 0xcb, 0x63, 0x50, 0xbf, 0x47, 0x73, 0x78, 0x37, 0x8b, 0x33, 0x97, 0x38, 0x64,
+	0x83, 0xde, 0x2b, 0x0e, 0x0c, 0xd3, 0x70, 0x0f, 0x59, 0xff, 0x0c, 0xed, 0x07, 0x1f, 0xb4, 0x41,
+	0xeb, 0x59, 0xf1, 0x1b, 0xc3, 0x53, 0x3b, 0xa4, 0x82, 0x70, 0x96, 0xd5, 0xb3, 0x12, 0xc0, 0x26,
+	0x29, 0x12, 0x0c, 0xde, 0xbc, 0xe2, 0xf1, 0xde, 0xbc, 0x52, 0xe4, 0xcd, 0x5b, 0x81, 0x19, 0x07,
+	0x6b, 0xae, 0x65, 0xf2, 0xcb, 0x3d, 0x6f, 0x91, 0xad, 0xe9, 0x63, 0xd7, 0x25, 0x23, 0x71, 0x67,
+	0x8f, 0x37, 0x05, 0x27, 0x75, 0x6e, 0xac, 0x93, 0x3a, 0x22, 0xfb, 0x1b, 0x71, 0x52, 0x2b, 0x63,
+	0x9d, 0xd4, 0x2c, 0xc9, 0x5f, 0xc1, 0x4d, 0x9f, 0xcf, 0xe6, 0xa6, 0x8b, 0x5e, 0xed, 0x42, 0xd8,
+	0xab, 0x7d, 0x02, 0xb3, 0xaf, 0xac, 0xde, 0xa0, 0x8f, 0xdd, 0x9a, 0x9e, 0x96, 0xe8, 0x8e, 0x4a,
+	0xf7, 0x9c, 0x11, 0xf0, 0x32, 0x34, 0x4e, 0x1e, 0x0e, 0x2c, 0xe0, 0x2f, 0x10, 0x58, 0x10, 0x9d,
+	0xcf, 0x83, 0x90, 0xf3, 0x19, 0x5c, 0x68, 0xba, 0xd9, 0x2e, 0x34, 0x5f, 0xa2, 0x29, 0xaa, 0xef,
+	0xc2, 0x9c, 0xb8, 0x4e, 0x09, 0xb4, 0x6b, 0x22, 0x6d, 0xe2, 0xd5, 0x89, 0x31, 0x10, 0x0d, 0x5c,
+	0x11, 0x66, 0x18, 0x50, 0xfe, 0x67, 0x09, 0x56, 0x63, 0x46, 0x89, 0x1b, 0xbb, 0xdb, 0x91, 0x2c,
+	0xfc, 0xf9, 0xb1, 0x7b, 0x1a, 0x24, 0xe1, 0x1f, 0x87, 0x92, 0xf0, 0x1f, 0x8c, 0x27, 0x7c, 0xeb,
+	0x39, 0xf8, 0xbf, 0xce, 0xc1, 0xd9, 0x3d, 0x5b, 0x8f, 0xf8, 0xc7, 0x5c, 0x4d, 0xb2, 0x9b, 0xdd,
+	0xfb, 0xfe, 0x3d, 0x2b, 0x37, 0xa9, 0x2a, 0x32, 0x3a, 0xf4, 0x19, 0x54, 0x5d, 0x1b, 0x77, 0x54,
+	0xf1, 0x05, 0x66, 0xaf, 0xc8, 0xa3, 0x84, 0x44, 0xc1, 0x68, 0x81, 0xd7, 0x88, 0xa9, 0x8a, 0xbd,
+	0xd4, 0x0b, 0x6e, 0x18, 0x5a, 0x7f, 0x08, 0xcb, 0x49, 0x88, 0x13, 0x2d, 0x9f, 0x0c, 0xe7, 0xd2,
+	0x85, 0xe1, 0x7e, 0xf2, 0xf7, 0x61, 0x61, 0xfb, 0x0d, 0xee, 0xb4, 0x8f, 0xcc, 0xce, 0x04, 0x2b,
+	0x5a, 0x85, 0x7c, 0xa7, 0xaf, 0xf3, 0xc0, 0x3a, 0x79, 0x14, 0x5d, 0xff, 0x7c, 0xd8, 0xf5, 0x57,
+	0xa1, 0x3a, 0x1c, 0x81, 0x6b, 0xe5, 0x0a, 0xd1, 0x4a, 0x9d, 0x20, 0x13, 0xe6, 0x73, 0x0a, 0x6f,
+	0x71, 0x38, 0x76, 0x58, 0x05, 0x1d, 0x83, 0x63, 0xc7, 0x09, 0x1f, 0x11, 0xf9, 0xf0, 0x11, 0x21,
+	0xff, 0xb1, 0x04, 0x65, 0x32, 0xc2, 0x17, 0x92, 0x9f, 0xdf, 0xce, 0xf3, 0xc3, 0xdb, 0x79, 0x70,
+	0xc9, 0x2f, 0x88, 0x97, 0xfc, 0xa1, 0xe4, 0xd3, 0x14, 0x1c, 0x97, 0x7c, 0x26, 0x80, 0x63, 0xc7,
+	0x91, 0xcf, 0xc1, 0x1c, 0x93, 0x8d, 0xcf, 0x9c, 0xd4, 0xce, 0x3a, 0x3d, 0x7f, 0xff, 0x06, 0x4e,
+	0x4f, 0xfe, 0xb1, 0x04, 0x95, 0x0d, 0xcf, 0xd3, 0x3a, 0x87, 0x13, 0x4c, 0x20, 0x10, 0x2e, 0x27,
+	0x0a, 0x17, 0x9f, 0xc4, 0x50, 0xdc, 0x42, 0x8a, 0xb8, 0xd3, 0x21, 0x71, 0x65, 0x98, 0xf7, 0x65,
+	0x49, 0x15, 0xb8, 0x49, 0x0a, 0x85, 0x1d, 0xef, 0x91, 0xe5, 0xbc, 0xd6, 0x1c, 0x7d, 0xb2, 0x6b,
+	0x37, 0xc9, 0x54, 0xb1, 0x0f, 0x5b, 0xf2, 0x57, 0xa6, 0x15, 0xfa, 0x2c, 0x5f, 0x86, 0xa5, 0x10,
+	0xbf, 0xd4, 0x81, 0x1f, 0x40, 0x99, 0x1e, 0xf6, 0xfc, 0xfe, 0x75, 0x53, 0x4c, 0xeb, 0x67, 0x72,
+	0x0d, 0xe4, 0x6f, 0xc1, 0x22, 0x71, 0x0a, 0x29, 0x3c, 0xb0, 0x20, 0xdf, 0x88, 0x5c, 0x4e, 0x4e,
+	0xa7, 0x30, 0x8a, 0x5c, 0x4c, 0x7e, 0x91, 0x83, 0x69, 0x0a, 0x8f, 0x39, 0x6a, 0xa7, 0xc8, 0xf1,
+	0x67, 0x5b, 0xaa, 0xa7, 0x75, 0x83, 0xcf, 0x88, 0x08, 0x60, 0x57, 0xeb, 0xd2, 0x90, 0x0b, 0xed,
+	0xd4, 0x8d, 0x2e, 0x76, 0x3d, 0xff, 0x5b, 0xa2, 0x32, 0x81, 0x6d, 0x31, 0x10, 0x4d, 0xba, 0x19,
+	0xbf, 0xc3, 0x2e, 0x1b, 0x05, 0x85, 0x3e, 0xa3, 0x35, 0x56, 0x73, 0x9d, 0x25, 0x0b, 0x43, 0x10,
+	0x49, 0x09, 0x74, 0x24, 0xf1, 0x12, 0xb4, 0xd1, 0xbd, 0xe8, 0x41, 0x7f, 0x31, 0x65, 0xc6, 0xc9,
+	0xc7, 0xfb, 0x6f, 0xe8, 0x3c, 0xdb, 0x06, 0x24, 0xee, 0x0d, 0xd7, 0x82, 0x1b, 0x30, 0x43, 0xb7,
+	0xce, 0x77, 0xd4, 0x57, 0x53, 0x44, 0x55, 0x38, 0x9a, 0xac, 0x01, 0x62, 0xdb, 0x1e, 0x72, 0xce,
+	0x27, 0xd7, 0x95, 0x11, 0xce, 0xfa, 0xdf, 0x49, 0xb0, 0x14, 0x1a, 0x83, 0xcb, 0x7a, 0x3d, 0x3c,
+	0x48, 0xaa, 0xa8, 0x7c, 0x80, 0xcd, 0xd0, 0xf9, 0x7a, 0x23, 0x4d, 0xa4, 0xdf, 0xd0, 0xd9, 0xfa,
+	0x0b, 0x09, 0x60, 0x63, 0xe0, 0x1d, 0xf2, 0x10, 0xb7, 0xa8, 0x2f, 0x52, 0x44, 0x5f, 0xea, 0x50,
+	0xb4, 0x35, 0xd7, 0x7d, 0x6d, 0x39, 0xfe, 0xf5, 0x3a, 0x68, 0xd3, 0x60, 0xf4, 0x80, 0x7f, 0x21,
+	0x44, 0x82, 0xd1, 0x03, 0xef, 0x90, 0x04, 0xea, 0xd9, 0x07, 0x75, 0xaa, 0xa6, 0xeb, 0x0e, 0x49,
+	0xcb, 0xb3, 0xe4, 0x6e, 0x85, 0x41, 0x37, 0x18, 0x90, 0xa0, 0x19, 0x3a, 0x36, 0x3d, 0x92, 0x28,
+	0xf1, 0xac, 0x97, 0xd8, 0xe4, 0xd7, 0xe4, 0x8a, 0x0f, 0xdd, 0x25, 0x40, 0x96, 0xe5, 0xea, 0x1a,
+	0xae, 0xe7, 0xf8, 0x68, 0x7e, 0x22, 0x91, 0x43, 0x29, 0x1a, 0xd9, 0x94, 0x6a, 0x6b, 0xd0, 0xeb,
+	0xb1, 0x25, 0x3e, 0xfe, 0xb6, 0xbf, 0xcf, 0x27, 0x94, 0x4b, 0x7b, 0xd3, 0x86, 0x8b, 0xc6, 0xa7,
+	0xfb, 0x16, 0xe3, 0x81, 0xef, 0xc3, 0xa2, 0x30, 0x07, 0xae, 0x56, 0xa1, 0xfb, 0x8c, 0x14, 0xbe,
+	0xcf, 0xc8, 0x8f, 0x01, 0xb1, 0x10, 0xd8, 0x17, 0x9c, 0xb7, 0x7c, 0x02, 0x96, 0x42, 0x8c, 0xb8,
+	0x7f, 0x70, 0x0d, 0x2a, 0xbc, 0xaa, 0x96, 0x2b, 0xca, 0x49, 0x28, 0x12, 0x3b, 0xdf, 0x31, 0x74,
+	0x3f, 0xe1, 0x3f, 0x6b, 0x5b, 0xfa, 0xa6, 0xa1, 0x3b, 0xf2, 0x0b, 0xa8, 0x28, 0x6c, 0x1c, 0x8e,
+	0xfb, 0x08, 0xe6, 0x79, 0x0d, 0xae, 0x1a, 0xaa, 0xae, 0x4f, 0xfa, 0x40, 0x4c, 0x1c, 0x44, 0xa9,
+	0x98, 0x62, 0x53, 0xd6, 0xa1, 0xce, 0x1c, 0x99, 0x10, 0x7b, 0x7f, 0xb2, 0x8f, 0xc0, 0x2f, 0xb4,
+	0x1f, 0x3b, 0x4a, 0x98, 0xbe, 0xe2, 0x88, 0x4d, 0xf9, 0x34, 0x9c, 0x4a, 0x1c, 0x85, 0xaf, 0x84,
+	0x0d, 0xd5, 0x61, 0x07, 0xab, 0x0d, 0x0f, 0x2a, 0x1a, 0x24, 0xa1, 0xa2, 0x61, 0x25, 0xf0, 0xb8,
+	0x73, 0xfe, 0xd1, 0x4a, 0x5a, 0xc2, 0xcd, 0x33, 0x9f, 0x76, 0xf3, 0x2c, 0x84, 0x6e, 0x9e, 0x72,
+	0x3b, 0x58, 0x4f, 0x1e, 0x11, 0x78, 0x48, 0x23, 0x17, 0x6c, 0x6c, 0xdf, 0x20, 0xca, 0xa3, 0x66,
+	0xc9, 0x50, 0x15, 0x81, 0x4a, 0xbe, 0x0a, 0x95, 0xb0, 0x69, 0x14, 0xec, 0x9c, 0x14, 0xb3, 0x73,
+	0xf3, 0x11, 0x13, 0xf7, 0x61, 0xe4, 0x3a, 0x91, 0xbe, 0xc6, 0x91, 0xcb, 0xc4, 0xbd, 0x90, 0xb1,
+	0xbb, 0x16, 0x27, 0xfb, 0x4d, 0xd9, 0xb9, 0x65, 0x7e, 0x1e, 0x3c, 0x72, 0x09, 0x3d, 0x9f, 0xb4,
+	0x7c, 0x01, 0xca, 0x7b, 0x69, 0x9f, 0x06, 0x16, 0x38, 0xb9, 0x7c, 0x0b, 0x96, 0x1f, 0x19, 0x3d,
+	0xec, 0x1e, 0xb9, 0x1e, 0xee, 0x37, 0xa8, 0x51, 0x3a, 0x30, 0xb0, 0x43, 0x6a, 0x3a, 0xe8, 0x6d,
+	0xda, 0xb6, 0x8c, 0xe0, 0x8b, 0x31, 0x01, 0x42, 0x3e, 0xb5, 0x5d, 0x18, 0x12, 0xee, 0xd1, 0x28,
+	0xc2, 0x3b, 0x50, 0x22, 0xf3, 0x75, 0x3d, 0xad, 0x6f, 0xfb, 0x89, 0xd9, 0x00, 0x40, 0x42, 0xc7,
+	0x07, 0xae, 0x1f, 0xbd, 0x4c, 0xcc, 0x04, 0x25, 0x09, 0xa2, 0x14, 0x0e, 0xdc, 0x06, 0xa9, 0x19,
+	0x86, 0x81, 0x8b, 0x75, 0x9e, 0x8c, 0xcd, 0xa7, 0xf9, 0x30, 0x7b, 0x62, 0xa1, 0x06, 0x21, 0x60,
+	0x85, 0x82, 0xf7, 0xa0, 0x6c, 0x98, 0x96, 0x8e, 0x69, 0xf2, 0x5c, 0xaf, 0x15, 0xb2, 0x90, 0x03,
+	0xa3, 0xd8, 0x73, 0xb1, 0x2e, 0x63, 0x58, 0x0a, 0xad, 0x2f, 0x57, 0x94, 0x26, 0x2c, 0x32, 0xa3,
+	0x75, 0x10, 0x08, 0xee, 0x6b, 0xec, 0xf9, 0x51, 0xb3, 0xa3, 0xab, 0xa5, 0x54, 0x0d, 0xee, 0x70,
+	0xf9, 0xa4, 0x24, 0xd7, 0x11, 0xba, 0x6e, 0x4e, 0x70, 0xff, 0x93, 0x5b, 0x91, 0x98, 0xdd, 0x50,
+	0x9d, 0x79, 0x44, 0xcc, 0xd7, 0xe6, 0x71, 0x11, 0x31, 0x97, 0x45, 0xc4, 0x5c, 0xf9, 0xbb, 0x70,
+	0x32, 0x14, 0x5c, 0x0c, 0x49, 0x74, 0x2f, 0xe2, 0x4f, 0x5e, 0x1a, 0xc7, 0x35, 0xe2, 0x58, 0xfe,
+	0xb7, 0x04, 0xcb, 0x49, 0x08, 0xc7, 0x0c, 0x7e, 0x7f, 0x3f, 0xa5, 0x28, 0xfc, 0x76, 0x36, 0xb1,
+	0x7e, 0x2b, 0x89, 0x83, 0x5d, 0xa8, 0x27, 0xad, 0x67, 0x7c, 0x97, 0xf2, 0x93, 0xec, 0xd2, 0x8f,
+	0xf2, 0x42, 0x12, 0x68, 0xc3, 0xf3, 0x1c, 0x63, 0x7f, 0x40, 0x54, 0xfe, 0xad, 0x07, 0x56, 0x1b,
+	0x41, 0x88, 0x90, 0x2d, 0xed, 0xcd, 0x11, 0xe4, 0x43, 0x39, 0x12, 0xc3, 0x84, 0x9f, 0x84, 0xc3,
+	0x84, 0x2c, 0xbd, 0x73, 0x2b, 0x1b, 0xbf, 0xaf, 0x6c, 0x2c, 0xfe, 0x47, 0x39, 0x98, 0x0f, 0x6f,
+	0x11, 0xda, 0x06, 0xd0, 0x02, 0xc9, 0x6b, 0xd2, 0xd8, 0x8c, 0xd9, 0x70, 0x9a, 0x8a, 0x40, 0x88,
+	0xde, 0x83, 0x7c, 0xc7, 0x1e, 0xf0, 0x5d, 0x4b, 0x08, 0x02, 0x6e, 0xda, 0x03, 0x66, 0x51, 0x08,
+	0x1a, 0xb9, 0xe9, 0xf1, 0xfa, 0xd3, 0x54, 0x2b, 0xc9, 0x6a, 0x51, 0x19, 0x0d, 0x47, 0x46, 0x4f,
+	0x60, 0x9e, 0x54, 0xc2, 0x6a, 0xfb, 0x3d, 0xac, 0xf6, 0xb4, 0x23, 0xec, 0x70, 0x2b, 0x99, 0xc1,
+	0x90, 0x55, 0x7c, 0xc2, 0xa7, 0x84, 0x4e, 0xfe, 0x3d, 0x28, 0xfa, 0x12, 0x8d, 0x39, 0x11, 0x76,
+	0x61, 0x75, 0x40, 0xd0, 0x54, 0x5a, 0xc0, 0x6d, 0x6a, 0xa6, 0xa5, 0xba, 0x98, 0x1c, 0xe3, 0xfe,
+	0xa7, 0x65, 0x63, 0x4c, 0xf4, 0x32, 0xa5, 0xde, 0xb4, 0x1c, 0xdc, 0xd4, 0x4c, 0xab, 0xcd, 0x48,
+	0xe5, 0x57, 0x50, 0x16, 0x26, 0x38, 0x46, 0x84, 0x06, 0x2c, 0xfa, 0x35, 0x25, 0xa4, 0x04, 0x9c,
+	0x1d, 0x2f, 0x99, 0x06, 0x5f, 0xe0, 0x74, 0x6d, 0xec, 0xb1, 0x3a, 0xa0, 0x7b, 0xb0, 0x12, 0xfe,
+	0x46, 0x62, 0xb2, 0x6f, 0x00, 0x65, 0x25, 0xfa, 0x11, 0xa1, 0xe8, 0x91, 0x84, 0x4c, 0xf8, 0xd8,
+	0xaf, 0x33, 0x02, 0xeb, 0xf0, 0x8f, 0x12, 0x9c, 0x88, 0x74, 0xa5, 0xd8, 0x59, 0x2d, 0x66, 0x41,
+	0x99, 0x17, 0x73, 0x67, 0xec, 0x58, 0xbf, 0x45, 0x13, 0xfa, 0xff, 0x99, 0x09, 0x4d, 0x59, 0xe6,
+	0xfb, 0x91, 0x33, 0xe9, 0x72, 0x46, 0xd1, 0x83, 0x43, 0xe9, 0x39, 0x9c, 0x4a, 0x64, 0x1f, 0xdf,
+	0x85, 0xfc, 0x44, 0xbb, 0xf0, 0xe3, 0xbc, 0xf8, 0x9d, 0xd5, 0x08, 0x23, 0xfd, 0xc5, 0xbf, 0xe3,
+	0xf9, 0x56, 0xc4, 0x4a, 0xaf, 0x8f, 0xa2, 0x1f, 0x63, 0xa6, 0x3f, 0x4d, 0x32, 0xd3, 0x1f, 0x66,
+	0x64, 0xf8, 0x95, 0xb5, 0xd3, 0xbf, 0x92, 0x60, 0x21, 0xb2, 0x4f, 0xe8, 0x51, 0x82, 0xa1, 0xbe,
+	0x94, 0x6d, 0xa2, 0x21, 0x4b, 0x7d, 0x37, 0x1c, 0x84, 0xbf, 0x94, 0xe9, 0x23, 0x4c, 0x37, 0xa1,
+	0xd8, 0x29, 0x3f, 0xa6, 0xd8, 0x29, 0xca, 0xc1, 0x27, 0x94, 0xff, 0x22, 0xc7, 0xeb, 0x60, 0xa3,
+	0x53, 0xe4, 0x87, 0x88, 0x34, 0xe9, 0x21, 0x92, 0x9b, 0xe4, 0x10, 0xf9, 0x68, 0xf8, 0x25, 0x2b,
+	0x9b, 0xc1, 0x99, 0xd4, 0x4b, 0x30, 0x23, 0xf4, 0xd1, 0x09, 0xa5, 0xed, 0x58, 0x1d, 0x3f, 0x8e,
+	0x92, 0x48, 0xd9, 0x62, 0x08, 0x9c, 0x92, 0xa3, 0xa3, 0x07, 0xa1, 0x4c, 0xf7, 0x74, 0x46, 0xef,
+	0x49, 0xa0, 0x21, 0x65, 0x42, 0xc9, 0xcb, 0x2a, 0xff, 0x83, 0x04, 0x73, 0xa2, 0xbc, 0x63, 0xcf,
+	0xb3, 0x45, 0x1d, 0x1f, 0x68, 0x83, 0x1e, 0xa9, 0x1b, 0xf5, 0xb0, 0x73, 0xa0, 0x75, 0xfc, 0xe8,
+	0xe0, 0xe5, 0xd4, 0x85, 0x68, 0xf8, 0x98, 0xfc, 0x56, 0xc0, 0x39, 0x04, 0x60, 0xf4, 0x18, 0x20,
+	0xe0, 0xe6, 0xbf, 0xd6, 0x99, 0xd9, 0x09, 0xa4, 0xf2, 0x1f, 0xe5, 0xe0, 0x44, 0x22, 0x56, 0x62,
+	0xb5, 0xea, 0x47, 0x50, 0x74, 0xde, 0x4c, 0x72, 0x20, 0xce, 0x3a, 0x6f, 0xd8, 0x6d, 0xeb, 0x0e,
+	0x94, 0x9c, 0x37, 0x2a, 0x76, 0x1c, 0xcb, 0xc9, 0x78, 0x55, 0x2b, 0x3a, 0x6f, 0xb6, 0x29, 0x3a,
+	0x19, 0xd5, 0x7b, 0x23, 0x94, 0xdc, 0x8e, 0x1f, 0xd5, 0x1b, 0x8e, 0xea, 0x05, 0xa3, 0x4e, 0x67,
+	0x1a, 0xd5, 0xe3, 0xa3, 0xca, 0x36, 0xcc, 0x89, 0xca, 0x35, 0x66, 0x9b, 0xc9, 0x9f, 0x5f, 0x30,
+	0x6c, 0xa1, 0xca, 0x78, 0xec, 0x68, 0x73, 0x9c, 0x86, 0x16, 0x22, 0xcb, 0xf7, 0xe0, 0xa4, 0x82,
+	0x2d, 0x1b, 0x9b, 0x81, 0x5e, 0x3e, 0xb5, 0xba, 0x13, 0x5c, 0xf7, 0xde, 0x81, 0x7a, 0x12, 0x3d,
+	0x0f, 0xe3, 0xdc, 0x81, 0x13, 0x2d, 0x6d, 0xe0, 0xe2, 0x63, 0x16, 0xcd, 0x45, 0x69, 0x39, 0xd7,
+	0xbb, 0xb0, 0xba, 0x67, 0xda, 0xc7, 0xe5, 0x5b, 0x87, 0x5a, 0x9c, 0x9a, 0x73, 0xbe, 0xe5, 0xc7,
+	0xe5, 0x78, 0xc4, 0x9c, 0x73, 0x3d, 0x0b, 0x65, 0x16, 0x8e, 0x57, 0x05, 0xed, 0x04, 0x06, 0x22,
+	0x5f, 0x89, 0xc8, 0x2b, 0xb0, 0x1c, 0xa6, 0xe3, 0xfc, 0xee, 0xf1, 0xc2, 0xbf, 0xe3, 0xfe, 0xd7,
+	0xc3, 0x49, 0x58, 0x8d, 0xd1, 0x73, 0xd6, 0x08, 0xaa, 0x8f, 0xb1, 0xb7, 0xfd, 0x0a, 0x9b, 0x81,
+	0xe3, 0x21, 0xff, 0x32, 0x27, 0x5c, 0xbe, 0x69, 0xd7, 0x04, 0x15, 0x8e, 0xe8, 0x39, 0x2c, 0x0f,
+	0x51, 0x30, 0xa1, 0x56, 0x69, 0xbc, 0x8d, 0xfd, 0x13, 0xd7, 0xc5, 0x11, 0xa6, 0x8c, 0x0e, 0xb5,
+	0x7b, 0x64, 0x63, 0x05, 0x75, 0x62, 0xb0, 0x48, 0x45, 0x4b, 0x3e, 0x5a, 0xd1, 0xd2, 0x02, 0x24,
+	0xae, 0x04, 0x8f, 0x78, 0x15, 0x32, 0x7f, 0xc6, 0x5e, 0xb5, 0x23, 0x10, 0xa4, 0xc0, 0xd2, 0xd0,
+	0xaa, 0x72, 0x86, 0xd8, 0x37, 0xc9, 0x19, 0x72, 0xf2, 0xc3, 0x49, 0xb8, 0x6d, 0x4e, 0x2c, 0x0f,
+	0xa0, 0xbe, 0x79, 0x88, 0x3b, 0x2f, 0x69, 0xd0, 0xe9, 0x38, 0xe5, 0x9a, 0x75, 0x52, 0x0b, 0xd2,
+	0x61, 0x9f, 0xd9, 0xf1, 0xb8, 0xbe, 0xdf, 0x1e, 0x91, 0xcf, 0x3d, 0x0d, 0xa7, 0x12, 0x87, 0x65,
+	0xbb, 0x7a, 0xed, 0x12, 0x14, 0xfd, 0xbf, 0xb6, 0x43, 0xb3, 0x90, 0xdf, 0xdd, 0x6c, 0x55, 0xa7,
+	0xc8, 0xc3, 0xde, 0x56, 0xab, 0x2a, 0xa1, 0x22, 0x14, 0xda, 0x9b, 0xbb, 0xad, 0x6a, 0xee, 0x5a,
+	0x1f, 0xaa, 0xd1, 0xff, 0x75, 0x43, 0xab, 0xb0, 0xd4, 0x52, 0x76, 0x5a, 0x1b, 0x8f, 0x37, 0x76,
+	0x1b, 0x3b, 0x4d, 0xb5, 0xa5, 0x34, 0x9e, 0x6f, 0xec, 0x6e, 0x57, 0xa7, 0xd0, 0x79, 0x38, 0x2d,
+	0x76, 0x3c, 0xd9, 0x69, 0xef, 0xaa, 0xbb, 0x3b, 0xea, 0xe6, 0x4e, 0x73, 0x77, 0xa3, 0xd1, 0xdc,
+	0x56, 0xaa, 0x12, 0x3a, 0x0d, 0x27, 0x45, 0x94, 0x87, 0x8d, 0xad, 0x86, 0xb2, 0xbd, 0x49, 0x9e,
+	0x37, 0x9e, 0x56, 0x73, 0xd7, 0x6e, 0x42, 0x25, 0xf4, 0x1f, 0x64, 0x44, 0xa4, 0xd6, 0xce, 0x56,
+	0x75, 0x0a, 0x55, 0xa0, 0x24, 0xf2, 0x29, 0x42, 0xa1, 0xb9, 0xb3, 0xb5, 0x5d, 0xcd, 0x5d, 0x6b,
+	0x45, 0x9d, 0x21, 0x8c, 0x16, 0xa1, 0xd2, 0xde, 0x68, 0x6e, 0x3d, 0xdc, 0xf9, 0x44, 0x55, 0xb6,
+	0x37, 0xb6, 0x3e, 0xad, 0x4e, 0xa1, 0x65, 0xa8, 0xfa, 0xa0, 0xe6, 0xce, 0x2e, 0x83, 0x4a, 0x11,
+	0xe8, 0xa3, 0x9d, 0xbd, 0xe6, 0x56, 0x55, 0xbf, 0xf6, 0x43, 0x29, 0x72, 0x0f, 0xc6, 0xe8, 0x04,
+	0x2c, 0x06, 0xa3, 0xab, 0x9b, 0xca, 0xf6, 0xc6, 0xee, 0x36, 0x11, 0x2a, 0x04, 0x56, 0xf6, 0x9a,
+	0xcd, 0x46, 0xf3, 0x31, 0x63, 0x3b, 0x04, 0x6f, 0x7f, 0xd2, 0x20, 0xc8, 0xb9, 0x30, 0xf2, 0x5e,
+	0xf3, 0xdb, 0xcd, 0x9d, 0x17, 0xcd, 0x6a, 0x1e, 0x2d, 0xc1, 0xc2, 0x10, 0xdc, 0xda, 0xd8, 0x6b,
+	0x6f, 0x57, 0x0b, 0xd7, 0xfe, 0x40, 0x02, 0x14, 0x7f, 0x49, 0xd0, 0x29, 0x58, 0x8d, 0x89, 0xa1,
+	0x6e, 0x3f, 0xdf, 0x6e, 0xee, 0x56, 0xa7, 0xc2, 0x9d, 0xed, 0xdd, 0x0d, 0x65, 0xd8, 0x29, 0x45,
+	0x3b, 0x77, 0x5a, 0xad, 0xa0, 0x33, 0x17, 0xee, 0xdc, 0xda, 0x7e, 0xba, 0x3d, 0xa4, 0xcc, 0xaf,
+	0xff, 0xcd, 0x2a, 0xcc, 0xfb, 0xc1, 0x62, 0xec, 0xd0, 0x4f, 0x3c, 0x5a, 0x30, 0xeb, 0xff, 0xc3,
+	0x63, 0x82, 0x9f, 0x12, 0xfe, 0x5f, 0xca, 0xfa, 0xf9, 0x11, 0x18, 0xdc, 0x22, 0x4d, 0xa1, 0x7d,
+	0x1a, 0x43, 0x1f, 0xee, 0x23, 0xba, 0x94, 0x18, 0xb1, 0x8e, 0x59, 0xc3, 0xfa, 0xe5, 0xb1, 0x78,
+	0xc1, 0x18, 0x18, 0xe6, 0xc3, 0xff, 0xac, 0x83, 0x2e, 0x27, 0xc5, 0xb7, 0x13, 0xfe, 0xba, 0xa7,
+	0x7e, 0x65, 0x3c, 0x62, 0x30, 0xcc, 0x4b, 0xa8, 0x46, 0xff, 0x65, 0x07, 0x25, 0xd4, 0xb2, 0xa4,
+	0xfc, 0x95, 0x4f, 0xfd, 0x5a, 0x16, 0x54, 0x71, 0xb0, 0xd8, 0xdf, 0xc6, 0x5c, 0xcd, 0x60, 0xfa,
+	0xd2, 0x07, 0x4b, 0xfb, 0xcb, 0x0e, 0xb6, 0x80, 0xe1, 0xcb, 0x25, 0x4a, 0xfc, 0x8f, 0x16, 0xd7,
+	0xcb, 0xb4, 0x80, 0xc9, 0x7f, 0x23, 0x20, 0x4f, 0xa1, 0x43, 0x58, 0x88, 0x54, 0xdb, 0xa3, 0x04,
+	0xf2, 0xe4, 0xcf, 0x0a, 0xea, 0x57, 0x33, 0x60, 0x86, 0x35, 0x42, 0xac, 0xae, 0x4f, 0xd6, 0x88,
+	0x84, 0xda, 0xfd, 0xfa, 0x95, 0xf1, 0x88, 0xa2, 0x72, 0x87, 0xaa, 0xea, 0x93, 0x94, 0x3b, 0xa9,
+	0x96, 0xbf, 0x7e, 0x79, 0x2c, 0x9e, 0xb8, 0x68, 0x91, 0x1a, 0xfb, 0xa4, 0x45, 0x4b, 0xae, 0xe1,
+	0xaf, 0x5f, 0xcd, 0x80, 0x19, 0xd5, 0x82, 0xa0, 0xcb, 0x4d, 0xd3, 0x82, 0x58, 0x7d, 0x79, 0xfd,
+	0xca, 0x78, 0xc4, 0x90, 0x16, 0x44, 0x2a, 0x6d, 0xaf, 0x8c, 0x3f, 0x80, 0x47, 0x68, 0x41, 0x72,
+	0x15, 0x9c, 0x3c, 0x85, 0x7e, 0x5f, 0x82, 0x5a, 0x5a, 0x01, 0x16, 0xba, 0x39, 0x71, 0xe5, 0x58,
+	0x7d, 0x7d, 0x12, 0x92, 0x40, 0x8a, 0xcf, 0x00, 0xc5, 0xdd, 0x61, 0xf4, 0xb5, 0xa4, 0x9d, 0x49,
+	0x71, 0xba, 0xeb, 0xef, 0x65, 0x43, 0x16, 0x77, 0x32, 0xec, 0x27, 0x27, 0xed, 0x64, 0xa2, 0x17,
+	0x5e, 0xbf, 0x32, 0x1e, 0x51, 0xb4, 0x51, 0x51, 0xb7, 0x39, 0xc9, 0x46, 0xa5, 0x38, 0xe6, 0xf5,
+	0x6b, 0x59, 0x50, 0x83, 0xc1, 0xda, 0x50, 0xf4, 0xcb, 0xd8, 0x50, 0xc2, 0xc9, 0x13, 0x29, 0xa2,
+	0xab, 0xcb, 0xa3, 0x50, 0x02, 0xa6, 0x8f, 0xa1, 0x40, 0xa0, 0xe8, 0x74, 0x32, 0xb6, 0xcf, 0xec,
+	0x4c, 0x5a, 0x77, 0xc0, 0xe8, 0x19, 0xcc, 0xb0, 0xba, 0x2d, 0x94, 0x90, 0x91, 0x0d, 0x55, 0x97,
+	0xd5, 0xcf, 0xa5, 0x23, 0x04, 0xec, 0xbe, 0x07, 0x65, 0xa1, 0x24, 0x0b, 0x5d, 0x4c, 0xfe, 0xef,
+	0xc2, 0x70, 0x05, 0x58, 0xfd, 0xdd, 0x31, 0x58, 0xa2, 0x7a, 0x44, 0xb2, 0x01, 0x97, 0xc7, 0x06,
+	0x25, 0xd2, 0xd5, 0x23, 0x39, 0x69, 0xc4, 0x14, 0x3f, 0x9e, 0x54, 0x4a, 0x52, 0xfc, 0xd4, 0x54,
+	0x5e, 0xfd, 0xbd, 0x6c, 0xc8, 0xa2, 0x6d, 0x89, 0x06, 0x97, 0xae, 0x8c, 0x0f, 0x85, 0xa6, 0xdb,
+	0x96, 0x94, 0x70, 0xab, 0x3c, 0x85, 0x3c, 0x58, 0x4a, 0x88, 0xc7, 0xa2, 0xf7, 0xc6, 0x1d, 0x87,
+	0xa1, 0x11, 0xaf, 0x67, 0xc4, 0x16, 0x47, 0x4d, 0x28, 0x91, 0x48, 0x1a, 0x35, 0xbd, 0x5e, 0xa3,
+	0x7e, 0x3d, 0x23, 0xb6, 0xa8, 0xdc, 0xdc, 0x50, 0x9f, 0x4d, 0xaf, 0x1b, 0x48, 0x55, 0xee, 0x98,
+	0x59, 0x3e, 0x84, 0x85, 0xc8, 0x0d, 0x16, 0xa5, 0x1d, 0xba, 0x71, 0x7f, 0xe3, 0x6a, 0x06, 0xcc,
+	0x60, 0xa4, 0x03, 0x40, 0x8f, 0xb1, 0x17, 0x76, 0xb7, 0x5d, 0x94, 0x60, 0x1a, 0xa2, 0xd7, 0xe6,
+	0x91, 0x7a, 0x1e, 0xba, 0x45, 0xcb, 0x53, 0xef, 0x4b, 0x64, 0x5b, 0x12, 0xae, 0x64, 0x49, 0xdb,
+	0x92, 0x7e, 0x61, 0xac, 0x5f, 0xcf, 0x88, 0xed, 0x8f, 0xbb, 0xfe, 0xab, 0x3c, 0xcc, 0xb1, 0x32,
+	0x22, 0xee, 0xbd, 0x7f, 0x0a, 0x30, 0xac, 0xe0, 0x43, 0x17, 0x92, 0x95, 0x2b, 0x54, 0x7b, 0x59,
+	0xbf, 0x38, 0x1a, 0x49, 0x34, 0x48, 0x42, 0x35, 0x1c, 0xba, 0x38, 0xa6, 0x58, 0x2e, 0xd5, 0x20,
+	0x25, 0x94, 0xd4, 0xc9, 0x53, 0xe8, 0x39, 0x94, 0x82, 0xb2, 0xab, 0xa4, 0xed, 0x89, 0xd6, 0x95,
+	0xd5, 0x2f, 0x8c, 0xc4, 0x11, 0xa5, 0x16, 0x6a, 0xaa, 0x92, 0xa4, 0x8e, 0xd7, 0x6e, 0xd5, 0xdf,
+	0x1d, 0x83, 0x15, 0x5b, 0x13, 0x56, 0x79, 0x91, 0xba, 0x26, 0xa1, 0xc2, 0x97, 0xfa, 0xbb, 0x63,
+	0xb0, 0x82, 0xdd, 0xb5, 0xa1, 0xc2, 0x22, 0x47, 0xfe, 0xee, 0xaa, 0x30, 0x27, 0x06, 0x94, 0x50,
+	0xaa, 0x9c, 0xa1, 0x40, 0x55, 0xfd, 0xd2, 0x38, 0x34, 0x7f, 0xc4, 0x87, 0x97, 0x7e, 0xfe, 0xeb,
+	0x33, 0xd2, 0x2f, 0x7f, 0x7d, 0x66, 0xea, 0x87, 0x9f, 0x9f, 0x91, 0x7e, 0xfe, 0xf9, 0x19, 0xe9,
+	0x9f, 0x3e, 0x3f, 0x23, 0xfd, 0xdb, 0xe7, 0x67, 0xa4, 0x3f, 0xfc, 0xf7, 0x33, 0x53, 0xdf, 0x29,
+	0xfa, 0xe4, 0xfb, 0x33, 0xf4, 0x0f, 0xf3, 0x3f, 0xf8, 0xdf, 0x01, 0x00, 0x06, 0x9b, 0xc5, 0x03,
+	0xf6, 0x60, 0x00, 0x00,
 }
@@ -21,10 +21,33 @@ type Config struct {
 	StreamServerPort string `json:"stream-server-port,omitempty"`
 	// StreamServerReusePort specify whether cri stream server share port with pouchd.
 	StreamServerReusePort bool `json:"stream-server-reuse-port,omitempty"`
+	// StreamServerTLSCert is the cert file of cri stream server, TLS is enabled if both cert and key are specified.
+	StreamServerTLSCert string `json:"stream-server-tlscert,omitempty"`
+	// StreamServerTLSKey is the key file of cri stream server.
+	StreamServerTLSKey string `json:"stream-server-tlskey,omitempty"`
+	// StreamServerTLSCA is the CA file to verify the client certs of cri stream server.
+	StreamServerTLSCA string `json:"stream-server-tlscacert,omitempty"`
 	// CriStatsCollectPeriod specify the time duration (in time.Second) cri collect stats from containerd.
 	CriStatsCollectPeriod int `json:"cri-stats-collect-period,omitempty"`
 	// EnableCriStatsCollect specify whether cri collect stats from containerd.
 	EnableCriStatsCollect bool `json:"enable-cri-stats-collect,omitempty"`
 	// RuntimeConfigFile is a file to make the runtime config persistent.
 	RuntimeConfigFile string `json:"runtime-config-file"`
+	// RuntimeHandlers is the registry of runtime handlers which can be requested by RuntimeClass.
+	RuntimeHandlers map[string]RuntimeHandler `json:"runtime-handlers,omitempty"`
+}
+
+// RuntimeHandler defines the OCI runtime used by the pods requesting the handler.
+type RuntimeHandler struct {
+	// Path is the name or path of the OCI runtime binary, defaults to the handler name.
+	Path string `json:"path,omitempty"`
+	// Type is the runtime type used in containerd.
+	Type string `json:"type,omitempty"`
+	// Options are the config options for the runtime type.
+	Options interface{} `json:"options,omitempty"`
+	// Annotations are the default annotations of every container in the pod,
+	// which can be overridden by the annotations of pod and container.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// PrivilegedAllowed specifies whether the privileged pods and containers can use the handler.
+	PrivilegedAllowed bool `json:"privileged-allowed,omitempty"`
 }
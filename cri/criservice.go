@@ -4,15 +4,18 @@ import (
 	"fmt"
 
 	"github.com/alibaba/pouch/cri/stream"
+	criv1 "github.com/alibaba/pouch/cri/v1"
 	criv1alpha2 "github.com/alibaba/pouch/cri/v1alpha2"
 	"github.com/alibaba/pouch/daemon/config"
 	"github.com/alibaba/pouch/daemon/mgr"
 	"github.com/alibaba/pouch/hookplugins"
 	"github.com/alibaba/pouch/pkg/log"
+
+	"google.golang.org/grpc"
 )
 
 // RunCriService start cri service if pouchd is specified with --enable-cri.
-func RunCriService(daemonconfig *config.Config, containerMgr mgr.ContainerMgr, imageMgr mgr.ImageMgr, volumeMgr mgr.VolumeMgr, criPlugin hookplugins.CriPlugin, streamRouterCh chan stream.Router, stopCh chan error, readyCh chan bool) {
+func RunCriService(daemonconfig *config.Config, containerMgr mgr.ContainerMgr, imageMgr mgr.ImageMgr, volumeMgr mgr.VolumeMgr, networkMgr mgr.NetworkMgr, systemMgr mgr.SystemMgr, criPlugin hookplugins.CriPlugin, streamRouterCh chan stream.Router, stopCh chan error, readyCh chan bool) {
 	var err error
 
 	defer func() {
@@ -26,26 +29,31 @@ func RunCriService(daemonconfig *config.Config, containerMgr mgr.ContainerMgr, i
 		return
 	}
 	switch daemonconfig.CriConfig.CriVersion {
+	case "v1":
+		// runtime.v1 is served alongside v1alpha2 for the kubelets which
+		// have not moved to v1 yet.
+		err = runv1alpha2(daemonconfig, containerMgr, imageMgr, volumeMgr, networkMgr, systemMgr, criPlugin, streamRouterCh, readyCh, criv1.RegisterService)
 	case "v1alpha2":
-		err = runv1alpha2(daemonconfig, containerMgr, imageMgr, volumeMgr, criPlugin, streamRouterCh, readyCh)
+		err = runv1alpha2(daemonconfig, containerMgr, imageMgr, volumeMgr, networkMgr, systemMgr, criPlugin, streamRouterCh, readyCh)
 	default:
 		streamRouterCh <- nil
 		readyCh <- false
-		err = fmt.Errorf("failed to start CRI service: invalid CRI version %s, expected to be v1alpha2", daemonconfig.CriConfig.CriVersion)
+		err = fmt.Errorf("failed to start CRI service: invalid CRI version %s, expected to be v1 or v1alpha2", daemonconfig.CriConfig.CriVersion)
 	}
 }
 
-// Start CRI service with CRI version: v1alpha2
-func runv1alpha2(daemonconfig *config.Config, containerMgr mgr.ContainerMgr, imageMgr mgr.ImageMgr, volumeMgr mgr.VolumeMgr, criPlugin hookplugins.CriPlugin, streamRouterCh chan stream.Router, readyCh chan bool) error {
-	log.With(nil).Infof("Start CRI service with CRI version: v1alpha2")
-	criMgr, err := criv1alpha2.NewCriManager(daemonconfig, containerMgr, imageMgr, volumeMgr, criPlugin)
+// Start CRI service with CRI version: v1alpha2, the registers are called to
+// register other versions of CRI backed by the v1alpha2 CriManager.
+func runv1alpha2(daemonconfig *config.Config, containerMgr mgr.ContainerMgr, imageMgr mgr.ImageMgr, volumeMgr mgr.VolumeMgr, networkMgr mgr.NetworkMgr, systemMgr mgr.SystemMgr, criPlugin hookplugins.CriPlugin, streamRouterCh chan stream.Router, readyCh chan bool, registers ...func(*grpc.Server, criv1alpha2.CriMgr)) error {
+	log.With(nil).Infof("Start CRI service with CRI version: %s", daemonconfig.CriConfig.CriVersion)
+	criMgr, err := criv1alpha2.NewCriManager(daemonconfig, containerMgr, imageMgr, volumeMgr, networkMgr, systemMgr, criPlugin)
 	if err != nil {
 		streamRouterCh <- nil
 		readyCh <- false
 		return fmt.Errorf("failed to get CriManager with error: %v", err)
 	}
 
-	service, err := criv1alpha2.NewService(daemonconfig, criMgr)
+	service, err := criv1alpha2.NewService(daemonconfig, criMgr, registers...)
 	if err != nil {
 		streamRouterCh <- nil
 		readyCh <- false
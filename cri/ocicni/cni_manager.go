@@ -6,21 +6,37 @@ import (
 	"io/ioutil"
 	"net"
 	"os"
+	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/alibaba/pouch/cri/config"
 	"github.com/alibaba/pouch/pkg/log"
 
 	cnicurrent "github.com/containernetworking/cni/pkg/types/current"
 	"github.com/cri-o/ocicni/pkg/ocicni"
+	"github.com/fsnotify/fsnotify"
 	"github.com/pkg/errors"
 )
 
+// cniReloadDelay is the delay to reload the CNI network configs after the
+// last change of conf dir, so that a burst of changes reloads them once.
+const cniReloadDelay = 500 * time.Millisecond
+
+// cniConfFileExtensions are the extensions of the CNI config files loaded
+// from conf dir.
+var cniConfFileExtensions = []string{".conf", ".conflist", ".json"}
+
 // CniManager is an implementation of interface CniMgr.
 type CniManager struct {
 	sync.RWMutex
 	// plugin is used to setup and teardown network when run/stop pod sandbox.
+	// It is replaced when the CNI network configs are reloaded.
 	plugin ocicni.CNIPlugin
+	// confDir is the directory in which the CNI network configs are kept.
+	confDir string
+	// binDir is the directory in which the CNI plugin binaries are kept.
+	binDir string
 	// runtimeConfigFile is a file to make the runtime config persistent.
 	runtimeConfigFile string
 	// defaultRuntimeConfig is configuration specific to the default pod network interface.
@@ -58,22 +74,105 @@ func NewCniManager(cfg *config.Config) (CniMgr, error) {
 		return nil, err
 	}
 
-	return &CniManager{
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		plugin.Shutdown()
+		return nil, fmt.Errorf("failed to create watcher of %s: %v", networkPluginConfDir, err)
+	}
+	if err := watcher.Add(networkPluginConfDir); err != nil {
+		watcher.Close()
+		plugin.Shutdown()
+		return nil, fmt.Errorf("failed to watch %s: %v", networkPluginConfDir, err)
+	}
+
+	c := &CniManager{
 		plugin:               plugin,
+		confDir:              networkPluginConfDir,
+		binDir:               networkPluginBinDir,
 		defaultRuntimeConfig: runtimeConfig,
 		runtimeConfigFile:    cfg.RuntimeConfigFile,
-	}, nil
+	}
+	go c.watchConfDir(watcher)
+
+	return c, nil
+}
+
+// watchConfDir reloads the CNI network configs when the config files in conf
+// dir are created, changed, renamed or removed, so that the changes apply
+// without restarting pouchd.
+func (c *CniManager) watchConfDir(watcher *fsnotify.Watcher) {
+	defer watcher.Close()
+
+	var reload <-chan time.Time
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op == fsnotify.Chmod || !isCniConfFile(event.Name) {
+				continue
+			}
+			log.With(nil).Debugf("CNI conf dir changed: %s", event)
+			reload = time.After(cniReloadDelay)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			log.With(nil).Errorf("failed to watch CNI conf dir %s: %v", c.confDir, err)
+		case <-reload:
+			reload = nil
+			if err := c.reload(); err != nil {
+				log.With(nil).Errorf("failed to reload CNI network configs from %s: %v", c.confDir, err)
+			}
+		}
+	}
+}
+
+// reload loads the CNI network configs from conf dir and replaces the plugin
+// with the new one. The pods set up already are not affected.
+func (c *CniManager) reload() error {
+	plugin, err := ocicni.InitCNI("", c.confDir, c.binDir)
+	if err != nil {
+		return err
+	}
+
+	c.Lock()
+	old := c.plugin
+	c.plugin = plugin
+	c.Unlock()
+
+	log.With(nil).Infof("reloaded CNI network configs from %s, default network is %q", c.confDir, plugin.GetDefaultNetworkName())
+	return old.Shutdown()
+}
+
+// isCniConfFile returns whether the file is loaded as CNI network config.
+func isCniConfFile(file string) bool {
+	ext := filepath.Ext(file)
+	for _, e := range cniConfFileExtensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+// getPlugin returns the plugin of current CNI network configs.
+func (c *CniManager) getPlugin() ocicni.CNIPlugin {
+	c.RLock()
+	defer c.RUnlock()
+	return c.plugin
 }
 
 // Name returns the plugin's name. This will be used when searching
 // for a plugin by name, e.g.
 func (c *CniManager) Name() string {
-	return c.plugin.Name()
+	return c.getPlugin().Name()
 }
 
 // GetDefaultNetworkName returns the name of the plugin's default network.
 func (c *CniManager) GetDefaultNetworkName() string {
-	return c.plugin.GetDefaultNetworkName()
+	return c.getPlugin().GetDefaultNetworkName()
 }
 
 // SetUpPodNetwork is the method called after the sandbox container of the
@@ -81,15 +180,16 @@ func (c *CniManager) GetDefaultNetworkName() string {
 // are launched.
 func (c *CniManager) SetUpPodNetwork(podNetwork *ocicni.PodNetwork) error {
 	c.RLock()
+	plugin := c.plugin
 	c.updateDefaultRuntimeConfig(podNetwork)
 	c.RUnlock()
 
-	_, err := c.plugin.SetUpPod(*podNetwork)
+	_, err := plugin.SetUpPod(*podNetwork)
 
 	defer func() {
 		if err != nil {
 			// Teardown network if an error returned.
-			err := c.plugin.TearDownPod(*podNetwork)
+			err := plugin.TearDownPod(*podNetwork)
 			if err != nil {
 				log.With(nil).Errorf("failed to destroy network for sandbox %q: %v", podNetwork.ID, err)
 			}
@@ -104,7 +204,7 @@ func (c *CniManager) SetUpPodNetwork(podNetwork *ocicni.PodNetwork) error {
 }
 
 // updateDefaultRuntimeConfig set some config of the pod default network interface.
-// only set podCIDR now.
+// only set podCIDR now. The caller should hold the lock of CniManager.
 func (c *CniManager) updateDefaultRuntimeConfig(podNetwork *ocicni.PodNetwork) {
 	if len(c.defaultRuntimeConfig.IpRanges) == 0 {
 		return
@@ -114,7 +214,11 @@ func (c *CniManager) updateDefaultRuntimeConfig(podNetwork *ocicni.PodNetwork) {
 		podNetwork.RuntimeConfig = make(map[string]ocicni.RuntimeConfig)
 	}
 
-	defaultNetworkName := c.GetDefaultNetworkName()
+	// the first network of pod is attached as the default interface.
+	defaultNetworkName := c.plugin.GetDefaultNetworkName()
+	if len(podNetwork.Networks) > 0 {
+		defaultNetworkName = podNetwork.Networks[0]
+	}
 
 	if _, exist := podNetwork.RuntimeConfig[defaultNetworkName]; !exist {
 		podNetwork.RuntimeConfig[defaultNetworkName] = ocicni.RuntimeConfig{}
@@ -130,12 +234,13 @@ func (c *CniManager) updateDefaultRuntimeConfig(podNetwork *ocicni.PodNetwork) {
 // TearDownPodNetwork is the method called before a pod's sandbox container will be deleted.
 func (c *CniManager) TearDownPodNetwork(podNetwork *ocicni.PodNetwork) error {
 	c.RLock()
+	plugin := c.plugin
 	c.updateDefaultRuntimeConfig(podNetwork)
 	c.RUnlock()
 
 	// perform the teardown network operation whatever to
 	// give CNI Plugin a chance to perform some operations
-	err := c.plugin.TearDownPod(*podNetwork)
+	err := plugin.TearDownPod(*podNetwork)
 	if err == nil {
 		return nil
 	}
@@ -151,39 +256,44 @@ func (c *CniManager) TearDownPodNetwork(podNetwork *ocicni.PodNetwork) error {
 	return errors.Wrapf(err, "failed to destroy network for sandbox %q", podNetwork.ID)
 }
 
-// GetPodNetworkStatus is the method called to obtain the ipv4 or ipv6 addresses of the pod sandbox.
-func (c *CniManager) GetPodNetworkStatus(netnsPath string) (string, error) {
+// GetPodNetworkStatus is the method called to obtain the ipv4 or ipv6 addresses
+// of the pod sandbox attached to networks. The first address is the one of the
+// default interface, and the others are in the order of networks.
+func (c *CniManager) GetPodNetworkStatus(netnsPath string, networks []string) ([]string, error) {
 	// TODO: we need more validation tests.
 	podNetwork := ocicni.PodNetwork{
-		NetNS: netnsPath,
+		NetNS:    netnsPath,
+		Networks: networks,
 	}
 
-	var err error
-	results, err := c.plugin.GetPodNetworkStatus(podNetwork)
+	results, err := c.getPlugin().GetPodNetworkStatus(podNetwork)
 	if err != nil {
-		return "", fmt.Errorf("failed to get pod network status: %v", err)
+		return nil, fmt.Errorf("failed to get pod network status: %v", err)
 	}
 
 	if len(results) == 0 {
-		return "", fmt.Errorf("failed to get pod network status for nil result")
+		return nil, fmt.Errorf("failed to get pod network status for nil result")
 	}
 
-	result, ok := results[0].(*cnicurrent.Result)
-	if !ok {
-		return "", fmt.Errorf("failed to get pod network status for wrong result: %+v", results[0])
-	}
+	ips := make([]string, 0, len(results))
+	for _, r := range results {
+		result, ok := r.(*cnicurrent.Result)
+		if !ok {
+			return nil, fmt.Errorf("failed to get pod network status for wrong result: %+v", r)
+		}
 
-	if len(result.IPs) == 0 {
-		return "", fmt.Errorf("failed to get pod network status for nil IP")
+		if len(result.IPs) == 0 {
+			return nil, fmt.Errorf("failed to get pod network status for nil IP")
+		}
+		ips = append(ips, result.IPs[0].Address.IP.String())
 	}
 
-	ip := result.IPs[0].Address.IP.String()
-	return ip, nil
+	return ips, nil
 }
 
 // Status returns error if the network plugin is in error state.
 func (c *CniManager) Status() error {
-	return c.plugin.Status()
+	return c.getPlugin().Status()
 }
 
 const (
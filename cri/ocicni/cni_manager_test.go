@@ -0,0 +1,200 @@
+package ocicni
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/cri/config"
+
+	"github.com/cri-o/ocicni/pkg/ocicni"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeCniPlugin records the network config from stdin to the record dir and
+// returns a fake result.
+const fakeCniPlugin = `#!/bin/sh
+cat > "%s/${CNI_COMMAND}-$(basename "$0")-${CNI_IFNAME}.json"
+if [ "${CNI_COMMAND}" = "ADD" ]; then
+	if [ "$(basename "$0")" = "loopback" ]; then
+		echo '{"cniVersion": "0.2.0"}'
+	else
+		echo '{"cniVersion": "0.3.1", "ips": [{"version": "4", "address": "10.88.0.2/16"}]}'
+	fi
+fi
+`
+
+// installFakeCniPlugins installs the fake CNI plugins to binDir, which record
+// the network configs to recordDir.
+func installFakeCniPlugins(t *testing.T, binDir, recordDir string, plugins ...string) {
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, plugin := range plugins {
+		if err := ioutil.WriteFile(filepath.Join(binDir, plugin), []byte(fmt.Sprintf(fakeCniPlugin, recordDir)), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// readRuntimeConfig returns the runtime config in the network config passed
+// to the fake CNI plugin.
+func readRuntimeConfig(t *testing.T, file string) map[string]json.RawMessage {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := struct {
+		RuntimeConfig map[string]json.RawMessage `json:"runtimeConfig"`
+	}{}
+	if err := json.Unmarshal(data, &conf); err != nil {
+		t.Fatal(err)
+	}
+	return conf.RuntimeConfig
+}
+
+func writeCniConfList(t *testing.T, dir, file, name string) {
+	data := fmt.Sprintf(`{"cniVersion": "0.3.1", "name": %q, "plugins": [{"type": "bridge"}]}`, name)
+	if err := ioutil.WriteFile(filepath.Join(dir, file), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// waitDefaultNetwork waits until the default network of c becomes expected.
+func waitDefaultNetwork(t *testing.T, c CniMgr, expected string) {
+	deadline := time.Now().Add(5 * time.Second)
+	for c.GetDefaultNetworkName() != expected {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected default network %q, got %q", expected, c.GetDefaultNetworkName())
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestCniManagerReload(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "cni-reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	confDir := filepath.Join(tmpDir, "net.d")
+	c, err := NewCniManager(&config.Config{
+		NetworkPluginBinDir:  filepath.Join(tmpDir, "bin"),
+		NetworkPluginConfDir: confDir,
+		RuntimeConfigFile:    filepath.Join(tmpDir, "runtime.json"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// no network config is loaded.
+	assert.Error(t, c.Status())
+
+	writeCniConfList(t, confDir, "10-a.conflist", "a")
+	waitDefaultNetwork(t, c, "a")
+	assert.NoError(t, c.Status())
+
+	// the config sorted first becomes the default network.
+	writeCniConfList(t, confDir, "05-b.conflist", "b")
+	waitDefaultNetwork(t, c, "b")
+
+	// the config moved out of conf dir is not loaded any more.
+	if err := os.Rename(filepath.Join(confDir, "05-b.conflist"), filepath.Join(tmpDir, "05-b.conflist")); err != nil {
+		t.Fatal(err)
+	}
+	waitDefaultNetwork(t, c, "a")
+
+	if err := os.Remove(filepath.Join(confDir, "10-a.conflist")); err != nil {
+		t.Fatal(err)
+	}
+	waitDefaultNetwork(t, c, "")
+	assert.Error(t, c.Status())
+}
+
+func TestSetUpPodNetworkCapabilities(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "cni-capabilities")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	binDir, confDir := filepath.Join(tmpDir, "bin"), filepath.Join(tmpDir, "net.d")
+	installFakeCniPlugins(t, binDir, tmpDir, "loopback", "fake-bridge", "fake-portmap")
+	if err := os.MkdirAll(confDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	conf := `{
+	"cniVersion": "0.3.1",
+	"name": "fake",
+	"plugins": [
+		{"type": "fake-bridge", "capabilities": {"ipRanges": true}},
+		{"type": "fake-portmap", "capabilities": {"portMappings": true, "bandwidth": true}}
+	]
+}`
+	if err := ioutil.WriteFile(filepath.Join(confDir, "10-fake.conflist"), []byte(conf), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := NewCniManager(&config.Config{
+		NetworkPluginBinDir:  binDir,
+		NetworkPluginConfDir: confDir,
+		RuntimeConfigFile:    filepath.Join(tmpDir, "runtime.json"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, c.Event(CNIChangeEventPodCIDR, "10.88.0.0/16"))
+
+	portMappings := []ocicni.PortMapping{
+		{HostPort: 8080, ContainerPort: 80, Protocol: "tcp"},
+		{HostPort: 5353, ContainerPort: 53, Protocol: "udp", HostIP: "127.0.0.1"},
+	}
+	podNetwork := func() *ocicni.PodNetwork {
+		return &ocicni.PodNetwork{
+			Name:      "pod",
+			Namespace: "default",
+			ID:        fmt.Sprintf("cni-capabilities-%d", os.Getpid()),
+			NetNS:     filepath.Join(tmpDir, "netns"),
+			RuntimeConfig: map[string]ocicni.RuntimeConfig{
+				"fake": {
+					PortMappings: portMappings,
+					Bandwidth:    &ocicni.BandwidthConfig{IngressRate: 1000, IngressBurst: 2000},
+				},
+			},
+		}
+	}
+	assert.NoError(t, c.SetUpPodNetwork(podNetwork()))
+
+	// only the capabilities declared by the plugin are passed to it.
+	rc := readRuntimeConfig(t, filepath.Join(tmpDir, "ADD-fake-bridge-eth0.json"))
+	assert.Equal(t, []string{"ipRanges"}, keys(rc))
+	assert.JSONEq(t, `[[{"subnet": "10.88.0.0/16"}]]`, string(rc["ipRanges"]))
+
+	rc = readRuntimeConfig(t, filepath.Join(tmpDir, "ADD-fake-portmap-eth0.json"))
+	assert.Equal(t, []string{"bandwidth", "portMappings"}, keys(rc))
+	assert.JSONEq(t, `[
+		{"hostPort": 8080, "containerPort": 80, "protocol": "tcp", "hostIP": ""},
+		{"hostPort": 5353, "containerPort": 53, "protocol": "udp", "hostIP": "127.0.0.1"}
+	]`, string(rc["portMappings"]))
+	assert.JSONEq(t, `{"ingressRate": 1000, "ingressBurst": 2000, "egressRate": 0, "egressBurst": 0}`, string(rc["bandwidth"]))
+
+	// the port mappings are passed on teardown to clean up the rules.
+	assert.NoError(t, c.TearDownPodNetwork(podNetwork()))
+	rc = readRuntimeConfig(t, filepath.Join(tmpDir, "DEL-fake-portmap-eth0.json"))
+	assert.Contains(t, rc, "portMappings")
+}
+
+func keys(m map[string]json.RawMessage) []string {
+	var ks []string
+	for k := range m {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	return ks
+}
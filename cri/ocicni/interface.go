@@ -1,6 +1,10 @@
 package ocicni
 
-import "github.com/cri-o/ocicni/pkg/ocicni"
+import (
+	apitypes "github.com/alibaba/pouch/apis/types"
+
+	"github.com/cri-o/ocicni/pkg/ocicni"
+)
 
 // CniMgr as an interface defines all operations against CNI.
 type CniMgr interface {
@@ -20,8 +24,14 @@ type CniMgr interface {
 	// TearDownPodNetwork is the method called before a pod's sandbox container will be deleted.
 	TearDownPodNetwork(podNetwork *ocicni.PodNetwork) error
 
-	// GetPodNetworkStatus is the method called to obtain the ipv4 or ipv6 addresses of the pod sandbox.
-	GetPodNetworkStatus(netnsPath string) (string, error)
+	// GetPodNetworkStatus is the method called to obtain the ipv4 or ipv6 addresses
+	// of the pod sandbox attached to networks, the default network is used if
+	// networks is empty. The first address is the one of the default interface.
+	GetPodNetworkStatus(netnsPath string, networks []string) ([]string, error)
+
+	// GetPodNetworkStats returns the stats of network interfaces in the netns
+	// of pod sandbox, the loopback interface is excluded.
+	GetPodNetworkStats(netnsPath string) (map[string]apitypes.NetworkStats, error)
 
 	// Status returns error if the network plugin is in error state.
 	Status() error
@@ -3,14 +3,18 @@ package ocicni
 import (
 	"crypto/rand"
 	"fmt"
+	"net"
 	"os"
 	"path"
 	"runtime"
 	"strings"
 	"sync"
 
+	apitypes "github.com/alibaba/pouch/apis/types"
+
 	"github.com/containernetworking/plugins/pkg/ns"
 	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
 	"golang.org/x/sys/unix"
 )
 
@@ -164,3 +168,37 @@ func createNS(nsPath string) (res string, err error) {
 
 	return nsPath, nil
 }
+
+// GetPodNetworkStats returns the stats of network interfaces in the netns
+// of pod sandbox, the loopback interface is excluded.
+func (c *CniManager) GetPodNetworkStats(netnsPath string) (map[string]apitypes.NetworkStats, error) {
+	stats := make(map[string]apitypes.NetworkStats)
+	err := ns.WithNetNSPath(netnsPath, func(ns.NetNS) error {
+		links, err := netlink.LinkList()
+		if err != nil {
+			return err
+		}
+
+		for _, link := range links {
+			attrs := link.Attrs()
+			if attrs.Flags&net.FlagLoopback != 0 || attrs.Statistics == nil {
+				continue
+			}
+			stats[attrs.Name] = apitypes.NetworkStats{
+				RxBytes:   attrs.Statistics.RxBytes,
+				RxPackets: attrs.Statistics.RxPackets,
+				RxErrors:  attrs.Statistics.RxErrors,
+				RxDropped: attrs.Statistics.RxDropped,
+				TxBytes:   attrs.Statistics.TxBytes,
+				TxPackets: attrs.Statistics.TxPackets,
+				TxErrors:  attrs.Statistics.TxErrors,
+				TxDropped: attrs.Statistics.TxDropped,
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get network stats in netns %s", netnsPath)
+	}
+	return stats, nil
+}
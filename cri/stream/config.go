@@ -1,6 +1,7 @@
 package stream
 
 import (
+	"crypto/tls"
 	"net/url"
 	"time"
 
@@ -38,6 +39,9 @@ type Config struct {
 	// If empty, the baseURL will be constructed from the serve address.
 	BaseURL *url.URL
 
+	// TLSConfig is the TLS config of the server, nil means TLS is disabled.
+	TLSConfig *tls.Config
+
 	// StreamIdleTimeout is how long to leave idle connections open for.
 	StreamIdleTimeout time.Duration
 	// StreamCreationTimeout is how long to wait for clients to create streams. Only used for SPDY streaming.
@@ -25,6 +25,8 @@ func WriteError(err error, w http.ResponseWriter) error {
 	switch grpc.Code(err) {
 	case codes.NotFound:
 		status = http.StatusNotFound
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		status = http.StatusBadRequest
 	case codes.ResourceExhausted:
 		// We only expect to hit this if there is a DoS, so we just wait the full TTL.
 		// If this is ever hit in steady-state operations, consider increasing the MaxInFlight requests,
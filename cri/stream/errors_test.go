@@ -29,6 +29,10 @@ func TestWriteError(t *testing.T) {
 			ErrorTooManyInFlight(),
 			http.StatusTooManyRequests,
 		},
+		{
+			grpc.Errorf(codes.FailedPrecondition, "container is not running"),
+			http.StatusBadRequest,
+		},
 	} {
 		res := httptest.NewRecorder()
 		WriteError(tt.err, res)
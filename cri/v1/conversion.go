@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// message is the protobuf message of CRI api, the messages of v1 and
+// v1alpha2 are compatible in wire format.
+type message interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+// convert converts the message between v1 and v1alpha2 by marshaling from
+// and unmarshaling the data to to. The nil from leaves to unchanged.
+func convert(from, to message) error {
+	if from == nil || reflect.ValueOf(from).IsNil() {
+		return nil
+	}
+
+	data, err := from.Marshal()
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal %T", from)
+	}
+	if err := to.Unmarshal(data); err != nil {
+		return errors.Wrapf(err, "failed to convert %T to %T", from, to)
+	}
+	return nil
+}
@@ -0,0 +1,397 @@
+package v1
+
+import (
+	"context"
+
+	runtime "github.com/alibaba/pouch/cri/apis/v1"
+	runtimealpha "github.com/alibaba/pouch/cri/apis/v1alpha2"
+	criv1alpha2 "github.com/alibaba/pouch/cri/v1alpha2"
+
+	"google.golang.org/grpc"
+)
+
+// criService implements the runtime.v1 RuntimeService and ImageService by
+// converting the requests and responses from and to v1alpha2, so that all
+// the CRI logic is shared with the CriManager of v1alpha2.
+type criService struct {
+	criMgr criv1alpha2.CriMgr
+}
+
+// RegisterService registers the runtime.v1 RuntimeService and ImageService
+// backed by criMgr on the grpc server.
+func RegisterService(server *grpc.Server, criMgr criv1alpha2.CriMgr) {
+	s := &criService{criMgr: criMgr}
+
+	runtime.RegisterRuntimeServiceServer(server, s)
+	runtime.RegisterImageServiceServer(server, s)
+}
+
+// call converts the v1 request r to alphaReq, calls the v1alpha2 api by f
+// and converts the response of it to v1 resp.
+func (s *criService) call(r, alphaReq, resp message, f func() (message, error)) error {
+	if err := convert(r, alphaReq); err != nil {
+		return err
+	}
+
+	alphaResp, err := f()
+	if err != nil {
+		return err
+	}
+	return convert(alphaResp, resp)
+}
+
+// RuntimeService
+
+func (s *criService) Version(ctx context.Context, r *runtime.VersionRequest) (*runtime.VersionResponse, error) {
+	alphaReq, resp := &runtimealpha.VersionRequest{}, &runtime.VersionResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.Version(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) RunPodSandbox(ctx context.Context, r *runtime.RunPodSandboxRequest) (*runtime.RunPodSandboxResponse, error) {
+	alphaReq, resp := &runtimealpha.RunPodSandboxRequest{}, &runtime.RunPodSandboxResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.RunPodSandbox(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) StopPodSandbox(ctx context.Context, r *runtime.StopPodSandboxRequest) (*runtime.StopPodSandboxResponse, error) {
+	alphaReq, resp := &runtimealpha.StopPodSandboxRequest{}, &runtime.StopPodSandboxResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.StopPodSandbox(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) RemovePodSandbox(ctx context.Context, r *runtime.RemovePodSandboxRequest) (*runtime.RemovePodSandboxResponse, error) {
+	alphaReq, resp := &runtimealpha.RemovePodSandboxRequest{}, &runtime.RemovePodSandboxResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.RemovePodSandbox(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) PodSandboxStatus(ctx context.Context, r *runtime.PodSandboxStatusRequest) (*runtime.PodSandboxStatusResponse, error) {
+	alphaReq, resp := &runtimealpha.PodSandboxStatusRequest{}, &runtime.PodSandboxStatusResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.PodSandboxStatus(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) ListPodSandbox(ctx context.Context, r *runtime.ListPodSandboxRequest) (*runtime.ListPodSandboxResponse, error) {
+	alphaReq, resp := &runtimealpha.ListPodSandboxRequest{}, &runtime.ListPodSandboxResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.ListPodSandbox(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) CreateContainer(ctx context.Context, r *runtime.CreateContainerRequest) (*runtime.CreateContainerResponse, error) {
+	alphaReq, resp := &runtimealpha.CreateContainerRequest{}, &runtime.CreateContainerResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.CreateContainer(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) StartContainer(ctx context.Context, r *runtime.StartContainerRequest) (*runtime.StartContainerResponse, error) {
+	alphaReq, resp := &runtimealpha.StartContainerRequest{}, &runtime.StartContainerResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.StartContainer(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) StopContainer(ctx context.Context, r *runtime.StopContainerRequest) (*runtime.StopContainerResponse, error) {
+	alphaReq, resp := &runtimealpha.StopContainerRequest{}, &runtime.StopContainerResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.StopContainer(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) RemoveContainer(ctx context.Context, r *runtime.RemoveContainerRequest) (*runtime.RemoveContainerResponse, error) {
+	alphaReq, resp := &runtimealpha.RemoveContainerRequest{}, &runtime.RemoveContainerResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.RemoveContainer(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) ListContainers(ctx context.Context, r *runtime.ListContainersRequest) (*runtime.ListContainersResponse, error) {
+	alphaReq, resp := &runtimealpha.ListContainersRequest{}, &runtime.ListContainersResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.ListContainers(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) ContainerStatus(ctx context.Context, r *runtime.ContainerStatusRequest) (*runtime.ContainerStatusResponse, error) {
+	alphaReq, resp := &runtimealpha.ContainerStatusRequest{}, &runtime.ContainerStatusResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.ContainerStatus(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) UpdateContainerResources(ctx context.Context, r *runtime.UpdateContainerResourcesRequest) (*runtime.UpdateContainerResourcesResponse, error) {
+	alphaReq, resp := &runtimealpha.UpdateContainerResourcesRequest{}, &runtime.UpdateContainerResourcesResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.UpdateContainerResources(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) ReopenContainerLog(ctx context.Context, r *runtime.ReopenContainerLogRequest) (*runtime.ReopenContainerLogResponse, error) {
+	alphaReq, resp := &runtimealpha.ReopenContainerLogRequest{}, &runtime.ReopenContainerLogResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.ReopenContainerLog(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) PauseContainer(ctx context.Context, r *runtime.PauseContainerRequest) (*runtime.PauseContainerResponse, error) {
+	alphaReq, resp := &runtimealpha.PauseContainerRequest{}, &runtime.PauseContainerResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.PauseContainer(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) UnpauseContainer(ctx context.Context, r *runtime.UnpauseContainerRequest) (*runtime.UnpauseContainerResponse, error) {
+	alphaReq, resp := &runtimealpha.UnpauseContainerRequest{}, &runtime.UnpauseContainerResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.UnpauseContainer(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) ExecSync(ctx context.Context, r *runtime.ExecSyncRequest) (*runtime.ExecSyncResponse, error) {
+	alphaReq, resp := &runtimealpha.ExecSyncRequest{}, &runtime.ExecSyncResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.ExecSync(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) Exec(ctx context.Context, r *runtime.ExecRequest) (*runtime.ExecResponse, error) {
+	alphaReq, resp := &runtimealpha.ExecRequest{}, &runtime.ExecResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.Exec(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) Attach(ctx context.Context, r *runtime.AttachRequest) (*runtime.AttachResponse, error) {
+	alphaReq, resp := &runtimealpha.AttachRequest{}, &runtime.AttachResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.Attach(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) PortForward(ctx context.Context, r *runtime.PortForwardRequest) (*runtime.PortForwardResponse, error) {
+	alphaReq, resp := &runtimealpha.PortForwardRequest{}, &runtime.PortForwardResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.PortForward(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) ContainerStats(ctx context.Context, r *runtime.ContainerStatsRequest) (*runtime.ContainerStatsResponse, error) {
+	alphaReq, resp := &runtimealpha.ContainerStatsRequest{}, &runtime.ContainerStatsResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.ContainerStats(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) ListContainerStats(ctx context.Context, r *runtime.ListContainerStatsRequest) (*runtime.ListContainerStatsResponse, error) {
+	alphaReq, resp := &runtimealpha.ListContainerStatsRequest{}, &runtime.ListContainerStatsResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.ListContainerStats(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) PodSandboxStats(ctx context.Context, r *runtime.PodSandboxStatsRequest) (*runtime.PodSandboxStatsResponse, error) {
+	alphaReq, resp := &runtimealpha.PodSandboxStatsRequest{}, &runtime.PodSandboxStatsResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.PodSandboxStats(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) ListPodSandboxStats(ctx context.Context, r *runtime.ListPodSandboxStatsRequest) (*runtime.ListPodSandboxStatsResponse, error) {
+	alphaReq, resp := &runtimealpha.ListPodSandboxStatsRequest{}, &runtime.ListPodSandboxStatsResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.ListPodSandboxStats(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) UpdateRuntimeConfig(ctx context.Context, r *runtime.UpdateRuntimeConfigRequest) (*runtime.UpdateRuntimeConfigResponse, error) {
+	alphaReq, resp := &runtimealpha.UpdateRuntimeConfigRequest{}, &runtime.UpdateRuntimeConfigResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.UpdateRuntimeConfig(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) Status(ctx context.Context, r *runtime.StatusRequest) (*runtime.StatusResponse, error) {
+	alphaReq, resp := &runtimealpha.StatusRequest{}, &runtime.StatusResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.Status(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) StartPodSandbox(ctx context.Context, r *runtime.StartPodSandboxRequest) (*runtime.StartPodSandboxResponse, error) {
+	alphaReq, resp := &runtimealpha.StartPodSandboxRequest{}, &runtime.StartPodSandboxResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.StartPodSandbox(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) GetContainerEvents(r *runtime.GetEventsRequest, stream runtime.RuntimeService_GetContainerEventsServer) error {
+	alphaReq := &runtimealpha.GetEventsRequest{}
+	if err := convert(r, alphaReq); err != nil {
+		return err
+	}
+	return s.criMgr.GetContainerEvents(alphaReq, &containerEventsServer{stream})
+}
+
+func (s *criService) CheckpointContainer(ctx context.Context, r *runtime.CheckpointContainerRequest) (*runtime.CheckpointContainerResponse, error) {
+	alphaReq, resp := &runtimealpha.CheckpointContainerRequest{}, &runtime.CheckpointContainerResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.CheckpointContainer(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// containerEventsServer converts the v1alpha2 container events and sends
+// them to the runtime.v1 stream.
+type containerEventsServer struct {
+	runtime.RuntimeService_GetContainerEventsServer
+}
+
+func (s *containerEventsServer) Send(alphaResp *runtimealpha.ContainerEventResponse) error {
+	resp := &runtime.ContainerEventResponse{}
+	if err := convert(alphaResp, resp); err != nil {
+		return err
+	}
+	return s.RuntimeService_GetContainerEventsServer.Send(resp)
+}
+
+// ImageService
+
+func (s *criService) ListImages(ctx context.Context, r *runtime.ListImagesRequest) (*runtime.ListImagesResponse, error) {
+	alphaReq, resp := &runtimealpha.ListImagesRequest{}, &runtime.ListImagesResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.ListImages(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) ImageStatus(ctx context.Context, r *runtime.ImageStatusRequest) (*runtime.ImageStatusResponse, error) {
+	alphaReq, resp := &runtimealpha.ImageStatusRequest{}, &runtime.ImageStatusResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.ImageStatus(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) PullImage(ctx context.Context, r *runtime.PullImageRequest) (*runtime.PullImageResponse, error) {
+	alphaReq, resp := &runtimealpha.PullImageRequest{}, &runtime.PullImageResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.PullImage(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) RemoveImage(ctx context.Context, r *runtime.RemoveImageRequest) (*runtime.RemoveImageResponse, error) {
+	alphaReq, resp := &runtimealpha.RemoveImageRequest{}, &runtime.RemoveImageResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.RemoveImage(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *criService) ImageFsInfo(ctx context.Context, r *runtime.ImageFsInfoRequest) (*runtime.ImageFsInfoResponse, error) {
+	alphaReq, resp := &runtimealpha.ImageFsInfoRequest{}, &runtime.ImageFsInfoResponse{}
+	if err := s.call(r, alphaReq, resp, func() (message, error) {
+		return s.criMgr.ImageFsInfo(ctx, alphaReq)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
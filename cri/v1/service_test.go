@@ -0,0 +1,305 @@
+package v1
+
+import (
+	"context"
+	"io"
+	"net"
+	"reflect"
+	"testing"
+
+	runtime "github.com/alibaba/pouch/cri/apis/v1"
+	runtimealpha "github.com/alibaba/pouch/cri/apis/v1alpha2"
+	criv1alpha2 "github.com/alibaba/pouch/cri/v1alpha2"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeCriMgr records the requests and returns the canned responses.
+type fakeCriMgr struct {
+	criv1alpha2.CriMgr
+
+	requests []interface{}
+}
+
+func (f *fakeCriMgr) Version(ctx context.Context, r *runtimealpha.VersionRequest) (*runtimealpha.VersionResponse, error) {
+	f.requests = append(f.requests, r)
+	return &runtimealpha.VersionResponse{Version: "0.1.0", RuntimeName: "pouch", RuntimeVersion: "1.0.0", RuntimeApiVersion: "1.24"}, nil
+}
+
+func (f *fakeCriMgr) CreateContainer(ctx context.Context, r *runtimealpha.CreateContainerRequest) (*runtimealpha.CreateContainerResponse, error) {
+	f.requests = append(f.requests, r)
+	return &runtimealpha.CreateContainerResponse{ContainerId: r.GetConfig().GetMetadata().GetName() + "-id"}, nil
+}
+
+func (f *fakeCriMgr) ListContainers(ctx context.Context, r *runtimealpha.ListContainersRequest) (*runtimealpha.ListContainersResponse, error) {
+	f.requests = append(f.requests, r)
+	return &runtimealpha.ListContainersResponse{
+		Containers: []*runtimealpha.Container{{
+			Id:           "c1",
+			PodSandboxId: "p1",
+			Metadata:     &runtimealpha.ContainerMetadata{Name: "nginx", Attempt: 1},
+			Image:        &runtimealpha.ImageSpec{Image: "nginx:latest"},
+			State:        runtimealpha.ContainerState_CONTAINER_RUNNING,
+			Labels:       map[string]string{"app": "web"},
+		}},
+	}, nil
+}
+
+func (f *fakeCriMgr) RemoveContainer(ctx context.Context, r *runtimealpha.RemoveContainerRequest) (*runtimealpha.RemoveContainerResponse, error) {
+	f.requests = append(f.requests, r)
+	return nil, status.Errorf(codes.NotFound, "container %s not found", r.GetContainerId())
+}
+
+func (f *fakeCriMgr) ImageStatus(ctx context.Context, r *runtimealpha.ImageStatusRequest) (*runtimealpha.ImageStatusResponse, error) {
+	f.requests = append(f.requests, r)
+	return &runtimealpha.ImageStatusResponse{}, nil
+}
+
+func (f *fakeCriMgr) PullImage(ctx context.Context, r *runtimealpha.PullImageRequest) (*runtimealpha.PullImageResponse, error) {
+	f.requests = append(f.requests, r)
+	return &runtimealpha.PullImageResponse{ImageRef: "sha256:abc"}, nil
+}
+
+func (f *fakeCriMgr) GetContainerEvents(r *runtimealpha.GetEventsRequest, s runtimealpha.RuntimeService_GetContainerEventsServer) error {
+	f.requests = append(f.requests, r)
+	for _, id := range []string{"c1", "c2"} {
+		if err := s.Send(&runtimealpha.ContainerEventResponse{
+			ContainerId:        id,
+			ContainerEventType: runtimealpha.ContainerEventType_CONTAINER_STARTED_EVENT,
+			CreatedAt:          1,
+			PodSandboxStatus:   &runtimealpha.PodSandboxStatus{Id: "p1", State: runtimealpha.PodSandboxState_SANDBOX_READY},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// startServer starts the grpc server serving both v1alpha2 and v1 backed by
+// criMgr, and returns the client connection.
+func startServer(t *testing.T, criMgr criv1alpha2.CriMgr) (*grpc.ClientConn, func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := grpc.NewServer()
+	runtimealpha.RegisterRuntimeServiceServer(server, criMgr)
+	runtimealpha.RegisterImageServiceServer(server, criMgr)
+	RegisterService(server, criMgr)
+	go server.Serve(l)
+
+	conn, err := grpc.Dial(l.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		server.Stop()
+		t.Fatal(err)
+	}
+	return conn, func() {
+		conn.Close()
+		server.Stop()
+	}
+}
+
+// TestServiceConformance calls the same apis of both versions, and checks
+// the CriManager receives the same requests and the clients get the same
+// responses.
+func TestServiceConformance(t *testing.T) {
+	criMgr := &fakeCriMgr{}
+	conn, stop := startServer(t, criMgr)
+	defer stop()
+
+	ctx := context.Background()
+	alphaRuntime, v1Runtime := runtimealpha.NewRuntimeServiceClient(conn), runtime.NewRuntimeServiceClient(conn)
+	alphaImage, v1Image := runtimealpha.NewImageServiceClient(conn), runtime.NewImageServiceClient(conn)
+
+	for _, tc := range []struct {
+		name  string
+		alpha func() (message, error)
+		v1    func() (message, error)
+	}{
+		{
+			name: "Version",
+			alpha: func() (message, error) {
+				return alphaRuntime.Version(ctx, &runtimealpha.VersionRequest{Version: "0.1.0"})
+			},
+			v1: func() (message, error) {
+				return v1Runtime.Version(ctx, &runtime.VersionRequest{Version: "0.1.0"})
+			},
+		},
+		{
+			name: "CreateContainer",
+			alpha: func() (message, error) {
+				return alphaRuntime.CreateContainer(ctx, &runtimealpha.CreateContainerRequest{
+					PodSandboxId: "p1",
+					Config: &runtimealpha.ContainerConfig{
+						Metadata:    &runtimealpha.ContainerMetadata{Name: "nginx"},
+						Image:       &runtimealpha.ImageSpec{Image: "nginx:latest"},
+						Command:     []string{"nginx"},
+						Envs:        []*runtimealpha.KeyValue{{Key: "A", Value: "1"}},
+						Mounts:      []*runtimealpha.Mount{{ContainerPath: "/data", HostPath: "/tmp/data", Propagation: runtimealpha.MountPropagation_PROPAGATION_HOST_TO_CONTAINER}},
+						Annotations: map[string]string{"io.alibaba.pouch.init": "true"},
+						Linux: &runtimealpha.LinuxContainerConfig{
+							Resources: &runtimealpha.LinuxContainerResources{CpuShares: 512, MemoryLimitInBytes: 1 << 30},
+						},
+					},
+				})
+			},
+			v1: func() (message, error) {
+				return v1Runtime.CreateContainer(ctx, &runtime.CreateContainerRequest{
+					PodSandboxId: "p1",
+					Config: &runtime.ContainerConfig{
+						Metadata:    &runtime.ContainerMetadata{Name: "nginx"},
+						Image:       &runtime.ImageSpec{Image: "nginx:latest"},
+						Command:     []string{"nginx"},
+						Envs:        []*runtime.KeyValue{{Key: "A", Value: "1"}},
+						Mounts:      []*runtime.Mount{{ContainerPath: "/data", HostPath: "/tmp/data", Propagation: runtime.MountPropagation_PROPAGATION_HOST_TO_CONTAINER}},
+						Annotations: map[string]string{"io.alibaba.pouch.init": "true"},
+						Linux: &runtime.LinuxContainerConfig{
+							Resources: &runtime.LinuxContainerResources{CpuShares: 512, MemoryLimitInBytes: 1 << 30},
+						},
+					},
+				})
+			},
+		},
+		{
+			name: "ListContainers",
+			alpha: func() (message, error) {
+				return alphaRuntime.ListContainers(ctx, &runtimealpha.ListContainersRequest{
+					Filter: &runtimealpha.ContainerFilter{
+						State:         &runtimealpha.ContainerStateValue{State: runtimealpha.ContainerState_CONTAINER_RUNNING},
+						LabelSelector: map[string]string{"app": "web"},
+					},
+				})
+			},
+			v1: func() (message, error) {
+				return v1Runtime.ListContainers(ctx, &runtime.ListContainersRequest{
+					Filter: &runtime.ContainerFilter{
+						State:         &runtime.ContainerStateValue{State: runtime.ContainerState_CONTAINER_RUNNING},
+						LabelSelector: map[string]string{"app": "web"},
+					},
+				})
+			},
+		},
+		{
+			name: "RemoveContainer",
+			alpha: func() (message, error) {
+				return alphaRuntime.RemoveContainer(ctx, &runtimealpha.RemoveContainerRequest{ContainerId: "c1"})
+			},
+			v1: func() (message, error) {
+				return v1Runtime.RemoveContainer(ctx, &runtime.RemoveContainerRequest{ContainerId: "c1"})
+			},
+		},
+		{
+			name: "PullImage",
+			alpha: func() (message, error) {
+				return alphaImage.PullImage(ctx, &runtimealpha.PullImageRequest{
+					Image: &runtimealpha.ImageSpec{Image: "nginx:latest"},
+					Auth:  &runtimealpha.AuthConfig{Username: "user", Password: "pass"},
+				})
+			},
+			v1: func() (message, error) {
+				return v1Image.PullImage(ctx, &runtime.PullImageRequest{
+					Image: &runtime.ImageSpec{Image: "nginx:latest"},
+					Auth:  &runtime.AuthConfig{Username: "user", Password: "pass"},
+				})
+			},
+		},
+		{
+			name: "ImageStatus",
+			alpha: func() (message, error) {
+				return alphaImage.ImageStatus(ctx, &runtimealpha.ImageStatusRequest{Image: &runtimealpha.ImageSpec{Image: "busybox"}, Verbose: true})
+			},
+			v1: func() (message, error) {
+				return v1Image.ImageStatus(ctx, &runtime.ImageStatusRequest{Image: &runtime.ImageSpec{Image: "busybox"}, Verbose: true})
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			criMgr.requests = nil
+
+			alphaResp, alphaErr := tc.alpha()
+			v1Resp, v1Err := tc.v1()
+
+			// the CriManager receives the same requests from both versions.
+			assert.Equal(t, 2, len(criMgr.requests))
+			assert.Equal(t, criMgr.requests[0], criMgr.requests[1])
+
+			assert.Equal(t, status.Code(alphaErr), status.Code(v1Err))
+			if alphaErr != nil {
+				assert.Equal(t, status.Convert(alphaErr).Message(), status.Convert(v1Err).Message())
+				return
+			}
+
+			// the responses of both versions are the same in wire format.
+			alphaData, err := alphaResp.Marshal()
+			assert.NoError(t, err)
+			v1Data, err := v1Resp.Marshal()
+			assert.NoError(t, err)
+			assert.Equal(t, alphaData, v1Data)
+		})
+	}
+}
+
+func TestServiceImplementsAllMethods(t *testing.T) {
+	// every method of the v1alpha2 services is served by v1 as well.
+	s := reflect.TypeOf(&criService{})
+	for _, iface := range []reflect.Type{
+		reflect.TypeOf((*runtimealpha.RuntimeServiceServer)(nil)).Elem(),
+		reflect.TypeOf((*runtimealpha.ImageServiceServer)(nil)).Elem(),
+	} {
+		for i := 0; i < iface.NumMethod(); i++ {
+			_, ok := s.MethodByName(iface.Method(i).Name)
+			assert.True(t, ok, "method %s is not served by v1", iface.Method(i).Name)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	v1 := &runtime.ContainerStatusResponse{}
+	assert.NoError(t, convert(&runtimealpha.ContainerStatusResponse{
+		Status: &runtimealpha.ContainerStatus{Id: "c1", ExitCode: 1, Reason: "Error"},
+		Info:   map[string]string{"info": "{}"},
+	}, v1))
+	assert.Equal(t, "c1", v1.GetStatus().GetId())
+	assert.Equal(t, int32(1), v1.GetStatus().GetExitCode())
+	assert.Equal(t, map[string]string{"info": "{}"}, v1.GetInfo())
+
+	// nil message is converted to empty one.
+	var nilResp *runtimealpha.ContainerStatusResponse
+	v1 = &runtime.ContainerStatusResponse{}
+	assert.NoError(t, convert(nilResp, v1))
+	assert.Nil(t, v1.GetStatus())
+}
+
+func TestGetContainerEvents(t *testing.T) {
+	criMgr := &fakeCriMgr{}
+	conn, stop := startServer(t, criMgr)
+	defer stop()
+
+	stream, err := runtime.NewRuntimeServiceClient(conn).GetContainerEvents(context.Background(), &runtime.GetEventsRequest{})
+	assert.NoError(t, err)
+
+	var events []*runtime.ContainerEventResponse
+	for {
+		ev, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		events = append(events, ev)
+	}
+
+	assert.Equal(t, 2, len(events))
+	for i, id := range []string{"c1", "c2"} {
+		assert.Equal(t, &runtime.ContainerEventResponse{
+			ContainerId:        id,
+			ContainerEventType: runtime.ContainerEventType_CONTAINER_STARTED_EVENT,
+			CreatedAt:          1,
+			PodSandboxStatus:   &runtime.PodSandboxStatus{Id: "p1", State: runtime.PodSandboxState_SANDBOX_READY},
+		}, events[i])
+	}
+	assert.Equal(t, []interface{}{&runtimealpha.GetEventsRequest{}}, criMgr.requests)
+}
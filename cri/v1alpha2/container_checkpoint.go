@@ -0,0 +1,70 @@
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	runtime "github.com/alibaba/pouch/cri/apis/v1alpha2"
+	"github.com/alibaba/pouch/cri/metrics"
+	util_metrics "github.com/alibaba/pouch/pkg/utils/metrics"
+)
+
+// criCheckpointPrefix is the prefix of the checkpoint created by CRI, the
+// checkpoint is removed after it is exported.
+const criCheckpointPrefix = "cri-checkpoint-"
+
+// CheckpointContainer checkpoints a container. The container keeps running,
+// and the checkpoint images, the config and the writable layer of it are
+// written to the archive at location. A running container is paused by the
+// container manager until the writable layer is exported, so the writable
+// layer is consistent with the checkpoint images.
+func (c *CriManager) CheckpointContainer(ctx context.Context, r *runtime.CheckpointContainerRequest) (*runtime.CheckpointContainerResponse, error) {
+	label := util_metrics.ActionCheckpointLabel
+	metrics.ContainerActionsCounter.WithLabelValues(label).Inc()
+	defer func(start time.Time) {
+		metrics.ContainerActionsTimer.WithLabelValues(label).Observe(time.Since(start).Seconds())
+	}(time.Now())
+
+	containerID := r.GetContainerId()
+	location := r.GetLocation()
+	if location == "" || !filepath.IsAbs(location) {
+		return nil, fmt.Errorf("location of checkpoint archive should be an absolute path, got %q", location)
+	}
+
+	if r.GetTimeout() > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(r.GetTimeout())*time.Second)
+		defer cancel()
+	}
+
+	checkpointID := fmt.Sprintf("%s%d", criCheckpointPrefix, time.Now().UnixNano())
+
+	// write to a temp file first, so that the partial archive is never left
+	// at location.
+	f, err := ioutil.TempFile(filepath.Dir(location), "."+filepath.Base(location))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checkpoint archive: %v", err)
+	}
+	defer func() {
+		f.Close()
+		os.Remove(f.Name())
+	}()
+
+	if err := c.ContainerMgr.CheckpointAndExport(ctx, containerID, checkpointID, f); err != nil {
+		return nil, fmt.Errorf("failed to checkpoint container %q: %v", containerID, err)
+	}
+	if err := f.Sync(); err != nil {
+		return nil, fmt.Errorf("failed to sync checkpoint archive: %v", err)
+	}
+	if err := os.Rename(f.Name(), location); err != nil {
+		return nil, fmt.Errorf("failed to create checkpoint archive: %v", err)
+	}
+
+	metrics.ContainerSuccessActionsCounter.WithLabelValues(label).Inc()
+
+	return &runtime.CheckpointContainerResponse{}, nil
+}
@@ -0,0 +1,78 @@
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	runtime "github.com/alibaba/pouch/cri/apis/v1alpha2"
+	"github.com/alibaba/pouch/daemon/mgr"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeCheckpointContainerMgr records the checkpoints of container.
+type fakeCheckpointContainerMgr struct {
+	mgr.ContainerMgr
+
+	checkpoints []string
+	exportErr   error
+}
+
+func (f *fakeCheckpointContainerMgr) CheckpointAndExport(ctx context.Context, name, checkpointID string, w io.Writer) error {
+	f.checkpoints = append(f.checkpoints, checkpointID)
+	if _, err := io.WriteString(w, name+"/"+checkpointID); err != nil {
+		return err
+	}
+	return f.exportErr
+}
+
+func TestCheckpointContainer(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "cri-checkpoint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	ctrMgr := &fakeCheckpointContainerMgr{}
+	c := &CriManager{ContainerMgr: ctrMgr}
+
+	location := filepath.Join(tmpDir, "checkpoint.tar")
+	_, err = c.CheckpointContainer(context.Background(), &runtime.CheckpointContainerRequest{
+		ContainerId: "c1",
+		Location:    location,
+		Timeout:     10,
+	})
+	assert.NoError(t, err)
+
+	data, err := ioutil.ReadFile(location)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(data), "c1/"+criCheckpointPrefix))
+	assert.Equal(t, 1, len(ctrMgr.checkpoints))
+
+	// the partial archive is removed if failed to export.
+	ctrMgr.exportErr = fmt.Errorf("failed to export")
+	_, err = c.CheckpointContainer(context.Background(), &runtime.CheckpointContainerRequest{
+		ContainerId: "c1",
+		Location:    filepath.Join(tmpDir, "failed.tar"),
+	})
+	assert.Error(t, err)
+
+	files, err := ioutil.ReadDir(tmpDir)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(files))
+	assert.Equal(t, "checkpoint.tar", files[0].Name())
+
+	for _, location := range []string{"", "checkpoint.tar"} {
+		_, err = c.CheckpointContainer(context.Background(), &runtime.CheckpointContainerRequest{
+			ContainerId: "c1",
+			Location:    location,
+		})
+		assert.Error(t, err, location)
+	}
+}
@@ -0,0 +1,189 @@
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alibaba/pouch/apis/filters"
+	apitypes "github.com/alibaba/pouch/apis/types"
+	runtime "github.com/alibaba/pouch/cri/apis/v1alpha2"
+	"github.com/alibaba/pouch/pkg/log"
+)
+
+const (
+	// exitHandledTimeout is the max time to wait for pouchd to handle the
+	// exit of container after the die event.
+	exitHandledTimeout = 2 * time.Second
+	// exitHandledInterval is the interval to check the state of container.
+	exitHandledInterval = 100 * time.Millisecond
+)
+
+// containerEventTypes maps the pouch container events to CRI container events.
+var containerEventTypes = map[string]runtime.ContainerEventType{
+	"create":  runtime.ContainerEventType_CONTAINER_CREATED_EVENT,
+	"start":   runtime.ContainerEventType_CONTAINER_STARTED_EVENT,
+	"die":     runtime.ContainerEventType_CONTAINER_STOPPED_EVENT,
+	"destroy": runtime.ContainerEventType_CONTAINER_DELETED_EVENT,
+}
+
+// containerEventResult is the response of the event converted asynchronously.
+type containerEventResult struct {
+	id   string
+	resp *runtime.ContainerEventResponse
+}
+
+// GetContainerEvents gets container events from the CRI runtime. The events
+// of containers and sandboxes managed by CRI are sent with the status of the
+// pod sandbox until the stream is closed.
+func (c *CriManager) GetContainerEvents(r *runtime.GetEventsRequest, s runtime.RuntimeService_GetContainerEventsServer) error {
+	ctx, cancel := context.WithCancel(s.Context())
+	defer cancel()
+
+	filter := filters.NewArgs(filters.Arg("type", string(apitypes.EventTypeContainer)))
+	for action := range containerEventTypes {
+		filter.Add("event", action)
+	}
+	_, eventq, errq := c.SystemMgr.SubscribeToEvents(ctx, time.Time{}, time.Time{}, filter)
+
+	var (
+		// held keeps the events of the container whose stopped event is
+		// pending, they are sent after the stopped event in order.
+		held  = make(map[string][]*apitypes.EventsMessage)
+		exitq = make(chan containerEventResult)
+	)
+
+	send := func(resp *runtime.ContainerEventResponse) error {
+		if err := s.Send(resp); err != nil {
+			return fmt.Errorf("failed to send event of container %q: %v", resp.ContainerId, err)
+		}
+		return nil
+	}
+
+	// handle sends the event of container. The die event is sent before
+	// pouchd updates the state of container, so the stopped event is sent
+	// after the exit is handled, without blocking the events of others.
+	handle := func(ev *apitypes.EventsMessage) error {
+		if containerEventSandboxID(ev) == "" {
+			return nil
+		}
+
+		id := ev.Actor.ID
+		if _, pending := held[id]; pending {
+			held[id] = append(held[id], ev)
+			return nil
+		}
+
+		if containerEventTypes[ev.Action] != runtime.ContainerEventType_CONTAINER_STOPPED_EVENT {
+			return send(c.toContainerEventResponse(ctx, ev))
+		}
+
+		held[id] = nil
+		go func() {
+			c.waitExitHandled(ctx, id)
+			select {
+			case exitq <- containerEventResult{id: id, resp: c.toContainerEventResponse(ctx, ev)}:
+			case <-ctx.Done():
+			}
+		}()
+		return nil
+	}
+
+	for {
+		select {
+		case ev := <-eventq:
+			if err := handle(ev); err != nil {
+				return err
+			}
+		case result := <-exitq:
+			if err := send(result.resp); err != nil {
+				return err
+			}
+
+			events := held[result.id]
+			delete(held, result.id)
+			for _, ev := range events {
+				if err := handle(ev); err != nil {
+					return err
+				}
+			}
+		case err := <-errq:
+			return err
+		}
+	}
+}
+
+// containerEventSandboxID returns the id of the sandbox which the container
+// of event belongs to, empty is returned if it is not a container event of CRI.
+func containerEventSandboxID(ev *apitypes.EventsMessage) string {
+	if _, ok := containerEventTypes[ev.Action]; !ok || ev.Actor == nil {
+		return ""
+	}
+
+	switch ev.Actor.Attributes[containerTypeLabelKey] {
+	case containerTypeLabelSandbox:
+		return ev.Actor.ID
+	case containerTypeLabelContainer:
+		return ev.Actor.Attributes[sandboxIDLabelKey]
+	}
+	return ""
+}
+
+// toContainerEventResponse converts the CRI container event to the response
+// with the status of the pod sandbox and its containers.
+func (c *CriManager) toContainerEventResponse(ctx context.Context, ev *apitypes.EventsMessage) *runtime.ContainerEventResponse {
+	sandboxID := containerEventSandboxID(ev)
+	resp := &runtime.ContainerEventResponse{
+		ContainerId:        ev.Actor.ID,
+		ContainerEventType: containerEventTypes[ev.Action],
+		CreatedAt:          ev.TimeNano,
+	}
+
+	sandboxStatus, err := c.PodSandboxStatus(ctx, &runtime.PodSandboxStatusRequest{PodSandboxId: sandboxID})
+	if err != nil {
+		// the sandbox may have been removed.
+		log.With(ctx).Debugf("failed to get status of sandbox %q for event %s of %q: %v", sandboxID, ev.Action, ev.Actor.ID, err)
+		return resp
+	}
+	resp.PodSandboxStatus = sandboxStatus.GetStatus()
+
+	containers, err := c.ListContainers(ctx, &runtime.ListContainersRequest{
+		Filter: &runtime.ContainerFilter{PodSandboxId: sandboxID},
+	})
+	if err != nil {
+		log.With(ctx).Warnf("failed to list containers of sandbox %q: %v", sandboxID, err)
+		return resp
+	}
+	for _, container := range containers.GetContainers() {
+		status, err := c.ContainerStatus(ctx, &runtime.ContainerStatusRequest{ContainerId: container.Id})
+		if err != nil {
+			log.With(ctx).Debugf("failed to get status of container %q: %v", container.Id, err)
+			continue
+		}
+		resp.ContainersStatuses = append(resp.ContainersStatuses, status.GetStatus())
+	}
+
+	return resp
+}
+
+// waitExitHandled waits until pouchd marks the container exited, or timeout.
+func (c *CriManager) waitExitHandled(ctx context.Context, id string) {
+	ctx, cancel := context.WithTimeout(ctx, exitHandledTimeout)
+	defer cancel()
+
+	tick := time.NewTicker(exitHandledInterval)
+	defer tick.Stop()
+	for {
+		container, err := c.ContainerMgr.Get(ctx, id)
+		if err != nil || !container.IsRunningOrPaused() {
+			return
+		}
+
+		select {
+		case <-tick.C:
+		case <-ctx.Done():
+			log.With(ctx).Warnf("timeout to wait for the exit of container %q to be handled", id)
+			return
+		}
+	}
+}
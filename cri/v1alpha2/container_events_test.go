@@ -0,0 +1,246 @@
+package v1alpha2
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/filters"
+	apitypes "github.com/alibaba/pouch/apis/types"
+	runtime "github.com/alibaba/pouch/cri/apis/v1alpha2"
+	metatypes "github.com/alibaba/pouch/cri/v1alpha2/types"
+	"github.com/alibaba/pouch/daemon/mgr"
+	"github.com/alibaba/pouch/pkg/meta"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// fakeSystemMgr publishes the events to the subscriber.
+type fakeSystemMgr struct {
+	mgr.SystemMgr
+
+	events []*apitypes.EventsMessage
+	filter filters.Args
+	// done is closed to end the subscription if it is not nil.
+	done chan struct{}
+}
+
+func (f *fakeSystemMgr) SubscribeToEvents(ctx context.Context, since, until time.Time, filter filters.Args) ([]apitypes.EventsMessage, <-chan *apitypes.EventsMessage, <-chan error) {
+	f.filter = filter
+
+	eventq, errq := make(chan *apitypes.EventsMessage), make(chan error)
+	go func() {
+		for _, ev := range f.events {
+			eventq <- ev
+		}
+		if f.done != nil {
+			<-f.done
+		}
+		errq <- nil
+	}()
+	return nil, eventq, errq
+}
+
+// fakeContainerEventsServer records the events sent.
+type fakeContainerEventsServer struct {
+	grpc.ServerStream
+
+	events []*runtime.ContainerEventResponse
+	// onSend is called after the event is recorded if it is not nil.
+	onSend func(ev *runtime.ContainerEventResponse)
+}
+
+func (f *fakeContainerEventsServer) Context() context.Context {
+	return context.Background()
+}
+
+func (f *fakeContainerEventsServer) Send(ev *runtime.ContainerEventResponse) error {
+	f.events = append(f.events, ev)
+	if f.onSend != nil {
+		f.onSend(ev)
+	}
+	return nil
+}
+
+// fakeContainerMgr reports the container running until its exit is handled.
+type fakeContainerMgr struct {
+	mgr.ContainerMgr
+
+	running map[string]chan struct{}
+}
+
+func (f *fakeContainerMgr) Get(ctx context.Context, id string) (*mgr.Container, error) {
+	state := &apitypes.ContainerState{}
+	if exited, ok := f.running[id]; ok {
+		select {
+		case <-exited:
+		default:
+			state.Running = true
+		}
+	}
+	return &mgr.Container{ID: id, State: state}, nil
+}
+
+func TestGetContainerEvents(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "container-events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	sandboxStore, err := meta.NewStore(meta.Config{
+		Driver:  "local",
+		BaseDir: tmpDir,
+		Buckets: []meta.Bucket{
+			{
+				Name: meta.MetaJSONFile,
+				Type: reflect.TypeOf(metatypes.SandboxMeta{}),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	systemMgr := &fakeSystemMgr{
+		events: []*apitypes.EventsMessage{
+			{
+				// not managed by CRI.
+				Action: "start",
+				Actor:  &apitypes.EventsActor{ID: "c0"},
+			},
+			{
+				Action:   "destroy",
+				TimeNano: 1,
+				Actor: &apitypes.EventsActor{ID: "c1", Attributes: map[string]string{
+					containerTypeLabelKey: containerTypeLabelContainer,
+					sandboxIDLabelKey:     "p1",
+				}},
+			},
+			{
+				// not a container event of CRI.
+				Action: "pause",
+				Actor: &apitypes.EventsActor{ID: "c1", Attributes: map[string]string{
+					containerTypeLabelKey: containerTypeLabelContainer,
+					sandboxIDLabelKey:     "p1",
+				}},
+			},
+			{
+				Action:   "destroy",
+				TimeNano: 2,
+				Actor: &apitypes.EventsActor{ID: "p1", Attributes: map[string]string{
+					containerTypeLabelKey: containerTypeLabelSandbox,
+				}},
+			},
+		},
+	}
+	c := &CriManager{SystemMgr: systemMgr, SandboxStore: sandboxStore}
+
+	s := &fakeContainerEventsServer{}
+	assert.NoError(t, c.GetContainerEvents(&runtime.GetEventsRequest{}, s))
+
+	assert.True(t, systemMgr.filter.ExactMatch("type", string(apitypes.EventTypeContainer)))
+	for _, action := range []string{"create", "start", "die", "destroy"} {
+		assert.True(t, systemMgr.filter.ExactMatch("event", action), action)
+	}
+	assert.False(t, systemMgr.filter.ExactMatch("event", "pause"))
+
+	// the sandbox has been removed, so no status is attached.
+	assert.Equal(t, []*runtime.ContainerEventResponse{
+		{
+			ContainerId:        "c1",
+			ContainerEventType: runtime.ContainerEventType_CONTAINER_DELETED_EVENT,
+			CreatedAt:          1,
+		},
+		{
+			ContainerId:        "p1",
+			ContainerEventType: runtime.ContainerEventType_CONTAINER_DELETED_EVENT,
+			CreatedAt:          2,
+		},
+	}, s.events)
+}
+
+func TestGetContainerEventsWaitExitHandled(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "container-events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	sandboxStore, err := meta.NewStore(meta.Config{
+		Driver:  "local",
+		BaseDir: tmpDir,
+		Buckets: []meta.Bucket{
+			{
+				Name: meta.MetaJSONFile,
+				Type: reflect.TypeOf(metatypes.SandboxMeta{}),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event := func(action, id string, timeNano int64) *apitypes.EventsMessage {
+		return &apitypes.EventsMessage{
+			Action:   action,
+			TimeNano: timeNano,
+			Actor: &apitypes.EventsActor{ID: id, Attributes: map[string]string{
+				containerTypeLabelKey: containerTypeLabelContainer,
+				sandboxIDLabelKey:     "p1",
+			}},
+		}
+	}
+
+	systemMgr := &fakeSystemMgr{
+		events: []*apitypes.EventsMessage{
+			event("die", "c1", 1),
+			event("destroy", "c1", 2),
+			event("start", "c2", 3),
+		},
+		done: make(chan struct{}),
+	}
+	c1Exited := make(chan struct{})
+	c := &CriManager{
+		SystemMgr:    systemMgr,
+		SandboxStore: sandboxStore,
+		ContainerMgr: &fakeContainerMgr{running: map[string]chan struct{}{"c1": c1Exited}},
+	}
+
+	s := &fakeContainerEventsServer{}
+	s.onSend = func(ev *runtime.ContainerEventResponse) {
+		// the exit of c1 is handled after the event of c2 is sent,
+		// so the pending stopped event doesn't block others.
+		if ev.ContainerId == "c2" {
+			close(c1Exited)
+		}
+		if len(s.events) == 3 {
+			close(systemMgr.done)
+		}
+	}
+
+	assert.NoError(t, c.GetContainerEvents(&runtime.GetEventsRequest{}, s))
+
+	// the events of c1 are kept in order after the stopped one.
+	assert.Equal(t, []*runtime.ContainerEventResponse{
+		{
+			ContainerId:        "c2",
+			ContainerEventType: runtime.ContainerEventType_CONTAINER_STARTED_EVENT,
+			CreatedAt:          3,
+		},
+		{
+			ContainerId:        "c1",
+			ContainerEventType: runtime.ContainerEventType_CONTAINER_STOPPED_EVENT,
+			CreatedAt:          1,
+		},
+		{
+			ContainerId:        "c1",
+			ContainerEventType: runtime.ContainerEventType_CONTAINER_DELETED_EVENT,
+			CreatedAt:          2,
+		},
+	}, s.events)
+}
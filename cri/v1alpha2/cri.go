@@ -34,6 +34,8 @@ import (
 	"github.com/alibaba/pouch/version"
 
 	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
 const (
@@ -104,6 +106,8 @@ type CriManager struct {
 	ContainerMgr mgr.ContainerMgr
 	ImageMgr     mgr.ImageMgr
 	VolumeMgr    mgr.VolumeMgr
+	NetworkMgr   mgr.NetworkMgr
+	SystemMgr    mgr.SystemMgr
 	CniMgr       cni.CniMgr
 	CriPlugin    hookplugins.CriPlugin
 
@@ -125,32 +129,37 @@ type CriManager struct {
 	// imageFSPath is the path to image filesystem.
 	imageFSPath string
 
+	// podNetworkStats caches the network stats of pod sandboxes, it is nil
+	// if cri doesn't collect stats periodically.
+	podNetworkStats *podNetworkStatsStore
+
 	// DaemonConfig is the config of daemon
 	DaemonConfig *config.Config
 }
 
 // NewCriManager creates a brand new cri manager.
-func NewCriManager(config *config.Config, ctrMgr mgr.ContainerMgr, imgMgr mgr.ImageMgr, volumeMgr mgr.VolumeMgr, criPlugin hookplugins.CriPlugin) (CriMgr, error) {
+func NewCriManager(config *config.Config, ctrMgr mgr.ContainerMgr, imgMgr mgr.ImageMgr, volumeMgr mgr.VolumeMgr, networkMgr mgr.NetworkMgr, systemMgr mgr.SystemMgr, criPlugin hookplugins.CriPlugin) (CriMgr, error) {
 	streamCfg, err := toStreamConfig(config)
 	if err != nil {
 		return nil, err
 	}
-	streamServer, err := NewStreamServer(streamCfg, stream.NewStreamRuntime(ctrMgr))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create stream server for cri manager: %v", err)
-	}
-
 	c := &CriManager{
 		ContainerMgr:   ctrMgr,
 		ImageMgr:       imgMgr,
 		VolumeMgr:      volumeMgr,
+		NetworkMgr:     networkMgr,
+		SystemMgr:      systemMgr,
 		CriPlugin:      criPlugin,
-		StreamServer:   streamServer,
 		SandboxBaseDir: path.Join(config.HomeDir, "sandboxes"),
 		SandboxImage:   config.CriConfig.SandboxImage,
 		SnapshotStore:  mgr.NewSnapshotStore(),
 		DaemonConfig:   config,
 	}
+	c.StreamServer, err = NewStreamServer(streamCfg, stream.NewStreamRuntime(ctrMgr), c.validateStreamRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stream server for cri manager: %v", err)
+	}
+
 	c.CniMgr, err = cni.NewCniManager(&config.CriConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create cni manager: %v", err)
@@ -183,6 +192,9 @@ func NewCriManager(config *config.Config, ctrMgr mgr.ContainerMgr, imgMgr mgr.Im
 			time.Duration(period)*time.Second,
 		)
 		snapshotsSyncer.Start()
+
+		c.podNetworkStats = newPodNetworkStatsStore()
+		c.startPodNetworkStatsSyncer(time.Duration(period) * time.Second)
 	} else {
 		log.With(nil).Infof("disable cri to collect stats from containerd periodically")
 	}
@@ -263,6 +275,10 @@ func (c *CriManager) RunPodSandbox(ctx context.Context, r *runtime.RunPodSandbox
 
 	// If it is in host network, no need to configure the network of sandbox.
 	if sandboxNetworkMode(config) != runtime.NamespaceMode_NODE {
+		sandboxMeta.Networks, err = c.makePodNetworks(config.GetAnnotations())
+		if err != nil {
+			return nil, err
+		}
 		sandboxMeta.NetNS, err = c.CniMgr.NewNetNS()
 		if err != nil {
 			return nil, err
@@ -274,12 +290,12 @@ func (c *CriManager) RunPodSandbox(ctx context.Context, r *runtime.RunPodSandbox
 				}
 			}
 		}()
-		if err := c.setupPodNetwork(id, sandboxMeta.NetNS, config); err != nil {
+		if err := c.setupPodNetwork(id, sandboxMeta.NetNS, sandboxMeta.Networks, config); err != nil {
 			return nil, err
 		}
 		defer func() {
 			if retErr != nil {
-				if err := c.teardownNetwork(id, sandboxMeta.NetNS, config); err != nil {
+				if err := c.teardownNetwork(id, sandboxMeta.NetNS, sandboxMeta.Networks, config); err != nil {
 					log.With(ctx).Errorf("failed to teardown pod network for sandbox %q: %v", id, err)
 				}
 			}
@@ -289,7 +305,7 @@ func (c *CriManager) RunPodSandbox(ctx context.Context, r *runtime.RunPodSandbox
 	// Step 3: Create the sandbox container.
 
 	// applies the runtime of container specified by the caller.
-	if err := c.applySandboxRuntimeHandler(sandboxMeta, r.GetRuntimeHandler(), config.GetAnnotations()); err != nil {
+	if err := c.applySandboxRuntimeHandler(sandboxMeta, r.GetRuntimeHandler(), config); err != nil {
 		return nil, err
 	}
 
@@ -304,6 +320,7 @@ func (c *CriManager) RunPodSandbox(ctx context.Context, r *runtime.RunPodSandbox
 		return nil, fmt.Errorf("failed to make sandbox pouch config for pod %q: %v", config.GetMetadata().GetName(), err)
 	}
 	createConfig.SpecificID = id
+	c.applyRuntimeHandlerAnnotations(createConfig.SpecAnnotation, sandboxMeta.Runtime, config.GetAnnotations())
 
 	sandboxName := makeSandboxName(config)
 
@@ -382,9 +399,9 @@ func (c *CriManager) StartPodSandbox(ctx context.Context, r *runtime.StartPodSan
 	sandboxMeta := res.(*metatypes.SandboxMeta)
 
 	if mgr.IsNetNS(sandbox.HostConfig.NetworkMode) {
-		ip, _ := c.CniMgr.GetPodNetworkStatus(sandboxMeta.NetNS)
+		ips, _ := c.CniMgr.GetPodNetworkStatus(sandboxMeta.NetNS, sandboxMeta.Networks)
 		// recover network if it is down.
-		if ip == "" {
+		if len(ips) == 0 {
 			if err := c.CniMgr.RecoverNetNS(sandboxMeta.NetNS); err != nil {
 				return nil, fmt.Errorf("failed to recover netns %s for sandbox %q: %v", sandboxMeta.NetNS, podSandboxID, err)
 			}
@@ -396,12 +413,12 @@ func (c *CriManager) StartPodSandbox(ctx context.Context, r *runtime.StartPodSan
 				}
 			}()
 
-			if err = c.setupPodNetwork(podSandboxID, sandboxMeta.NetNS, sandboxMeta.Config); err != nil {
+			if err = c.setupPodNetwork(podSandboxID, sandboxMeta.NetNS, sandboxMeta.Networks, sandboxMeta.Config); err != nil {
 				return nil, err
 			}
 			defer func() {
 				if retErr != nil {
-					if err := c.teardownNetwork(podSandboxID, sandboxMeta.NetNS, sandboxMeta.Config); err != nil {
+					if err := c.teardownNetwork(podSandboxID, sandboxMeta.NetNS, sandboxMeta.Networks, sandboxMeta.Config); err != nil {
 						log.With(ctx).Errorf("failed to teardown pod network for sandbox %q: %v", podSandboxID, err)
 					}
 				}
@@ -425,7 +442,7 @@ func (c *CriManager) StartPodSandbox(ctx context.Context, r *runtime.StartPodSan
 
 	// legacy container using /proc/$pid/ns/net as the sandbox netns.
 	if mgr.IsNone(sandbox.HostConfig.NetworkMode) {
-		if err = c.setupPodNetwork(podSandboxID, containerNetns(sandbox), sandboxMeta.Config); err != nil {
+		if err = c.setupPodNetwork(podSandboxID, containerNetns(sandbox), sandboxMeta.Networks, sandboxMeta.Config); err != nil {
 			return nil, err
 		}
 	}
@@ -495,7 +512,7 @@ func (c *CriManager) StopPodSandbox(ctx context.Context, r *runtime.StopPodSandb
 		if err != nil {
 			return nil, err
 		}
-		if err = c.teardownNetwork(podSandboxID, containerNetns(container), sandboxMeta.Config); err != nil {
+		if err = c.teardownNetwork(podSandboxID, containerNetns(container), sandboxMeta.Networks, sandboxMeta.Config); err != nil {
 			return nil, fmt.Errorf("failed to teardown network of sandbox %s, ns path %s: %v", podSandboxID, sandboxMeta.NetNS, err)
 		}
 	}
@@ -514,7 +531,7 @@ func (c *CriManager) StopPodSandbox(ctx context.Context, r *runtime.StopPodSandb
 
 	// After container stop, no one refer the net namespace, do the clean up job.
 	if sandboxNetworkMode(sandboxMeta.Config) != runtime.NamespaceMode_NODE && sandboxMeta.NetNS != "" {
-		if err := c.teardownNetwork(podSandboxID, sandboxMeta.NetNS, sandboxMeta.Config); err != nil {
+		if err := c.teardownNetwork(podSandboxID, sandboxMeta.NetNS, sandboxMeta.Networks, sandboxMeta.Config); err != nil {
 			return nil, fmt.Errorf("failed to teardown network of sandbox %s, ns path %s: %v", podSandboxID, sandboxMeta.NetNS, err)
 		}
 		if err := c.CniMgr.CloseNetNS(sandboxMeta.NetNS); err != nil {
@@ -645,18 +662,19 @@ func (c *CriManager) PodSandboxStatus(ctx context.Context, r *runtime.PodSandbox
 	nsOpts := sandboxMeta.Config.GetLinux().GetSecurityContext().GetNamespaceOptions()
 	hostNet := nsOpts.GetNetwork() == runtime.NamespaceMode_NODE
 
-	var ip string
+	networkStatus := &runtime.PodSandboxNetworkStatus{}
 	// No need to get ip for host network mode.
 	if !hostNet {
-		ip, err = c.CniMgr.GetPodNetworkStatus(containerNetns(sandbox))
+		ips, err := c.CniMgr.GetPodNetworkStatus(containerNetns(sandbox), sandboxMeta.Networks)
 		if err != nil {
 			// Maybe the pod has been stopped.
 			log.With(ctx).Warnf("failed to get ip of sandbox %q: %v", podSandboxID, err)
 		}
+		networkStatus = toCriPodSandboxNetworkStatus(ips)
 	}
 
 	if v, exist := annotations[anno.PassthruKey]; exist && v == "true" {
-		ip = annotations[anno.PassthruIP]
+		networkStatus.Ip = annotations[anno.PassthruIP]
 	}
 
 	status := &runtime.PodSandboxStatus{
@@ -666,7 +684,7 @@ func (c *CriManager) PodSandboxStatus(ctx context.Context, r *runtime.PodSandbox
 		Metadata:    sandboxMeta.Config.Metadata,
 		Labels:      labels,
 		Annotations: annotations,
-		Network:     &runtime.PodSandboxNetworkStatus{Ip: ip},
+		Network:     networkStatus,
 		Linux: &runtime.LinuxPodSandboxStatus{
 			Namespaces: &runtime.Namespace{
 				Options: &runtime.NamespaceOption{
@@ -811,6 +829,11 @@ func (c *CriManager) CreateContainer(ctx context.Context, r *runtime.CreateConta
 		return nil, err
 	}
 
+	// containers share the user namespace of sandbox.
+	if len(sandbox.UIDMappings) > 0 {
+		createConfig.HostConfig.UsernsMode = fmt.Sprintf("container:%s", podSandboxID)
+	}
+
 	// Bindings to overwrite the container's /etc/resolv.conf, /etc/hosts etc.
 	sandboxRootDir := path.Join(c.SandboxBaseDir, podSandboxID)
 	createConfig.HostConfig.Binds = append(createConfig.HostConfig.Binds, generateContainerMounts(sandboxRootDir)...)
@@ -1252,6 +1275,51 @@ func (c *CriManager) PortForward(ctx context.Context, r *runtime.PortForwardRequ
 	return c.StreamServer.GetPortForward(r)
 }
 
+// validateStreamRequest validates the container of exec and attach request, or
+// the sandbox of port forward request, is running and not restarted since the
+// request is issued.
+func (c *CriManager) validateStreamRequest(req stream.Request, issuedAt time.Time) error {
+	ctx := context.Background()
+
+	switch r := req.(type) {
+	case *runtime.ExecRequest:
+		return c.validateStreamContainer(ctx, r.GetContainerId(), issuedAt)
+	case *runtime.AttachRequest:
+		return c.validateStreamContainer(ctx, r.GetContainerId(), issuedAt)
+	case *runtime.PortForwardRequest:
+		if _, err := c.SandboxStore.Get(r.GetPodSandboxId()); err != nil {
+			return grpc.Errorf(codes.NotFound, "sandbox %q not found", r.GetPodSandboxId())
+		}
+		return c.validateStreamContainer(ctx, r.GetPodSandboxId(), issuedAt)
+	default:
+		return grpc.Errorf(codes.InvalidArgument, "unknown stream request %T", req)
+	}
+}
+
+// validateStreamContainer validates the container is running and started
+// before issuedAt.
+func (c *CriManager) validateStreamContainer(ctx context.Context, id string, issuedAt time.Time) error {
+	container, err := c.ContainerMgr.Get(ctx, id)
+	if err != nil {
+		if errtypes.IsNotfound(err) {
+			return grpc.Errorf(codes.NotFound, "container %q not found", id)
+		}
+		return fmt.Errorf("failed to get container %q: %v", id, err)
+	}
+	if !container.IsRunning() {
+		return grpc.Errorf(codes.FailedPrecondition, "container %q is not running", id)
+	}
+
+	startedAt, err := time.Parse(utils.TimeLayout, container.State.StartedAt)
+	if err != nil {
+		return fmt.Errorf("failed to parse started time of container %q: %v", id, err)
+	}
+	if startedAt.After(issuedAt) {
+		return grpc.Errorf(codes.FailedPrecondition, "container %q is restarted after the stream request is issued", id)
+	}
+	return nil
+}
+
 // UpdateRuntimeConfig updates the runtime config. Currently only handles podCIDR updates.
 func (c *CriManager) UpdateRuntimeConfig(ctx context.Context, r *runtime.UpdateRuntimeConfigRequest) (*runtime.UpdateRuntimeConfigResponse, error) {
 	podCIDR := r.GetRuntimeConfig().GetNetworkConfig().GetPodCidr()
@@ -1310,8 +1378,13 @@ func (c *CriManager) Status(ctx context.Context, r *runtime.StatusRequest) (*run
 		if err != nil {
 			return nil, err
 		}
+		handlersByt, err := json.Marshal(c.DaemonConfig.CriConfig.RuntimeHandlers)
+		if err != nil {
+			return nil, err
+		}
 		resp.Info["golang"] = string(versionByt)
 		resp.Info["daemon-config"] = string(configByt)
+		resp.Info["runtime-handlers"] = string(handlersByt)
 
 		// TODO return more info
 	}
@@ -1330,10 +1403,24 @@ func (c *CriManager) ListImages(ctx context.Context, r *runtime.ListImagesReques
 		metrics.ImageActionsTimer.WithLabelValues(label).Observe(time.Since(start).Seconds())
 	}(time.Now())
 
-	// TODO: handle image list filters.
-	imageList, err := c.ImageMgr.ListImages(ctx, filters.NewArgs())
-	if err != nil {
-		return nil, err
+	var (
+		imageList []apitypes.ImageInfo
+		err       error
+	)
+	if ref := r.GetFilter().GetImage().GetImage(); ref != "" {
+		// the image is looked up by the reference index of image store.
+		image, err := c.ImageMgr.GetImage(ctx, ref)
+		if err != nil && !errtypes.IsNotfound(err) {
+			return nil, err
+		}
+		if image != nil {
+			imageList = append(imageList, *image)
+		}
+	} else {
+		imageList, err = c.ImageMgr.ListImages(ctx, filters.NewArgs())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// We may get images with same id and different repoTag or repoDigest,
@@ -1341,22 +1428,17 @@ func (c *CriManager) ListImages(ctx context.Context, r *runtime.ListImagesReques
 	idExist := make(map[string]bool)
 
 	images := make([]*runtime.Image, 0, len(imageList))
-	for _, i := range imageList {
-		if _, ok := idExist[i.ID]; ok {
+	for i := range imageList {
+		if _, ok := idExist[imageList[i].ID]; ok {
 			continue
 		}
-		// NOTE: we should query image cache to get the correct image info.
-		imageInfo, err := c.ImageMgr.GetImage(ctx, i.ID)
+		image, err := imageToCriImage(&imageList[i])
 		if err != nil {
-			continue
-		}
-		image, err := imageToCriImage(imageInfo)
-		if err != nil {
-			// TODO: log an error message?
+			log.With(ctx).Warnf("failed to convert image %s to cri image: %v", imageList[i].ID, err)
 			continue
 		}
 		images = append(images, image)
-		idExist[i.ID] = true
+		idExist[imageList[i].ID] = true
 	}
 
 	metrics.ImageSuccessActionsCounter.WithLabelValues(label).Inc()
@@ -1392,9 +1474,39 @@ func (c *CriManager) ImageStatus(ctx context.Context, r *runtime.ImageStatusRequ
 		return nil, err
 	}
 
+	var info map[string]string
+	if r.GetVerbose() {
+		info, err = c.imageStatusInfo(ctx, imageInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	metrics.ImageSuccessActionsCounter.WithLabelValues(label).Inc()
 
-	return &runtime.ImageStatusResponse{Image: image}, nil
+	return &runtime.ImageStatusResponse{Image: image, Info: info}, nil
+}
+
+// imageStatusInfo returns the verbose info of image, which includes the
+// image spec, chain ID, snapshotter and size.
+func (c *CriManager) imageStatusInfo(ctx context.Context, imageInfo *apitypes.ImageInfo) (map[string]string, error) {
+	// the image spec, including the history, is not kept in the image
+	// info, read it from the config content in containerd.
+	_, _, primaryRef, err := c.ImageMgr.CheckReference(ctx, imageInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := c.ImageMgr.GetOCIImage(ctx, primaryRef.String())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get spec of image %s", imageInfo.ID)
+	}
+
+	verboseInfo := toImageVerboseInfo(imageInfo, spec, ctrd.CurrentSnapshotterName(ctx))
+	data, err := json.Marshal(verboseInfo)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"info": string(data)}, nil
 }
 
 // PullImage pulls an image with authentication config.
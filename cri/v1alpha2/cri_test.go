@@ -0,0 +1,171 @@
+package v1alpha2
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/filters"
+	apitypes "github.com/alibaba/pouch/apis/types"
+	runtime "github.com/alibaba/pouch/cri/apis/v1alpha2"
+	"github.com/alibaba/pouch/daemon/mgr"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/reference"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeImageMgr serves the images by reference and counts the calls.
+type fakeImageMgr struct {
+	mgr.ImageMgr
+
+	images        map[string]apitypes.ImageInfo
+	getImageCalls int
+}
+
+func (f *fakeImageMgr) GetImage(ctx context.Context, idOrRef string) (*apitypes.ImageInfo, error) {
+	f.getImageCalls++
+	for _, image := range f.images {
+		if image.ID == idOrRef {
+			return &image, nil
+		}
+		for _, tag := range image.RepoTags {
+			if tag == idOrRef {
+				return &image, nil
+			}
+		}
+	}
+	return nil, errtypes.ErrNotfound
+}
+
+func (f *fakeImageMgr) ListImages(ctx context.Context, filter filters.Args) ([]apitypes.ImageInfo, error) {
+	var images []apitypes.ImageInfo
+	for _, id := range []string{"sha256:busybox", "sha256:nginx"} {
+		images = append(images, f.images[id])
+	}
+	return images, nil
+}
+
+func (f *fakeImageMgr) CheckReference(ctx context.Context, idOrRef string) (digest.Digest, reference.Named, reference.Named, error) {
+	image, ok := f.images[idOrRef]
+	if !ok {
+		return "", nil, nil, errtypes.ErrNotfound
+	}
+	ref, err := reference.Parse(image.RepoTags[0])
+	return digest.Digest(image.ID), ref, ref, err
+}
+
+func (f *fakeImageMgr) GetOCIImageConfig(ctx context.Context, image string) (ocispec.ImageConfig, error) {
+	return ocispec.ImageConfig{Cmd: []string{"sh"}, User: "1000"}, nil
+}
+
+func (f *fakeImageMgr) GetOCIImage(ctx context.Context, ref string) (ocispec.Image, error) {
+	for _, image := range f.images {
+		if image.RepoTags[0] != ref {
+			continue
+		}
+		spec := ocispec.Image{
+			Architecture: image.Architecture,
+			OS:           image.Os,
+			Config:       ocispec.ImageConfig{Cmd: []string{"sh"}, User: "1000"},
+			RootFS:       ocispec.RootFS{Type: image.RootFS.Type},
+			History:      []ocispec.History{{CreatedBy: "/bin/sh -c #(nop)  CMD [\"sh\"]", EmptyLayer: true}},
+		}
+		for _, layer := range image.RootFS.Layers {
+			spec.RootFS.DiffIDs = append(spec.RootFS.DiffIDs, digest.Digest(layer))
+		}
+		return spec, nil
+	}
+	return ocispec.Image{}, errtypes.ErrNotfound
+}
+
+func newFakeImageMgr() *fakeImageMgr {
+	return &fakeImageMgr{
+		images: map[string]apitypes.ImageInfo{
+			"sha256:busybox": {
+				ID:           "sha256:busybox",
+				RepoTags:     []string{"docker.io/library/busybox:latest"},
+				Config:       &apitypes.ContainerConfig{User: "1000"},
+				Size:         1024,
+				Architecture: "amd64",
+				Os:           "linux",
+				CreatedAt:    "2018-01-01T00:00:00Z",
+				RootFS: &apitypes.ImageInfoRootFS{
+					Type:   "layers",
+					Layers: []string{"sha256:2a1b5f2a8c0f8d7f0a1d5c5d1f5c1a1b5f2a8c0f8d7f0a1d5c5d1f5c1a1b5f2a"},
+				},
+			},
+			"sha256:nginx": {
+				ID:       "sha256:nginx",
+				RepoTags: []string{"docker.io/library/nginx:latest"},
+				Config:   &apitypes.ContainerConfig{},
+				Size:     2048,
+			},
+		},
+	}
+}
+
+func TestListImages(t *testing.T) {
+	imageMgr := newFakeImageMgr()
+	c := &CriManager{ImageMgr: imageMgr}
+
+	// list all the images without GetImage for each one.
+	resp, err := c.ListImages(context.Background(), &runtime.ListImagesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(resp.Images))
+	assert.Equal(t, "sha256:busybox", resp.Images[0].Id)
+	assert.Equal(t, "sha256:nginx", resp.Images[1].Id)
+	assert.Equal(t, 0, imageMgr.getImageCalls)
+
+	// filter by reference.
+	resp, err = c.ListImages(context.Background(), &runtime.ListImagesRequest{
+		Filter: &runtime.ImageFilter{Image: &runtime.ImageSpec{Image: "docker.io/library/nginx:latest"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(resp.Images))
+	assert.Equal(t, "sha256:nginx", resp.Images[0].Id)
+	assert.Equal(t, []string{"docker.io/library/nginx:latest"}, resp.Images[0].RepoTags)
+
+	// filter by the reference not found.
+	resp, err = c.ListImages(context.Background(), &runtime.ListImagesRequest{
+		Filter: &runtime.ImageFilter{Image: &runtime.ImageSpec{Image: "docker.io/library/redis:latest"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(resp.Images))
+}
+
+func TestImageStatusVerbose(t *testing.T) {
+	c := &CriManager{ImageMgr: newFakeImageMgr()}
+
+	resp, err := c.ImageStatus(context.Background(), &runtime.ImageStatusRequest{
+		Image: &runtime.ImageSpec{Image: "sha256:busybox"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "sha256:busybox", resp.Image.Id)
+	assert.Nil(t, resp.Info)
+
+	resp, err = c.ImageStatus(context.Background(), &runtime.ImageStatusRequest{
+		Image:   &runtime.ImageSpec{Image: "sha256:busybox"},
+		Verbose: true,
+	})
+	assert.NoError(t, err)
+
+	info := &imageVerboseInfo{}
+	assert.NoError(t, json.Unmarshal([]byte(resp.Info["info"]), info))
+	assert.Equal(t, "sha256:2a1b5f2a8c0f8d7f0a1d5c5d1f5c1a1b5f2a8c0f8d7f0a1d5c5d1f5c1a1b5f2a", info.ChainID)
+	assert.Equal(t, []string{"sha256:2a1b5f2a8c0f8d7f0a1d5c5d1f5c1a1b5f2a8c0f8d7f0a1d5c5d1f5c1a1b5f2a"}, []string{info.ImageSpec.RootFS.DiffIDs[0].String()})
+	assert.Equal(t, []string{"sh"}, info.ImageSpec.Config.Cmd)
+	assert.Equal(t, "amd64", info.ImageSpec.Architecture)
+	assert.Len(t, info.ImageSpec.History, 1)
+	assert.Equal(t, int64(1024), info.Size)
+
+	// the image not found.
+	resp, err = c.ImageStatus(context.Background(), &runtime.ImageStatusRequest{
+		Image:   &runtime.ImageSpec{Image: "sha256:redis"},
+		Verbose: true,
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, resp.Image)
+}
@@ -2,10 +2,12 @@ package v1alpha2
 
 import (
 	"bytes"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"net"
 	"net/url"
 	"os"
@@ -23,12 +25,16 @@ import (
 	"github.com/alibaba/pouch/daemon/config"
 	"github.com/alibaba/pouch/daemon/mgr"
 	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/netutils"
 	"github.com/alibaba/pouch/pkg/randomid"
 	"github.com/alibaba/pouch/pkg/utils"
 
 	"github.com/cri-o/ocicni/pkg/ocicni"
 	"github.com/go-openapi/strfmt"
+	"github.com/opencontainers/image-spec/identity"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"golang.org/x/net/context"
 )
 
@@ -46,6 +52,15 @@ func toStreamConfig(cfg *config.Config) (stream.Config, error) {
 	// If the reused pouchd's port is https, the url that stream server return should be with https scheme.
 	reuseHTTPSPort := cfg.CriConfig.StreamServerReusePort && cfg.TLS.Key != "" && cfg.TLS.Cert != ""
 
+	tlsCert, tlsKey, tlsCA := cfg.CriConfig.StreamServerTLSCert, cfg.CriConfig.StreamServerTLSKey, cfg.CriConfig.StreamServerTLSCA
+	enableTLS := tlsCert != "" || tlsKey != "" || tlsCA != ""
+	if enableTLS && (tlsCert == "" || tlsKey == "") {
+		return stream.Config{}, fmt.Errorf("both cert and key of stream server should be specified to enable TLS")
+	}
+	if enableTLS && cfg.CriConfig.StreamServerReusePort {
+		return stream.Config{}, fmt.Errorf("TLS of stream server can not be specified when it reuses the port of pouchd")
+	}
+
 	ip := net.ParseIP(address)
 	// If the address is "" or "0.0.0.0", choose a proper one by ourselves.
 	if ip == nil || ip.IsUnspecified() {
@@ -66,6 +81,20 @@ func toStreamConfig(cfg *config.Config) (stream.Config, error) {
 		streamCfg.BaseURL.Scheme = "https"
 	}
 
+	if enableTLS {
+		tlsConfig, err := httputils.GenTLSConfig(tlsKey, tlsCert, tlsCA)
+		if err != nil {
+			return stream.Config{}, fmt.Errorf("failed to load TLS config of stream server: %v", err)
+		}
+		tlsConfig.MinVersion = tls.VersionTLS12
+		// only the clients with certs signed by the CA are allowed.
+		if tlsCA != "" {
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+		streamCfg.TLSConfig = tlsConfig
+		streamCfg.BaseURL.Scheme = "https"
+	}
+
 	return streamCfg, nil
 }
 
@@ -251,6 +280,38 @@ func modifySandboxNamespaceOptions(nsOpts *runtime.NamespaceOption, hostConfig *
 	}
 }
 
+// toUsernsMode converts the user namespace options of sandbox to pouch's userns mode.
+func toUsernsMode(opts *runtime.UserNamespace) (string, error) {
+	// the user namespace of node is used if not specified.
+	if opts == nil {
+		return "", nil
+	}
+
+	switch opts.GetMode() {
+	case runtime.NamespaceMode_NODE:
+		return namespaceModeHost, nil
+	case runtime.NamespaceMode_POD:
+	default:
+		return "", fmt.Errorf("unsupported user namespace mode %s", opts.GetMode())
+	}
+
+	uids, gids := opts.GetUids(), opts.GetGids()
+	if len(uids) == 0 && len(gids) == 0 {
+		return mgr.UsernsModeAuto, nil
+	}
+	if len(uids) != 1 || len(gids) != 1 {
+		return "", fmt.Errorf("only one uid and gid mapping is supported for user namespace")
+	}
+	uid, gid := uids[0], gids[0]
+	if uid.GetContainerId() != 0 || gid.GetContainerId() != 0 {
+		return "", fmt.Errorf("the container id of user namespace mapping must be 0")
+	}
+	if uid.GetHostId() != gid.GetHostId() || uid.GetLength() != gid.GetLength() {
+		return "", fmt.Errorf("the uid and gid mappings of user namespace must be the same")
+	}
+	return fmt.Sprintf("%s:%d:%d", mgr.UsernsModeRemap, uid.GetHostId(), uid.GetLength()), nil
+}
+
 func applySandboxSecurityContext(lc *runtime.LinuxPodSandboxConfig, config *apitypes.ContainerConfig, hc *apitypes.HostConfig) error {
 	if lc == nil {
 		return nil
@@ -279,6 +340,11 @@ func applySandboxSecurityContext(lc *runtime.LinuxPodSandboxConfig, config *apit
 	}
 	modifySandboxNamespaceOptions(sc.GetNamespaceOptions(), hc)
 
+	hc.UsernsMode, err = toUsernsMode(sc.GetNamespaceOptions().GetUsernsOptions())
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -300,20 +366,57 @@ func applySandboxLinuxOptions(hc *apitypes.HostConfig, lc *runtime.LinuxPodSandb
 }
 
 // applySandboxRuntimeHandler applies the runtime of container specified by the caller.
-func (c *CriManager) applySandboxRuntimeHandler(sandboxMeta *metatypes.SandboxMeta, runtimehandler string, annotations map[string]string) error {
+func (c *CriManager) applySandboxRuntimeHandler(sandboxMeta *metatypes.SandboxMeta, runtimehandler string, config *runtime.PodSandboxConfig) error {
 	if runtimehandler == "" {
 		// apply the annotation of io.kubernetes.runtime which specify the runtime of container.
 		// NOTE: Deprecated
-		rt, ok := annotations[anno.KubernetesRuntime]
+		rt, ok := config.GetAnnotations()[anno.KubernetesRuntime]
 		if !ok {
 			rt = c.DaemonConfig.DefaultRuntime
 		}
 		runtimehandler = rt
 	}
+	if err := c.validateRuntimeHandler(runtimehandler, config.GetLinux().GetSecurityContext().GetPrivileged()); err != nil {
+		return err
+	}
 	sandboxMeta.Runtime = runtimehandler
 	return c.SandboxStore.Put(sandboxMeta)
 }
 
+// validateRuntimeHandler checks whether the runtime handler is known and
+// whether it allows the privileged pods and containers.
+func (c *CriManager) validateRuntimeHandler(runtimehandler string, privileged bool) error {
+	handler, ok := c.DaemonConfig.CriConfig.RuntimeHandlers[runtimehandler]
+	if !ok {
+		// the runtimes added by add-runtime are allowed for compatibility.
+		if _, exist := c.DaemonConfig.Runtimes[runtimehandler]; !exist {
+			return fmt.Errorf("unknown runtime handler %q", runtimehandler)
+		}
+		return nil
+	}
+	if privileged && !handler.PrivilegedAllowed {
+		return fmt.Errorf("privileged is not allowed by runtime handler %q", runtimehandler)
+	}
+	return nil
+}
+
+// applyRuntimeHandlerAnnotations applies the default annotations of the runtime handler
+// to the spec annotations. The default value is overridden by the annotations in order,
+// and the annotations set by cri are kept.
+func (c *CriManager) applyRuntimeHandlerAnnotations(specAnnotation map[string]string, runtimehandler string, annotations ...map[string]string) {
+	for k, v := range c.DaemonConfig.CriConfig.RuntimeHandlers[runtimehandler].Annotations {
+		if _, exist := specAnnotation[k]; exist {
+			continue
+		}
+		for _, a := range annotations {
+			if av, ok := a[k]; ok {
+				v = av
+			}
+		}
+		specAnnotation[k] = v
+	}
+}
+
 // applySandboxAnnotations applies the annotations extended.
 func (c *CriManager) applySandboxAnnotations(sandboxMeta *metatypes.SandboxMeta, annotations map[string]string) error {
 	// apply the annotation of io.kubernetes.lxcfs.enabled
@@ -408,6 +511,21 @@ func toCriSandbox(c *mgr.Container) (*runtime.PodSandbox, error) {
 	}, nil
 }
 
+// toCriPodSandboxNetworkStatus converts the ips of pod to CRI network status,
+// the first one is the ip of the default interface.
+func toCriPodSandboxNetworkStatus(ips []string) *runtime.PodSandboxNetworkStatus {
+	status := &runtime.PodSandboxNetworkStatus{}
+	if len(ips) == 0 {
+		return status
+	}
+
+	status.Ip = ips[0]
+	for _, ip := range ips[1:] {
+		status.AdditionalIps = append(status.AdditionalIps, &runtime.PodIP{Ip: ip})
+	}
+	return status
+}
+
 func filterCRISandboxes(sandboxes []*runtime.PodSandbox, filter *runtime.PodSandboxFilter) []*runtime.PodSandbox {
 	if filter == nil {
 		return sandboxes
@@ -509,36 +627,77 @@ func setupSandboxFiles(sandboxRootDir string, config *runtime.PodSandboxConfig)
 	return nil
 }
 
-// setupPodNetwork sets up the network of PodSandbox
-// and do nothing when networkNamespaceMode equals runtime.NamespaceMode_NODE.
-func (c *CriManager) setupPodNetwork(id, netnsPath string, config *runtime.PodSandboxConfig) error {
-	return c.CniMgr.SetUpPodNetwork(&ocicni.PodNetwork{
+// makePodNetworks returns the CNI networks to attach the pod to. The default
+// network comes first, followed by the additional networks in annotations.
+func (c *CriManager) makePodNetworks(annotations map[string]string) ([]string, error) {
+	defaultNetwork := c.CniMgr.GetDefaultNetworkName()
+	if defaultNetwork == "" {
+		return nil, fmt.Errorf("no default CNI network found")
+	}
+
+	networks := []string{defaultNetwork}
+	for _, network := range strings.Split(annotations[anno.AdditionalNetworksExtendAnnotation], ",") {
+		network = strings.TrimSpace(network)
+		if network == "" || utils.StringInSlice(networks, network) {
+			continue
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// makePodNetwork returns the CNI config of the pod attached to networks, port
+// mappings and bandwidth are applied to the default network. The invalid
+// bandwidth is only rejected on setup, it is left out on teardown, so that the
+// network of the pod created with it can always be torn down.
+func (c *CriManager) makePodNetwork(id, netnsPath string, networks []string, config *runtime.PodSandboxConfig, setup bool) (*ocicni.PodNetwork, error) {
+	defaultNetwork := c.CniMgr.GetDefaultNetworkName()
+	if len(networks) > 0 {
+		defaultNetwork = networks[0]
+	}
+
+	bandwidth, err := toCNIBandwidth(config.GetAnnotations())
+	if err != nil {
+		if setup {
+			return nil, err
+		}
+		log.With(nil).Warnf("ignore the bandwidth of pod %q on teardown: %v", id, err)
+		bandwidth = nil
+	}
+
+	return &ocicni.PodNetwork{
 		Name:      config.GetMetadata().GetName(),
 		Namespace: config.GetMetadata().GetNamespace(),
 		ID:        id,
 		NetNS:     netnsPath,
+		Networks:  networks,
 		RuntimeConfig: map[string]ocicni.RuntimeConfig{
-			c.CniMgr.GetDefaultNetworkName(): {
+			defaultNetwork: {
 				PortMappings: toCNIPortMappings(config.GetPortMappings()),
+				Bandwidth:    bandwidth,
 			},
 		},
-	})
+	}, nil
+}
+
+// setupPodNetwork sets up the network of PodSandbox
+// and do nothing when networkNamespaceMode equals runtime.NamespaceMode_NODE.
+func (c *CriManager) setupPodNetwork(id, netnsPath string, networks []string, config *runtime.PodSandboxConfig) error {
+	podNetwork, err := c.makePodNetwork(id, netnsPath, networks, config, true)
+	if err != nil {
+		return err
+	}
+	return c.CniMgr.SetUpPodNetwork(podNetwork)
 }
 
 // teardownNetwork teardown the network of PodSandbox.
 // and do nothing when networkNamespaceMode equals runtime.NamespaceMode_NODE.
-func (c *CriManager) teardownNetwork(id, netnsPath string, config *runtime.PodSandboxConfig) error {
-	return c.CniMgr.TearDownPodNetwork(&ocicni.PodNetwork{
-		Name:      config.GetMetadata().GetName(),
-		Namespace: config.GetMetadata().GetNamespace(),
-		ID:        id,
-		NetNS:     netnsPath,
-		RuntimeConfig: map[string]ocicni.RuntimeConfig{
-			c.CniMgr.GetDefaultNetworkName(): {
-				PortMappings: toCNIPortMappings(config.GetPortMappings()),
-			},
-		},
-	})
+func (c *CriManager) teardownNetwork(id, netnsPath string, networks []string, config *runtime.PodSandboxConfig) error {
+	podNetwork, err := c.makePodNetwork(id, netnsPath, networks, config, false)
+	if err != nil {
+		return err
+	}
+	return c.CniMgr.TearDownPodNetwork(podNetwork)
 }
 
 func sandboxNetworkMode(config *runtime.PodSandboxConfig) runtime.NamespaceMode {
@@ -818,6 +977,12 @@ func (c *CriManager) updateCreateConfig(createConfig *apitypes.ContainerCreateCo
 		return fmt.Errorf("failed to apply container security context for container %q: %v", config.GetMetadata().GetName(), err)
 	}
 
+	// Apply the runtime handler of the sandbox.
+	if err := c.validateRuntimeHandler(sandboxMeta.Runtime, createConfig.HostConfig.Privileged); err != nil {
+		return fmt.Errorf("failed to apply runtime handler for container %q: %v", config.GetMetadata().GetName(), err)
+	}
+	c.applyRuntimeHandlerAnnotations(createConfig.SpecAnnotation, sandboxMeta.Runtime, sandboxConfig.GetAnnotations(), config.GetAnnotations())
+
 	if len(config.GetAnnotations()) > 0 {
 		// Apply container config by annotation
 		if err := applyContainerConfigByAnnotation(config.GetAnnotations(), &createConfig.ContainerConfig, createConfig.HostConfig, nil); err != nil {
@@ -961,6 +1126,24 @@ func imageToCriImage(image *apitypes.ImageInfo) (*runtime.Image, error) {
 	return runtimeImage, nil
 }
 
+// imageVerboseInfo is the verbose info of image returned by ImageStatus.
+type imageVerboseInfo struct {
+	ChainID     string        `json:"chainID"`
+	ImageSpec   ocispec.Image `json:"imageSpec"`
+	Snapshotter string        `json:"snapshotter"`
+	Size        int64         `json:"size"`
+}
+
+// toImageVerboseInfo makes the verbose info from the image and its OCI spec.
+func toImageVerboseInfo(image *apitypes.ImageInfo, spec ocispec.Image, snapshotter string) *imageVerboseInfo {
+	return &imageVerboseInfo{
+		ChainID:     identity.ChainID(spec.RootFS.DiffIDs).String(),
+		ImageSpec:   spec,
+		Snapshotter: snapshotter,
+		Size:        image.Size,
+	}
+}
+
 // ensureSandboxImageExists pulls the image when it's not present.
 func (c *CriManager) ensureSandboxImageExists(ctx context.Context, imageRef string) error {
 	_, _, _, err := c.ImageMgr.CheckReference(ctx, imageRef)
@@ -1238,6 +1421,89 @@ func toCNIPortMappings(criPortMappings []*runtime.PortMapping) []ocicni.PortMapp
 	return portMappings
 }
 
+// bandwidthUnits are the multipliers of the suffixes in kubernetes quantity.
+var bandwidthUnits = map[string]float64{
+	"":   1,
+	"k":  1e3,
+	"M":  1e6,
+	"G":  1e9,
+	"T":  1e12,
+	"P":  1e15,
+	"E":  1e18,
+	"Ki": 1 << 10,
+	"Mi": 1 << 20,
+	"Gi": 1 << 30,
+	"Ti": 1 << 40,
+	"Pi": 1 << 50,
+	"Ei": 1 << 60,
+}
+
+const (
+	// minBandwidth is the min bandwidth of pod in bits per second, 1k.
+	minBandwidth = 1e3
+	// maxBandwidth is the max bandwidth of pod in bits per second, 1P.
+	maxBandwidth = 1e15
+)
+
+// parseBandwidth parses the bandwidth in kubernetes quantity format, such as
+// 10M and 1Gi, to bits per second.
+func parseBandwidth(bandwidth string) (uint64, error) {
+	i := strings.IndexFunc(bandwidth, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if i < 0 {
+		i = len(bandwidth)
+	}
+
+	unit, ok := bandwidthUnits[bandwidth[i:]]
+	if !ok {
+		return 0, fmt.Errorf("invalid bandwidth %q", bandwidth)
+	}
+	value, err := strconv.ParseFloat(bandwidth[:i], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid bandwidth %q", bandwidth)
+	}
+
+	bps := value * unit
+	if bps < minBandwidth {
+		return 0, fmt.Errorf("bandwidth %q is unreasonably small (< 1kbit)", bandwidth)
+	}
+	if bps > maxBandwidth {
+		return 0, fmt.Errorf("bandwidth %q is unreasonably large (> 1Pbit)", bandwidth)
+	}
+	return uint64(bps), nil
+}
+
+// toCNIBandwidth converts the bandwidth annotations of pod to the config of
+// CNI bandwidth capability, nil is returned if no bandwidth is specified.
+func toCNIBandwidth(annotations map[string]string) (*ocicni.BandwidthConfig, error) {
+	ingress, hasIngress := annotations[anno.IngressBandwidth]
+	egress, hasEgress := annotations[anno.EgressBandwidth]
+	if !hasIngress && !hasEgress {
+		return nil, nil
+	}
+
+	bandwidth := &ocicni.BandwidthConfig{}
+	if hasIngress {
+		rate, err := parseBandwidth(ingress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", anno.IngressBandwidth, err)
+		}
+		bandwidth.IngressRate = rate
+		// no limit of burst.
+		bandwidth.IngressBurst = math.MaxUint32
+	}
+	if hasEgress {
+		rate, err := parseBandwidth(egress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", anno.EgressBandwidth, err)
+		}
+		bandwidth.EgressRate = rate
+		bandwidth.EgressBurst = math.MaxUint32
+	}
+	return bandwidth, nil
+}
+
 // applyContainerConfigByAnnotation updates pouch container config according to annotation.
 func applyContainerConfigByAnnotation(annotations map[string]string, config *apitypes.ContainerConfig, hc *apitypes.HostConfig, uc *apitypes.UpdateConfig) error {
 	if len(annotations) == 0 {
@@ -1272,6 +1538,16 @@ func applyContainerConfigByAnnotation(annotations map[string]string, config *api
 		}
 	}
 
+	if init, ok := annotations[anno.InitExtendAnnotation]; ok {
+		enabled, err := strconv.ParseBool(init)
+		if err != nil {
+			return fmt.Errorf("failed to parse init: %v", err)
+		}
+		if hc != nil {
+			hc.Init = enabled
+		}
+	}
+
 	return nil
 }
 
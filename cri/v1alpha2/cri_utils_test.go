@@ -3,6 +3,7 @@ package v1alpha2
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
@@ -12,10 +13,15 @@ import (
 	apitypes "github.com/alibaba/pouch/apis/types"
 	anno "github.com/alibaba/pouch/cri/annotations"
 	runtime "github.com/alibaba/pouch/cri/apis/v1alpha2"
+	criconfig "github.com/alibaba/pouch/cri/config"
+	"github.com/alibaba/pouch/daemon/config"
 	"github.com/alibaba/pouch/daemon/mgr"
 	"github.com/alibaba/pouch/pkg/utils"
 
 	"github.com/cri-o/ocicni/pkg/ocicni"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/identity"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -1804,6 +1810,58 @@ func Test_modifySandboxNamespaceOptions(t *testing.T) {
 }
 
 // CNI Network related unit tests.
+func Test_toUsernsMode(t *testing.T) {
+	mapping := func(hostID, containerID, length uint32) []*runtime.IDMapping {
+		return []*runtime.IDMapping{{HostId: hostID, ContainerId: containerID, Length: length}}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		opts    *runtime.UserNamespace
+		want    string
+		wantErr bool
+	}{
+		{name: "not specified", opts: nil, want: ""},
+		{name: "node", opts: &runtime.UserNamespace{Mode: runtime.NamespaceMode_NODE}, want: namespaceModeHost},
+		{name: "pod without mappings", opts: &runtime.UserNamespace{Mode: runtime.NamespaceMode_POD}, want: mgr.UsernsModeAuto},
+		{
+			name: "pod with mappings",
+			opts: &runtime.UserNamespace{Mode: runtime.NamespaceMode_POD, Uids: mapping(100000, 0, 65536), Gids: mapping(100000, 0, 65536)},
+			want: "remap:100000:65536",
+		},
+		{
+			name:    "different uid and gid mappings",
+			opts:    &runtime.UserNamespace{Mode: runtime.NamespaceMode_POD, Uids: mapping(100000, 0, 65536), Gids: mapping(200000, 0, 65536)},
+			wantErr: true,
+		},
+		{
+			name:    "non root container id",
+			opts:    &runtime.UserNamespace{Mode: runtime.NamespaceMode_POD, Uids: mapping(100000, 1, 65536), Gids: mapping(100000, 1, 65536)},
+			wantErr: true,
+		},
+		{
+			name:    "only uid mappings",
+			opts:    &runtime.UserNamespace{Mode: runtime.NamespaceMode_POD, Uids: mapping(100000, 0, 65536)},
+			wantErr: true,
+		},
+		{name: "container", opts: &runtime.UserNamespace{Mode: runtime.NamespaceMode_CONTAINER}, wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// the user namespace options are kept through the wire.
+			nsOpts := &runtime.NamespaceOption{UsernsOptions: tc.opts}
+			data, err := nsOpts.Marshal()
+			assert.NoError(t, err)
+			decoded := &runtime.NamespaceOption{}
+			assert.NoError(t, decoded.Unmarshal(data))
+			assert.Equal(t, nsOpts, decoded)
+
+			got, err := toUsernsMode(decoded.GetUsernsOptions())
+			assert.Equal(t, tc.wantErr, err != nil, "%v", err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func Test_toCNIPortMappings(t *testing.T) {
 	criNormalTCP := &runtime.PortMapping{
 		Protocol:      runtime.Protocol_TCP,
@@ -1889,6 +1947,144 @@ func Test_toCNIPortMappings(t *testing.T) {
 }
 
 // CRI test: apply container config by annotation
+func Test_makePodNetworks(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		defaultNetwork string
+		annotations    map[string]string
+		want           []string
+		wantErr        bool
+	}{
+		{
+			name:           "default network only",
+			defaultNetwork: "bridge",
+			want:           []string{"bridge"},
+		},
+		{
+			name:           "additional networks",
+			defaultNetwork: "bridge",
+			annotations: map[string]string{
+				anno.AdditionalNetworksExtendAnnotation: "macvlan, bridge,,sriov,macvlan",
+			},
+			want: []string{"bridge", "macvlan", "sriov"},
+		},
+		{
+			name:    "no default network",
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &CriManager{CniMgr: &fakeCniMgr{defaultNetwork: tc.defaultNetwork}}
+			got, err := c.makePodNetworks(tc.annotations)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func Test_makePodNetwork(t *testing.T) {
+	c := &CriManager{CniMgr: &fakeCniMgr{defaultNetwork: "bridge"}}
+	config := &runtime.PodSandboxConfig{
+		Metadata: &runtime.PodSandboxMetadata{Name: "pod", Namespace: "default"},
+		PortMappings: []*runtime.PortMapping{
+			{Protocol: runtime.Protocol_TCP, ContainerPort: 80, HostPort: 8080},
+		},
+		Annotations: map[string]string{
+			anno.IngressBandwidth: "10M",
+		},
+	}
+	runtimeConfig := ocicni.RuntimeConfig{
+		PortMappings: []ocicni.PortMapping{{Protocol: "tcp", ContainerPort: 80, HostPort: 8080}},
+		Bandwidth:    &ocicni.BandwidthConfig{IngressRate: 10000000, IngressBurst: math.MaxUint32},
+	}
+
+	// the pod is attached to the networks it was set up with, even if the
+	// default network of CNI plugin changes.
+	got, err := c.makePodNetwork("id", "/var/run/netns/id", []string{"macvlan", "sriov"}, config, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"macvlan", "sriov"}, got.Networks)
+	assert.Equal(t, map[string]ocicni.RuntimeConfig{"macvlan": runtimeConfig}, got.RuntimeConfig)
+
+	got, err = c.makePodNetwork("id", "/var/run/netns/id", nil, config, true)
+	assert.NoError(t, err)
+	assert.Nil(t, got.Networks)
+	assert.Equal(t, map[string]ocicni.RuntimeConfig{"bridge": runtimeConfig}, got.RuntimeConfig)
+
+	config.Annotations[anno.EgressBandwidth] = "10X"
+	_, err = c.makePodNetwork("id", "/var/run/netns/id", nil, config, true)
+	assert.Error(t, err)
+
+	// the invalid bandwidth is left out on teardown.
+	got, err = c.makePodNetwork("id", "/var/run/netns/id", nil, config, false)
+	assert.NoError(t, err)
+	assert.Nil(t, got.RuntimeConfig["bridge"].Bandwidth)
+	assert.Equal(t, runtimeConfig.PortMappings, got.RuntimeConfig["bridge"].PortMappings)
+}
+
+func Test_parseBandwidth(t *testing.T) {
+	for _, tc := range []struct {
+		bandwidth string
+		want      uint64
+		wantErr   bool
+	}{
+		{bandwidth: "1000", want: 1000},
+		{bandwidth: "10M", want: 10000000},
+		{bandwidth: "1.5G", want: 1500000000},
+		{bandwidth: "1Mi", want: 1048576},
+		{bandwidth: "1P", want: 1000000000000000},
+		{bandwidth: "999", wantErr: true},
+		{bandwidth: "2P", wantErr: true},
+		{bandwidth: "10m", wantErr: true},
+		{bandwidth: "M", wantErr: true},
+		{bandwidth: "", wantErr: true},
+	} {
+		got, err := parseBandwidth(tc.bandwidth)
+		if tc.wantErr {
+			assert.Error(t, err, tc.bandwidth)
+			continue
+		}
+		assert.NoError(t, err, tc.bandwidth)
+		assert.Equal(t, tc.want, got, tc.bandwidth)
+	}
+}
+
+func Test_toCNIBandwidth(t *testing.T) {
+	got, err := toCNIBandwidth(map[string]string{"foo": "bar"})
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	got, err = toCNIBandwidth(map[string]string{
+		anno.IngressBandwidth: "1M",
+		anno.EgressBandwidth:  "2M",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &ocicni.BandwidthConfig{
+		IngressRate:  1000000,
+		IngressBurst: math.MaxUint32,
+		EgressRate:   2000000,
+		EgressBurst:  math.MaxUint32,
+	}, got)
+
+	_, err = toCNIBandwidth(map[string]string{anno.EgressBandwidth: "1"})
+	assert.Error(t, err)
+}
+
+func Test_toCriPodSandboxNetworkStatus(t *testing.T) {
+	assert.Equal(t, &runtime.PodSandboxNetworkStatus{}, toCriPodSandboxNetworkStatus(nil))
+	assert.Equal(t, &runtime.PodSandboxNetworkStatus{Ip: "10.0.0.2"}, toCriPodSandboxNetworkStatus([]string{"10.0.0.2"}))
+	assert.Equal(t, &runtime.PodSandboxNetworkStatus{
+		Ip: "10.0.0.2",
+		AdditionalIps: []*runtime.PodIP{
+			{Ip: "192.168.0.2"},
+			{Ip: "fd00::2"},
+		},
+	}, toCriPodSandboxNetworkStatus([]string{"10.0.0.2", "192.168.0.2", "fd00::2"}))
+}
+
 func Test_applyContainerConfigByAnnotation(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -1952,6 +2148,26 @@ func Test_applyContainerConfigByAnnotation(t *testing.T) {
 			},
 			errMsg: "failed to parse resources.pids-limit",
 		},
+		{
+			name: "normalInitTest",
+			annotation: map[string]string{
+				anno.InitExtendAnnotation: "true",
+			},
+			checkFn: func(config *apitypes.ContainerConfig, hc *apitypes.HostConfig, uc *apitypes.UpdateConfig) bool {
+				return hc.Init
+			},
+			errMsg: "",
+		},
+		{
+			name: "errorInitTest",
+			annotation: map[string]string{
+				anno.InitExtendAnnotation: "yes",
+			},
+			checkFn: func(config *apitypes.ContainerConfig, hc *apitypes.HostConfig, uc *apitypes.UpdateConfig) bool {
+				return false
+			},
+			errMsg: "failed to parse init",
+		},
 	}
 
 	for _, tt := range tests {
@@ -1992,3 +2208,93 @@ func TestCWWrite(t *testing.T) {
 	_, err = cw.Write([]byte("world"))
 	assert.Equal(t, err.Error(), errNoRemain.Error())
 }
+
+func Test_toImageVerboseInfo(t *testing.T) {
+	created := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	spec := ocispec.Image{
+		Created: &created,
+		Config:  ocispec.ImageConfig{Env: []string{"A=1"}},
+		RootFS: ocispec.RootFS{
+			Type: "layers",
+			DiffIDs: []digest.Digest{
+				"sha256:1111111111111111111111111111111111111111111111111111111111111111",
+				"sha256:2222222222222222222222222222222222222222222222222222222222222222",
+			},
+		},
+		History: []ocispec.History{{CreatedBy: "/bin/sh -c #(nop) ADD file:abc in /"}},
+	}
+
+	info := toImageVerboseInfo(&apitypes.ImageInfo{ID: "sha256:busybox", Size: 1024}, spec, "overlayfs")
+	assert.Equal(t, identity.ChainID(spec.RootFS.DiffIDs).String(), info.ChainID)
+	assert.NotEqual(t, spec.RootFS.DiffIDs[1].String(), info.ChainID)
+	assert.Equal(t, "overlayfs", info.Snapshotter)
+	assert.Equal(t, int64(1024), info.Size)
+	assert.Equal(t, spec, info.ImageSpec)
+}
+
+func newRuntimeHandlerCriManager() *CriManager {
+	return &CriManager{
+		DaemonConfig: &config.Config{
+			DefaultRuntime: "runc",
+			Runtimes:       map[string]apitypes.Runtime{"runc": {Path: "runc"}},
+			CriConfig: criconfig.Config{
+				RuntimeHandlers: map[string]criconfig.RuntimeHandler{
+					"kata": {
+						Path: "kata-runtime",
+						Annotations: map[string]string{
+							"io.katacontainers.config.hypervisor.default_memory": "256",
+							anno.ContainerType: "handler",
+						},
+					},
+					"runsc": {Path: "runsc", PrivilegedAllowed: true},
+				},
+			},
+		},
+	}
+}
+
+func Test_validateRuntimeHandler(t *testing.T) {
+	c := newRuntimeHandlerCriManager()
+	for _, tc := range []struct {
+		handler    string
+		privileged bool
+		wantErr    bool
+	}{
+		{handler: "kata"},
+		{handler: "kata", privileged: true, wantErr: true},
+		{handler: "runsc", privileged: true},
+		// the runtimes added by add-runtime are allowed.
+		{handler: "runc", privileged: true},
+		{handler: "unknown", wantErr: true},
+	} {
+		err := c.validateRuntimeHandler(tc.handler, tc.privileged)
+		assert.Equal(t, tc.wantErr, err != nil, "handler %s, privileged %v: %v", tc.handler, tc.privileged, err)
+	}
+}
+
+func Test_applyRuntimeHandlerAnnotations(t *testing.T) {
+	c := newRuntimeHandlerCriManager()
+
+	specAnnotation := map[string]string{anno.ContainerType: anno.ContainerTypeContainer}
+	c.applyRuntimeHandlerAnnotations(specAnnotation, "kata")
+	assert.Equal(t, map[string]string{
+		anno.ContainerType: anno.ContainerTypeContainer,
+		"io.katacontainers.config.hypervisor.default_memory": "256",
+	}, specAnnotation)
+
+	// the default annotations are overridden by pod and then container.
+	specAnnotation = map[string]string{}
+	pod := map[string]string{"io.katacontainers.config.hypervisor.default_memory": "512"}
+	c.applyRuntimeHandlerAnnotations(specAnnotation, "kata", pod)
+	assert.Equal(t, "512", specAnnotation["io.katacontainers.config.hypervisor.default_memory"])
+
+	specAnnotation = map[string]string{}
+	container := map[string]string{"io.katacontainers.config.hypervisor.default_memory": "1024"}
+	c.applyRuntimeHandlerAnnotations(specAnnotation, "kata", pod, container)
+	assert.Equal(t, "1024", specAnnotation["io.katacontainers.config.hypervisor.default_memory"])
+
+	// the runtime without handler has no default annotations.
+	specAnnotation = map[string]string{}
+	c.applyRuntimeHandlerAnnotations(specAnnotation, "runc", pod)
+	assert.Empty(t, specAnnotation)
+}
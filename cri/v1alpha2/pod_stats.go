@@ -0,0 +1,377 @@
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	apitypes "github.com/alibaba/pouch/apis/types"
+	runtime "github.com/alibaba/pouch/cri/apis/v1alpha2"
+	"github.com/alibaba/pouch/cri/metrics"
+	metatypes "github.com/alibaba/pouch/cri/v1alpha2/types"
+	"github.com/alibaba/pouch/daemon/mgr"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/utils"
+	util_metrics "github.com/alibaba/pouch/pkg/utils/metrics"
+
+	"github.com/containerd/cgroups"
+	"github.com/cri-o/ocicni/pkg/ocicni"
+)
+
+// PodSandboxStats returns stats of the pod sandbox. If the pod sandbox does not
+// exist, the call returns an error.
+func (c *CriManager) PodSandboxStats(ctx context.Context, r *runtime.PodSandboxStatsRequest) (*runtime.PodSandboxStatsResponse, error) {
+	label := util_metrics.ActionStatsLabel
+	defer func(start time.Time) {
+		metrics.PodActionsCounter.WithLabelValues(label).Inc()
+		metrics.PodActionsTimer.WithLabelValues(label).Observe(time.Since(start).Seconds())
+	}(time.Now())
+
+	podSandboxID := r.GetPodSandboxId()
+
+	res, err := c.SandboxStore.Get(podSandboxID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata of %q from SandboxStore: %v", podSandboxID, err)
+	}
+	sandboxMeta := res.(*metatypes.SandboxMeta)
+	if sandboxMeta.Config == nil {
+		return nil, fmt.Errorf("failed to get stats of partially created sandbox %q", podSandboxID)
+	}
+
+	sandbox, err := c.ContainerMgr.Get(ctx, podSandboxID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sandbox %q: %v", podSandboxID, err)
+	}
+
+	stats, err := c.getPodSandboxMetrics(ctx, sandbox, sandboxMeta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stats of sandbox %q: %v", podSandboxID, err)
+	}
+
+	metrics.PodSuccessActionsCounter.WithLabelValues(label).Inc()
+
+	return &runtime.PodSandboxStatsResponse{Stats: stats}, nil
+}
+
+// ListPodSandboxStats returns stats of the pod sandboxes matching a filter.
+func (c *CriManager) ListPodSandboxStats(ctx context.Context, r *runtime.ListPodSandboxStatsRequest) (*runtime.ListPodSandboxStatsResponse, error) {
+	label := util_metrics.ActionStatsListLabel
+	defer func(start time.Time) {
+		metrics.PodActionsCounter.WithLabelValues(label).Inc()
+		metrics.PodActionsTimer.WithLabelValues(label).Observe(time.Since(start).Seconds())
+	}(time.Now())
+
+	sandboxes, err := c.listRunningSandboxes(ctx, func(s *mgr.Container) bool {
+		if r.GetFilter().GetId() != "" && s.ID != r.GetFilter().GetId() {
+			return false
+		}
+		if r.GetFilter().GetLabelSelector() != nil &&
+			!utils.MatchLabelSelector(r.GetFilter().GetLabelSelector(), s.Config.Labels) {
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sandboxes: %v", err)
+	}
+
+	result := &runtime.ListPodSandboxStatsResponse{}
+	for _, sandbox := range sandboxes {
+		res, err := c.SandboxStore.Get(sandbox.ID)
+		if err != nil {
+			log.With(ctx).Warnf("failed to get metadata of sandbox %q: %v", sandbox.ID, err)
+			continue
+		}
+		sandboxMeta := res.(*metatypes.SandboxMeta)
+		if sandboxMeta.Config == nil {
+			continue
+		}
+
+		stats, err := c.getPodSandboxMetrics(ctx, sandbox, sandboxMeta)
+		if err != nil {
+			log.With(ctx).Warnf("failed to get stats of sandbox %q: %v", sandbox.ID, err)
+			continue
+		}
+		result.Stats = append(result.Stats, stats)
+	}
+
+	metrics.PodSuccessActionsCounter.WithLabelValues(label).Inc()
+
+	return result, nil
+}
+
+// listRunningSandboxes returns the running sandbox containers matching the filter.
+func (c *CriManager) listRunningSandboxes(ctx context.Context, filter func(*mgr.Container) bool) ([]*mgr.Container, error) {
+	return c.ContainerMgr.List(ctx, &mgr.ContainerListOption{
+		All: true,
+		FilterFunc: func(s *mgr.Container) bool {
+			if s.Config.Labels[containerTypeLabelKey] != containerTypeLabelSandbox || !s.IsRunning() {
+				return false
+			}
+			return filter == nil || filter(s)
+		},
+	})
+}
+
+// getPodSandboxMetrics returns the stats of the sandbox. The cpu, memory and
+// process stats are aggregated from the cgroup parent of the sandbox.
+func (c *CriManager) getPodSandboxMetrics(ctx context.Context, sandbox *mgr.Container, sandboxMeta *metatypes.SandboxMeta) (*runtime.PodSandboxStats, error) {
+	labels, annotations := extractLabels(sandbox.Config.Labels)
+
+	linuxStats := &runtime.LinuxPodSandboxStats{}
+	if cgroupParent := sandboxMeta.Config.GetLinux().GetCgroupParent(); cgroupParent != "" {
+		cgroupMetrics, err := podCgroupMetrics(podCgroupPath(cgroupParent, c.DaemonConfig.UseSystemd()))
+		if err != nil {
+			return nil, err
+		}
+		toPodSandboxCgroupStats(linuxStats, cgroupMetrics, time.Now().UnixNano())
+	}
+
+	network, err := c.getPodNetworkStats(ctx, sandbox, sandboxMeta)
+	if err != nil {
+		// Maybe the pod has been stopped.
+		log.With(ctx).Warnf("failed to get network stats of sandbox %q: %v", sandbox.ID, err)
+	}
+	linuxStats.Network = network
+
+	containers, err := c.ContainerMgr.List(ctx, &mgr.ContainerListOption{
+		All: true,
+		FilterFunc: func(ctr *mgr.Container) bool {
+			return ctr.Config.Labels[containerTypeLabelKey] == containerTypeLabelContainer &&
+				ctr.Config.Labels[sandboxIDLabelKey] == sandbox.ID
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers of sandbox %q: %v", sandbox.ID, err)
+	}
+	for _, ctr := range containers {
+		cs, err := c.getContainerMetrics(ctx, ctr)
+		if err != nil {
+			log.With(ctx).Warnf("failed to decode metrics of container %q: %v", ctr.ID, err)
+			continue
+		}
+		linuxStats.Containers = append(linuxStats.Containers, cs)
+	}
+
+	return &runtime.PodSandboxStats{
+		Attributes: &runtime.PodSandboxAttributes{
+			Id:          sandbox.ID,
+			Metadata:    sandboxMeta.Config.Metadata,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Linux: linuxStats,
+	}, nil
+}
+
+// podCgroupPath returns the cgroup path of pod from the cgroup parent, the
+// systemd slice like "kubepods-besteffort-pod1.slice" is expanded to
+// "/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod1.slice".
+func podCgroupPath(cgroupParent string, systemd bool) string {
+	if !systemd || !strings.HasSuffix(cgroupParent, ".slice") || strings.Contains(cgroupParent, "/") {
+		return cgroupParent
+	}
+
+	name := strings.TrimSuffix(cgroupParent, ".slice")
+	if name == "-" {
+		return "/"
+	}
+
+	var path, prefix string
+	for _, component := range strings.Split(name, "-") {
+		if component == "" {
+			return cgroupParent
+		}
+		path = filepath.Join(path, prefix+component+".slice")
+		prefix += component + "-"
+	}
+	return "/" + path
+}
+
+// podCgroupMetrics returns the metrics of the pod cgroup.
+func podCgroupMetrics(path string) (*cgroups.Metrics, error) {
+	cg, err := cgroups.Load(cgroups.V1, cgroups.StaticPath(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cgroup %s: %v", path, err)
+	}
+
+	cgroupMetrics, err := cg.Stat(cgroups.IgnoreNotExist)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stats of cgroup %s: %v", path, err)
+	}
+	return cgroupMetrics, nil
+}
+
+// toPodSandboxCgroupStats fills the cpu, memory and process stats of pod.
+func toPodSandboxCgroupStats(stats *runtime.LinuxPodSandboxStats, cgroupMetrics *cgroups.Metrics, timestamp int64) {
+	if cgroupMetrics.CPU != nil && cgroupMetrics.CPU.Usage != nil {
+		stats.Cpu = &runtime.CpuUsage{
+			Timestamp:            timestamp,
+			UsageCoreNanoSeconds: &runtime.UInt64Value{Value: cgroupMetrics.CPU.Usage.Total},
+		}
+	}
+	if cgroupMetrics.Memory != nil && cgroupMetrics.Memory.Usage != nil {
+		stats.Memory = &runtime.MemoryUsage{
+			Timestamp:       timestamp,
+			WorkingSetBytes: &runtime.UInt64Value{Value: workingSetBytes(cgroupMetrics.Memory)},
+		}
+	}
+	if cgroupMetrics.Pids != nil {
+		stats.Process = &runtime.ProcessUsage{
+			Timestamp:    timestamp,
+			ProcessCount: &runtime.UInt64Value{Value: cgroupMetrics.Pids.Current},
+		}
+	}
+}
+
+// workingSetBytes returns the memory usage excluding the inactive page cache,
+// which is how cadvisor computes the working set used by kubelet eviction.
+func workingSetBytes(memory *cgroups.MemoryStat) uint64 {
+	if memory.Usage.Usage < memory.TotalInactiveFile {
+		return 0
+	}
+	return memory.Usage.Usage - memory.TotalInactiveFile
+}
+
+// getPodNetworkStats returns the network stats of sandbox from the cache if
+// cri collects stats periodically, otherwise collects them directly.
+func (c *CriManager) getPodNetworkStats(ctx context.Context, sandbox *mgr.Container, sandboxMeta *metatypes.SandboxMeta) (*runtime.NetworkUsage, error) {
+	if c.podNetworkStats != nil {
+		if network, ok := c.podNetworkStats.Get(sandbox.ID); ok {
+			return network, nil
+		}
+	}
+	return c.collectPodNetworkStats(sandbox, sandboxMeta)
+}
+
+// collectPodNetworkStats collects the network stats of sandbox. The stats of
+// pod netns is got through CNI, and the stats of sandbox connected to pouch
+// network is got from network manager. Nil is returned for host network.
+func (c *CriManager) collectPodNetworkStats(sandbox *mgr.Container, sandboxMeta *metatypes.SandboxMeta) (*runtime.NetworkUsage, error) {
+	var (
+		stats map[string]apitypes.NetworkStats
+		err   error
+	)
+
+	timestamp := time.Now().UnixNano()
+	switch {
+	case sandboxNetworkMode(sandboxMeta.Config) == runtime.NamespaceMode_NODE:
+		return nil, nil
+	case containerNetns(sandbox) != "":
+		stats, err = c.CniMgr.GetPodNetworkStats(containerNetns(sandbox))
+	case sandbox.NetworkSettings != nil && sandbox.NetworkSettings.SandboxID != "" && c.NetworkMgr != nil:
+		stats, err = c.NetworkMgr.GetNetworkStats(sandbox.NetworkSettings.SandboxID)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toCriNetworkUsage(stats, timestamp), nil
+}
+
+// toCriNetworkUsage converts the stats of network interfaces to CRI network
+// usage, the interface eth0 set up by CNI is the default one.
+func toCriNetworkUsage(stats map[string]apitypes.NetworkStats, timestamp int64) *runtime.NetworkUsage {
+	usage := &runtime.NetworkUsage{Timestamp: timestamp}
+
+	names := make([]string, 0, len(stats))
+	for name := range stats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		s := stats[name]
+		iface := &runtime.NetworkInterfaceUsage{
+			Name:     name,
+			RxBytes:  &runtime.UInt64Value{Value: s.RxBytes},
+			RxErrors: &runtime.UInt64Value{Value: s.RxErrors},
+			TxBytes:  &runtime.UInt64Value{Value: s.TxBytes},
+			TxErrors: &runtime.UInt64Value{Value: s.TxErrors},
+		}
+		if name == ocicni.DefaultInterfaceName {
+			usage.DefaultInterface = iface
+			continue
+		}
+		usage.Interfaces = append(usage.Interfaces, iface)
+	}
+	return usage
+}
+
+// podNetworkStatsStore stores the network stats of pod sandboxes.
+type podNetworkStatsStore struct {
+	lock  sync.RWMutex
+	stats map[string]*runtime.NetworkUsage
+}
+
+// newPodNetworkStatsStore creates a new pod network stats store.
+func newPodNetworkStatsStore() *podNetworkStatsStore {
+	return &podNetworkStatsStore{stats: make(map[string]*runtime.NetworkUsage)}
+}
+
+// Get returns the network stats of sandbox.
+func (s *podNetworkStatsStore) Get(id string) (*runtime.NetworkUsage, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	stats, ok := s.stats[id]
+	return stats, ok
+}
+
+// Replace replaces all the network stats in the store.
+func (s *podNetworkStatsStore) Replace(stats map[string]*runtime.NetworkUsage) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.stats = stats
+}
+
+// startPodNetworkStatsSyncer syncs the network stats of running sandboxes
+// into the cache periodically.
+func (c *CriManager) startPodNetworkStatsSyncer(period time.Duration) {
+	tick := time.NewTicker(period)
+	go func() {
+		defer tick.Stop()
+		for {
+			if err := c.syncPodNetworkStats(context.Background()); err != nil {
+				log.With(nil).Errorf("failed to sync pod network stats: %v", err)
+			}
+			<-tick.C
+		}
+	}()
+}
+
+// syncPodNetworkStats updates the network stats of running sandboxes, the
+// stats of sandboxes which are not running any more are removed.
+func (c *CriManager) syncPodNetworkStats(ctx context.Context) error {
+	sandboxes, err := c.listRunningSandboxes(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list sandboxes: %v", err)
+	}
+
+	stats := make(map[string]*runtime.NetworkUsage, len(sandboxes))
+	for _, sandbox := range sandboxes {
+		res, err := c.SandboxStore.Get(sandbox.ID)
+		if err != nil {
+			continue
+		}
+		sandboxMeta := res.(*metatypes.SandboxMeta)
+		if sandboxMeta.Config == nil {
+			continue
+		}
+
+		network, err := c.collectPodNetworkStats(sandbox, sandboxMeta)
+		if err != nil {
+			log.With(ctx).Warnf("failed to get network stats of sandbox %q: %v", sandbox.ID, err)
+			continue
+		}
+		stats[sandbox.ID] = network
+	}
+	c.podNetworkStats.Replace(stats)
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package v1alpha2
+
+import (
+	"context"
+	"testing"
+
+	apitypes "github.com/alibaba/pouch/apis/types"
+	runtime "github.com/alibaba/pouch/cri/apis/v1alpha2"
+	cni "github.com/alibaba/pouch/cri/ocicni"
+	metatypes "github.com/alibaba/pouch/cri/v1alpha2/types"
+	"github.com/alibaba/pouch/daemon/mgr"
+
+	"github.com/containerd/cgroups"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodCgroupPath(t *testing.T) {
+	for _, tc := range []struct {
+		cgroupParent string
+		systemd      bool
+		expected     string
+	}{
+		{"/kubepods/besteffort/pod1", false, "/kubepods/besteffort/pod1"},
+		{"kubepods-besteffort-pod1.slice", false, "kubepods-besteffort-pod1.slice"},
+		{"kubepods-besteffort-pod1.slice", true, "/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod1.slice"},
+		{"kubepods.slice", true, "/kubepods.slice"},
+		{"-.slice", true, "/"},
+		{"kubepods--pod1.slice", true, "kubepods--pod1.slice"},
+		{"/kubepods.slice/kubepods-pod1.slice", true, "/kubepods.slice/kubepods-pod1.slice"},
+	} {
+		assert.Equal(t, tc.expected, podCgroupPath(tc.cgroupParent, tc.systemd), tc.cgroupParent)
+	}
+}
+
+func TestToPodSandboxCgroupStats(t *testing.T) {
+	stats := &runtime.LinuxPodSandboxStats{}
+	toPodSandboxCgroupStats(stats, &cgroups.Metrics{
+		CPU:    &cgroups.CPUStat{Usage: &cgroups.CPUUsage{Total: 100}},
+		Memory: &cgroups.MemoryStat{Usage: &cgroups.MemoryEntry{Usage: 200}, TotalInactiveFile: 50},
+		Pids:   &cgroups.PidsStat{Current: 3},
+	}, 1)
+
+	// the inactive page cache is excluded from the working set.
+	assert.Equal(t, &runtime.CpuUsage{Timestamp: 1, UsageCoreNanoSeconds: &runtime.UInt64Value{Value: 100}}, stats.Cpu)
+	assert.Equal(t, &runtime.MemoryUsage{Timestamp: 1, WorkingSetBytes: &runtime.UInt64Value{Value: 150}}, stats.Memory)
+	assert.Equal(t, &runtime.ProcessUsage{Timestamp: 1, ProcessCount: &runtime.UInt64Value{Value: 3}}, stats.Process)
+
+	// the working set is clamped at 0.
+	stats = &runtime.LinuxPodSandboxStats{}
+	toPodSandboxCgroupStats(stats, &cgroups.Metrics{
+		Memory: &cgroups.MemoryStat{Usage: &cgroups.MemoryEntry{Usage: 200}, TotalInactiveFile: 300},
+	}, 1)
+	assert.Equal(t, &runtime.MemoryUsage{Timestamp: 1, WorkingSetBytes: &runtime.UInt64Value{Value: 0}}, stats.Memory)
+
+	stats = &runtime.LinuxPodSandboxStats{}
+	toPodSandboxCgroupStats(stats, &cgroups.Metrics{}, 1)
+	assert.Nil(t, stats.Cpu)
+	assert.Nil(t, stats.Memory)
+	assert.Nil(t, stats.Process)
+}
+
+// fakeCniMgr returns the network stats by netns.
+type fakeCniMgr struct {
+	cni.CniMgr
+
+	defaultNetwork string
+	stats          map[string]map[string]apitypes.NetworkStats
+}
+
+func (f *fakeCniMgr) GetDefaultNetworkName() string {
+	return f.defaultNetwork
+}
+
+func (f *fakeCniMgr) GetPodNetworkStats(netnsPath string) (map[string]apitypes.NetworkStats, error) {
+	return f.stats[netnsPath], nil
+}
+
+func TestCollectPodNetworkStats(t *testing.T) {
+	c := &CriManager{
+		CniMgr: &fakeCniMgr{
+			stats: map[string]map[string]apitypes.NetworkStats{
+				"/proc/100/ns/net": {
+					"eth1": {RxBytes: 3, TxBytes: 4},
+					"eth0": {RxBytes: 1, RxErrors: 2, TxBytes: 3, TxErrors: 4},
+					"net1": {RxBytes: 5},
+				},
+			},
+		},
+	}
+
+	sandbox := &mgr.Container{State: &apitypes.ContainerState{Pid: 100}}
+	sandboxMeta := &metatypes.SandboxMeta{Config: &runtime.PodSandboxConfig{}}
+
+	usage, err := c.collectPodNetworkStats(sandbox, sandboxMeta)
+	assert.NoError(t, err)
+	assert.NotZero(t, usage.Timestamp)
+	assert.Equal(t, &runtime.NetworkInterfaceUsage{
+		Name:     "eth0",
+		RxBytes:  &runtime.UInt64Value{Value: 1},
+		RxErrors: &runtime.UInt64Value{Value: 2},
+		TxBytes:  &runtime.UInt64Value{Value: 3},
+		TxErrors: &runtime.UInt64Value{Value: 4},
+	}, usage.DefaultInterface)
+	assert.Equal(t, 2, len(usage.Interfaces))
+	assert.Equal(t, "eth1", usage.Interfaces[0].Name)
+	assert.Equal(t, "net1", usage.Interfaces[1].Name)
+
+	// no network stats for the host network.
+	sandboxMeta.Config.Linux = &runtime.LinuxPodSandboxConfig{
+		SecurityContext: &runtime.LinuxSandboxSecurityContext{
+			NamespaceOptions: &runtime.NamespaceOption{Network: runtime.NamespaceMode_NODE},
+		},
+	}
+	usage, err = c.collectPodNetworkStats(sandbox, sandboxMeta)
+	assert.NoError(t, err)
+	assert.Nil(t, usage)
+
+	// the cached stats are used if cri collects stats periodically.
+	c.podNetworkStats = newPodNetworkStatsStore()
+	c.podNetworkStats.Replace(map[string]*runtime.NetworkUsage{"sandbox": {Timestamp: 1}})
+	usage, err = c.getPodNetworkStats(context.Background(), &mgr.Container{ID: "sandbox"}, sandboxMeta)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), usage.Timestamp)
+}
@@ -20,8 +20,9 @@ type Service struct {
 	server *grpc.Server
 }
 
-// NewService creates a brand new cri service.
-func NewService(cfg *config.Config, criMgr CriMgr) (*Service, error) {
+// NewService creates a brand new cri service, the registers are called to
+// register the other services backed by criMgr, e.g. the runtime.v1 api.
+func NewService(cfg *config.Config, criMgr CriMgr, registers ...func(*grpc.Server, CriMgr)) (*Service, error) {
 	s := &Service{
 		config: cfg,
 		server: grpc.NewServer(
@@ -37,6 +38,10 @@ func NewService(cfg *config.Config, criMgr CriMgr) (*Service, error) {
 	runtime.RegisterImageServiceServer(s.server, criMgr)
 	runtime.RegisterVolumeServiceServer(s.server, criMgr)
 
+	for _, register := range registers {
+		register(s.server, criMgr)
+	}
+
 	// EnableHandlingTimeHistogram turns on recording of handling time
 	// of RPCs. Histogram metrics can be very expensive for Prometheus
 	// to retain and query.
@@ -60,6 +65,7 @@ func (s *Service) Serve() error {
 func criLogLevelDecider(ctx context.Context, fullMethodName string, servingObject interface{}) logrus.Level {
 	// extract methodName from fullMethodName
 	// eg. extract 'StartContainer' from '/runtime.v1alpha2.RuntimeService/StartContainer'
+	// or '/runtime.v1.RuntimeService/StartContainer'
 	methodName := path.Base(fullMethodName)
 
 	// method->logLevel map
@@ -72,6 +78,8 @@ func criLogLevelDecider(ctx context.Context, fullMethodName string, servingObjec
 		"ContainerStatus",
 		"ContainerStats",
 		"ListContainerStats",
+		"PodSandboxStats",
+		"ListPodSandboxStats",
 		"Status",
 		"ListImages",
 		"ImageStatus",
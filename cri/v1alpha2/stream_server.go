@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"time"
 
 	runtimeapi "github.com/alibaba/pouch/cri/apis/v1alpha2"
 	"github.com/alibaba/pouch/cri/stream"
@@ -33,19 +34,34 @@ type StreamServer interface {
 	stream.Router
 }
 
+// RequestValidator validates the container or sandbox of the stream request,
+// issuedAt is the time when the token of the request is issued.
+type RequestValidator func(req stream.Request, issuedAt time.Time) error
+
+// tokenRequest is the cached request of a token. The token is bound to the
+// container running when it is issued, and becomes invalid if the container
+// is restarted.
+type tokenRequest struct {
+	stream.Request
+	issuedAt time.Time
+}
+
 type server struct {
-	config  stream.Config
-	runtime stream.Runtime
-	cache   *stream.RequestCache
-	server  *http.Server
+	config   stream.Config
+	runtime  stream.Runtime
+	validate RequestValidator
+	cache    *stream.RequestCache
+	server   *http.Server
 }
 
-// NewStreamServer creates a new stream server.
-func NewStreamServer(config stream.Config, runtime stream.Runtime) (StreamServer, error) {
+// NewStreamServer creates a new stream server, the requests are validated by
+// validate when their tokens are issued and consumed.
+func NewStreamServer(config stream.Config, runtime stream.Runtime, validate RequestValidator) (StreamServer, error) {
 	s := &server{
-		config:  config,
-		runtime: runtime,
-		cache:   stream.NewRequestCache(),
+		config:   config,
+		runtime:  runtime,
+		validate: validate,
+		cache:    stream.NewRequestCache(),
 	}
 
 	endpoints := []struct {
@@ -65,8 +81,9 @@ func NewStreamServer(config stream.Config, runtime stream.Runtime) (StreamServer
 	}
 
 	s.server = &http.Server{
-		Addr:    s.config.Address,
-		Handler: r,
+		Addr:      s.config.Address,
+		Handler:   r,
+		TLSConfig: s.config.TLSConfig,
 	}
 
 	return s, nil
@@ -74,19 +91,47 @@ func NewStreamServer(config stream.Config, runtime stream.Runtime) (StreamServer
 
 // Start starts the stream server.
 func (s *server) Start() error {
+	if s.config.TLSConfig != nil {
+		// the certificates are loaded in TLSConfig already.
+		return s.server.ListenAndServeTLS("", "")
+	}
 	return s.server.ListenAndServe()
 }
 
-func (s *server) ServeExec(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// insert validates the request and caches it, the token of it is returned.
+func (s *server) insert(req stream.Request) (string, error) {
+	now := time.Now()
+	if err := s.validate(req, now); err != nil {
+		return "", err
+	}
+	return s.cache.Insert(&tokenRequest{Request: req, issuedAt: now})
+}
 
+// consume consumes the token of the http request and returns the cached
+// request if it is still valid, otherwise the error is written to w.
+func (s *server) consume(w http.ResponseWriter, r *http.Request) (stream.Request, bool) {
 	token := mux.Vars(r)["token"]
 	cachedRequest, ok := s.cache.Consume(token)
 	if !ok {
 		http.NotFound(w, r)
-		return
+		return nil, false
 	}
 
+	req := cachedRequest.(*tokenRequest)
+	if err := s.validate(req.Request, req.issuedAt); err != nil {
+		stream.WriteError(err, w)
+		return nil, false
+	}
+	return req.Request, true
+}
+
+func (s *server) ServeExec(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cachedRequest, ok := s.consume(w, r)
+	if !ok {
+		return
+	}
 	exec, ok := cachedRequest.(*runtimeapi.ExecRequest)
 	if !ok {
 		http.NotFound(w, r)
@@ -116,10 +161,8 @@ func (s *server) ServeExec(w http.ResponseWriter, r *http.Request) {
 func (s *server) ServeAttach(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	token := mux.Vars(r)["token"]
-	cachedRequest, ok := s.cache.Consume(token)
+	cachedRequest, ok := s.consume(w, r)
 	if !ok {
-		http.NotFound(w, r)
 		return
 	}
 	attach, ok := cachedRequest.(*runtimeapi.AttachRequest)
@@ -150,10 +193,8 @@ func (s *server) ServeAttach(w http.ResponseWriter, r *http.Request) {
 func (s *server) ServePortForward(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	token := mux.Vars(r)["token"]
-	cachedRequest, ok := s.cache.Consume(token)
+	cachedRequest, ok := s.consume(w, r)
 	if !ok {
-		http.NotFound(w, r)
 		return
 	}
 	pf, ok := cachedRequest.(*runtimeapi.PortForwardRequest)
@@ -180,10 +221,29 @@ func (s *server) buildURL(method string, token string) string {
 	}).String()
 }
 
+// validateStreams validates the streams requested by exec and attach.
+func validateStreams(stdin, stdout, stderr, tty bool) error {
+	if !stdin && !stdout && !stderr {
+		return grpc.Errorf(codes.InvalidArgument, "one of stdin, stdout, or stderr must be set")
+	}
+	if tty && stderr {
+		return grpc.Errorf(codes.InvalidArgument, "tty and stderr cannot both be true")
+	}
+	return nil
+}
+
 // GetExec gets the serving URL for the Exec requests.
 func (s *server) GetExec(req *runtimeapi.ExecRequest) (*runtimeapi.ExecResponse, error) {
-	// TODO: validate the request.
-	token, err := s.cache.Insert(req)
+	if req.ContainerId == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "missing required container_id")
+	}
+	if len(req.Cmd) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "missing required cmd")
+	}
+	if err := validateStreams(req.Stdin, req.Stdout, req.Stderr, req.Tty); err != nil {
+		return nil, err
+	}
+	token, err := s.insert(req)
 	if err != nil {
 		return nil, err
 	}
@@ -195,8 +255,13 @@ func (s *server) GetExec(req *runtimeapi.ExecRequest) (*runtimeapi.ExecResponse,
 
 // GetAttach gets the serving URL for the Attach requests.
 func (s *server) GetAttach(req *runtimeapi.AttachRequest) (*runtimeapi.AttachResponse, error) {
-	// TODO: validate the request.
-	token, err := s.cache.Insert(req)
+	if req.ContainerId == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "missing required container_id")
+	}
+	if err := validateStreams(req.Stdin, req.Stdout, req.Stderr, req.Tty); err != nil {
+		return nil, err
+	}
+	token, err := s.insert(req)
 	if err != nil {
 		return nil, err
 	}
@@ -211,7 +276,7 @@ func (s *server) GetPortForward(req *runtimeapi.PortForwardRequest) (*runtimeapi
 	if req.PodSandboxId == "" {
 		return nil, grpc.Errorf(codes.InvalidArgument, "missing required pod_sandbox_id")
 	}
-	token, err := s.cache.Insert(req)
+	token, err := s.insert(req)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,201 @@
+package v1alpha2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	apitypes "github.com/alibaba/pouch/apis/types"
+	runtime "github.com/alibaba/pouch/cri/apis/v1alpha2"
+	"github.com/alibaba/pouch/cri/stream"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/daemon/mgr"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func newTestStreamServer(t *testing.T, validate RequestValidator) *server {
+	cfg := stream.DefaultConfig
+	cfg.Address = "127.0.0.1:10010"
+	cfg.BaseURL = &url.URL{Scheme: "http", Host: cfg.Address}
+
+	s, err := NewStreamServer(cfg, nil, validate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s.(*server)
+}
+
+func TestGetExecValidation(t *testing.T) {
+	s := newTestStreamServer(t, func(req stream.Request, issuedAt time.Time) error {
+		if req.(*runtime.ExecRequest).ContainerId == "stopped" {
+			return grpc.Errorf(codes.FailedPrecondition, "container is not running")
+		}
+		return nil
+	})
+
+	for _, tc := range []struct {
+		name string
+		req  *runtime.ExecRequest
+		code codes.Code
+	}{
+		{
+			name: "valid",
+			req:  &runtime.ExecRequest{ContainerId: "running", Cmd: []string{"sh"}, Stdin: true, Stdout: true, Tty: true},
+			code: codes.OK,
+		},
+		{
+			name: "missing container",
+			req:  &runtime.ExecRequest{Cmd: []string{"sh"}, Stdout: true},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "missing cmd",
+			req:  &runtime.ExecRequest{ContainerId: "running", Stdout: true},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "no streams",
+			req:  &runtime.ExecRequest{ContainerId: "running", Cmd: []string{"sh"}},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "tty with stderr",
+			req:  &runtime.ExecRequest{ContainerId: "running", Cmd: []string{"sh"}, Stdout: true, Stderr: true, Tty: true},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "container not running",
+			req:  &runtime.ExecRequest{ContainerId: "stopped", Cmd: []string{"sh"}, Stdout: true},
+			code: codes.FailedPrecondition,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := s.GetExec(tc.req)
+			assert.Equal(t, tc.code, grpc.Code(err))
+			if tc.code == codes.OK {
+				assert.Contains(t, resp.Url, "http://127.0.0.1:10010/exec/")
+			}
+		})
+	}
+}
+
+func TestServeTokenValidation(t *testing.T) {
+	restarted := false
+	var issued time.Time
+	s := newTestStreamServer(t, func(req stream.Request, issuedAt time.Time) error {
+		issued = issuedAt
+		if restarted {
+			return grpc.Errorf(codes.FailedPrecondition, "container is restarted")
+		}
+		return nil
+	})
+
+	resp, err := s.GetAttach(&runtime.AttachRequest{ContainerId: "c1", Stdout: true})
+	assert.NoError(t, err)
+	issuedAt := issued
+
+	// the container is restarted after the token is issued.
+	restarted = true
+	w := httptest.NewRecorder()
+	s.server.Handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, resp.Url, nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, issuedAt, issued)
+
+	// the token can be used only once.
+	w = httptest.NewRecorder()
+	s.server.Handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, resp.Url, nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	// the token of attach can't be used to exec.
+	restarted = false
+	resp, err = s.GetAttach(&runtime.AttachRequest{ContainerId: "c1", Stdout: true})
+	assert.NoError(t, err)
+	w = httptest.NewRecorder()
+	execURL := "http://127.0.0.1:10010/exec/" + resp.Url[len("http://127.0.0.1:10010/attach/"):]
+	s.server.Handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, execURL, nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// fakeStreamContainerMgr returns the containers by id.
+type fakeStreamContainerMgr struct {
+	mgr.ContainerMgr
+
+	containers map[string]*mgr.Container
+}
+
+func (f *fakeStreamContainerMgr) Get(ctx context.Context, id string) (*mgr.Container, error) {
+	c, ok := f.containers[id]
+	if !ok {
+		return nil, errors.Wrapf(errtypes.ErrNotfound, "container %s", id)
+	}
+	return c, nil
+}
+
+func TestValidateStreamContainer(t *testing.T) {
+	issuedAt := time.Now()
+	c := &CriManager{
+		ContainerMgr: &fakeStreamContainerMgr{
+			containers: map[string]*mgr.Container{
+				"running": {State: &apitypes.ContainerState{
+					Running:   true,
+					StartedAt: issuedAt.Add(-time.Minute).UTC().Format(utils.TimeLayout),
+				}},
+				"restarted": {State: &apitypes.ContainerState{
+					Running:   true,
+					StartedAt: issuedAt.Add(time.Second).UTC().Format(utils.TimeLayout),
+				}},
+				"stopped": {State: &apitypes.ContainerState{
+					Running:   false,
+					StartedAt: issuedAt.Add(-time.Minute).UTC().Format(utils.TimeLayout),
+				}},
+			},
+		},
+	}
+
+	for id, code := range map[string]codes.Code{
+		"running":   codes.OK,
+		"restarted": codes.FailedPrecondition,
+		"stopped":   codes.FailedPrecondition,
+		"notfound":  codes.NotFound,
+	} {
+		err := c.validateStreamRequest(&runtime.ExecRequest{ContainerId: id}, issuedAt)
+		assert.Equal(t, code, grpc.Code(err), id)
+	}
+}
+
+func TestToStreamConfigTLS(t *testing.T) {
+	cert, key, ca := "../../test/tls/server/cert.pem", "../../test/tls/server/key.pem", "../../test/tls/server/ca.pem"
+
+	cfg := &config.Config{}
+	cfg.CriConfig.StreamServerPort = "10010"
+	cfg.CriConfig.StreamServerTLSCert = cert
+	cfg.CriConfig.StreamServerTLSKey = key
+	cfg.CriConfig.StreamServerTLSCA = ca
+	streamCfg, err := toStreamConfig(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, "https", streamCfg.BaseURL.Scheme)
+	assert.NotNil(t, streamCfg.TLSConfig)
+	assert.Len(t, streamCfg.TLSConfig.Certificates, 1)
+	assert.NotNil(t, streamCfg.TLSConfig.ClientCAs)
+
+	// the key is missing.
+	cfg.CriConfig.StreamServerTLSKey = ""
+	_, err = toStreamConfig(cfg)
+	assert.Error(t, err)
+
+	// TLS can't be configured when the port of pouchd is reused.
+	cfg.CriConfig.StreamServerTLSKey = key
+	cfg.CriConfig.StreamServerReusePort = true
+	cfg.Listen = []string{"tcp://127.0.0.1:10010"}
+	_, err = toStreamConfig(cfg)
+	assert.Error(t, err)
+}
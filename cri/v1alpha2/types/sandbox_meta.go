@@ -20,6 +20,10 @@ type SandboxMeta struct {
 
 	// NetNS is the sandbox's network namespace
 	NetNS string
+
+	// Networks are the CNI networks the sandbox is attached to, the first one
+	// is the default network. Empty means the default network of CNI plugin.
+	Networks []string
 }
 
 // Key returns sandbox's id.
@@ -429,8 +429,8 @@ func (c *Client) killContainer(ctx context.Context, id string, signal int) error
 }
 
 // DestroyContainer kill container and delete it.
-func (c *Client) DestroyContainer(ctx context.Context, id string, timeout int64) (*Message, error) {
-	msg, err := c.destroyContainer(ctx, id, timeout)
+func (c *Client) DestroyContainer(ctx context.Context, id string, stopSignal syscall.Signal, initOnly bool, timeout int64) (*Message, error) {
+	msg, err := c.destroyContainer(ctx, id, stopSignal, initOnly, timeout)
 	if err != nil {
 		return msg, convertCtrdErr(err)
 	}
@@ -438,7 +438,7 @@ func (c *Client) DestroyContainer(ctx context.Context, id string, timeout int64)
 }
 
 // DestroyContainer kill container and delete it.
-func (c *Client) destroyContainer(ctx context.Context, id string, timeout int64) (*Message, error) {
+func (c *Client) destroyContainer(ctx context.Context, id string, stopSignal syscall.Signal, initOnly bool, timeout int64) (*Message, error) {
 	// TODO(ziren): if we just want to stop a container,
 	// we may need lease to lock the snapshot of container,
 	// in case, it be deleted by gc.
@@ -476,8 +476,15 @@ func (c *Client) destroyContainer(ctx context.Context, id string, timeout int64)
 
 	var msg *Message
 
+	// the stop signal is sent to all the processes in container, unless it
+	// is only meaningful for the init, such as SIGRTMIN+3 for systemd.
+	var killOpts []containerd.KillOpts
+	if !initOnly {
+		killOpts = append(killOpts, containerd.WithKillAll)
+	}
+
 	// TODO: set task request timeout by context timeout
-	if err := pack.task.Kill(ctx, syscall.SIGTERM, containerd.WithKillAll); err != nil {
+	if err := pack.task.Kill(ctx, stopSignal, killOpts...); err != nil {
 		if !errdefs.IsNotFound(err) {
 			return nil, errors.Wrap(err, "failed to kill task")
 		}
@@ -852,8 +859,9 @@ func (c *Client) waitContainer(ctx context.Context, id string) (types.ContainerW
 	}, nil
 }
 
-// CreateCheckpoint create a checkpoint from a running container
-func (c *Client) CreateCheckpoint(ctx context.Context, id string, checkpointDir string, exit bool) error {
+// CreateCheckpoint create a checkpoint from a running container, the
+// terminal should be true if the container has tty.
+func (c *Client) CreateCheckpoint(ctx context.Context, id string, checkpointDir string, exit, terminal bool) error {
 	pack, err := c.watch.get(id)
 	if err != nil {
 		return err
@@ -866,8 +874,8 @@ func (c *Client) CreateCheckpoint(ctx context.Context, id string, checkpointDir
 	client := wrapperCli.client
 
 	var opts []containerd.CheckpointTaskOpts
-	if exit {
-		opts = append(opts, withExitShimV1CheckpointTaskOpts())
+	if exit || terminal {
+		opts = append(opts, withShimV1CheckpointTaskOpts(exit, terminal))
 	}
 	checkpoint, err := pack.task.Checkpoint(ctx, opts...)
 	if err != nil {
@@ -52,6 +52,12 @@ type CommitConfig struct {
 	// container ID
 	ContainerID string
 
+	// snapshot ID of container, defaults to container ID
+	SnapshotID string
+
+	// squash the layers of parent image and container into one layer
+	Squash bool
+
 	// parent reference
 	ParentReference string
 
@@ -91,21 +97,29 @@ func (c *Client) Commit(ctx context.Context, config *CommitConfig) (_ digest.Dig
 	)
 
 	// export new layer
-	snapshot, err := c.GetSnapshot(ctx, config.ContainerID)
+	snapshotID := config.SnapshotID
+	if snapshotID == "" {
+		snapshotID = config.ContainerID
+	}
+	snapshot, err := c.GetSnapshot(ctx, snapshotID)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to get snapshot")
 	}
 
-	layer, diffID, err := exportLayer(ctx, snapshot.Name, sn, cs, differ)
+	layer, diffID, err := exportLayer(ctx, snapshot.Name, config.Squash, sn, cs, differ)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to export layer")
 	}
 
 	childImg := newChildImage(ctx, config, diffID)
 
-	// create new snapshot for new layer
+	// create new snapshot for new layer, the squashed layer has no parent.
+	var parentDiffIDs []digest.Digest
+	if !config.Squash {
+		parentDiffIDs = config.Image.RootFS.DiffIDs
+	}
 	rootfsID := identity.ChainID(childImg.RootFS.DiffIDs).String()
-	if err = newSnapshot(ctx, rootfsID, config.Image, sn, differ, layer); err != nil {
+	if err = newSnapshot(ctx, rootfsID, parentDiffIDs, sn, differ, layer); err != nil {
 		return "", err
 	}
 
@@ -136,6 +150,9 @@ func (c *Client) Commit(ctx context.Context, config *CommitConfig) (_ digest.Dig
 
 	// new layer descriptor
 	layers := append(pmfst.Layers, layer)
+	if config.Squash {
+		layers = []ocispec.Descriptor{layer}
+	}
 	labels := map[string]string{
 		"containerd.io/gc.ref.content.0": configDesc.Digest.String(),
 	}
@@ -212,10 +229,11 @@ func (c *Client) Commit(ctx context.Context, config *CommitConfig) (_ digest.Dig
 	return configDesc.Digest, nil
 }
 
-// export a new layer from a container
-func exportLayer(ctx context.Context, name string, sn snapshots.Snapshotter, cs content.Store, comparer diff.Comparer) (ocispec.Descriptor, digest.Digest, error) {
+// export a new layer from a container, the squashed layer includes the
+// layers of parent image.
+func exportLayer(ctx context.Context, name string, squash bool, sn snapshots.Snapshotter, cs content.Store, comparer diff.Comparer) (ocispec.Descriptor, digest.Digest, error) {
 	// export new layer
-	rwDesc, err := createDiff(ctx, name, sn, comparer)
+	rwDesc, err := createDiff(ctx, name, squash, sn, comparer)
 	if err != nil {
 		return ocispec.Descriptor{}, digest.Digest(""), fmt.Errorf("failed to diff: %s", err)
 	}
@@ -247,7 +265,9 @@ func exportLayer(ctx context.Context, name string, sn snapshots.Snapshotter, cs
 //
 // 1. don't use canceled context
 // 2. add the random string to lowdir
-func createDiff(ctx context.Context, snapshotID string, sn snapshots.Snapshotter, d diff.Comparer, opts ...diff.Opt) (ocispec.Descriptor, error) {
+//
+// If squash is true, the diff is created against an empty dir.
+func createDiff(ctx context.Context, snapshotID string, squash bool, sn snapshots.Snapshotter, d diff.Comparer, opts ...diff.Opt) (ocispec.Descriptor, error) {
 	// NOTE: the passthrough context might be canceled and we can't use
 	// the ctx to do any cleanup things.
 	//
@@ -262,16 +282,20 @@ func createDiff(ctx context.Context, snapshotID string, sn snapshots.Snapshotter
 
 	randKey := utils.RandString(5, "", "")
 
-	lowerKey := fmt.Sprintf("%s-parent-view-%s", info.Parent, randKey)
-	lower, err := sn.View(ctx, lowerKey, info.Parent)
-	if err != nil {
-		return ocispec.Descriptor{}, err
-	}
-	defer func() {
-		if err := sn.Remove(cctx, lowerKey); err != nil {
-			log.With(cctx).Warnf("failed to cleanup diff lower snapshotter(key=%s): %v", lowerKey, err)
+	// NOTE: no mount means empty lower dir.
+	lower := []mount.Mount{}
+	if !squash {
+		lowerKey := fmt.Sprintf("%s-parent-view-%s", info.Parent, randKey)
+		lower, err = sn.View(ctx, lowerKey, info.Parent)
+		if err != nil {
+			return ocispec.Descriptor{}, err
 		}
-	}()
+		defer func() {
+			if err := sn.Remove(cctx, lowerKey); err != nil {
+				log.With(cctx).Warnf("failed to cleanup diff lower snapshotter(key=%s): %v", lowerKey, err)
+			}
+		}()
+	}
 
 	var upper []mount.Mount
 	if info.Kind == snapshots.KindActive {
@@ -309,6 +333,19 @@ func newChildImage(ctx context.Context, config *CommitConfig, diffID digest.Dige
 
 	// new child image
 	pImg := config.Image
+	diffIDs := append(pImg.RootFS.DiffIDs, diffID)
+	histories := append(pImg.History, history)
+	if config.Squash {
+		// the layers of parent image are squashed into the new one.
+		diffIDs = []digest.Digest{diffID}
+		histories = make([]ocispec.History, 0, len(pImg.History)+1)
+		for _, h := range pImg.History {
+			h.EmptyLayer = true
+			histories = append(histories, h)
+		}
+		histories = append(histories, history)
+	}
+
 	return ocispec.Image{
 		Architecture: runtime.GOARCH,
 		OS:           runtime.GOOS,
@@ -317,17 +354,17 @@ func newChildImage(ctx context.Context, config *CommitConfig, diffID digest.Dige
 		Config:       newImageConfig(config.ContainerConfig),
 		RootFS: ocispec.RootFS{
 			Type:    "layers",
-			DiffIDs: append(pImg.RootFS.DiffIDs, diffID),
+			DiffIDs: diffIDs,
 		},
-		History: append(pImg.History, history),
+		History: histories,
 	}
 }
 
 // create a new snapshot for exported layer
-func newSnapshot(ctx context.Context, name string, pImg ocispec.Image, sn snapshots.Snapshotter, differ diff.Applier, layer ocispec.Descriptor) error {
+func newSnapshot(ctx context.Context, name string, parentDiffIDs []digest.Digest, sn snapshots.Snapshotter, differ diff.Applier, layer ocispec.Descriptor) error {
 	var (
 		key    = randomid.Generate()
-		parent = identity.ChainID(pImg.RootFS.DiffIDs).String()
+		parent = identity.ChainID(parentDiffIDs).String()
 	)
 
 	mount, err := sn.Prepare(ctx, key, parent)
@@ -361,13 +398,21 @@ func newImageConfig(c *types.ContainerConfig) ocispec.ImageConfig {
 			volumes[i] = struct{}(nv)
 		}
 	}
+	var exposedPorts map[string]struct{}
+	if len(c.ExposedPorts) > 0 {
+		exposedPorts = make(map[string]struct{}, len(c.ExposedPorts))
+		for port := range c.ExposedPorts {
+			exposedPorts[port] = struct{}{}
+		}
+	}
 	return ocispec.ImageConfig{
-		User:       c.User,
-		Env:        c.Env,
-		Entrypoint: c.Entrypoint,
-		Cmd:        c.Cmd,
-		Volumes:    volumes,
-		WorkingDir: c.WorkingDir,
-		Labels:     c.Labels,
+		User:         c.User,
+		ExposedPorts: exposedPorts,
+		Env:          c.Env,
+		Entrypoint:   c.Entrypoint,
+		Cmd:          c.Cmd,
+		Volumes:      volumes,
+		WorkingDir:   c.WorkingDir,
+		Labels:       c.Labels,
 	}
 }
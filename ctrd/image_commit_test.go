@@ -0,0 +1,45 @@
+package ctrd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewChildImage(t *testing.T) {
+	parent := ocispec.Image{
+		RootFS: ocispec.RootFS{
+			Type:    "layers",
+			DiffIDs: []digest.Digest{"sha256:aaa", "sha256:bbb"},
+		},
+		History: []ocispec.History{{CreatedBy: "ADD rootfs"}, {CreatedBy: "RUN make"}},
+	}
+	config := &CommitConfig{
+		ContainerConfig: &types.ContainerConfig{
+			Cmd:          []string{"top"},
+			ExposedPorts: map[string]interface{}{"80/tcp": struct{}{}},
+		},
+		Image: parent,
+	}
+
+	img := newChildImage(context.Background(), config, "sha256:ccc")
+	assert.Equal(t, []digest.Digest{"sha256:aaa", "sha256:bbb", "sha256:ccc"}, img.RootFS.DiffIDs)
+	assert.Len(t, img.History, 3)
+	assert.False(t, img.History[0].EmptyLayer)
+	assert.Equal(t, map[string]struct{}{"80/tcp": {}}, img.Config.ExposedPorts)
+
+	config.Image = parent
+	config.Squash = true
+	img = newChildImage(context.Background(), config, "sha256:ddd")
+	assert.Equal(t, []digest.Digest{"sha256:ddd"}, img.RootFS.DiffIDs)
+	assert.Len(t, img.History, 3)
+	for _, h := range img.History[:2] {
+		assert.True(t, h.EmptyLayer)
+	}
+	assert.Equal(t, "top", img.History[2].CreatedBy)
+}
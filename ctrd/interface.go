@@ -3,6 +3,7 @@ package ctrd
 import (
 	"context"
 	"io"
+	"syscall"
 	"time"
 
 	"github.com/alibaba/pouch/apis/types"
@@ -17,6 +18,7 @@ import (
 	"github.com/containerd/containerd/remotes/docker"
 	"github.com/containerd/containerd/snapshots"
 	"github.com/opencontainers/go-digest"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 // APIClient defines common methods of containerd api client
@@ -37,8 +39,9 @@ type ContainerAPIClient interface {
 	CreateContainer(ctx context.Context, container *Container, checkpointDir string) error
 	// KillContainer kills a container's all processes by signal.
 	KillContainer(ctx context.Context, id string, signal int) error
-	// DestroyContainer kill container and delete it.
-	DestroyContainer(ctx context.Context, id string, timeout int64) (*Message, error)
+	// DestroyContainer kill container by stop signal and delete it. The stop signal
+	// is sent to all the processes in container, or only the init process if initOnly is true.
+	DestroyContainer(ctx context.Context, id string, stopSignal syscall.Signal, initOnly bool, timeout int64) (*Message, error)
 	// ProbeContainer probe the container's status, if timeout <= 0, will block to receive message.
 	ProbeContainer(ctx context.Context, id string, timeout time.Duration) *Message
 	// ContainerPIDs returns the all processes's ids inside the container.
@@ -101,6 +104,9 @@ type ImageAPIClient interface {
 type SnapshotAPIClient interface {
 	// CreateSnapshot creates a active snapshot with image's name and id.
 	CreateSnapshot(ctx context.Context, id, ref string) error
+	// CreateRemappedSnapshot creates an active snapshot with image's name and id, whose files
+	// are shifted by the uid and gid mappings.
+	CreateRemappedSnapshot(ctx context.Context, id, ref string, uidMap, gidMap specs.LinuxIDMapping) error
 	// GetSnapshot returns the snapshot's info by id.
 	GetSnapshot(ctx context.Context, id string) (snapshots.Info, error)
 	// RemoveSnapshot removes the snapshot by id.
@@ -114,6 +120,15 @@ type SnapshotAPIClient interface {
 	// WalkSnapshot walk all snapshots in specific snapshotter. If not set specific snapshotter,
 	// it will be set to current snapshotter. For each snapshot, the function will be called.
 	WalkSnapshot(ctx context.Context, snapshotter string, fn func(context.Context, snapshots.Info) error) error
+	// ApplySnapshotDiff applies the changes of snapshot src against its parent to
+	// the active snapshot dst, only the changes under paths are applied if specified.
+	ApplySnapshotDiff(ctx context.Context, src, dst string, paths []string) error
+	// ExportSnapshotDiff writes the changes of the active snapshot against its parent to w.
+	ExportSnapshotDiff(ctx context.Context, id string, w io.Writer) error
+	// ExportRootfsDiff writes the changes of the rootfs mounted from the active snapshot against its parent to w.
+	ExportRootfsDiff(ctx context.Context, id, rootfs string, w io.Writer) error
+	// ImportSnapshotDiff applies the changes written by ExportSnapshotDiff to the active snapshot.
+	ImportSnapshotDiff(ctx context.Context, id string, r io.Reader) error
 	// CreateCheckpoint creates a checkpoint from a running container
-	CreateCheckpoint(ctx context.Context, id string, checkpointDir string, exit bool) error
+	CreateCheckpoint(ctx context.Context, id string, checkpointDir string, exit, terminal bool) error
 }
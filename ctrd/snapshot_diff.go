@@ -0,0 +1,283 @@
+package ctrd
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/randomid"
+
+	"github.com/containerd/containerd/archive"
+	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/snapshots"
+	"github.com/pkg/errors"
+)
+
+const (
+	// whiteoutPrefix prefix means file is a whiteout.
+	whiteoutPrefix = ".wh."
+	// whiteoutOpaqueDir means the directory has been made opaque.
+	whiteoutOpaqueDir = whiteoutPrefix + whiteoutPrefix + ".opq"
+)
+
+// ApplySnapshotDiff applies the changes of snapshot src against its parent to
+// the active snapshot dst, the deleted files are removed from dst too. If
+// paths are specified, only the changes under the paths are applied.
+//
+// NOTE: both snapshots should not be used by running container.
+func (c *Client) ApplySnapshotDiff(ctx context.Context, src, dst string, paths []string) error {
+	return c.withSnapshotDiffMounts(ctx, src, func(ctx context.Context, sn snapshots.Snapshotter, lowerRoot, upperRoot string) error {
+		target, err := sn.Mounts(ctx, dst)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get mounts of snapshot %s", dst)
+		}
+
+		return mount.WithTempMount(ctx, target, func(targetRoot string) error {
+			return applyDiff(ctx, lowerRoot, upperRoot, targetRoot, paths)
+		})
+	})
+}
+
+// ExportSnapshotDiff writes the changes of the active snapshot against its
+// parent to w as a tar stream, the deleted files are written as whiteouts.
+//
+// NOTE: the snapshot should not be used by running container.
+func (c *Client) ExportSnapshotDiff(ctx context.Context, id string, w io.Writer) error {
+	return c.withSnapshotDiffMounts(ctx, id, func(ctx context.Context, _ snapshots.Snapshotter, lowerRoot, upperRoot string) error {
+		if err := archive.WriteDiff(ctx, w, lowerRoot, upperRoot); err != nil {
+			return errors.Wrapf(err, "failed to write diff of snapshot %s", id)
+		}
+		return nil
+	})
+}
+
+// ExportRootfsDiff writes the changes of the rootfs mounted from the active
+// snapshot against its parent to w as a tar stream. It is used for the
+// running container whose snapshot can not be mounted again.
+func (c *Client) ExportRootfsDiff(ctx context.Context, id, rootfs string, w io.Writer) error {
+	return c.withSnapshotParentMount(ctx, id, func(ctx context.Context, _ snapshots.Snapshotter, lowerRoot string) error {
+		if err := archive.WriteDiff(ctx, w, lowerRoot, rootfs); err != nil {
+			return errors.Wrapf(err, "failed to write diff of rootfs %s", rootfs)
+		}
+		return nil
+	})
+}
+
+// ImportSnapshotDiff applies the tar stream written by ExportSnapshotDiff to
+// the active snapshot.
+//
+// NOTE: the snapshot should not be used by running container.
+func (c *Client) ImportSnapshotDiff(ctx context.Context, id string, r io.Reader) error {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	sn := wrapperCli.client.SnapshotService(CurrentSnapshotterName(ctx))
+	defer sn.Close()
+
+	mounts, err := sn.Mounts(ctx, id)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get mounts of snapshot %s", id)
+	}
+
+	return mount.WithTempMount(ctx, mounts, func(root string) error {
+		if _, err := archive.Apply(ctx, root, r); err != nil {
+			return errors.Wrapf(err, "failed to apply diff to snapshot %s", id)
+		}
+		return nil
+	})
+}
+
+// withSnapshotDiffMounts mounts the parent and the active snapshot id
+// temporarily, and calls f with their mount points.
+func (c *Client) withSnapshotDiffMounts(ctx context.Context, id string, f func(ctx context.Context, sn snapshots.Snapshotter, lowerRoot, upperRoot string) error) error {
+	return c.withSnapshotParentMount(ctx, id, func(ctx context.Context, sn snapshots.Snapshotter, lowerRoot string) error {
+		upper, err := sn.Mounts(ctx, id)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get mounts of snapshot %s", id)
+		}
+
+		return mount.WithTempMount(ctx, upper, func(upperRoot string) error {
+			return f(ctx, sn, lowerRoot, upperRoot)
+		})
+	})
+}
+
+// withSnapshotParentMount mounts the parent of the active snapshot id
+// temporarily, and calls f with its mount point.
+func (c *Client) withSnapshotParentMount(ctx context.Context, id string, f func(ctx context.Context, sn snapshots.Snapshotter, lowerRoot string) error) error {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+	client := wrapperCli.client
+
+	// NOTE: make sure that gc scheduler doesn't remove the view of parent.
+	ctx, done, err := client.WithLease(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to create lease for snapshot diff")
+	}
+	defer done(ctx)
+
+	sn := client.SnapshotService(CurrentSnapshotterName(ctx))
+	defer sn.Close()
+
+	info, err := sn.Stat(ctx, id)
+	if err != nil {
+		return errors.Wrapf(err, "failed to stat snapshot %s", id)
+	}
+	if info.Kind != snapshots.KindActive {
+		return errors.Errorf("snapshot %s is not active", id)
+	}
+
+	var lower []mount.Mount
+	if info.Parent != "" {
+		viewKey := fmt.Sprintf("%s-view-%s", id, randomid.Generate())
+		lower, err = sn.View(ctx, viewKey, info.Parent)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create view of snapshot %s", info.Parent)
+		}
+		defer func() {
+			if err := sn.Remove(ctx, viewKey); err != nil {
+				log.With(ctx).Warnf("failed to remove view snapshot %s: %v", viewKey, err)
+			}
+		}()
+	}
+
+	return withTempMounts(ctx, lower, func(lowerRoot string) error {
+		return f(ctx, sn, lowerRoot)
+	})
+}
+
+// withTempMounts is like mount.WithTempMount, but uses an empty dir if there
+// is no mount.
+func withTempMounts(ctx context.Context, mounts []mount.Mount, f func(root string) error) error {
+	if len(mounts) != 0 {
+		return mount.WithTempMount(ctx, mounts, f)
+	}
+
+	root, err := ioutil.TempDir("", "empty-snapshot")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(root)
+
+	return f(root)
+}
+
+// applyDiff writes the diff between lower and upper dirs, and applies it to
+// the target dir.
+func applyDiff(ctx context.Context, lower, upper, target string, paths []string) error {
+	pr, pw := io.Pipe()
+
+	go func() {
+		if len(paths) == 0 {
+			pw.CloseWithError(archive.WriteDiff(ctx, pw, lower, upper))
+			return
+		}
+
+		dr, dw := io.Pipe()
+		go func() {
+			dw.CloseWithError(archive.WriteDiff(ctx, dw, lower, upper))
+		}()
+
+		err := filterDiff(dr, pw, paths)
+		dr.CloseWithError(err)
+		pw.CloseWithError(err)
+	}()
+
+	_, err := archive.Apply(ctx, target, pr)
+	pr.CloseWithError(err)
+	if err != nil {
+		return errors.Wrap(err, "failed to apply diff")
+	}
+	return nil
+}
+
+// filterDiff copies the entries under the paths from the diff tar stream,
+// the parent dirs of the paths are also kept to preserve their attributes.
+func filterDiff(r io.Reader, w io.Writer, paths []string) error {
+	cleaned := make([]string, 0, len(paths))
+	for _, p := range paths {
+		cleaned = append(cleaned, strings.Trim(path.Clean("/"+p), "/"))
+	}
+	paths = cleaned
+
+	var (
+		tr   = tar.NewReader(r)
+		tw   = tar.NewWriter(w)
+		kept = map[string]struct{}{}
+	)
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		name := strings.Trim(path.Clean("/"+hdr.Name), "/")
+		dir, base := path.Split(name)
+		dir = strings.TrimSuffix(dir, "/")
+
+		var keep bool
+		switch {
+		case base == whiteoutOpaqueDir:
+			// opaque whiteout removes everything in the dir.
+			keep = underPaths(dir, paths)
+		case strings.HasPrefix(base, whiteoutPrefix):
+			keep = underPaths(path.Join(dir, strings.TrimPrefix(base, whiteoutPrefix)), paths)
+		case underPaths(name, paths):
+			keep = true
+		case hdr.Typeflag == tar.TypeDir:
+			keep = parentOfPaths(name, paths)
+		}
+
+		// hardlink can only be kept if its target is kept.
+		if keep && hdr.Typeflag == tar.TypeLink {
+			_, keep = kept[strings.Trim(path.Clean("/"+hdr.Linkname), "/")]
+		}
+		if !keep {
+			continue
+		}
+		kept[name] = struct{}{}
+
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+// underPaths returns true if the name is one of the paths or under them.
+func underPaths(name string, paths []string) bool {
+	for _, p := range paths {
+		if p == "" || name == p || strings.HasPrefix(name, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// parentOfPaths returns true if the name is the parent dir of any path.
+func parentOfPaths(name string, paths []string) bool {
+	for _, p := range paths {
+		if strings.HasPrefix(p, name+"/") {
+			return true
+		}
+	}
+	return false
+}
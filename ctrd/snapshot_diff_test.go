@@ -0,0 +1,112 @@
+package ctrd
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var mtime = time.Unix(1500000000, 0)
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	for name, content := range files {
+		p := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		// the unchanged files in upper have the same stat as lower.
+		if err := os.Chtimes(p, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestApplyDiff(t *testing.T) {
+	base := map[string]string{
+		"etc/config":     "old",
+		"etc/removed":    "old",
+		"data/removed":   "old",
+		"data/unchanged": "old",
+	}
+
+	for _, tc := range []struct {
+		name    string
+		paths   []string
+		exist   map[string]string
+		missing []string
+	}{
+		{
+			name: "all changes",
+			exist: map[string]string{
+				"etc/config":     "new",
+				"data/added":     "new",
+				"data/unchanged": "image",
+				"var/log/app":    "new",
+			},
+			missing: []string{"etc/removed", "data/removed"},
+		},
+		{
+			name:  "selected paths",
+			paths: []string{"/data", "var/log/"},
+			exist: map[string]string{
+				"etc/config":     "image",
+				"etc/removed":    "image",
+				"data/added":     "new",
+				"data/unchanged": "image",
+				"var/log/app":    "new",
+			},
+			missing: []string{"data/removed"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", "apply-diff")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			lower := filepath.Join(tmpDir, "lower")
+			upper := filepath.Join(tmpDir, "upper")
+			target := filepath.Join(tmpDir, "target")
+
+			writeFiles(t, lower, base)
+			writeFiles(t, upper, base)
+			writeFiles(t, upper, map[string]string{
+				"etc/config":  "new",
+				"data/added":  "new",
+				"var/log/app": "new",
+			})
+			os.Remove(filepath.Join(upper, "etc/removed"))
+			os.Remove(filepath.Join(upper, "data/removed"))
+
+			// the new image changes all the files.
+			image := map[string]string{}
+			for name := range base {
+				image[name] = "image"
+			}
+			writeFiles(t, target, image)
+
+			if err := applyDiff(context.Background(), lower, upper, target, tc.paths); err != nil {
+				t.Fatalf("failed to apply diff: %v", err)
+			}
+
+			for name, content := range tc.exist {
+				data, err := ioutil.ReadFile(filepath.Join(target, name))
+				if err != nil || string(data) != content {
+					t.Errorf("expect %s to be %q, got %q, %v", name, content, data, err)
+				}
+			}
+			for _, name := range tc.missing {
+				if _, err := os.Stat(filepath.Join(target, name)); !os.IsNotExist(err) {
+					t.Errorf("expect %s to be removed, got %v", name, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package ctrd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/leases"
+	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/snapshots"
+	"github.com/opencontainers/image-spec/identity"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// remappedSnapshotInfix separates the chain id of image and the id mappings
+// in the key of remapped snapshot.
+const remappedSnapshotInfix = "-userns-"
+
+// overflowID is the id which the ids unmapped in the user namespace are
+// shown as, the files owned by the ids out of the mapping are remapped to it.
+const overflowID = 65534
+
+// CreateRemappedSnapshot creates an active snapshot with image's name and id,
+// whose files are owned by the same users in the user namespace with the uid
+// and gid mappings. The parent of the snapshot is the image remapped by the
+// mappings, which is created once and shared by the snapshots with the same
+// mappings, so that the files of image are not copied up into every snapshot.
+func (c *Client) CreateRemappedSnapshot(ctx context.Context, id, ref string, uidMap, gidMap specs.LinuxIDMapping) error {
+	for _, m := range []specs.LinuxIDMapping{uidMap, gidMap} {
+		if err := validateIDMapping(m); err != nil {
+			return err
+		}
+	}
+
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	image, err := wrapperCli.client.GetImage(ctx, ref)
+	if err != nil {
+		return err
+	}
+
+	diffIDs, err := image.RootFS(ctx)
+	if err != nil {
+		return err
+	}
+	parent := remappedSnapshotKey(identity.ChainID(diffIDs).String(), uidMap, gidMap)
+
+	// the remapped parent is held by the lease of pouchd like the image.
+	ctx = leases.WithLease(ctx, wrapperCli.lease.ID)
+	sn := wrapperCli.client.SnapshotService(CurrentSnapshotterName(ctx))
+	defer sn.Close()
+
+	if err := c.prepareRemapped(ctx, sn, id, parent, ref, uidMap, gidMap); !errdefs.IsNotFound(err) {
+		return err
+	}
+	// the remapped parent may be removed along with its last child between
+	// being checked and used, create it again.
+	return c.prepareRemapped(ctx, sn, id, parent, ref, uidMap, gidMap)
+}
+
+// prepareRemapped prepares the active snapshot id on the remapped parent,
+// the parent is created if it doesn't exist.
+func (c *Client) prepareRemapped(ctx context.Context, sn snapshots.Snapshotter, id, parent, ref string, uidMap, gidMap specs.LinuxIDMapping) error {
+	if _, err := sn.Stat(ctx, parent); err != nil {
+		if !errdefs.IsNotFound(err) {
+			return err
+		}
+		if err := c.createRemappedParent(ctx, sn, parent, ref, uidMap, gidMap); err != nil {
+			return err
+		}
+	}
+
+	_, err := sn.Prepare(ctx, id, parent)
+	return err
+}
+
+// createRemappedParent commits the image remapped by the uid and gid mappings
+// into the snapshot parent. The files are chowned through a temporary active
+// snapshot of image, which is removed after being committed.
+func (c *Client) createRemappedParent(ctx context.Context, sn snapshots.Snapshotter, parent, ref string, uidMap, gidMap specs.LinuxIDMapping) error {
+	// the containers with the same mappings may be created concurrently,
+	// so that the temporary key is unique and the one committed first wins.
+	key := fmt.Sprintf("%s-remap-%s", parent, utils.RandString(5, "", ""))
+	if err := c.CreateSnapshot(ctx, key, ref); err != nil {
+		return err
+	}
+	defer func() {
+		if err := c.RemoveSnapshot(ctx, key); err != nil && !errdefs.IsNotFound(err) {
+			log.With(ctx).Warnf("failed to remove snapshot %s: %v", key, err)
+		}
+	}()
+
+	mounts, err := sn.Mounts(ctx, key)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get mounts of snapshot %s", key)
+	}
+
+	if err := mount.WithTempMount(ctx, mounts, func(root string) error {
+		return filepath.Walk(root, remapFS(uidMap, gidMap))
+	}); err != nil {
+		return errors.Wrapf(err, "failed to remap image %s", ref)
+	}
+
+	if err := sn.Commit(ctx, parent, key); err != nil && !errdefs.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "failed to commit remapped snapshot %s", parent)
+	}
+	return nil
+}
+
+// remappedSnapshotKey returns the key of the snapshot remapped from the
+// snapshot chainID by the uid and gid mappings.
+func remappedSnapshotKey(chainID string, uidMap, gidMap specs.LinuxIDMapping) string {
+	return fmt.Sprintf("%s%s%d:%d:%d-%d:%d:%d", chainID, remappedSnapshotInfix,
+		uidMap.ContainerID, uidMap.HostID, uidMap.Size,
+		gidMap.ContainerID, gidMap.HostID, gidMap.Size)
+}
+
+// IsRemappedSnapshot returns whether the snapshot key is the image remapped
+// by the id mappings, which is shared by the snapshots with the same mappings.
+func IsRemappedSnapshot(key string) bool {
+	return strings.Contains(key, remappedSnapshotInfix)
+}
+
+// validateIDMapping checks the ids of mapping don't exceed the range of uint32.
+func validateIDMapping(m specs.LinuxIDMapping) error {
+	if m.Size == 0 {
+		return fmt.Errorf("invalid id mapping %d:%d:%d: size is zero", m.ContainerID, m.HostID, m.Size)
+	}
+	for _, start := range []uint32{m.ContainerID, m.HostID} {
+		if uint64(start)+uint64(m.Size) > 1<<32 {
+			return fmt.Errorf("invalid id mapping %d:%d:%d: id out of range", m.ContainerID, m.HostID, m.Size)
+		}
+	}
+	return nil
+}
+
+// mapID returns the host id of id in the mapping. The id out of the mapping
+// is mapped to the overflow id, error returns if the overflow id is out of
+// the mapping too.
+func mapID(m specs.LinuxIDMapping, id uint32) (uint32, error) {
+	if id < m.ContainerID || id-m.ContainerID >= m.Size {
+		if id == overflowID {
+			return 0, fmt.Errorf("id %d is out of mapping %d:%d:%d", id, m.ContainerID, m.HostID, m.Size)
+		}
+		return mapID(m, overflowID)
+	}
+	return m.HostID + (id - m.ContainerID), nil
+}
+
+// remapFS returns a walk function which shifts the owner of each file by the
+// uid and gid mappings.
+func remapFS(uidMap, gidMap specs.LinuxIDMapping) filepath.WalkFunc {
+	return func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		stat, ok := info.Sys().(*syscall.Stat_t)
+		if !ok {
+			return fmt.Errorf("failed to get the owner of %s", path)
+		}
+
+		uid, err := mapID(uidMap, stat.Uid)
+		if err != nil {
+			return errors.Wrapf(err, "failed to map the owner of %s", path)
+		}
+		gid, err := mapID(gidMap, stat.Gid)
+		if err != nil {
+			return errors.Wrapf(err, "failed to map the group of %s", path)
+		}
+
+		// use lchown to not dereference the symlink to a host file.
+		if err := os.Lchown(path, int(uid), int(gid)); err != nil {
+			return err
+		}
+
+		// chown clears the setuid and setgid bits, restore them.
+		if info.Mode()&os.ModeSymlink == 0 && info.Mode()&(os.ModeSetuid|os.ModeSetgid) != 0 {
+			return os.Chmod(path, info.Mode())
+		}
+		return nil
+	}
+}
@@ -0,0 +1,133 @@
+package ctrd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapID(t *testing.T) {
+	m := specs.LinuxIDMapping{ContainerID: 0, HostID: 100000, Size: 65536}
+	for _, tc := range []struct {
+		id       uint32
+		expected uint32
+	}{
+		{0, 100000},
+		{1000, 101000},
+		{65535, 165535},
+		// the ids out of mapping are mapped to the overflow id.
+		{65536, 165534},
+		{4294967295, 165534},
+	} {
+		id, err := mapID(m, tc.id)
+		assert.NoError(t, err, tc.id)
+		assert.Equal(t, tc.expected, id, tc.id)
+	}
+
+	// the overflow id is out of the mapping.
+	_, err := mapID(specs.LinuxIDMapping{ContainerID: 0, HostID: 100000, Size: 1000}, 1000)
+	assert.Error(t, err)
+
+	assert.NoError(t, validateIDMapping(m))
+	assert.Error(t, validateIDMapping(specs.LinuxIDMapping{HostID: 100000}))
+	assert.Error(t, validateIDMapping(specs.LinuxIDMapping{HostID: 4294901760, Size: 65537}))
+}
+
+func TestRemapFS(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("chown requires root")
+	}
+
+	root, err := ioutil.TempDir("", "remap-fs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	if err := ioutil.WriteFile(filepath.Join(root, "passwd"), []byte("root"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(root, "su"), []byte("su"), 0755|os.ModeSetuid); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(filepath.Join(root, "su"), 0755|os.ModeSetuid); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chown(filepath.Join(root, "passwd"), 1000, 100); err != nil {
+		t.Fatal(err)
+	}
+	// the owner out of mapping is mapped to the overflow id.
+	if err := ioutil.WriteFile(filepath.Join(root, "nobody"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chown(filepath.Join(root, "nobody"), 70000, 100); err != nil {
+		t.Fatal(err)
+	}
+	// the symlink to host file should not be followed.
+	if err := os.Symlink("/etc/hostname", filepath.Join(root, "hostname")); err != nil {
+		t.Fatal(err)
+	}
+
+	uidMap := specs.LinuxIDMapping{ContainerID: 0, HostID: 100000, Size: 65536}
+	gidMap := specs.LinuxIDMapping{ContainerID: 0, HostID: 200000, Size: 65536}
+	assert.NoError(t, filepath.Walk(root, remapFS(uidMap, gidMap)))
+
+	owner := func(name string) (uint32, uint32) {
+		fi, err := os.Lstat(filepath.Join(root, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		stat := fi.Sys().(*syscall.Stat_t)
+		return stat.Uid, stat.Gid
+	}
+
+	uid, gid := owner("")
+	assert.Equal(t, []uint32{100000, 200000}, []uint32{uid, gid})
+	uid, gid = owner("passwd")
+	assert.Equal(t, []uint32{101000, 200100}, []uint32{uid, gid})
+	uid, gid = owner("nobody")
+	assert.Equal(t, []uint32{165534, 200100}, []uint32{uid, gid})
+	uid, gid = owner("hostname")
+	assert.Equal(t, []uint32{100000, 200000}, []uint32{uid, gid})
+
+	fi, err := os.Stat(filepath.Join(root, "su"))
+	assert.NoError(t, err)
+	assert.NotZero(t, fi.Mode()&os.ModeSetuid)
+
+	fi, err = os.Stat("/etc/hostname")
+	if err == nil {
+		assert.Equal(t, uint32(0), fi.Sys().(*syscall.Stat_t).Uid)
+	}
+}
+
+func TestRemapFSOutOfRange(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("chown requires root")
+	}
+
+	root, err := ioutil.TempDir("", "remap-fs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	if err := ioutil.WriteFile(filepath.Join(root, "file"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chown(filepath.Join(root, "file"), 2000, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// neither the owner nor the overflow id is in the mapping.
+	m := specs.LinuxIDMapping{ContainerID: 0, HostID: 100000, Size: 1000}
+	assert.Error(t, filepath.Walk(root, remapFS(m, m)))
+
+	fi, err := os.Lstat(filepath.Join(root, "file"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2000), fi.Sys().(*syscall.Stat_t).Uid)
+}
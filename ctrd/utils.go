@@ -24,10 +24,11 @@ import (
 	"github.com/pkg/errors"
 )
 
-func withExitShimV1CheckpointTaskOpts() containerd.CheckpointTaskOpts {
+func withShimV1CheckpointTaskOpts(exit, terminal bool) containerd.CheckpointTaskOpts {
 	return func(r *containerd.CheckpointTaskInfo) error {
 		r.Options = &runctypes.CheckpointOptions{
-			Exit: true,
+			Exit:     exit,
+			Terminal: terminal,
 		}
 		return nil
 	}
@@ -15,8 +15,11 @@ import (
 	"github.com/alibaba/pouch/client"
 	criconfig "github.com/alibaba/pouch/cri/config"
 	"github.com/alibaba/pouch/network"
+	"github.com/alibaba/pouch/pkg/bytefmt"
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/userns"
 	"github.com/alibaba/pouch/pkg/utils"
+	"github.com/alibaba/pouch/storage/quota"
 	"github.com/alibaba/pouch/storage/volume"
 
 	"github.com/spf13/pflag"
@@ -31,11 +34,17 @@ const (
 	DefaultCgroupDriver = CgroupfsDriver
 	// ValidNameChars collects the characters allowed to represent a name, normally used to validate container and volume names.
 	ValidNameChars = `[a-zA-Z0-9][a-zA-Z0-9_.-]`
+	// DefaultUsernsSubIDSize is the default number of subordinate ids allocated to each user namespace.
+	DefaultUsernsSubIDSize = 65536
 )
 
 // ValidNamePattern is a regular expression to validate names against the collection of restricted characters.
 var ValidNamePattern = regexp.MustCompile(`^/?` + ValidNameChars + `+$`)
 
+// RuntimeHandlerNamePattern is a regular expression to validate the names of runtime handlers,
+// which are used as the handler of RuntimeClass in kubernetes.
+var RuntimeHandlerNamePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
 // Config refers to daemon's whole configurations.
 type Config struct {
 	sync.Mutex `json:"-"`
@@ -89,12 +98,28 @@ type Config struct {
 	// LxcfsHome is the absolute path of lxcfs
 	LxcfsHome string `json:"lxcfs-home,omitempty"`
 
+	// InitPath is the path of init binary used by container with init
+	// enabled, the binary named pouch-init in PATH is used if not specified.
+	InitPath string `json:"init-path,omitempty"`
+
 	// ImagxeProxy is a http proxy to pull image
 	ImageProxy string `json:"image-proxy,omitempty"`
 
 	// QuotaDriver is used to set the driver of Quota
 	QuotaDriver string `json:"quota-driver,omitempty"`
 
+	// DiskProfiles is the named disk profiles which can be selected by containers,
+	// key is the profile name.
+	DiskProfiles map[string]quota.DiskProfile `json:"disk-profiles,omitempty"`
+
+	// UsernsSubIDRange is the range of subordinate ids on the host in the form of start:size,
+	// which are allocated to the user namespaces of containers. Containers can't be remapped
+	// to a user namespace if it is empty.
+	UsernsSubIDRange string `json:"userns-subid-range,omitempty"`
+
+	// UsernsSubIDSize is the number of subordinate ids allocated to each user namespace.
+	UsernsSubIDSize uint32 `json:"userns-subid-size,omitempty"`
+
 	// Configuration file of pouchd
 	ConfigFile string `json:"config-file,omitempty"`
 
@@ -131,6 +156,9 @@ type Config struct {
 	// AllowMultiSnapshotter allows multi snapshotter, default false
 	AllowMultiSnapshotter bool `json:"allow-multi-snapshotter,omitempty"`
 
+	// RemoveOrphanSnapshots removes the orphaned snapshots when daemon starts, default false
+	RemoveOrphanSnapshots bool `json:"remove-orphan-snapshots,omitempty"`
+
 	// CgroupDriver sets cgroup driver for all containers
 	CgroupDriver string `json:"cgroup-driver,omitempty"`
 
@@ -181,11 +209,30 @@ func (cfg *Config) Validate() error {
 	if len(cfg.Runtimes) == 0 {
 		cfg.Runtimes = make(map[string]types.Runtime)
 	}
+	// runtime handlers of cri are registered as runtimes
+	if err := validateRuntimeHandlers(cfg.CriConfig.RuntimeHandlers, cfg.Runtimes); err != nil {
+		return err
+	}
 	if _, exist := cfg.Runtimes[cfg.DefaultRuntime]; !exist {
 		// add default runtime
 		cfg.Runtimes[cfg.DefaultRuntime] = types.Runtime{Path: cfg.DefaultRuntime}
 	}
 
+	// validates init binary config
+	if err := validateInitPath(cfg.InitPath); err != nil {
+		return err
+	}
+
+	// validates user namespace config
+	if err := cfg.validateUserns(); err != nil {
+		return err
+	}
+
+	// validates disk profiles config
+	if err := validateDiskProfiles(cfg.DiskProfiles); err != nil {
+		return err
+	}
+
 	// if cgroup driver is empty, use default cgroup driver
 	if cfg.CgroupDriver == "" {
 		cfg.CgroupDriver = DefaultCgroupDriver
@@ -314,3 +361,86 @@ func validateCgroupDriver(driver string) error {
 
 	return fmt.Errorf("invalid cgroup driver: %s, valid driver is cgroupfs or systemd", driver)
 }
+
+// validateRuntimeHandlers validates the runtime handlers of cri and registers them into runtimes.
+func validateRuntimeHandlers(handlers map[string]criconfig.RuntimeHandler, runtimes map[string]types.Runtime) error {
+	for name, handler := range handlers {
+		if !RuntimeHandlerNamePattern.MatchString(name) {
+			return fmt.Errorf("invalid runtime handler name (%s), it must be a lowercase RFC 1123 label of at most 63 characters", name)
+		}
+		if _, exist := runtimes[name]; exist {
+			return fmt.Errorf("runtime handler (%s) conflicts with the runtime added by add-runtime", name)
+		}
+		for key := range handler.Annotations {
+			if key == "" {
+				return fmt.Errorf("annotation key of runtime handler (%s) cannot be empty", name)
+			}
+		}
+
+		if handler.Path == "" {
+			handler.Path = name
+			handlers[name] = handler
+		}
+		runtimes[name] = types.Runtime{
+			Path:    handler.Path,
+			Type:    handler.Type,
+			Options: handler.Options,
+		}
+	}
+
+	return nil
+}
+
+// validateInitPath validates the init binary used by container with init,
+// the container fails to start later if the binary is unavailable.
+func validateInitPath(initPath string) error {
+	if initPath == "" {
+		return nil
+	}
+
+	fi, err := os.Stat(initPath)
+	if err != nil {
+		return fmt.Errorf("invalid init-path %s: %v", initPath, err)
+	}
+	if !fi.Mode().IsRegular() {
+		return fmt.Errorf("invalid init-path %s: not a regular file", initPath)
+	}
+	return nil
+}
+
+// validateUserns validates the subordinate ids of user namespace.
+func (cfg *Config) validateUserns() error {
+	if cfg.UsernsSubIDRange == "" {
+		return nil
+	}
+	if cfg.UsernsSubIDSize == 0 {
+		cfg.UsernsSubIDSize = DefaultUsernsSubIDSize
+	}
+
+	r, err := userns.ParseIDRange(cfg.UsernsSubIDRange)
+	if err != nil {
+		return err
+	}
+	if cfg.UsernsSubIDSize > r.Size {
+		return fmt.Errorf("userns-subid-size %d is larger than the size of userns-subid-range %s", cfg.UsernsSubIDSize, cfg.UsernsSubIDRange)
+	}
+	return nil
+}
+
+// validateDiskProfiles validates disk profiles
+func validateDiskProfiles(profiles map[string]quota.DiskProfile) error {
+	for name, profile := range profiles {
+		if !ValidNamePattern.MatchString(name) {
+			return fmt.Errorf("invalid disk profile name (%s), only %s are allowed", name, ValidNameChars)
+		}
+
+		if profile.Size == "" {
+			continue
+		}
+		if _, err := bytefmt.ToBytes(profile.Size); err != nil {
+			return fmt.Errorf("invalid size (%s) of disk profile (%s): %v", profile.Size, name, err)
+		}
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/alibaba/pouch/client"
 	criconfig "github.com/alibaba/pouch/cri/config"
 	"github.com/alibaba/pouch/network"
+	"github.com/alibaba/pouch/storage/quota"
 	"github.com/alibaba/pouch/storage/volume"
 
 	"github.com/containerd/containerd/namespaces"
@@ -141,6 +142,36 @@ func TestConfigValidate(t *testing.T) {
 	}
 	assert.Equal(nil, cfg.Validate())
 
+	// Test disk profiles configuration
+	cfg = &Config{
+		DiskProfiles: map[string]quota.DiskProfile{
+			"gold": {
+				Size:     "10g",
+				ReadBps:  100 * 1024 * 1024,
+				WriteBps: 50 * 1024 * 1024,
+			},
+			"silver": {
+				ReadIOps:  1000,
+				WriteIOps: 500,
+			},
+		},
+	}
+	assert.Equal(nil, cfg.Validate())
+
+	cfg = &Config{
+		DiskProfiles: map[string]quota.DiskProfile{
+			"gold": {Size: "10x"},
+		},
+	}
+	assert.Error(cfg.Validate())
+
+	cfg = &Config{
+		DiskProfiles: map[string]quota.DiskProfile{
+			"/gold/": {Size: "10g"},
+		},
+	}
+	assert.Error(cfg.Validate())
+
 	// Test label configuration
 	cfg = &Config{
 		Labels: []string{},
@@ -300,3 +331,81 @@ func TestValidateCgroupDriver(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateRuntimeHandlers(t *testing.T) {
+	cfg := &Config{
+		DefaultRuntime: "runc",
+		CriConfig: criconfig.Config{
+			RuntimeHandlers: map[string]criconfig.RuntimeHandler{
+				"kata": {
+					Path:        "/usr/bin/kata-runtime",
+					Type:        "io.containerd.kata.v2",
+					Annotations: map[string]string{"io.katacontainers.config.hypervisor.enable_iothreads": "true"},
+				},
+				"runsc": {},
+			},
+		},
+	}
+	assert.NoError(t, cfg.Validate())
+	assert.Equal(t, types.Runtime{Path: "/usr/bin/kata-runtime", Type: "io.containerd.kata.v2"}, cfg.Runtimes["kata"])
+	assert.Equal(t, "runsc", cfg.Runtimes["runsc"].Path)
+	assert.Equal(t, "runsc", cfg.CriConfig.RuntimeHandlers["runsc"].Path)
+	assert.Equal(t, "runc", cfg.Runtimes["runc"].Path)
+
+	for name, cfg := range map[string]*Config{
+		"invalid name": {
+			CriConfig: criconfig.Config{
+				RuntimeHandlers: map[string]criconfig.RuntimeHandler{"Kata_Runtime": {}},
+			},
+		},
+		"too long name": {
+			CriConfig: criconfig.Config{
+				RuntimeHandlers: map[string]criconfig.RuntimeHandler{strings.Repeat("a", 64): {}},
+			},
+		},
+		"conflict with add-runtime": {
+			Runtimes: map[string]types.Runtime{"kata": {Path: "kata-runtime"}},
+			CriConfig: criconfig.Config{
+				RuntimeHandlers: map[string]criconfig.RuntimeHandler{"kata": {}},
+			},
+		},
+		"empty annotation key": {
+			CriConfig: criconfig.Config{
+				RuntimeHandlers: map[string]criconfig.RuntimeHandler{"kata": {Annotations: map[string]string{"": "v"}}},
+			},
+		},
+	} {
+		assert.Error(t, cfg.Validate(), name)
+	}
+}
+
+func TestValidateUserns(t *testing.T) {
+	cfg := &Config{UsernsSubIDRange: "100000:655360"}
+	assert.NoError(t, cfg.Validate())
+	assert.Equal(t, uint32(DefaultUsernsSubIDSize), cfg.UsernsSubIDSize)
+
+	for _, cfg := range []*Config{
+		{UsernsSubIDRange: "100000"},
+		{UsernsSubIDRange: "0:655360"},
+		{UsernsSubIDRange: "100000:65536", UsernsSubIDSize: 65537},
+	} {
+		assert.Error(t, cfg.Validate(), cfg.UsernsSubIDRange)
+	}
+}
+
+func TestValidateInitPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "init-path")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	initPath := dir + "/pouch-init"
+	assert.NoError(t, ioutil.WriteFile(initPath, []byte("#!/bin/sh\n"), 0755))
+
+	assert.NoError(t, validateInitPath(""))
+	assert.NoError(t, validateInitPath(initPath))
+	assert.Error(t, validateInitPath(dir))
+	assert.Error(t, validateInitPath(dir+"/not-exist"))
+	assert.Error(t, (&Config{InitPath: dir + "/not-exist"}).Validate())
+}
@@ -6,8 +6,11 @@ import (
 	"path"
 	"path/filepath"
 	"reflect"
+	"strconv"
+	"time"
 
 	"github.com/alibaba/pouch/apis/server"
+	"github.com/alibaba/pouch/apis/types"
 	criservice "github.com/alibaba/pouch/cri"
 	"github.com/alibaba/pouch/cri/stream"
 	"github.com/alibaba/pouch/ctrd"
@@ -17,6 +20,7 @@ import (
 	"github.com/alibaba/pouch/daemon/mgr"
 	"github.com/alibaba/pouch/hookplugins"
 	"github.com/alibaba/pouch/internal"
+	"github.com/alibaba/pouch/lxcfs"
 	"github.com/alibaba/pouch/network/mode"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/meta"
@@ -50,6 +54,9 @@ type Daemon struct {
 	criPlugin       hookplugins.CriPlugin
 	apiPlugin       hookplugins.APIPlugin
 	eventsService   *events.Events
+
+	// lxcfsStopCh stops the lxcfs watchdog.
+	lxcfsStopCh chan struct{}
 }
 
 // NewDaemon constructs a brand new server.
@@ -227,6 +234,18 @@ func (d *Daemon) Run() error {
 		return err
 	}
 
+	// reconcile the snapshots left by crash in background, the snapshots
+	// created after daemon starts are skipped.
+	go d.reconcileSnapshots(time.Now())
+
+	// remount lxcfs proc files of containers automatically after lxcfs
+	// restarts, the proc files bound to the dead lxcfs are broken.
+	if lxcfs.IsLxcfsEnabled {
+		d.lxcfsStopCh = make(chan struct{})
+		go lxcfs.NewWatchdog(lxcfs.LxcfsHomeDir, lxcfs.DefaultWatchdogInterval,
+			d.onLxcfsStale, d.onLxcfsRestart).Run(d.lxcfsStopCh)
+	}
+
 	if err := d.addSystemLabels(); err != nil {
 		return err
 	}
@@ -244,7 +263,7 @@ func (d *Daemon) Run() error {
 	criReadyCh := make(chan bool)
 	criStopCh := make(chan error)
 
-	go criservice.RunCriService(d.config, d.containerMgr, d.imageMgr, d.volumeMgr, d.criPlugin, criStreamRouterCh, criStopCh, criReadyCh)
+	go criservice.RunCriService(d.config, d.containerMgr, d.imageMgr, d.volumeMgr, d.networkMgr, d.systemMgr, d.criPlugin, criStreamRouterCh, criStopCh, criReadyCh)
 
 	streamRouter := <-criStreamRouterCh
 
@@ -311,6 +330,10 @@ func (d *Daemon) Run() error {
 func (d *Daemon) Shutdown() error {
 	var errMsg string
 
+	if d.lxcfsStopCh != nil {
+		close(d.lxcfsStopCh)
+	}
+
 	if err := d.server.Stop(); err != nil {
 		errMsg = fmt.Sprintf("%s\n", err.Error())
 	}
@@ -416,6 +439,68 @@ func (d *Daemon) addSystemLabels() error {
 	return nil
 }
 
+// reconcileSnapshots logs the orphaned snapshots created before the given
+// time, and removes them if remove-orphan-snapshots is set.
+func (d *Daemon) reconcileSnapshots(before time.Time) {
+	result, err := d.containerMgr.ReconcileSnapshots(context.Background(), d.config.RemoveOrphanSnapshots, before)
+	if err != nil {
+		log.With(nil).Errorf("failed to reconcile snapshots: %v", err)
+		return
+	}
+
+	for _, sn := range result.OrphanSnapshots {
+		log.With(nil).Warnf("found orphaned snapshot %s in snapshotter %s, size %d, removed %v",
+			sn.Key, sn.Snapshotter, sn.Size, sn.Removed)
+	}
+	if len(result.OrphanSnapshots) > 0 {
+		log.With(nil).Infof("reconcile snapshots: %d orphaned, %d bytes reclaimed",
+			len(result.OrphanSnapshots), result.SpaceReclaimed)
+	}
+}
+
+// onLxcfsStale publishes the daemon event when lxcfs becomes unavailable.
+func (d *Daemon) onLxcfsStale(err error) {
+	d.logLxcfsEvent("lxcfs_stale", map[string]string{
+		"error": err.Error(),
+	})
+}
+
+// onLxcfsRestart remounts the lxcfs proc files of containers after lxcfs
+// restarts, and publishes the daemon event. The ids are the containers to
+// remount, nil means all of them. The containers which fail are returned,
+// so that only they are retried by watchdog.
+func (d *Daemon) onLxcfsRestart(ids []string) ([]string, error) {
+	results, err := d.containerMgr.RemountLxcfs(context.Background(), ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	var failed []string
+	for _, r := range results {
+		if r.Status != mgr.RemountLxcfsStatusOK {
+			failed = append(failed, r.ID)
+			log.With(nil).Errorf("failed to remount lxcfs of container %s: %s", r.ID, r.Error)
+		}
+	}
+
+	d.logLxcfsEvent("lxcfs_remount", map[string]string{
+		"containers": strconv.Itoa(len(results)),
+		"failed":     strconv.Itoa(len(failed)),
+	})
+	return failed, nil
+}
+
+// logLxcfsEvent generates a daemon event related to lxcfs.
+func (d *Daemon) logLxcfsEvent(action string, attributes map[string]string) {
+	attributes["lxcfs-home"] = lxcfs.LxcfsHomeDir
+	actor := &types.EventsActor{
+		ID:         "lxcfs",
+		Attributes: attributes,
+	}
+
+	_ = d.eventsService.Publish(context.Background(), action, types.EventTypeDaemon, actor)
+}
+
 func notifySystemd() {
 	if !systemdutil.IsRunningSystemd() {
 		return
@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -30,7 +31,9 @@ import (
 	"github.com/alibaba/pouch/pkg/meta"
 	mountutils "github.com/alibaba/pouch/pkg/mount"
 	"github.com/alibaba/pouch/pkg/streams"
+	"github.com/alibaba/pouch/pkg/userns"
 	"github.com/alibaba/pouch/pkg/utils"
+	"github.com/alibaba/pouch/storage/quota"
 	volumetypes "github.com/alibaba/pouch/storage/volume/types"
 
 	"github.com/containerd/cgroups"
@@ -109,6 +112,13 @@ type ContainerMgr interface {
 	// Upgrade upgrades a container with new image and args.
 	Upgrade(ctx context.Context, name string, config *types.ContainerUpgradeConfig) error
 
+	// Rollback restores a container to the revision before the last upgrade.
+	Rollback(ctx context.Context, name string) error
+
+	// RemountLxcfs re-binds the lxcfs proc files inside the running containers which enable lxcfs,
+	// only the containers in ids are remounted if ids are given.
+	RemountLxcfs(ctx context.Context, ids ...string) ([]*types.RemountLxcfsResult, error)
+
 	// Top lists the processes running inside of the given container
 	Top(ctx context.Context, name string, psArgs string) (*types.ContainerProcessList, error)
 
@@ -158,6 +168,10 @@ type ContainerMgr interface {
 	// NewSnapshotsSyncer creates a snapshot syncer.
 	NewSnapshotsSyncer(snapshotStore *SnapshotStore, duration time.Duration) *SnapshotsSyncer
 
+	// ReconcileSnapshots finds the snapshots which belong to no container and no image,
+	// and removes them if remove is true.
+	ReconcileSnapshots(ctx context.Context, remove bool, before time.Time) (*types.SystemReconcileResult, error)
+
 	// CreateCheckpoint creates a checkpoint from a running container
 	CreateCheckpoint(ctx context.Context, name string, options *types.CheckpointCreateOptions) error
 
@@ -167,6 +181,16 @@ type ContainerMgr interface {
 	// DeleteCheckpoint deletes a checkpoint from a container
 	DeleteCheckpoint(ctx context.Context, name string, options *types.CheckpointDeleteOptions) error
 
+	// ExportCheckpoint exports a checkpoint of container as a bundle
+	ExportCheckpoint(ctx context.Context, name string, options *types.CheckpointExportOptions, w io.Writer) error
+
+	// CheckpointAndExport checkpoints a container and exports the checkpoint with its writable layer,
+	// the container keeps running.
+	CheckpointAndExport(ctx context.Context, name, checkpointID string, w io.Writer) error
+
+	// ImportCheckpoint imports a checkpoint bundle to a container
+	ImportCheckpoint(ctx context.Context, name string, options *types.CheckpointImportOptions, r io.Reader) (*types.Checkpoint, error)
+
 	// Commit commits an image from a container.
 	Commit(ctx context.Context, name string, options *types.ContainerCommitOptions) (*types.ContainerCommitResp, error)
 
@@ -213,6 +237,9 @@ type ContainerManager struct {
 
 	// eventsService is used to publish events generated by pouchd
 	eventsService *events.Events
+
+	// usernsAllocator allocates the subordinate ids to the user namespaces of containers.
+	usernsAllocator *userns.Allocator
 }
 
 // NewContainerManager creates a brand new container manager.
@@ -232,6 +259,20 @@ func NewContainerManager(ctx context.Context, store *meta.Store, cli ctrd.APICli
 		eventsService:   eventsService,
 	}
 
+	if cfg.UsernsSubIDRange != "" {
+		pool, err := userns.ParseIDRange(cfg.UsernsSubIDRange)
+		if err != nil {
+			return nil, err
+		}
+		mgr.usernsAllocator, err = userns.NewAllocator(path.Join(cfg.HomeDir, "userns-meta"), pool, cfg.UsernsSubIDSize)
+		if err != nil {
+			return nil, err
+		}
+		if err := prepareUsernsHome(cfg.HomeDir); err != nil {
+			return nil, errors.Wrap(err, "failed to prepare home dir for user namespace")
+		}
+	}
+
 	mgr.Client.SetExitHooks(mgr.exitedAndRelease)
 	mgr.Client.SetExecExitHooks(mgr.execExitedAndRelease)
 	mgr.Client.SetEventsHooks(mgr.publishContainerdEvent, mgr.updateContainerState)
@@ -291,6 +332,14 @@ func (mgr *ContainerManager) Restore(ctx context.Context) error {
 			continue
 		}
 
+		// mark the quota id of container in use, since scanning quota ids
+		// may miss it on the kernel without Q_GETNEXTQUOTA.
+		if quota.IsSetQuotaID(c.Config.QuotaID) {
+			if qid, err := strconv.ParseUint(c.Config.QuotaID, 10, 32); err == nil {
+				quota.MarkQuotaID(uint32(qid))
+			}
+		}
+
 		// NOTE: when pouch is restarting, we need to initialize
 		// container IO for the existing containers just in case that
 		// user tries to restart the stopped containers.
@@ -392,6 +441,15 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "NetworkingConfig cannot be empty")
 	}
 
+	// merge disk quota of disk profile
+	var diskProfile *quota.DiskProfile
+	if config.DiskProfile != "" {
+		if diskProfile, err = mgr.getDiskProfile(config.DiskProfile); err != nil {
+			return nil, err
+		}
+		config.DiskQuota, config.InodeQuota = mergeDiskProfileQuota(config.DiskQuota, config.InodeQuota, diskProfile)
+	}
+
 	// validate disk quota
 	if err := mgr.validateDiskQuota(config); err != nil {
 		return nil, errors.Wrapf(err, "invalid disk quota config")
@@ -435,14 +493,23 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "unknown runtime %s: %v", config.HostConfig.Runtime, err)
 	}
 
+	// allocate the id mappings of user namespace.
+	uidMappings, gidMappings, err := mgr.allocateIDMappings(ctx, id, config.HostConfig)
+	if err != nil {
+		return nil, err
+	}
+	cleanups = append(cleanups, func() error {
+		return mgr.releaseIDMappings(id)
+	})
+
 	snapID := id
-	// create a snapshot with image.
-	if err := mgr.Client.CreateSnapshot(ctx, snapID, config.Image); err != nil {
+	// create a snapshot with image, which is owned by the root of user namespace.
+	if err := mgr.createSnapshot(ctx, snapID, config.Image, uidMappings, gidMappings); err != nil {
 		return nil, err
 	}
 	cleanups = append(cleanups, func() error {
 		log.With(ctx).Infof("start to cleanup snapshot, id is %v", id)
-		return mgr.Client.RemoveSnapshot(ctx, id)
+		return mgr.removeSnapshot(ctx, id)
 	})
 
 	// set lxcfs binds
@@ -471,13 +538,15 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 			StartedAt:  time.Time{}.UTC().Format(utils.TimeLayout),
 			FinishedAt: time.Time{}.UTC().Format(utils.TimeLayout),
 		},
-		ID:         id,
-		Image:      imgID.String(),
-		Name:       name,
-		Config:     &config.ContainerConfig,
-		Created:    time.Now().UTC().Format(utils.TimeLayout),
-		HostConfig: config.HostConfig,
-		SnapshotID: snapID,
+		ID:          id,
+		Image:       imgID.String(),
+		Name:        name,
+		Config:      &config.ContainerConfig,
+		Created:     time.Now().UTC().Format(utils.TimeLayout),
+		HostConfig:  config.HostConfig,
+		SnapshotID:  snapID,
+		UIDMappings: uidMappings,
+		GIDMappings: gidMappings,
 	}
 
 	if _, err := mgr.initContainerIO(container); err != nil {
@@ -531,6 +600,13 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 	}
 	container.SetSnapshotterMeta(mounts)
 
+	// set blkio throttles of disk profile on the device of rootfs
+	if diskProfile != nil {
+		if err := mgr.setDiskProfileThrottles(container, &container.HostConfig.Resources, diskProfile); err != nil {
+			return nil, err
+		}
+	}
+
 	// amendContainerSettings modify container config settings to wanted
 	amendContainerSettings(&config.ContainerConfig, config.HostConfig)
 
@@ -764,14 +840,24 @@ func (mgr *ContainerManager) prepareContainerNetwork(ctx context.Context, c *Con
 
 // buildNetworkRelatedPath builds the network related path.
 func (mgr *ContainerManager) buildNetworkRelatedPath(c *Container) error {
+	dir := mgr.Store.Path(c.ID)
+	// the files of container in user namespace are kept in the dir owned by
+	// the root of user namespace, which can access them.
+	if len(c.UIDMappings) > 0 {
+		var err error
+		if dir, err = mgr.prepareUsernsNetworkDir(c); err != nil {
+			return err
+		}
+	}
+
 	// set the hosts file path.
-	c.HostsPath = path.Join(mgr.Store.Path(c.ID), "hosts")
+	c.HostsPath = path.Join(dir, "hosts")
 
 	// set the resolv.conf file path.
-	c.ResolvConfPath = path.Join(mgr.Store.Path(c.ID), "resolv.conf")
+	c.ResolvConfPath = path.Join(dir, "resolv.conf")
 
 	// set the hostname file path.
-	c.HostnamePath = path.Join(mgr.Store.Path(c.ID), "hostname")
+	c.HostnamePath = path.Join(dir, "hostname")
 
 	// write the hostname file, other files are filled by libnetwork.
 	return ioutil.WriteFile(c.HostnamePath, []byte(c.Config.Hostname+"\n"), 0644)
@@ -815,6 +901,12 @@ func (mgr *ContainerManager) createContainerdContainer(ctx context.Context, c *C
 		useSystemd: mgr.Config.UseSystemd(),
 	}
 
+	if c.HostConfig.Init {
+		if sw.initPath, err = lookupInitPath(mgr.Config.InitPath); err != nil {
+			return err
+		}
+	}
+
 	if err = createSpec(ctx, c, sw); err != nil {
 		return err
 	}
@@ -964,7 +1056,7 @@ func (mgr *ContainerManager) stop(ctx context.Context, c *Container, timeout int
 	}
 
 	id := c.ID
-	msg, err := mgr.Client.DestroyContainer(ctx, id, timeout)
+	msg, err := mgr.Client.DestroyContainer(ctx, id, c.StopSignal(), isRichModeSystemd(c), timeout)
 	if err != nil {
 		return errors.Wrapf(err, "failed to destroy container %s", id)
 	}
@@ -1263,11 +1355,44 @@ func (mgr *ContainerManager) Update(ctx context.Context, name string, config *ty
 		return fmt.Errorf("cannot update a dead container %s", c.ID)
 	}
 
+	// update container disk profile, the blkio throttles of profile are merged
+	// into the current ones, then updated with other resources. The quota and
+	// throttles of the current profile are reset if the new one doesn't set them.
+	if config.DiskProfile != "" {
+		profile, err := mgr.getDiskProfile(config.DiskProfile)
+		if err != nil {
+			return err
+		}
+		config.DiskQuota, config.InodeQuota = mgr.updateDiskProfileQuota(c, config.DiskQuota, config.InodeQuota, profile)
+
+		r := &config.Resources
+		if len(r.BlkioDeviceReadBps) == 0 {
+			r.BlkioDeviceReadBps = c.HostConfig.BlkioDeviceReadBps
+		}
+		if len(r.BlkioDeviceWriteBps) == 0 {
+			r.BlkioDeviceWriteBps = c.HostConfig.BlkioDeviceWriteBps
+		}
+		if len(r.BlkioDeviceReadIOps) == 0 {
+			r.BlkioDeviceReadIOps = c.HostConfig.BlkioDeviceReadIOps
+		}
+		if len(r.BlkioDeviceWriteIOps) == 0 {
+			r.BlkioDeviceWriteIOps = c.HostConfig.BlkioDeviceWriteIOps
+		}
+		if err := mgr.updateDiskProfileThrottles(c, r, profile); err != nil {
+			return errors.Wrapf(err, "failed to update disk profile of container %s", c.ID)
+		}
+	}
+
 	// update container disk quota
-	if err := mgr.updateContainerDiskQuota(ctx, c, config.DiskQuota); err != nil {
+	if err := mgr.updateContainerDiskQuota(ctx, c, config.DiskQuota, config.InodeQuota); err != nil {
 		return errors.Wrapf(err, "failed to update diskquota of container %s", c.ID)
 	}
 
+	// the disk profile is recorded only after its quota takes effect.
+	if config.DiskProfile != "" {
+		c.Config.DiskProfile = config.DiskProfile
+	}
+
 	// init Container Labels
 	if c.Config.Labels == nil {
 		c.Config.Labels = map[string]string{}
@@ -1371,9 +1496,17 @@ func (mgr *ContainerManager) Remove(ctx context.Context, name string, options *t
 		return nil
 	}
 
+	// the subordinate ids are released on removal, so the user namespace
+	// can't be removed while other containers join it.
+	if len(c.UIDMappings) > 0 {
+		if joiners := mgr.usernsJoiners(c); len(joiners) > 0 {
+			return errors.Wrapf(errtypes.ErrInUse, "user namespace of container %s is joined by containers %s, remove them first", c.ID, strings.Join(joiners, ", "))
+		}
+	}
+
 	// if the container is running, force to stop it.
 	if c.IsRunningOrPaused() && options.Force {
-		_, err := mgr.Client.DestroyContainer(ctx, c.ID, c.StopTimeout())
+		_, err := mgr.Client.DestroyContainer(ctx, c.ID, c.StopSignal(), isRichModeSystemd(c), c.StopTimeout())
 		if err != nil && !errtypes.IsNotfound(err) {
 			return errors.Wrapf(err, "failed to destroy container %s when removing", c.ID)
 		}
@@ -1388,6 +1521,11 @@ func (mgr *ContainerManager) Remove(ctx context.Context, name string, options *t
 		log.With(ctx).Errorf("failed to detach volume: %v", err)
 	}
 
+	// the volumes used only by the revision kept by upgrade should be detached too.
+	if c.Revision != nil {
+		mgr.detachVolumesExcept(ctx, c.ID, c.Revision.Mounts, c.Mounts)
+	}
+
 	// if creating the container by specify rootfs,
 	// we should umount the rootfs when delete the container.
 	if c.RootFSProvided {
@@ -1402,10 +1540,19 @@ func (mgr *ContainerManager) Remove(ctx context.Context, name string, options *t
 		if err := c.CleanRootfsSnapshotDirs(); err != nil {
 			log.With(ctx).Errorf("failed to clean rootfs: %v", err)
 		}
-	} else if err := mgr.Client.RemoveSnapshot(ctx, c.SnapshotKey()); err != nil {
+	} else {
 		// if the container is created by normal method, remove the
 		// snapshot when delete it.
-		log.With(ctx).Errorf("failed to remove snapshot of container %s: %v", c.ID, err)
+		if err := mgr.removeSnapshot(ctx, c.SnapshotKey()); err != nil {
+			log.With(ctx).Errorf("failed to remove snapshot of container %s: %v", c.ID, err)
+		}
+
+		// the snapshot of revision kept by upgrade should be removed too.
+		if c.Revision != nil {
+			if err := mgr.removeSnapshot(ctx, c.Revision.SnapshotID); err != nil {
+				log.With(ctx).Errorf("failed to remove snapshot of container %s revision: %v", c.ID, err)
+			}
+		}
 	}
 
 	// When removing a container, we have set up such rule for object removing sequences:
@@ -1429,6 +1576,16 @@ func (mgr *ContainerManager) Remove(ctx context.Context, name string, options *t
 		}
 	}
 
+	// release the subordinate ids of user namespace.
+	if err := mgr.releaseIDMappings(c.ID); err != nil {
+		log.With(ctx).Errorf("failed to release user namespace of container %s: %v", c.ID, err)
+	}
+	if len(c.UIDMappings) > 0 {
+		if err := os.RemoveAll(mgr.usernsNetworkDir(c.ID)); err != nil {
+			log.With(ctx).Errorf("failed to remove network files of container %s: %v", c.ID, err)
+		}
+	}
+
 	// remove meta.json for container in local disk
 	if err := mgr.Store.Remove(c.Key()); err != nil {
 		log.With(ctx).Errorf("failed to remove container %s from meta store: %v", c.ID, err)
@@ -1438,25 +1595,29 @@ func (mgr *ContainerManager) Remove(ctx context.Context, name string, options *t
 	return nil
 }
 
-func (mgr *ContainerManager) updateContainerDiskQuota(ctx context.Context, c *Container, diskQuota map[string]string) (err error) {
-	if diskQuota == nil {
+func (mgr *ContainerManager) updateContainerDiskQuota(ctx context.Context, c *Container, diskQuota, inodeQuota map[string]string) (err error) {
+	if diskQuota == nil && inodeQuota == nil {
 		return nil
 	}
 
-	// backup diskquota
+	for _, limit := range inodeQuota {
+		if _, _, err := opts.ParseInodeLimit(limit); err != nil {
+			return errors.Wrap(errInvalidDiskQuota, err.Error())
+		}
+	}
+
+	// backup diskquota and inode quota
 	origDiskQuota := c.Config.DiskQuota
+	origInodeQuota := c.Config.InodeQuota
 	defer func() {
 		if err != nil {
 			c.Config.DiskQuota = origDiskQuota
+			c.Config.InodeQuota = origInodeQuota
 		}
 	}()
 
-	if c.Config.DiskQuota == nil {
-		c.Config.DiskQuota = make(map[string]string)
-	}
-	for dir, quota := range diskQuota {
-		c.Config.DiskQuota[dir] = quota
-	}
+	c.Config.DiskQuota = mergeQuotaMap(c.Config.DiskQuota, diskQuota)
+	c.Config.InodeQuota = mergeQuotaMap(c.Config.InodeQuota, inodeQuota)
 
 	// set mount point disk quota
 	// prepare quota map
@@ -1472,6 +1633,22 @@ func (mgr *ContainerManager) updateContainerDiskQuota(ctx context.Context, c *Co
 	return nil
 }
 
+// mergeQuotaMap returns a new quota map which updates the origin one.
+func mergeQuotaMap(origin, update map[string]string) map[string]string {
+	if update == nil {
+		return origin
+	}
+
+	merged := make(map[string]string, len(origin)+len(update))
+	for dir, quota := range origin {
+		merged[dir] = quota
+	}
+	for dir, quota := range update {
+		merged[dir] = quota
+	}
+	return merged
+}
+
 // updateContainerResources update container's resources parameters.
 func (mgr *ContainerManager) updateContainerResources(c *Container, resources types.Resources) error {
 	// update resources of container.
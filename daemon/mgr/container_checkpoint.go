@@ -4,12 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/pkg/errors"
 )
 
 var (
@@ -66,8 +70,15 @@ func (mgr *ContainerManager) CreateCheckpoint(ctx context.Context, name string,
 		return fmt.Errorf("can not checkpoint from a %s container", c.State.Status)
 	}
 
-	if c.Config.Tty {
-		return fmt.Errorf("checkpoint not support on containers with tty")
+	if options.PreDump && options.Exit {
+		return errors.Wrap(errtypes.ErrInvalidParam, "container keeps running after pre-dump, can not exit")
+	}
+
+	var parentDir string
+	if options.ParentCheckpoint != "" {
+		if parentDir, err = mgr.getCheckpointDir(c.ID, options.CheckpointDir, options.ParentCheckpoint, false); err != nil {
+			return errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+		}
 	}
 
 	dir, err := mgr.getCheckpointDir(c.ID, options.CheckpointDir, options.CheckpointID, true)
@@ -80,7 +91,21 @@ func (mgr *ContainerManager) CreateCheckpoint(ctx context.Context, name string,
 		}
 	}()
 
-	if err := mgr.Client.CreateCheckpoint(ctx, c.ID, dir, options.Exit); err != nil {
+	// NOTE: the tty of container is dumped as external terminal, and a new
+	// console is created for it by runtime on restore.
+	//
+	// The checkpoint of containerd task api pauses the task and resumes it
+	// after dumping, which fails for the paused container. The paused
+	// container is checkpointed by runc directly and is left paused.
+	c.Lock()
+	paused := c.State.Paused
+	c.Unlock()
+	if options.PreDump || parentDir != "" || paused {
+		err = mgr.runcCheckpoint(ctx, c, dir, parentDir, options.PreDump, options.Exit)
+	} else {
+		err = mgr.Client.CreateCheckpoint(ctx, c.ID, dir, options.Exit, c.Config.Tty)
+	}
+	if err != nil {
 		return err
 	}
 
@@ -133,6 +158,196 @@ func (mgr *ContainerManager) DeleteCheckpoint(ctx context.Context, name string,
 	return os.RemoveAll(dir)
 }
 
+// ExportCheckpoint exports a checkpoint of container as a bundle to w, the
+// bundle can be imported on another host to restore the container.
+func (mgr *ContainerManager) ExportCheckpoint(ctx context.Context, name string, options *types.CheckpointExportOptions, w io.Writer) error {
+	c, err := mgr.container(name)
+	if err != nil {
+		return err
+	}
+
+	ctx = log.AddFields(ctx, map[string]interface{}{"ContainerID": c.ID})
+
+	dir, err := mgr.getCheckpointDir(c.ID, options.CheckpointDir, options.CheckpointID, false)
+	if err != nil {
+		return errors.Wrap(errtypes.ErrNotfound, err.Error())
+	}
+
+	c.Lock()
+	bundle := &checkpointBundle{
+		ContainerID:  c.ID,
+		CheckpointID: options.CheckpointID,
+		Config:       c.Config,
+		HostConfig:   c.HostConfig,
+	}
+	running := c.IsRunningOrPaused()
+	snapID := c.SnapshotKey()
+	baseFS := c.BaseFS
+	c.Unlock()
+
+	var rootfs *os.File
+	if options.Rootfs {
+		rootfs, err = ioutil.TempFile("", "checkpoint-rootfs")
+		if err != nil {
+			return err
+		}
+		defer func() {
+			rootfs.Close()
+			os.Remove(rootfs.Name())
+		}()
+
+		// the snapshot of running container can not be mounted again, so
+		// the diff is made from its mounted rootfs.
+		if running {
+			err = mgr.Client.ExportRootfsDiff(ctx, snapID, baseFS, rootfs)
+		} else {
+			err = mgr.Client.ExportSnapshotDiff(ctx, snapID, rootfs)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return writeCheckpointBundle(w, bundle, dir, rootfs)
+}
+
+// CheckpointAndExport checkpoints the container and exports the checkpoint
+// with the writable layer to w, the checkpoint is removed after exported and
+// the container keeps running. The running container is paused until the
+// writable layer is exported, so that it is consistent with the checkpoint
+// images, the paused container is left paused.
+func (mgr *ContainerManager) CheckpointAndExport(ctx context.Context, name, checkpointID string, w io.Writer) error {
+	c, err := mgr.container(name)
+	if err != nil {
+		return err
+	}
+
+	ctx = log.AddFields(ctx, map[string]interface{}{"ContainerID": c.ID})
+
+	c.Lock()
+	if c.IsRunning() {
+		if err := mgr.doPause(ctx, c); err != nil {
+			c.Unlock()
+			return errors.Wrapf(err, "failed to pause container %s", c.ID)
+		}
+		defer func() {
+			c.Lock()
+			defer c.Unlock()
+			if err := mgr.doUnpause(ctx, c); err != nil {
+				log.With(ctx).Errorf("failed to unpause container %s after checkpoint: %v", c.ID, err)
+			}
+		}()
+	}
+	c.Unlock()
+
+	if err := mgr.CreateCheckpoint(ctx, c.ID, &types.CheckpointCreateOptions{
+		CheckpointID: checkpointID,
+	}); err != nil {
+		return err
+	}
+	defer func() {
+		if err := mgr.DeleteCheckpoint(ctx, c.ID, &types.CheckpointDeleteOptions{
+			CheckpointID: checkpointID,
+		}); err != nil {
+			log.With(ctx).Warnf("failed to remove checkpoint %s: %v", checkpointID, err)
+		}
+	}()
+
+	return mgr.ExportCheckpoint(ctx, c.ID, &types.CheckpointExportOptions{
+		CheckpointID: checkpointID,
+		Rootfs:       true,
+	}, w)
+}
+
+// ImportCheckpoint imports a checkpoint bundle exported by ExportCheckpoint.
+// If the container does not exist, it is created with the config in bundle.
+func (mgr *ContainerManager) ImportCheckpoint(ctx context.Context, name string, options *types.CheckpointImportOptions, r io.Reader) (_ *types.Checkpoint, err0 error) {
+	var (
+		c            *Container
+		created      bool
+		dir          string
+		checkpointID string
+	)
+
+	defer func() {
+		if err0 == nil {
+			return
+		}
+		if dir != "" {
+			os.RemoveAll(dir)
+		}
+		if created {
+			if err := mgr.Remove(ctx, c.ID, &types.ContainerRemoveOptions{Force: true, Volumes: true}); err != nil {
+				log.With(ctx).Errorf("failed to remove container %s created by importing checkpoint: %v", c.ID, err)
+			}
+		}
+	}()
+
+	prepare := func(bundle *checkpointBundle) (string, error) {
+		var err error
+		c, err = mgr.container(name)
+		if err != nil && !errtypes.IsNotfound(err) {
+			return "", err
+		}
+
+		if c == nil {
+			if bundle.Config == nil {
+				return "", errors.Wrap(errtypes.ErrInvalidParam, "checkpoint bundle has no container config")
+			}
+
+			resp, err := mgr.Create(ctx, name, &types.ContainerCreateConfig{
+				ContainerConfig:  *bundle.Config,
+				HostConfig:       bundle.HostConfig,
+				NetworkingConfig: &types.NetworkingConfig{},
+			})
+			if err != nil {
+				return "", errors.Wrap(err, "failed to create container from checkpoint bundle")
+			}
+			created = true
+
+			if c, err = mgr.container(resp.ID); err != nil {
+				return "", err
+			}
+		}
+
+		if c.IsRunningOrPaused() {
+			return "", errors.Wrapf(errtypes.ErrInvalidParam, "can not import checkpoint to a %s container", c.State.Status)
+		}
+
+		checkpointID = options.CheckpointID
+		if checkpointID == "" {
+			checkpointID = bundle.CheckpointID
+		}
+		if checkpointID == "" {
+			return "", errors.Wrap(errtypes.ErrInvalidParam, "checkpoint id should not be empty")
+		}
+
+		checkpointDir, err := mgr.getCheckpointDir(c.ID, options.CheckpointDir, checkpointID, true)
+		if err != nil {
+			return "", errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+		}
+		dir = checkpointDir
+		return dir, nil
+	}
+
+	applyRootfs := func(r io.Reader) error {
+		return mgr.Client.ImportSnapshotDiff(ctx, c.SnapshotKey(), r)
+	}
+
+	if _, err := readCheckpointBundle(r, prepare, applyRootfs); err != nil {
+		return nil, err
+	}
+
+	if err := writeCheckpointConfig(filepath.Join(dir, checkpointConfigPath), c.ID, checkpointID); err != nil {
+		return nil, err
+	}
+
+	return &types.Checkpoint{
+		ContainerID:    c.ID,
+		CheckpointName: checkpointID,
+	}, nil
+}
+
 func writeCheckpointConfig(path, container, checkpoint string) error {
 	config := &types.Checkpoint{
 		ContainerID:    container,
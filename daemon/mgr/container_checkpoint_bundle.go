@@ -0,0 +1,228 @@
+package mgr
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/pkg/errors"
+)
+
+// The checkpoint bundle is a tar stream with the following entries in order:
+//
+//	bundle.json  the metadata and config of the container
+//	checkpoint/  the checkpoint images created by CRIU
+//	rootfs.tar   the diff of the writable layer, optional
+const (
+	checkpointBundleVersion = 1
+
+	checkpointBundleMetaPath   = "bundle.json"
+	checkpointBundleImagesPath = "checkpoint"
+	checkpointBundleRootfsPath = "rootfs.tar"
+)
+
+// checkpointBundle is the metadata of an exported checkpoint bundle.
+type checkpointBundle struct {
+	Version      int
+	ContainerID  string
+	CheckpointID string
+	Config       *types.ContainerConfig
+	HostConfig   *types.HostConfig
+
+	// Rootfs is true if the bundle contains the diff of the writable layer.
+	Rootfs bool
+}
+
+// writeCheckpointBundle writes the bundle with the checkpoint images in dir,
+// the rootfs is the diff of writable layer and can be nil.
+func writeCheckpointBundle(w io.Writer, bundle *checkpointBundle, dir string, rootfs *os.File) error {
+	bundle.Version = checkpointBundleVersion
+	bundle.Rootfs = rootfs != nil
+
+	raw, err := json.Marshal(bundle)
+	if err != nil {
+		return err
+	}
+
+	tw := tar.NewWriter(w)
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     checkpointBundleMetaPath,
+		Mode:     0600,
+		Size:     int64(len(raw)),
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(raw); err != nil {
+		return err
+	}
+
+	if err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		// the checkpoint config is generated again when importing.
+		if rel == checkpointConfigPath {
+			return nil
+		}
+
+		if !fi.IsDir() && !fi.Mode().IsRegular() {
+			return fmt.Errorf("unsupported file %s in checkpoint", rel)
+		}
+
+		hdr, err := tar.FileInfoHeader(fi, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = path.Join(checkpointBundleImagesPath, filepath.ToSlash(rel))
+		if fi.IsDir() {
+			hdr.Name += "/"
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+
+		if fi.IsDir() {
+			return nil
+		}
+		return copyFileTo(tw, p)
+	}); err != nil {
+		return errors.Wrap(err, "failed to write checkpoint images")
+	}
+
+	if rootfs != nil {
+		fi, err := rootfs.Stat()
+		if err != nil {
+			return err
+		}
+		if _, err := rootfs.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+
+		if err := tw.WriteHeader(&tar.Header{
+			Name:     checkpointBundleRootfsPath,
+			Mode:     0600,
+			Size:     fi.Size(),
+			Typeflag: tar.TypeReg,
+		}); err != nil {
+			return err
+		}
+		if _, err := io.Copy(tw, rootfs); err != nil {
+			return errors.Wrap(err, "failed to write rootfs diff")
+		}
+	}
+
+	return tw.Close()
+}
+
+func copyFileTo(w io.Writer, p string) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// readCheckpointBundle reads the bundle from r. The prepare is called with
+// the metadata and returns the dir to extract the checkpoint images, the
+// applyRootfs is called with the diff of writable layer if bundle has it.
+func readCheckpointBundle(r io.Reader, prepare func(*checkpointBundle) (string, error), applyRootfs func(io.Reader) error) (*checkpointBundle, error) {
+	tr := tar.NewReader(r)
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read checkpoint bundle")
+	}
+	if hdr.Name != checkpointBundleMetaPath {
+		return nil, fmt.Errorf("invalid checkpoint bundle: expect %s, got %s", checkpointBundleMetaPath, hdr.Name)
+	}
+
+	bundle := &checkpointBundle{}
+	if err := json.NewDecoder(tr).Decode(bundle); err != nil {
+		return nil, errors.Wrap(err, "failed to decode checkpoint bundle")
+	}
+	if bundle.Version != checkpointBundleVersion {
+		return nil, fmt.Errorf("unsupported checkpoint bundle version %d", bundle.Version)
+	}
+
+	dir, err := prepare(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	var rootfsApplied bool
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read checkpoint bundle")
+		}
+
+		name := path.Clean(hdr.Name)
+		switch {
+		case name == checkpointBundleRootfsPath && bundle.Rootfs:
+			if err := applyRootfs(tr); err != nil {
+				return nil, err
+			}
+			rootfsApplied = true
+		case strings.HasPrefix(name, checkpointBundleImagesPath+"/"):
+			rel := strings.TrimPrefix(name, checkpointBundleImagesPath+"/")
+			if err := extractCheckpointFile(dir, rel, hdr, tr); err != nil {
+				return nil, errors.Wrapf(err, "failed to extract %s", hdr.Name)
+			}
+		case name == checkpointBundleImagesPath:
+		default:
+			return nil, fmt.Errorf("invalid checkpoint bundle: unexpected entry %s", hdr.Name)
+		}
+	}
+
+	if bundle.Rootfs && !rootfsApplied {
+		return nil, fmt.Errorf("invalid checkpoint bundle: missing %s", checkpointBundleRootfsPath)
+	}
+	return bundle, nil
+}
+
+// extractCheckpointFile extracts the dir or regular file to dir.
+func extractCheckpointFile(dir, rel string, hdr *tar.Header, r io.Reader) error {
+	if rel == ".." || strings.HasPrefix(rel, "../") || path.IsAbs(rel) {
+		return fmt.Errorf("path is out of checkpoint dir")
+	}
+	target := filepath.Join(dir, filepath.FromSlash(rel))
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		return os.MkdirAll(target, 0700)
+	case tar.TypeReg, tar.TypeRegA:
+		if err := os.MkdirAll(filepath.Dir(target), 0700); err != nil {
+			return err
+		}
+
+		f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(hdr.Mode).Perm())
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(f, r)
+		return err
+	default:
+		return fmt.Errorf("unsupported file type %c", hdr.Typeflag)
+	}
+}
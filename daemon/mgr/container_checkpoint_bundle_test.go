@@ -0,0 +1,152 @@
+package mgr
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckpointBundleRoundTrip(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "checkpoint-bundle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	src := filepath.Join(tmpDir, "src")
+	images := map[string]string{
+		"core-1.img":        "core",
+		"pages-1.img":       "pages",
+		"sub/inventory.img": "inventory",
+	}
+	for name, content := range images {
+		p := filepath.Join(src, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(p), 0700))
+		assert.NoError(t, ioutil.WriteFile(p, []byte(content), 0600))
+	}
+	assert.NoError(t, writeCheckpointConfig(filepath.Join(src, checkpointConfigPath), "old", "cp0"))
+
+	rootfs, err := ioutil.TempFile(tmpDir, "rootfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rootfs.Close()
+	_, err = rootfs.WriteString("rootfs diff")
+	assert.NoError(t, err)
+
+	for _, withRootfs := range []bool{true, false} {
+		buf := bytes.NewBuffer(nil)
+		bundle := &checkpointBundle{
+			ContainerID:  "old",
+			CheckpointID: "cp0",
+			Config:       &types.ContainerConfig{Image: "busybox", Cmd: []string{"top"}},
+			HostConfig:   &types.HostConfig{},
+		}
+
+		var f *os.File
+		if withRootfs {
+			f = rootfs
+		}
+		assert.NoError(t, writeCheckpointBundle(buf, bundle, src, f))
+
+		dst, err := ioutil.TempDir(tmpDir, "dst")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var rootfsData []byte
+		got, err := readCheckpointBundle(buf, func(b *checkpointBundle) (string, error) {
+			assert.Equal(t, "cp0", b.CheckpointID)
+			assert.Equal(t, "busybox", b.Config.Image)
+			assert.Equal(t, withRootfs, b.Rootfs)
+			return dst, nil
+		}, func(r io.Reader) error {
+			rootfsData, err = ioutil.ReadAll(r)
+			return err
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"top"}, []string(got.Config.Cmd))
+
+		if withRootfs {
+			assert.Equal(t, "rootfs diff", string(rootfsData))
+		} else {
+			assert.Nil(t, rootfsData)
+		}
+
+		for name, content := range images {
+			data, err := ioutil.ReadFile(filepath.Join(dst, name))
+			assert.NoError(t, err)
+			assert.Equal(t, content, string(data))
+		}
+
+		// the checkpoint config is not exported.
+		_, err = os.Stat(filepath.Join(dst, checkpointConfigPath))
+		assert.True(t, os.IsNotExist(err))
+	}
+}
+
+func TestReadInvalidCheckpointBundle(t *testing.T) {
+	meta, err := json.Marshal(&checkpointBundle{Version: checkpointBundleVersion, CheckpointID: "cp0", Rootfs: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type entry struct {
+		name string
+		data []byte
+	}
+	for _, tc := range []struct {
+		name    string
+		entries []entry
+	}{
+		{
+			name:    "no metadata",
+			entries: []entry{{name: "checkpoint/core-1.img", data: []byte("core")}},
+		},
+		{
+			name:    "out of checkpoint dir",
+			entries: []entry{{name: checkpointBundleMetaPath, data: meta}, {name: "checkpoint/../../evil", data: []byte("evil")}},
+		},
+		{
+			name:    "missing rootfs",
+			entries: []entry{{name: checkpointBundleMetaPath, data: meta}, {name: "checkpoint/core-1.img", data: []byte("core")}},
+		},
+		{
+			name:    "unsupported version",
+			entries: []entry{{name: checkpointBundleMetaPath, data: []byte(`{"Version":100}`)}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := bytes.NewBuffer(nil)
+			tw := tar.NewWriter(buf)
+			for _, e := range tc.entries {
+				assert.NoError(t, tw.WriteHeader(&tar.Header{Name: e.name, Mode: 0600, Size: int64(len(e.data)), Typeflag: tar.TypeReg}))
+				_, err := tw.Write(e.data)
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, tw.Close())
+
+			dst, err := ioutil.TempDir("", "checkpoint-bundle")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dst)
+
+			_, err = readCheckpointBundle(buf, func(*checkpointBundle) (string, error) {
+				return dst, nil
+			}, func(io.Reader) error {
+				return nil
+			})
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/containerd/containerd/runtime/linux/runctypes"
+	runcoptions "github.com/containerd/containerd/runtime/v2/runc/options"
+	"github.com/pkg/errors"
+)
+
+// checkpointParentLink is the symlink to the parent images created by CRIU
+// in the checkpoint dir.
+const checkpointParentLink = "parent"
+
+// runcCheckpointOpts is the options of running runc checkpoint directly.
+type runcCheckpointOpts struct {
+	root         string
+	criuPath     string
+	systemd      bool
+	imagePath    string
+	parentPath   string
+	preDump      bool
+	leaveRunning bool
+	terminal     bool
+}
+
+// runcCheckpointArgs returns the args of runc checkpoint command.
+func runcCheckpointArgs(id string, opts runcCheckpointOpts) []string {
+	args := []string{"--root", opts.root}
+	if opts.criuPath != "" {
+		args = append(args, "--criu", opts.criuPath)
+	}
+	if opts.systemd {
+		args = append(args, "--systemd-cgroup")
+	}
+
+	args = append(args, "checkpoint", "--image-path", opts.imagePath)
+	if opts.parentPath != "" {
+		args = append(args, "--parent-path", opts.parentPath)
+	}
+	if opts.preDump {
+		args = append(args, "--pre-dump")
+	}
+	if opts.leaveRunning {
+		args = append(args, "--leave-running")
+	}
+	if opts.terminal {
+		args = append(args, "--shell-job")
+	}
+	return append(args, id)
+}
+
+// runcCheckpoint runs runc checkpoint for the container directly, since the
+// pre-dump and parent checkpoint are not supported by containerd task api.
+func (mgr *ContainerManager) runcCheckpoint(ctx context.Context, c *Container, dir, parentDir string, preDump, exit bool) error {
+	runtime := c.HostConfig.Runtime
+	r, exist := mgr.Config.Runtimes[runtime]
+	if !exist {
+		return fmt.Errorf("failed to find runtime %s in daemon config", runtime)
+	}
+
+	bin := r.Path
+	if bin == "" {
+		bin = runtime
+	}
+
+	opts := runcCheckpointOpts{
+		root:         filepath.Join(ctrd.RuntimeRoot, mgr.Config.DefaultNamespace),
+		systemd:      mgr.Config.UseSystemd(),
+		imagePath:    dir,
+		preDump:      preDump,
+		leaveRunning: !exit,
+		terminal:     c.Config.Tty,
+	}
+	switch o := r.Options.(type) {
+	case *runctypes.RuncOptions:
+		opts.criuPath = o.CriuPath
+	case *runcoptions.Options:
+		opts.criuPath = o.CriuPath
+	}
+
+	// the parent path is relative to the image path.
+	if parentDir != "" {
+		rel, err := filepath.Rel(dir, parentDir)
+		if err != nil {
+			return err
+		}
+		opts.parentPath = rel
+	}
+
+	args := runcCheckpointArgs(c.ID, opts)
+	log.With(ctx).Debugf("run checkpoint: %s %s", bin, strings.Join(args, " "))
+
+	if output, err := exec.CommandContext(ctx, bin, args...).CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "failed to checkpoint: %s", strings.TrimSpace(string(output)))
+	}
+
+	if parentDir != "" {
+		return materializeCheckpointParent(dir, parentDir)
+	}
+	return nil
+}
+
+// materializeCheckpointParent replaces the parent symlink created by CRIU in
+// dir with the parent images, so that the checkpoint can be restored or
+// exported without the parent. The files are hard linked if possible.
+func materializeCheckpointParent(dir, parentDir string) error {
+	link := filepath.Join(dir, checkpointParentLink)
+	fi, err := os.Lstat(link)
+	switch {
+	case err != nil && !os.IsNotExist(err):
+		return err
+	case err == nil && fi.Mode()&os.ModeSymlink == 0:
+		return fmt.Errorf("%s in checkpoint is not a symlink", checkpointParentLink)
+	case err == nil:
+		if err := os.Remove(link); err != nil {
+			return err
+		}
+	}
+
+	return filepath.Walk(parentDir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(parentDir, p)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(link, rel)
+
+		switch {
+		case fi.IsDir():
+			return os.MkdirAll(target, fi.Mode().Perm())
+		case fi.Mode().IsRegular():
+			if err := os.Link(p, target); err == nil {
+				return nil
+			}
+			return copyRegularFile(p, target, fi.Mode().Perm())
+		default:
+			return fmt.Errorf("unsupported file %s in parent checkpoint", rel)
+		}
+	})
+}
+
+func copyRegularFile(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
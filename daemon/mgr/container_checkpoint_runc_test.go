@@ -0,0 +1,76 @@
+package mgr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuncCheckpointArgs(t *testing.T) {
+	assert.Equal(t, []string{
+		"--root", "/run/default", "checkpoint", "--image-path", "/cp/cp0", "--pre-dump", "--leave-running", "id",
+	}, runcCheckpointArgs("id", runcCheckpointOpts{
+		root:         "/run/default",
+		imagePath:    "/cp/cp0",
+		preDump:      true,
+		leaveRunning: true,
+	}))
+
+	assert.Equal(t, []string{
+		"--root", "/run/default", "--criu", "/usr/sbin/criu", "--systemd-cgroup",
+		"checkpoint", "--image-path", "/cp/cp1", "--parent-path", "../cp0", "--shell-job", "id",
+	}, runcCheckpointArgs("id", runcCheckpointOpts{
+		root:       "/run/default",
+		criuPath:   "/usr/sbin/criu",
+		systemd:    true,
+		imagePath:  "/cp/cp1",
+		parentPath: "../cp0",
+		terminal:   true,
+	}))
+}
+
+func TestMaterializeCheckpointParent(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "checkpoint-parent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// cp0 <- cp1 <- cp2
+	cp0 := filepath.Join(tmpDir, "cp0")
+	cp1 := filepath.Join(tmpDir, "cp1")
+	cp2 := filepath.Join(tmpDir, "cp2")
+	for _, dir := range []string{cp0, cp1, cp2} {
+		assert.NoError(t, os.MkdirAll(dir, 0700))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "pages-1.img"), []byte(filepath.Base(dir)), 0600))
+	}
+
+	assert.NoError(t, os.Symlink("../cp0", filepath.Join(cp1, checkpointParentLink)))
+	assert.NoError(t, materializeCheckpointParent(cp1, cp0))
+
+	assert.NoError(t, os.Symlink("../cp1", filepath.Join(cp2, checkpointParentLink)))
+	assert.NoError(t, materializeCheckpointParent(cp2, cp1))
+
+	fi, err := os.Lstat(filepath.Join(cp2, checkpointParentLink))
+	assert.NoError(t, err)
+	assert.True(t, fi.IsDir())
+
+	for path, content := range map[string]string{
+		"cp2/pages-1.img":               "cp2",
+		"cp2/parent/pages-1.img":        "cp1",
+		"cp2/parent/parent/pages-1.img": "cp0",
+	} {
+		data, err := ioutil.ReadFile(filepath.Join(tmpDir, path))
+		assert.NoError(t, err)
+		assert.Equal(t, content, string(data))
+	}
+
+	// the parent can be removed after materialized.
+	assert.NoError(t, os.RemoveAll(cp1))
+	data, err := ioutil.ReadFile(filepath.Join(cp2, "parent/pages-1.img"))
+	assert.NoError(t, err)
+	assert.Equal(t, "cp1", string(data))
+}
@@ -1,12 +1,22 @@
 package mgr
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/pkg/collect"
+	"github.com/alibaba/pouch/pkg/meta"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -47,3 +57,108 @@ func TestRWCheckpointConfig(t *testing.T) {
 		})
 	}
 }
+
+// fakeCheckpointClient records the calls of pausing container and exporting
+// its writable layer.
+type fakeCheckpointClient struct {
+	ctrd.APIClient
+
+	paused bool
+	calls  []string
+}
+
+func (f *fakeCheckpointClient) PauseContainer(ctx context.Context, id string) error {
+	if f.paused {
+		return fmt.Errorf("container %s is already paused", id)
+	}
+	f.paused = true
+	f.calls = append(f.calls, "pause")
+	return nil
+}
+
+func (f *fakeCheckpointClient) UnpauseContainer(ctx context.Context, id string) error {
+	f.paused = false
+	f.calls = append(f.calls, "unpause")
+	return nil
+}
+
+func (f *fakeCheckpointClient) CreateCheckpoint(ctx context.Context, id string, checkpointDir string, exit, terminal bool) error {
+	// the checkpoint of task api pauses and resumes the task by itself.
+	return fmt.Errorf("task api should not be used to checkpoint the paused container")
+}
+
+func (f *fakeCheckpointClient) ExportRootfsDiff(ctx context.Context, id, rootfs string, w io.Writer) error {
+	if !f.paused {
+		return fmt.Errorf("container should be paused when exporting rootfs")
+	}
+	f.calls = append(f.calls, "export")
+	return nil
+}
+
+func TestCheckpointAndExport(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "checkpoint-export")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	// the fake runc records its args, and writes the checkpoint images.
+	argsFile := filepath.Join(tmpDir, "runc-args")
+	runc := filepath.Join(tmpDir, "runc")
+	script := fmt.Sprintf(`#!/bin/sh
+echo "$@" > %s
+while [ $# -gt 0 ]; do
+	if [ "$1" = "--image-path" ]; then echo dump > "$2/pages-1.img"; fi
+	shift
+done
+`, argsFile)
+	assert.NoError(t, ioutil.WriteFile(runc, []byte(script), 0755))
+
+	store, err := meta.NewStore(meta.Config{
+		Driver:  "local",
+		BaseDir: filepath.Join(tmpDir, "containers"),
+		Buckets: []meta.Bucket{
+			{
+				Name: meta.MetaJSONFile,
+				Type: reflect.TypeOf(Container{}),
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	client := &fakeCheckpointClient{}
+	mgr := &ContainerManager{
+		NameToID: collect.NewSafeMap(),
+		cache:    collect.NewSafeMap(),
+		Store:    store,
+		Client:   client,
+		Config: &config.Config{
+			DefaultNamespace: "default",
+			Runtimes:         map[string]types.Runtime{"runc": {Path: runc}},
+		},
+	}
+	c := &Container{
+		ID:         "c1",
+		Config:     &types.ContainerConfig{},
+		HostConfig: &types.HostConfig{Runtime: "runc"},
+		State:      &types.ContainerState{Status: types.StatusRunning, Running: true},
+	}
+	mgr.cache.Put(c.ID, c)
+
+	var buf bytes.Buffer
+	assert.NoError(t, mgr.CheckpointAndExport(context.Background(), c.ID, "cp0", &buf))
+
+	// the container is paused only once, the checkpoint and the export of
+	// rootfs are done before it is unpaused.
+	assert.Equal(t, []string{"pause", "export", "unpause"}, client.calls)
+	assert.True(t, c.IsRunning())
+
+	args, err := ioutil.ReadFile(argsFile)
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(string(args), "checkpoint"), string(args))
+	assert.True(t, strings.Contains(string(args), "--leave-running"), string(args))
+	assert.True(t, buf.Len() > 0)
+
+	// the checkpoint is removed after exported.
+	checkpoints, err := mgr.ListCheckpoint(context.Background(), c.ID, &types.CheckpointListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(checkpoints))
+}
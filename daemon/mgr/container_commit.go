@@ -2,12 +2,18 @@ package mgr
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/ctrd"
 	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
 
+	"github.com/mattn/go-shellwords"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
 )
@@ -34,7 +40,13 @@ func (mgr *ContainerManager) Commit(ctx context.Context, name string, options *t
 		return nil, errors.Wrapf(errtypes.ErrConflict, "failed to commit container(%s) which is Dead", c.ID)
 	}
 
-	if c.IsRunning() {
+	// validate the changes before pausing container.
+	if err := applyCommitChanges(&types.ContainerConfig{}, options.Changes); err != nil {
+		return nil, errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+
+	pause := options.Pause == nil || *options.Pause
+	if pause && c.IsRunning() {
 		if err := mgr.doPause(ctx, c); err != nil {
 			return nil, errors.Wrapf(err, "failed to pause container(%s)", c.ID)
 		}
@@ -67,13 +79,21 @@ func (mgr *ContainerManager) Commit(ctx context.Context, name string, options *t
 		return nil, errors.Wrapf(err, "failed to merge config from image")
 	}
 
+	// apply the changes to a copy of config, the container is not changed.
+	config := copyCommitConfig(c.Config)
+	if err := applyCommitChanges(config, options.Changes); err != nil {
+		return nil, errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+
 	commitConfig := &ctrd.CommitConfig{
 		Author:          options.Author,
 		Comment:         options.Comment,
 		ContainerID:     c.ID,
+		SnapshotID:      c.SnapshotKey(),
+		Squash:          options.Squash,
 		Reference:       options.Repository + ":" + options.Tag,
 		ParentReference: pRef.String(),
-		ContainerConfig: c.Config,
+		ContainerConfig: config,
 		CImage:          img,
 		Image:           ociImage,
 	}
@@ -105,3 +125,161 @@ func (mgr *ContainerManager) Commit(ctx context.Context, name string, options *t
 	imageID := imageDigest.Hex()
 	return &types.ContainerCommitResp{ID: string(imageID[:12])}, nil
 }
+
+// copyCommitConfig copies the config, the fields changed by commit changes
+// are deep copied.
+func copyCommitConfig(c *types.ContainerConfig) *types.ContainerConfig {
+	config := *c
+	config.Env = append([]string(nil), c.Env...)
+	config.Cmd = append([]string(nil), c.Cmd...)
+	config.Entrypoint = append([]string(nil), c.Entrypoint...)
+
+	config.Labels = make(map[string]string, len(c.Labels))
+	for k, v := range c.Labels {
+		config.Labels[k] = v
+	}
+	config.ExposedPorts = make(map[string]interface{}, len(c.ExposedPorts))
+	for k, v := range c.ExposedPorts {
+		config.ExposedPorts[k] = v
+	}
+	return &config
+}
+
+// applyCommitChanges applies the Dockerfile instructions to config, the
+// supported instructions are ENV, CMD, ENTRYPOINT, EXPOSE, LABEL, USER and
+// WORKDIR.
+func applyCommitChanges(config *types.ContainerConfig, changes []string) error {
+	for _, change := range changes {
+		change = strings.TrimSpace(change)
+		if change == "" {
+			continue
+		}
+
+		instruction, args := change, ""
+		if i := strings.IndexAny(change, " \t"); i != -1 {
+			instruction, args = change[:i], strings.TrimSpace(change[i+1:])
+		}
+		instruction = strings.ToUpper(instruction)
+		if args == "" {
+			return fmt.Errorf("%s requires at least one argument", instruction)
+		}
+
+		switch instruction {
+		case "ENV":
+			envs, err := parseCommitKeyValues(instruction, args)
+			if err != nil {
+				return err
+			}
+			if config.Env, err = mergeEnvSlice(envs, config.Env); err != nil {
+				return err
+			}
+		case "LABEL":
+			labels, err := parseCommitKeyValues(instruction, args)
+			if err != nil {
+				return err
+			}
+			if config.Labels == nil {
+				config.Labels = make(map[string]string)
+			}
+			for _, label := range labels {
+				kv := strings.SplitN(label, "=", 2)
+				config.Labels[kv[0]] = kv[1]
+			}
+		case "CMD", "ENTRYPOINT":
+			cmd, err := parseCommitCommand(args)
+			if err != nil {
+				return errors.Wrapf(err, "invalid %s", instruction)
+			}
+			if instruction == "CMD" {
+				config.Cmd = cmd
+			} else {
+				config.Entrypoint = cmd
+			}
+		case "EXPOSE":
+			ports, err := parseCommitExposedPorts(args)
+			if err != nil {
+				return err
+			}
+			if config.ExposedPorts == nil {
+				config.ExposedPorts = make(map[string]interface{})
+			}
+			for _, port := range ports {
+				config.ExposedPorts[port] = struct{}{}
+			}
+		case "USER":
+			config.User = args
+		case "WORKDIR":
+			// relative path is relative to the previous working dir.
+			if !filepath.IsAbs(args) {
+				args = filepath.Join("/", config.WorkingDir, args)
+			}
+			config.WorkingDir = filepath.Clean(args)
+		default:
+			return fmt.Errorf("%s is not supported by commit changes", instruction)
+		}
+	}
+	return nil
+}
+
+// parseCommitKeyValues parses the args in key=value format, the ENV also
+// supports the legacy format "ENV key value".
+func parseCommitKeyValues(instruction, args string) ([]string, error) {
+	words, err := shellwords.Parse(args)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", instruction)
+	}
+	if len(words) == 0 {
+		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "%s requires at least one argument", instruction)
+	}
+
+	if instruction == "ENV" && !strings.Contains(words[0], "=") {
+		key := words[0]
+		value := strings.TrimSpace(strings.TrimPrefix(args, key))
+		if value == "" {
+			return nil, fmt.Errorf("ENV %s requires a value", key)
+		}
+		return []string{key + "=" + value}, nil
+	}
+
+	for _, word := range words {
+		if strings.HasPrefix(word, "=") || !strings.Contains(word, "=") {
+			return nil, fmt.Errorf("%s %s should be in key=value format", instruction, word)
+		}
+	}
+	return words, nil
+}
+
+// parseCommitCommand parses the command in JSON array format, or runs it by
+// "/bin/sh -c" in shell format.
+func parseCommitCommand(args string) ([]string, error) {
+	if !strings.HasPrefix(args, "[") {
+		return []string{"/bin/sh", "-c", args}, nil
+	}
+
+	var cmd []string
+	if err := json.Unmarshal([]byte(args), &cmd); err != nil {
+		return nil, err
+	}
+	return cmd, nil
+}
+
+// parseCommitExposedPorts parses the ports in port[/proto] format, the
+// protocol defaults to tcp.
+func parseCommitExposedPorts(args string) ([]string, error) {
+	var ports []string
+	for _, field := range strings.Fields(args) {
+		port, proto := field, "tcp"
+		if i := strings.Index(field, "/"); i != -1 {
+			port, proto = field[:i], strings.ToLower(field[i+1:])
+		}
+
+		if proto != "tcp" && proto != "udp" && proto != "sctp" {
+			return nil, fmt.Errorf("invalid protocol of exposed port %s", field)
+		}
+		if n, err := strconv.ParseUint(port, 10, 16); err != nil || n == 0 {
+			return nil, fmt.Errorf("invalid exposed port %s", field)
+		}
+		ports = append(ports, port+"/"+proto)
+	}
+	return ports, nil
+}
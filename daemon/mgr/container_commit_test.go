@@ -0,0 +1,71 @@
+package mgr
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyCommitChanges(t *testing.T) {
+	config := &types.ContainerConfig{
+		Env:        []string{"PATH=/bin", "A=1"},
+		Cmd:        []string{"top"},
+		WorkingDir: "/app",
+		Labels:     map[string]string{"app": "web"},
+	}
+
+	err := applyCommitChanges(config, []string{
+		"ENV A=2 B=\"hello world\"",
+		"env C the value",
+		"CMD [\"nginx\", \"-g\", \"daemon off;\"]",
+		"ENTRYPOINT /entrypoint.sh -v",
+		"EXPOSE 80 53/UDP",
+		"LABEL version=v2 \"maintainer\"=pouch",
+		"USER nobody",
+		"WORKDIR logs",
+		"",
+	})
+	assert.NoError(t, err)
+
+	sort.Strings(config.Env)
+	assert.Equal(t, []string{"A=2", "B=hello world", "C=the value", "PATH=/bin"}, config.Env)
+	assert.Equal(t, []string{"nginx", "-g", "daemon off;"}, config.Cmd)
+	assert.Equal(t, []string{"/bin/sh", "-c", "/entrypoint.sh -v"}, config.Entrypoint)
+	assert.Equal(t, map[string]interface{}{"80/tcp": struct{}{}, "53/udp": struct{}{}}, config.ExposedPorts)
+	assert.Equal(t, map[string]string{"app": "web", "version": "v2", "maintainer": "pouch"}, config.Labels)
+	assert.Equal(t, "nobody", config.User)
+	assert.Equal(t, "/app/logs", config.WorkingDir)
+
+	for _, change := range []string{
+		"RUN echo hello",
+		"ENV",
+		"ENV A",
+		"ENV \"\"",
+		"ENV ''",
+		"LABEL \"\"",
+		"LABEL version",
+		"LABEL =v2",
+		"CMD [\"nginx\"",
+		"EXPOSE 80/http",
+		"EXPOSE 65536",
+	} {
+		assert.Error(t, applyCommitChanges(&types.ContainerConfig{}, []string{change}), change)
+	}
+}
+
+func TestCopyCommitConfig(t *testing.T) {
+	config := &types.ContainerConfig{
+		Env:    []string{"A=1"},
+		Labels: map[string]string{"app": "web"},
+	}
+
+	copied := copyCommitConfig(config)
+	assert.NoError(t, applyCommitChanges(copied, []string{"ENV A=2", "LABEL app=db", "EXPOSE 80"}))
+
+	assert.Equal(t, []string{"A=1"}, config.Env)
+	assert.Equal(t, map[string]string{"app": "web"}, config.Labels)
+	assert.Nil(t, config.ExposedPorts)
+}
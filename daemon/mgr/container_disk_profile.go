@@ -0,0 +1,170 @@
+package mgr
+
+import (
+	"strconv"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/storage/quota"
+
+	"github.com/pkg/errors"
+)
+
+// getDiskProfile returns the disk profile configured in daemon by name.
+func (mgr *ContainerManager) getDiskProfile(name string) (*quota.DiskProfile, error) {
+	profile, ok := mgr.Config.DiskProfiles[name]
+	if !ok {
+		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "disk profile %s not found", name)
+	}
+	return &profile, nil
+}
+
+// mergeDiskProfileQuota merges the disk quota and inode limit of profile into
+// rootfs quota, the rootfs quota which is specified explicitly has higher priority.
+func mergeDiskProfileQuota(diskQuota, inodeQuota map[string]string, profile *quota.DiskProfile) (map[string]string, map[string]string) {
+	if _, exists := diskQuota["/"]; !exists && profile.Size != "" {
+		diskQuota = mergeQuotaMap(diskQuota, map[string]string{"/": profile.Size})
+	}
+
+	if _, exists := inodeQuota["/"]; !exists && profile.Inodes != 0 {
+		inodeQuota = mergeQuotaMap(inodeQuota, map[string]string{"/": strconv.FormatUint(profile.Inodes, 10)})
+	}
+
+	return diskQuota, inodeQuota
+}
+
+// updateDiskProfileQuota merges the quota of the new profile into the quota to
+// update. The rootfs quota and inode limit set by the current profile of
+// container are reset to unlimited if the new profile doesn't set them.
+func (mgr *ContainerManager) updateDiskProfileQuota(c *Container, diskQuota, inodeQuota map[string]string, profile *quota.DiskProfile) (map[string]string, map[string]string) {
+	diskQuota, inodeQuota = mergeDiskProfileQuota(diskQuota, inodeQuota, profile)
+
+	if c.Config.DiskProfile == "" {
+		return diskQuota, inodeQuota
+	}
+	prev, err := mgr.getDiskProfile(c.Config.DiskProfile)
+	if err != nil {
+		return diskQuota, inodeQuota
+	}
+
+	// empty size and zero inode limit mean unlimited.
+	if _, exists := diskQuota["/"]; !exists && prev.Size != "" && c.Config.DiskQuota["/"] == prev.Size {
+		diskQuota = mergeQuotaMap(diskQuota, map[string]string{"/": ""})
+	}
+	if _, exists := inodeQuota["/"]; !exists && prev.Inodes != 0 && c.Config.InodeQuota["/"] == strconv.FormatUint(prev.Inodes, 10) {
+		inodeQuota = mergeQuotaMap(inodeQuota, map[string]string{"/": "0"})
+	}
+	return diskQuota, inodeQuota
+}
+
+// setDiskProfileThrottles sets the blkio throttles of profile on the device
+// of container rootfs.
+func (mgr *ContainerManager) setDiskProfileThrottles(c *Container, r *types.Resources, profile *quota.DiskProfile) error {
+	if !hasDiskProfileThrottles(profile) {
+		return nil
+	}
+
+	device, err := mgr.getRootfsDevice(c)
+	if err != nil {
+		return err
+	}
+
+	r.BlkioDeviceReadBps = setThrottleDevice(r.BlkioDeviceReadBps, device, profile.ReadBps)
+	r.BlkioDeviceWriteBps = setThrottleDevice(r.BlkioDeviceWriteBps, device, profile.WriteBps)
+	r.BlkioDeviceReadIOps = setThrottleDevice(r.BlkioDeviceReadIOps, device, profile.ReadIOps)
+	r.BlkioDeviceWriteIOps = setThrottleDevice(r.BlkioDeviceWriteIOps, device, profile.WriteIOps)
+	return nil
+}
+
+// updateDiskProfileThrottles sets the blkio throttles of the new profile on
+// the device of container rootfs. The throttles set by the current profile of
+// container are reset if the new profile doesn't set them.
+func (mgr *ContainerManager) updateDiskProfileThrottles(c *Container, r *types.Resources, profile *quota.DiskProfile) error {
+	prev := &quota.DiskProfile{}
+	if c.Config.DiskProfile != "" {
+		if p, err := mgr.getDiskProfile(c.Config.DiskProfile); err == nil {
+			prev = p
+		}
+	}
+	if !hasDiskProfileThrottles(profile) && !hasDiskProfileThrottles(prev) {
+		return nil
+	}
+
+	device, err := mgr.getRootfsDevice(c)
+	if err != nil {
+		return err
+	}
+
+	r.BlkioDeviceReadBps = updateThrottleDevice(r.BlkioDeviceReadBps, device, prev.ReadBps, profile.ReadBps)
+	r.BlkioDeviceWriteBps = updateThrottleDevice(r.BlkioDeviceWriteBps, device, prev.WriteBps, profile.WriteBps)
+	r.BlkioDeviceReadIOps = updateThrottleDevice(r.BlkioDeviceReadIOps, device, prev.ReadIOps, profile.ReadIOps)
+	r.BlkioDeviceWriteIOps = updateThrottleDevice(r.BlkioDeviceWriteIOps, device, prev.WriteIOps, profile.WriteIOps)
+	return nil
+}
+
+// hasDiskProfileThrottles returns true if profile sets any blkio throttle.
+func hasDiskProfileThrottles(profile *quota.DiskProfile) bool {
+	return profile.ReadBps != 0 || profile.WriteBps != 0 || profile.ReadIOps != 0 || profile.WriteIOps != 0
+}
+
+// getRootfsDevice returns the disk on which the writable layer of container lies.
+func (mgr *ContainerManager) getRootfsDevice(c *Container) (string, error) {
+	dir := mgr.Config.HomeDir
+	if c.Snapshotter != nil && c.Snapshotter.Data["UpperDir"] != "" {
+		dir = c.Snapshotter.Data["UpperDir"]
+	}
+
+	device, err := quota.GetDevicePath(dir)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get device of container %s rootfs", c.ID)
+	}
+
+	// blkio throttles are rejected on partitions, set them on the disk.
+	disk, err := quota.GetDiskDevicePath(device)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get disk of container %s rootfs", c.ID)
+	}
+	return disk, nil
+}
+
+// updateThrottleDevice returns a copy of devs with the rate of device replaced
+// by rate. If rate is zero, the rate of device is reset to zero only if it is
+// still the rate prev set by the previous profile.
+func updateThrottleDevice(devs []*types.ThrottleDevice, device string, prev, rate uint64) []*types.ThrottleDevice {
+	if rate != 0 {
+		return setThrottleDevice(devs, device, rate)
+	}
+	if prev == 0 {
+		return devs
+	}
+	for _, dev := range devs {
+		if dev.Path == device && dev.Rate == prev {
+			return setThrottleDevice(devs, device, 0)
+		}
+	}
+	return devs
+}
+
+// setThrottleDevice returns a copy of devs with the rate of device replaced.
+// A zero rate is kept only if the device is throttled before, since writing
+// zero into the cgroup removes the existing throttle.
+func setThrottleDevice(devs []*types.ThrottleDevice, device string, rate uint64) []*types.ThrottleDevice {
+	var (
+		found  bool
+		result = make([]*types.ThrottleDevice, 0, len(devs)+1)
+	)
+
+	for _, dev := range devs {
+		if dev.Path == device {
+			found = true
+			result = append(result, &types.ThrottleDevice{Path: device, Rate: rate})
+			continue
+		}
+		result = append(result, &types.ThrottleDevice{Path: dev.Path, Rate: dev.Rate})
+	}
+
+	if !found && rate != 0 {
+		result = append(result, &types.ThrottleDevice{Path: device, Rate: rate})
+	}
+	return result
+}
@@ -0,0 +1,173 @@
+package mgr
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/storage/quota"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeDiskProfileQuota(t *testing.T) {
+	profile := &quota.DiskProfile{Size: "10g", Inodes: 100000}
+
+	diskQuota, inodeQuota := mergeDiskProfileQuota(nil, nil, profile)
+	assert.Equal(t, map[string]string{"/": "10g"}, diskQuota)
+	assert.Equal(t, map[string]string{"/": "100000"}, inodeQuota)
+
+	diskQuota, inodeQuota = mergeDiskProfileQuota(map[string]string{"/": "20g"}, map[string]string{"/": "10:20"}, profile)
+	assert.Equal(t, map[string]string{"/": "20g"}, diskQuota)
+	assert.Equal(t, map[string]string{"/": "10:20"}, inodeQuota)
+
+	diskQuota, _ = mergeDiskProfileQuota(map[string]string{"/data": "5g"}, nil, profile)
+	assert.Equal(t, map[string]string{"/": "10g", "/data": "5g"}, diskQuota)
+
+	// profile without size and inodes doesn't change quota
+	diskQuota, inodeQuota = mergeDiskProfileQuota(nil, nil, &quota.DiskProfile{ReadBps: 1024})
+	assert.Nil(t, diskQuota)
+	assert.Nil(t, inodeQuota)
+}
+
+func TestUpdateDiskProfileQuota(t *testing.T) {
+	mgr := &ContainerManager{
+		Config: &config.Config{
+			DiskProfiles: map[string]quota.DiskProfile{
+				"gold": {Size: "10g", Inodes: 100000},
+			},
+		},
+	}
+	c := &Container{
+		Config: &types.ContainerConfig{
+			DiskProfile: "gold",
+			DiskQuota:   map[string]string{"/": "10g"},
+			InodeQuota:  map[string]string{"/": "100000"},
+		},
+	}
+
+	// the quota of new profile replaces the old one, the inode limit
+	// of old profile is reset.
+	diskQuota, inodeQuota := mgr.updateDiskProfileQuota(c, nil, nil, &quota.DiskProfile{Size: "5g"})
+	assert.Equal(t, map[string]string{"/": "5g"}, diskQuota)
+	assert.Equal(t, map[string]string{"/": "0"}, inodeQuota)
+
+	// the quota of old profile is reset if the new profile has no size.
+	diskQuota, inodeQuota = mgr.updateDiskProfileQuota(c, nil, nil, &quota.DiskProfile{ReadIOps: 1000})
+	assert.Equal(t, map[string]string{"/": ""}, diskQuota)
+	assert.Equal(t, map[string]string{"/": "0"}, inodeQuota)
+
+	// the quota specified explicitly is kept.
+	diskQuota, _ = mgr.updateDiskProfileQuota(c, map[string]string{"/": "20g"}, nil, &quota.DiskProfile{ReadIOps: 1000})
+	assert.Equal(t, map[string]string{"/": "20g"}, diskQuota)
+
+	// the quota which differs from old profile is not set by it, keep it.
+	c.Config.DiskQuota = map[string]string{"/": "30g"}
+	c.Config.InodeQuota = nil
+	diskQuota, inodeQuota = mgr.updateDiskProfileQuota(c, nil, nil, &quota.DiskProfile{ReadIOps: 1000})
+	assert.Nil(t, diskQuota)
+	assert.Nil(t, inodeQuota)
+
+	// no quota is reset for the container without profile.
+	c.Config.DiskProfile = ""
+	c.Config.DiskQuota = map[string]string{"/": "10g"}
+	diskQuota, _ = mgr.updateDiskProfileQuota(c, nil, nil, &quota.DiskProfile{ReadIOps: 1000})
+	assert.Nil(t, diskQuota)
+}
+
+func TestSetThrottleDevice(t *testing.T) {
+	devs := []*types.ThrottleDevice{
+		{Path: "/dev/sda", Rate: 1024},
+		{Path: "/dev/sdb", Rate: 2048},
+	}
+
+	// replace the rate of existing device
+	got := setThrottleDevice(devs, "/dev/sdb", 4096)
+	assert.Equal(t, []*types.ThrottleDevice{
+		{Path: "/dev/sda", Rate: 1024},
+		{Path: "/dev/sdb", Rate: 4096},
+	}, got)
+	assert.Equal(t, uint64(2048), devs[1].Rate)
+
+	// append new device
+	got = setThrottleDevice(devs, "/dev/sdc", 100)
+	assert.Equal(t, 3, len(got))
+	assert.Equal(t, &types.ThrottleDevice{Path: "/dev/sdc", Rate: 100}, got[2])
+
+	// zero rate removes the existing throttle
+	got = setThrottleDevice(devs, "/dev/sda", 0)
+	assert.Equal(t, &types.ThrottleDevice{Path: "/dev/sda", Rate: 0}, got[0])
+
+	// zero rate is ignored for the device without throttle
+	got = setThrottleDevice(devs, "/dev/sdc", 0)
+	assert.Equal(t, 2, len(got))
+}
+
+func TestUpdateThrottleDevice(t *testing.T) {
+	devs := []*types.ThrottleDevice{
+		{Path: "/dev/sda", Rate: 1024},
+		{Path: "/dev/sdb", Rate: 2048},
+	}
+
+	// the rate of new profile replaces the old one.
+	got := updateThrottleDevice(devs, "/dev/sda", 1024, 4096)
+	assert.Equal(t, &types.ThrottleDevice{Path: "/dev/sda", Rate: 4096}, got[0])
+
+	// the rate of old profile is reset if the new profile has no rate.
+	got = updateThrottleDevice(devs, "/dev/sda", 1024, 0)
+	assert.Equal(t, &types.ThrottleDevice{Path: "/dev/sda", Rate: 0}, got[0])
+	assert.Equal(t, uint64(1024), devs[0].Rate)
+
+	// the rate which differs from old profile is not set by it, keep it.
+	got = updateThrottleDevice(devs, "/dev/sdb", 1024, 0)
+	assert.Equal(t, devs, got)
+
+	// nothing to reset without the rate of old profile.
+	got = updateThrottleDevice(devs, "/dev/sda", 0, 0)
+	assert.Equal(t, devs, got)
+}
+
+func TestUpdateDiskProfileThrottles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "disk-profile-throttles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	device, err := quota.GetDevicePath(dir)
+	if err != nil {
+		t.Skipf("failed to get device of %s: %v", dir, err)
+	}
+
+	mgr := &ContainerManager{
+		Config: &config.Config{
+			HomeDir: dir,
+			DiskProfiles: map[string]quota.DiskProfile{
+				"gold": {ReadBps: 1024, WriteIOps: 100},
+			},
+		},
+	}
+	c := &Container{
+		Config: &types.ContainerConfig{DiskProfile: "gold"},
+	}
+
+	// switch from a throttled profile to an unthrottled one.
+	r := &types.Resources{
+		BlkioDeviceReadBps:   []*types.ThrottleDevice{{Path: device, Rate: 1024}},
+		BlkioDeviceWriteIOps: []*types.ThrottleDevice{{Path: device, Rate: 100}},
+	}
+	assert.NoError(t, mgr.updateDiskProfileThrottles(c, r, &quota.DiskProfile{Size: "10g"}))
+	assert.Equal(t, []*types.ThrottleDevice{{Path: device, Rate: 0}}, r.BlkioDeviceReadBps)
+	assert.Equal(t, []*types.ThrottleDevice{{Path: device, Rate: 0}}, r.BlkioDeviceWriteIOps)
+	assert.Empty(t, r.BlkioDeviceWriteBps)
+	assert.Empty(t, r.BlkioDeviceReadIOps)
+
+	// switch to another throttled profile.
+	r = &types.Resources{
+		BlkioDeviceReadBps: []*types.ThrottleDevice{{Path: device, Rate: 1024}},
+	}
+	assert.NoError(t, mgr.updateDiskProfileThrottles(c, r, &quota.DiskProfile{ReadBps: 2048}))
+	assert.Equal(t, []*types.ThrottleDevice{{Path: device, Rate: 2048}}, r.BlkioDeviceReadBps)
+}
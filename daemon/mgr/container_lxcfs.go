@@ -0,0 +1,72 @@
+package mgr
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/lxcfs"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/utils"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// RemountLxcfsStatusOK means lxcfs is remounted in container successfully.
+	RemountLxcfsStatusOK = "OK"
+	// RemountLxcfsStatusFailed means failed to remount lxcfs in container.
+	RemountLxcfsStatusFailed = "Failed"
+)
+
+// RemountLxcfs re-binds the lxcfs proc files inside every running container
+// which enables lxcfs, it is used to recover the dead binds after lxcfs restarts.
+// If ids are given, only the containers in ids are remounted.
+func (mgr *ContainerManager) RemountLxcfs(ctx context.Context, ids ...string) ([]*types.RemountLxcfsResult, error) {
+	if !lxcfs.IsLxcfsEnabled {
+		return nil, errors.Wrap(errtypes.ErrPreCheckFailed, "lxcfs is not enabled in daemon")
+	}
+
+	containers, err := mgr.List(ctx, &ContainerListOption{
+		All: true,
+		FilterFunc: func(c *Container) bool {
+			if len(ids) > 0 && !utils.StringInSlice(ids, c.ID) {
+				return false
+			}
+			return c.IsRunningOrPaused() && c.HostConfig != nil && c.HostConfig.EnableLxcfs
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list containers")
+	}
+
+	results := make([]*types.RemountLxcfsResult, 0, len(containers))
+	for _, c := range containers {
+		result := &types.RemountLxcfsResult{
+			ID:     c.ID,
+			Name:   c.Name,
+			Status: RemountLxcfsStatusOK,
+		}
+
+		if err := mgr.remountLxcfs(c); err != nil {
+			log.With(ctx).Errorf("failed to remount lxcfs in container %s: %v", c.ID, err)
+			result.Status = RemountLxcfsStatusFailed
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// remountLxcfs re-binds the lxcfs proc files in the mount namespace of container.
+func (mgr *ContainerManager) remountLxcfs(c *Container) error {
+	c.Lock()
+	pid := int(c.State.Pid)
+	c.Unlock()
+
+	if pid <= 0 {
+		return errors.Errorf("invalid pid %d of container %s", pid, c.ID)
+	}
+	return lxcfs.RemountProcFiles(pid)
+}
@@ -9,8 +9,18 @@ import (
 const (
 	richModeEnv       = "rich_mode=true"
 	richModeLaunchEnv = "rich_mode_launch_manner"
+
+	// systemdContainerEnv tells systemd that it runs in a container.
+	systemdContainerEnv = "container=pouch"
+	// systemdStopSignal makes systemd start the halt target.
+	systemdStopSignal = "SIGRTMIN+3"
 )
 
+// isRichModeSystemd returns true if the container uses systemd as init.
+func isRichModeSystemd(c *Container) bool {
+	return c.Config.Rich && c.Config.RichMode == types.ContainerConfigRichModeSystemd
+}
+
 func richContainerModeEnv(c *Container) []string {
 	if !c.Config.Rich {
 		return nil
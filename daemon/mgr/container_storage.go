@@ -550,68 +550,80 @@ func (mgr *ContainerManager) prepareQuotaMap(ctx context.Context, c *Container,
 
 	var qms []*quota.QMap
 	for _, mp := range mounts {
-		// get quota size
-		var (
-			found bool
-			qm    *quota.QMap
-		)
-		for exp, size := range quotas {
-			if strings.Contains(exp, "&") {
-				for _, p := range strings.Split(exp, "&") {
-					if p == mp.Destination {
-						found = true
-						qm = &quota.QMap{
-							Source:      mp.Source,
-							Destination: mp.Destination,
-							Expression:  exp,
-							Size:        size,
-						}
-						break
-					}
-				}
-			} else {
-				re := regexp.MustCompile(exp)
-
-				findStr := re.FindString(mp.Destination)
-				if findStr == mp.Destination {
-					qm = &quota.QMap{
-						Source:      mp.Source,
-						Destination: mp.Destination,
-						Size:        size,
-					}
-					if exp != ".*" {
-						break
-					}
-				}
+		// get quota size and inode limit
+		exp, size, sizeFound := matchQuotaExpression(quotas, mp.Destination)
+		inodeExp, inodes, inodeFound := matchQuotaExpression(c.Config.InodeQuota, mp.Destination)
+		if !sizeFound && !inodeFound {
+			continue
+		}
+
+		qm := &quota.QMap{
+			Source:      mp.Source,
+			Destination: mp.Destination,
+			Expression:  exp,
+			Size:        size,
+		}
+		if inodeFound {
+			qm.Inodes.Soft, qm.Inodes.Hard, err = opts.ParseInodeLimit(inodes)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid inode quota of %s", mp.Destination)
+			}
+			if qm.Expression == "" {
+				qm.Expression = inodeExp
 			}
+		}
 
-			if found {
-				break
+		// check duplicate quota map
+		prev := checkDupQuotaMap(qms, qm)
+		if prev == nil {
+			// get new quota id
+			id := globalQuotaID
+			if id == 0 {
+				id, err = quota.GetNextQuotaID()
+				if err != nil {
+					return nil, errors.Wrap(err, "failed to get next quota id")
+				}
 			}
+			qm.QuotaID = id
+		} else {
+			qm.QuotaID = prev.QuotaID
 		}
 
-		if qm != nil {
-			// check duplicate quota map
-			prev := checkDupQuotaMap(qms, qm)
-			if prev == nil {
-				// get new quota id
-				id := globalQuotaID
-				if id == 0 {
-					id, err = quota.GetNextQuotaID()
-					if err != nil {
-						return nil, errors.Wrap(err, "failed to get next quota id")
-					}
+		qms = append(qms, qm)
+	}
+
+	return qms, nil
+}
+
+// matchQuotaExpression returns the quota value whose expression matches the destination in container.
+// The returned expression is non-empty only for the expression joined by "&",
+// since the paths in the same expression share one quota id.
+func matchQuotaExpression(quotas map[string]string, destination string) (string, string, bool) {
+	var (
+		found bool
+		value string
+	)
+
+	for exp, v := range quotas {
+		if strings.Contains(exp, "&") {
+			for _, p := range strings.Split(exp, "&") {
+				if p == destination {
+					return exp, v, true
 				}
-				qm.QuotaID = id
-			} else {
-				qm.QuotaID = prev.QuotaID
 			}
+			continue
+		}
 
-			qms = append(qms, qm)
+		re := regexp.MustCompile(exp)
+		if re.FindString(destination) == destination {
+			found, value = true, v
+			if exp != ".*" {
+				break
+			}
 		}
 	}
 
-	return qms, nil
+	return "", value, found
 }
 
 func checkDupQuotaMap(qms []*quota.QMap, qm *quota.QMap) *quota.QMap {
@@ -632,13 +644,13 @@ func (mgr *ContainerManager) setDiskQuota(ctx context.Context, c *Container, upd
 	for _, qm := range qms {
 		if qm.Destination == "/" {
 			// set rootfs quota
-			_, err = quota.SetRootfsDiskQuota(qm.Source, qm.Size, qm.QuotaID, update)
+			_, err = quota.SetRootfsDiskQuota(qm.Source, qm.Size, qm.Inodes, qm.QuotaID, update)
 			if err != nil {
 				log.With(ctx).Warnf("failed to set rootfs quota, mountfs(%s), size(%s), quota id(%d), err(%v)",
 					qm.Source, qm.Size, qm.QuotaID, err)
 			}
 		} else {
-			err := quota.SetDiskQuota(qm.Source, qm.Size, qm.QuotaID)
+			err := quota.SetDiskQuota(qm.Source, qm.Size, qm.Inodes, qm.QuotaID)
 			if err != nil {
 				log.With(ctx).Warnf("failed to set disk quota, directory(%s), size(%s), quota id(%d), err(%v)",
 					qm.Source, qm.Size, qm.QuotaID, err)
@@ -883,7 +895,7 @@ func copyImageContent(ctx context.Context, source, destination string, qms []*qu
 	// can not inherit quota
 	for _, qm := range qms {
 		if qm.Source == destination {
-			if err := quota.SetDiskQuota(qm.Source, qm.Size, qm.QuotaID); err != nil {
+			if err := quota.SetDiskQuota(qm.Source, qm.Size, qm.Inodes, qm.QuotaID); err != nil {
 				log.With(ctx).Warnf("failed to set disk quota, directory(%s), size(%s), quota id(%d), err(%v)",
 					qm.Source, qm.Size, qm.QuotaID, err)
 			}
@@ -127,3 +127,27 @@ func TestCopyOwnership(t *testing.T) {
 		t.Fatalf("Gid %d is not equal to %d", sysInfo.Gid, uint32(300))
 	}
 }
+
+func TestMatchQuotaExpression(t *testing.T) {
+	for _, tc := range []struct {
+		quotas      map[string]string
+		destination string
+		expression  string
+		value       string
+		found       bool
+	}{
+		{quotas: nil, destination: "/", found: false},
+		{quotas: map[string]string{"/": "10g"}, destination: "/", value: "10g", found: true},
+		{quotas: map[string]string{"/": "10g"}, destination: "/data", found: false},
+		{quotas: map[string]string{".*": "10g"}, destination: "/data", value: "10g", found: true},
+		{quotas: map[string]string{".*": "10g", "/data": "5g"}, destination: "/data", value: "5g", found: true},
+		{quotas: map[string]string{"/a&/b": "100"}, destination: "/b", expression: "/a&/b", value: "100", found: true},
+		{quotas: map[string]string{"/a&/b": "100"}, destination: "/c", found: false},
+	} {
+		exp, value, found := matchQuotaExpression(tc.quotas, tc.destination)
+		if exp != tc.expression || value != tc.value || found != tc.found {
+			t.Fatalf("matchQuotaExpression(%v, %s) expect (%s, %s, %v), got (%s, %s, %v)",
+				tc.quotas, tc.destination, tc.expression, tc.value, tc.found, exp, value, found)
+		}
+	}
+}
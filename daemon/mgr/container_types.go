@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/alibaba/pouch/apis/types"
@@ -17,9 +18,11 @@ import (
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/meta"
 	"github.com/alibaba/pouch/pkg/utils"
+	"github.com/alibaba/pouch/pkg/utils/signal"
 
 	"github.com/containerd/containerd/mount"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 var (
@@ -94,6 +97,9 @@ const (
 	// DefaultStopTimeout is the timeout (in seconds) for the syscall signal used to stop a container.
 	DefaultStopTimeout = 10
 
+	// DefaultStopSignal is the syscall signal used to stop a container.
+	DefaultStopSignal = syscall.SIGTERM
+
 	// RuntimeDir is specified name keeps runtime path script.
 	RuntimeDir = "runtimes"
 )
@@ -280,6 +286,38 @@ type Container struct {
 
 	// SnapshotID specify id of the snapshot that container using.
 	SnapshotID string
+
+	// UIDMappings is the uid mappings of the user namespace of container.
+	UIDMappings []specs.LinuxIDMapping `json:"UIDMappings,omitempty"`
+
+	// GIDMappings is the gid mappings of the user namespace of container.
+	GIDMappings []specs.LinuxIDMapping `json:"GIDMappings,omitempty"`
+
+	// Revision is the container kept before the last upgrade, which is
+	// used to rollback the upgrade.
+	Revision *ContainerRevision `json:"Revision,omitempty"`
+}
+
+// ContainerRevision records the image, writable layer and config of a
+// container before upgrade.
+type ContainerRevision struct {
+	// Image is the image id of the revision.
+	Image string
+
+	// SnapshotID is the id of the writable layer of the revision.
+	SnapshotID string
+
+	// Config is the container config of the revision.
+	Config *types.ContainerConfig
+
+	// HostConfig is the host config of the revision.
+	HostConfig *types.HostConfig
+
+	// Mounts is the mount points of the revision.
+	Mounts []*types.MountPoint
+
+	// Created is the time when the revision is kept.
+	Created string
 }
 
 // Key returns container's id.
@@ -310,6 +348,24 @@ func (c *Container) StopTimeout() int64 {
 	return DefaultStopTimeout
 }
 
+// StopSignal returns the signal used to stop the container. The systemd rich
+// container is halted by SIGRTMIN+3 rather than SIGTERM by default.
+func (c *Container) StopSignal() syscall.Signal {
+	stopSignal := c.Config.StopSignal
+	if stopSignal == "" && isRichModeSystemd(c) {
+		stopSignal = systemdStopSignal
+	}
+	if stopSignal == "" {
+		return DefaultStopSignal
+	}
+
+	sig, err := signal.ParseSignal(stopSignal)
+	if err != nil {
+		return DefaultStopSignal
+	}
+	return sig
+}
+
 func (c *Container) merge(getconfig func() (v1.ImageConfig, error)) error {
 	imageConf, err := getconfig()
 	if err != nil {
@@ -2,19 +2,30 @@ package mgr
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"path/filepath"
+	"time"
 
+	"github.com/alibaba/pouch/apis/opts"
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/utils"
+	volumetypes "github.com/alibaba/pouch/storage/volume/types"
 
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
 )
 
-// Upgrade a container with new image and args. when upgrade a container,
-// we only support specify cmd and entrypoint. if you want to change other
-// parameters of the container, you should think about the update API first.
+// Upgrade a container with new image and args. Besides cmd and entrypoint,
+// the env, labels, binds and resources of the container can be patched, they
+// are validated and applied in one stop/recreate/start transaction.
+//
+// The old image, snapshot and config are kept as the revision of container,
+// which can be restored by Rollback. If the readiness probe is specified,
+// the running container is rolled back automatically when the probe fails,
+// and the probe is rejected for the container which is not running.
 func (mgr *ContainerManager) Upgrade(ctx context.Context, name string, config *types.ContainerUpgradeConfig) error {
 	var err error
 	c, err := mgr.container(name)
@@ -22,14 +33,36 @@ func (mgr *ContainerManager) Upgrade(ctx context.Context, name string, config *t
 		return err
 	}
 
+	if config.ReadinessProbe != nil {
+		if err := validateUpgradeProbe(config.ReadinessProbe); err != nil {
+			return err
+		}
+		// the probe checks the new container started by upgrade, which
+		// is only started if the old one is running.
+		if !c.IsRunning() {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "readiness probe requires container %s to be running", c.ID)
+		}
+	}
+
 	ctx = log.AddFields(ctx, map[string]interface{}{"ContainerID": c.ID})
 
+	// upgrade the deep copies of config and host config, since merging image
+	// config modifies the maps and slices in place, the old ones are kept.
+	c.Lock()
+	newConfig, newHostConfig, err := copyContainerConfig(c.Config, c.HostConfig)
+	c.Unlock()
+	if err != nil {
+		return errors.Wrapf(err, "failed to copy config of container %s", c.ID)
+	}
+
 	var (
 		needRollback  = false
-		oldConfig     = *c.Config
-		oldHostconfig = *c.HostConfig
+		oldConfig     = c.Config
+		oldHostconfig = c.HostConfig
+		oldMounts     = c.Mounts
 		oldImage      = c.Image
 		oldSnapID     = c.SnapshotKey()
+		newSnapID     = ""
 		IsRunning     = false
 	)
 
@@ -40,32 +73,51 @@ func (mgr *ContainerManager) Upgrade(ctx context.Context, name string, config *t
 		}
 
 		c.Lock()
+		upgradedMounts := c.Mounts
 		// recover old container config
-		c.Config = &oldConfig
-		c.HostConfig = &oldHostconfig
+		c.Config = oldConfig
+		c.HostConfig = oldHostconfig
+		c.Mounts = oldMounts
 		c.Image = oldImage
 		c.SnapshotID = oldSnapID
 		c.Unlock()
 
+		// detach the volumes attached for the new binds.
+		mgr.detachUnusedVolumes(ctx, c, upgradedMounts)
+
+		if newSnapID != "" {
+			if err := mgr.removeSnapshot(ctx, newSnapID); err != nil {
+				log.With(ctx).Errorf("failed to remove snapshot %s: %v", newSnapID, err)
+			}
+		}
+
 		// even if the err is not nil, we may still no need to rollback the container
 		if !needRollback {
 			return
 		}
 
-		if err := mgr.start(ctx, c, &types.ContainerStartOptions{}); err != nil {
-			log.With(nil).Errorf("failed to rollback upgrade action: %s", err.Error())
-			if err := mgr.markStoppedAndRelease(ctx, c, nil); err != nil {
-				log.With(nil).Errorf("failed to mark container %s stop status: %s", c.ID, err.Error())
-			}
-		}
+		mgr.restartForRollback(ctx, c)
+		mgr.LogContainerEventWithAttributes(ctx, c, "upgrade_rollback", map[string]string{
+			"error": err.Error(),
+		})
 	}()
 
+	c.Lock()
+	c.Config, c.HostConfig = newConfig, newHostConfig
+	c.Unlock()
+
 	// merge image config to container config
 	err = mgr.mergeImageConfigForUpgrade(ctx, c, config)
 	if err != nil {
 		return errors.Wrap(err, "failed to upgrade container")
 	}
 
+	// apply the patch of config and validate it before stopping container
+	err = mgr.applyUpgradePatch(c, config)
+	if err != nil {
+		return errors.Wrap(err, "failed to upgrade container")
+	}
+
 	// if the container is running, we need first stop it.
 	if c.State.Running {
 		IsRunning = true
@@ -77,12 +129,20 @@ func (mgr *ContainerManager) Upgrade(ctx context.Context, name string, config *t
 	}
 
 	// prepare new snapshot for the new container
-	newSnapID, err := mgr.prepareSnapshotForUpgrade(ctx, c.Key(), c.SnapshotKey(), config.Image)
+	newSnapID, err = mgr.prepareSnapshotForUpgrade(ctx, c, config.Image)
 	if err != nil {
 		return err
 	}
 	c.SnapshotID = newSnapID
 
+	// carry over the changes in the old writable layer to the new one
+	if config.PreserveRootfs || len(config.PreservePaths) > 0 {
+		err = mgr.Client.ApplySnapshotDiff(ctx, oldSnapID, newSnapID, config.PreservePaths)
+		if err != nil {
+			return errors.Wrapf(err, "failed to preserve rootfs of container %s", c.Key())
+		}
+	}
+
 	// initialize container storage config before container started
 	err = mgr.initContainerStorage(ctx, c)
 	if err != nil {
@@ -94,18 +154,40 @@ func (mgr *ContainerManager) Upgrade(ctx context.Context, name string, config *t
 	if IsRunning {
 		err = mgr.start(ctx, c, &types.ContainerStartOptions{})
 		if err != nil {
-			if err := mgr.Client.RemoveSnapshot(ctx, newSnapID); err != nil {
-				log.With(nil).Errorf("failed to remove snapshot %s: %v", newSnapID, err)
+			return errors.Wrap(err, "failed to create new container")
+		}
+
+		// check the readiness of new container, stop it before rollback
+		// if the probe fails.
+		if config.ReadinessProbe != nil {
+			if err = mgr.probeUpgrade(ctx, c, config.ReadinessProbe); err != nil {
+				if stopErr := mgr.stop(ctx, c, 10); stopErr != nil {
+					log.With(ctx).Errorf("failed to stop container %s after readiness probe failed: %v", c.ID, stopErr)
+				}
+				return errors.Wrap(err, "readiness probe failed after upgrade")
 			}
 		}
+	}
 
-		return errors.Wrap(err, "failed to create new container")
+	// Upgrade success, keep the old container as revision. Only the last
+	// revision is kept, so remove the snapshot of the older one.
+	c.Lock()
+	oldRevision := c.Revision
+	c.Revision = &ContainerRevision{
+		Image:      oldImage,
+		SnapshotID: oldSnapID,
+		Config:     oldConfig,
+		HostConfig: oldHostconfig,
+		Mounts:     oldMounts,
+		Created:    time.Now().UTC().Format(utils.TimeLayout),
 	}
+	c.Unlock()
 
-	// Upgrade success, remove snapshot of old container
-	if err := mgr.Client.RemoveSnapshot(ctx, oldSnapID); err != nil {
-		// TODO(ziren): remove old snapshot failed, may cause dirty data
-		log.With(nil).Errorf("failed to remove snapshot %s: %v", oldSnapID, err)
+	if oldRevision != nil {
+		if err := mgr.removeSnapshot(ctx, oldRevision.SnapshotID); err != nil {
+			log.With(ctx).Errorf("failed to remove snapshot %s of old revision: %v", oldRevision.SnapshotID, err)
+		}
+		mgr.detachUnusedVolumes(ctx, c, oldRevision.Mounts)
 	}
 
 	// Upgrade succeeded, refresh the cache
@@ -117,9 +199,122 @@ func (mgr *ContainerManager) Upgrade(ctx context.Context, name string, config *t
 		return err
 	}
 
+	mgr.LogContainerEvent(ctx, c, "upgrade")
 	return nil
 }
 
+// Rollback restores the image, snapshot and config of the container kept
+// before the last upgrade, and removes the snapshot of the upgraded one.
+func (mgr *ContainerManager) Rollback(ctx context.Context, name string) error {
+	var err error
+	c, err := mgr.container(name)
+	if err != nil {
+		return err
+	}
+
+	ctx = log.AddFields(ctx, map[string]interface{}{"ContainerID": c.ID})
+
+	c.Lock()
+	revision := c.Revision
+	c.Unlock()
+	if revision == nil {
+		return errors.Wrapf(errtypes.ErrInvalidParam, "container %s has no revision to rollback", c.Key())
+	}
+
+	var (
+		needRollback       = false
+		upgradedConfig     = c.Config
+		upgradedHostconfig = c.HostConfig
+		upgradedMounts     = c.Mounts
+		upgradedImage      = c.Image
+		upgradedSnapID     = c.SnapshotKey()
+		IsRunning          = false
+	)
+
+	// use err to determine if we should recover the upgraded container.
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		c.Lock()
+		c.Config = upgradedConfig
+		c.HostConfig = upgradedHostconfig
+		c.Mounts = upgradedMounts
+		c.Image = upgradedImage
+		c.SnapshotID = upgradedSnapID
+		c.Unlock()
+
+		if !needRollback {
+			return
+		}
+
+		mgr.restartForRollback(ctx, c)
+	}()
+
+	// if the container is running, we need first stop it.
+	if c.State.Running {
+		IsRunning = true
+		err = mgr.stop(ctx, c, 10)
+		if err != nil {
+			return errors.Wrapf(err, "failed to stop container %s when rollback", c.Key())
+		}
+		needRollback = true
+	}
+
+	c.Lock()
+	c.Config = revision.Config
+	c.HostConfig = revision.HostConfig
+	c.Mounts = revision.Mounts
+	c.Image = revision.Image
+	c.SnapshotID = revision.SnapshotID
+	c.Unlock()
+
+	// initialize container storage config before container started
+	err = mgr.initContainerStorage(ctx, c)
+	if err != nil {
+		return errors.Wrapf(err, "failed to init container storage, id: (%s)", c.Key())
+	}
+
+	if IsRunning {
+		err = mgr.start(ctx, c, &types.ContainerStartOptions{})
+		if err != nil {
+			return errors.Wrap(err, "failed to start container of revision")
+		}
+	}
+
+	// Rollback success, the revision has been restored.
+	c.Lock()
+	c.Revision = nil
+	c.Unlock()
+
+	if err := mgr.removeSnapshot(ctx, upgradedSnapID); err != nil {
+		log.With(ctx).Errorf("failed to remove snapshot %s of upgraded container: %v", upgradedSnapID, err)
+	}
+	mgr.detachUnusedVolumes(ctx, c, upgradedMounts)
+
+	mgr.cache.Put(c.ID, c)
+
+	if err := c.Write(mgr.Store); err != nil {
+		log.With(nil).Errorf("failed to update container %s in meta store: %v", c.ID, err)
+		return err
+	}
+
+	mgr.LogContainerEvent(ctx, c, "upgrade_rollback")
+	return nil
+}
+
+// restartForRollback starts the container recovered from failed upgrade or
+// rollback, the container is marked stopped if it fails to start.
+func (mgr *ContainerManager) restartForRollback(ctx context.Context, c *Container) {
+	if err := mgr.start(ctx, c, &types.ContainerStartOptions{}); err != nil {
+		log.With(nil).Errorf("failed to rollback upgrade action: %s", err.Error())
+		if err := mgr.markStoppedAndRelease(ctx, c, nil); err != nil {
+			log.With(nil).Errorf("failed to mark container %s stop status: %s", c.ID, err.Error())
+		}
+	}
+}
+
 func (mgr *ContainerManager) prepareContainerEntrypointForUpgrade(ctx context.Context, c *Container, config *types.ContainerUpgradeConfig) error {
 	// Firstly, try to use the entrypoint specified by ContainerUpgradeConfig
 	if len(config.Entrypoint) > 0 || len(config.Cmd) > 0 {
@@ -161,18 +356,19 @@ func (mgr *ContainerManager) prepareContainerEntrypointForUpgrade(ctx context.Co
 	return nil
 }
 
-func (mgr *ContainerManager) prepareSnapshotForUpgrade(ctx context.Context, cID, oldSnapID, image string) (string, error) {
+func (mgr *ContainerManager) prepareSnapshotForUpgrade(ctx context.Context, c *Container, image string) (string, error) {
 	newSnapID := ""
 	// get a ID for the new snapshot
 	for {
-		newSnapID = utils.RandString(8, cID, "")
-		if newSnapID != oldSnapID {
+		newSnapID = utils.RandString(8, c.Key(), "")
+		if newSnapID != c.SnapshotKey() {
 			break
 		}
 	}
 
-	// create a snapshot with image for new container.
-	if err := mgr.Client.CreateSnapshot(ctx, newSnapID, image); err != nil {
+	// create a snapshot with image for new container, which is owned by the
+	// root of user namespace.
+	if err := mgr.createSnapshot(ctx, newSnapID, image, c.UIDMappings, c.GIDMappings); err != nil {
 		return "", errors.Wrap(err, "failed to create snapshot")
 	}
 
@@ -188,7 +384,7 @@ func (mgr *ContainerManager) mergeImageConfigForUpgrade(ctx context.Context, c *
 
 	config.Image = primaryRef.String()
 	// Nothing changed, no need upgrade.
-	if config.Image == c.Config.Image {
+	if config.Image == c.Config.Image && !hasUpgradePatch(config) {
 		return fmt.Errorf("failed to upgrade container: image not changed")
 	}
 
@@ -214,3 +410,190 @@ func (mgr *ContainerManager) mergeImageConfigForUpgrade(ctx context.Context, c *
 
 	return nil
 }
+
+// copyContainerConfig returns the deep copies of config and host config.
+func copyContainerConfig(config *types.ContainerConfig, hostConfig *types.HostConfig) (*types.ContainerConfig, *types.HostConfig, error) {
+	var (
+		newConfig     types.ContainerConfig
+		newHostConfig types.HostConfig
+	)
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := json.Unmarshal(data, &newConfig); err != nil {
+		return nil, nil, err
+	}
+
+	data, err = json.Marshal(hostConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := json.Unmarshal(data, &newHostConfig); err != nil {
+		return nil, nil, err
+	}
+	return &newConfig, &newHostConfig, nil
+}
+
+// hasUpgradePatch returns true if the upgrade config patches the container
+// config besides image.
+func hasUpgradePatch(config *types.ContainerUpgradeConfig) bool {
+	return len(config.Env) > 0 || len(config.Labels) > 0 || len(config.Binds) > 0 || config.Resources != nil
+}
+
+// applyUpgradePatch applies the env, labels, binds and resources of upgrade
+// config to the container and validates the result. The mounts replaced by
+// binds are removed, they are regenerated when initializing container storage.
+//
+// NOTE: the config and host config should not be modified in place, since
+// they are kept as revision.
+func (mgr *ContainerManager) applyUpgradePatch(c *Container, config *types.ContainerUpgradeConfig) error {
+	if !hasUpgradePatch(config) {
+		return nil
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	newConfig := *c.Config
+	newHostConfig := *c.HostConfig
+
+	if len(config.Env) > 0 {
+		env, err := mergeEnvSlice(config.Env, newConfig.Env)
+		if err != nil {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "invalid env: %v", err)
+		}
+		newConfig.Env = env
+	}
+
+	if len(config.Labels) > 0 {
+		labels := make(map[string]string, len(newConfig.Labels)+len(config.Labels))
+		for k, v := range newConfig.Labels {
+			labels[k] = v
+		}
+		// the label with empty value is removed, same as update.
+		for k, v := range config.Labels {
+			if v == "" {
+				delete(labels, k)
+			} else {
+				labels[k] = v
+			}
+		}
+		newConfig.Labels = labels
+	}
+
+	mounts := c.Mounts
+	if len(config.Binds) > 0 {
+		binds, dests, err := mergeBinds(newHostConfig.Binds, config.Binds)
+		if err != nil {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "invalid binds: %v", err)
+		}
+		newHostConfig.Binds = binds
+
+		mounts = make([]*types.MountPoint, 0, len(c.Mounts))
+		for _, m := range c.Mounts {
+			if _, replaced := dests[filepath.Clean(m.Destination)]; !replaced {
+				mounts = append(mounts, m)
+			}
+		}
+	}
+
+	oldConfig, oldHostConfig, oldMounts := c.Config, c.HostConfig, c.Mounts
+	c.Config, c.HostConfig, c.Mounts = &newConfig, &newHostConfig, mounts
+
+	if config.Resources != nil {
+		if err := mgr.updateContainerResources(c, *config.Resources); err != nil {
+			c.Config, c.HostConfig, c.Mounts = oldConfig, oldHostConfig, oldMounts
+			return errors.Wrapf(errtypes.ErrInvalidParam, "invalid resources: %v", err)
+		}
+	}
+
+	// validate the patched container with the rules of creating container.
+	warnings, err := mgr.validateConfig(c, false)
+	if err != nil {
+		c.Config, c.HostConfig, c.Mounts = oldConfig, oldHostConfig, oldMounts
+		return errors.Wrapf(errtypes.ErrInvalidParam, "invalid config: %v", err)
+	}
+	if len(warnings) != 0 {
+		log.With(nil).Warnf("warnings upgrade %s: %v", c.ID, warnings)
+	}
+	return nil
+}
+
+// mergeBinds adds the binds to the old ones, the old bind with the same
+// destination is replaced. It returns the merged binds and the destinations
+// of the new binds.
+func mergeBinds(old, binds []string) ([]string, map[string]struct{}, error) {
+	dests := make(map[string]struct{}, len(binds))
+	for _, b := range binds {
+		dest, err := bindDestination(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, exist := dests[dest]; exist {
+			return nil, nil, fmt.Errorf("duplicate mount point %s", dest)
+		}
+		dests[dest] = struct{}{}
+	}
+
+	merged := make([]string, 0, len(old)+len(binds))
+	for _, b := range old {
+		dest, err := bindDestination(b)
+		if err == nil {
+			if _, replaced := dests[dest]; replaced {
+				continue
+			}
+		}
+		merged = append(merged, b)
+	}
+	return append(merged, binds...), dests, nil
+}
+
+// bindDestination returns the destination of the bind.
+func bindDestination(bind string) (string, error) {
+	parts, err := opts.CheckBind(bind)
+	if err != nil {
+		return "", err
+	}
+
+	if len(parts) == 1 {
+		return filepath.Clean(parts[0]), nil
+	}
+	return filepath.Clean(parts[1]), nil
+}
+
+// detachUnusedVolumes detaches the volumes of mounts which are used by
+// neither the container nor its revision.
+func (mgr *ContainerManager) detachUnusedVolumes(ctx context.Context, c *Container, mounts []*types.MountPoint) {
+	c.Lock()
+	inUse := c.Mounts
+	if c.Revision != nil {
+		inUse = append(inUse[:len(inUse):len(inUse)], c.Revision.Mounts...)
+	}
+	c.Unlock()
+
+	mgr.detachVolumesExcept(ctx, c.ID, mounts, inUse)
+}
+
+// detachVolumesExcept detaches the volumes of mounts, except the ones used by
+// inUse mounts.
+func (mgr *ContainerManager) detachVolumesExcept(ctx context.Context, id string, mounts, inUse []*types.MountPoint) {
+	used := map[string]struct{}{}
+	for _, m := range inUse {
+		used[m.Name] = struct{}{}
+	}
+
+	for _, m := range mounts {
+		if m.Name == "" {
+			continue
+		}
+		if _, exist := used[m.Name]; exist {
+			continue
+		}
+
+		if _, err := mgr.VolumeMgr.Detach(ctx, m.Name, map[string]string{volumetypes.OptionRef: id}); err != nil {
+			log.With(ctx).Warnf("failed to detach volume(%s), err(%v)", m.Name, err)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package mgr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/streams"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultUpgradeProbeTimeout is the default timeout of each upgrade probe.
+	defaultUpgradeProbeTimeout = time.Second
+	// defaultUpgradeProbeInterval is the default interval between upgrade probes.
+	defaultUpgradeProbeInterval = time.Second
+	// defaultUpgradeProbeRetries is the default retries of upgrade probe.
+	defaultUpgradeProbeRetries = 3
+)
+
+// validateUpgradeProbe checks only one action is specified in the probe.
+func validateUpgradeProbe(probe *types.UpgradeProbe) error {
+	actions := 0
+	if len(probe.Exec) > 0 {
+		actions++
+	}
+	if probe.TCPPort != 0 {
+		actions++
+	}
+	if probe.HTTPGet != nil {
+		actions++
+	}
+	if actions != 1 {
+		return errors.Wrap(errtypes.ErrInvalidParam, "readiness probe should specify exactly one of Exec, TCPPort and HTTPGet")
+	}
+
+	for _, port := range []int64{probe.TCPPort, httpGetPort(probe.HTTPGet)} {
+		if port < 0 || port > 65535 {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "invalid port %d of readiness probe", port)
+		}
+	}
+	if probe.HTTPGet != nil && probe.HTTPGet.Port == 0 {
+		return errors.Wrap(errtypes.ErrInvalidParam, "port of readiness probe HTTPGet should be specified")
+	}
+
+	if probe.Timeout < 0 || probe.Interval < 0 || probe.Retries < 0 {
+		return errors.Wrap(errtypes.ErrInvalidParam, "timeout, interval and retries of readiness probe should not be negative")
+	}
+	return nil
+}
+
+func httpGetPort(action *types.HTTPGetAction) int64 {
+	if action == nil {
+		return 0
+	}
+	return action.Port
+}
+
+// probeUpgrade runs the readiness probe against the upgraded container until
+// it succeeds, or the retries are exhausted.
+func (mgr *ContainerManager) probeUpgrade(ctx context.Context, c *Container, probe *types.UpgradeProbe) error {
+	timeout := time.Duration(probe.Timeout) * time.Second
+	if timeout == 0 {
+		timeout = defaultUpgradeProbeTimeout
+	}
+	interval := time.Duration(probe.Interval) * time.Second
+	if interval == 0 {
+		interval = defaultUpgradeProbeInterval
+	}
+	retries := int(probe.Retries)
+	if retries == 0 {
+		retries = defaultUpgradeProbeRetries
+	}
+
+	var err error
+	for i := 0; i < retries; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(interval):
+			}
+		}
+
+		if err = mgr.probeUpgradeOnce(ctx, c, probe, timeout); err == nil {
+			return nil
+		}
+		log.With(ctx).Warnf("readiness probe of container %s failed (%d/%d): %v", c.ID, i+1, retries, err)
+	}
+	return err
+}
+
+// probeUpgradeOnce runs the readiness probe once.
+func (mgr *ContainerManager) probeUpgradeOnce(ctx context.Context, c *Container, probe *types.UpgradeProbe, timeout time.Duration) error {
+	if len(probe.Exec) > 0 {
+		return mgr.probeExec(ctx, c, probe.Exec, timeout)
+	}
+
+	ip, err := containerProbeIP(c)
+	if err != nil {
+		return err
+	}
+
+	if probe.HTTPGet != nil {
+		return probeHTTPGet(ip, probe.HTTPGet, timeout)
+	}
+	return probeTCP(ip, int(probe.TCPPort), timeout)
+}
+
+// probeExec runs the command in container, exit code 0 means success.
+func (mgr *ContainerManager) probeExec(ctx context.Context, c *Container, cmd []string, timeout time.Duration) error {
+	execid, err := mgr.CreateExec(ctx, c.ID, &types.ExecCreateConfig{
+		Cmd: cmd,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create exec for probe")
+	}
+	defer mgr.ExecProcesses.Remove(execid)
+
+	output := bytes.NewBuffer(nil)
+	attachCfg := &streams.AttachConfig{
+		UseStdout: true,
+		Stdout:    output,
+		UseStderr: true,
+		Stderr:    output,
+	}
+
+	// round up the timeout to seconds, which is the unit of exec timeout.
+	seconds := int((timeout + time.Second - 1) / time.Second)
+	if err := mgr.StartExec(ctx, execid, attachCfg, seconds); err != nil {
+		return errors.Wrap(err, "failed to start exec for probe")
+	}
+
+	execConfig, err := mgr.GetExecConfig(ctx, execid)
+	if err != nil {
+		return err
+	}
+	if execConfig.ExitCode != 0 {
+		return fmt.Errorf("probe command exited with %d: %s", execConfig.ExitCode, strings.TrimSpace(output.String()))
+	}
+	return nil
+}
+
+// containerProbeIP returns the IP to probe the container, the container in
+// host network is probed by loopback address.
+func containerProbeIP(c *Container) (string, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.HostConfig != nil && IsHost(c.HostConfig.NetworkMode) {
+		return "127.0.0.1", nil
+	}
+
+	if c.NetworkSettings != nil {
+		for _, ep := range c.NetworkSettings.Networks {
+			if ep != nil && ep.IPAddress != "" {
+				return ep.IPAddress, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("container %s has no IP address to probe", c.ID)
+}
+
+// probeTCP checks the port of ip is connectable.
+func probeTCP(ip string, port int, timeout time.Duration) error {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(ip, strconv.Itoa(port)), timeout)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// probeHTTPGet checks the status code of HTTP GET request is in [200, 400).
+func probeHTTPGet(ip string, action *types.HTTPGetAction, timeout time.Duration) error {
+	url := fmt.Sprintf("http://%s/%s", net.JoinHostPort(ip, strconv.Itoa(int(action.Port))), strings.TrimPrefix(action.Path, "/"))
+
+	client := &http.Client{
+		Timeout: timeout,
+		// the container IP should not be requested through proxy.
+		Transport: &http.Transport{
+			DisableKeepAlives: true,
+		},
+		// redirect is considered success.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("HTTP probe %s failed with status %s", url, resp.Status)
+	}
+	return nil
+}
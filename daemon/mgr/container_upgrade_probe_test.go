@@ -0,0 +1,93 @@
+package mgr
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUpgradeProbe(t *testing.T) {
+	for _, tc := range []struct {
+		probe   *types.UpgradeProbe
+		wantErr bool
+	}{
+		{probe: &types.UpgradeProbe{Exec: []string{"true"}}},
+		{probe: &types.UpgradeProbe{TCPPort: 80, Timeout: 3, Retries: 5}},
+		{probe: &types.UpgradeProbe{HTTPGet: &types.HTTPGetAction{Port: 8080, Path: "/healthz"}}},
+		{probe: &types.UpgradeProbe{}, wantErr: true},
+		{probe: &types.UpgradeProbe{Exec: []string{"true"}, TCPPort: 80}, wantErr: true},
+		{probe: &types.UpgradeProbe{TCPPort: 65536}, wantErr: true},
+		{probe: &types.UpgradeProbe{HTTPGet: &types.HTTPGetAction{Path: "/healthz"}}, wantErr: true},
+		{probe: &types.UpgradeProbe{TCPPort: 80, Retries: -1}, wantErr: true},
+	} {
+		err := validateUpgradeProbe(tc.probe)
+		assert.Equal(t, tc.wantErr, err != nil, "probe %+v, got %v", tc.probe, err)
+	}
+}
+
+func TestContainerProbeIP(t *testing.T) {
+	c := &Container{
+		ID:         "123",
+		HostConfig: &types.HostConfig{NetworkMode: "host"},
+	}
+	ip, err := containerProbeIP(c)
+	assert.NoError(t, err)
+	assert.Equal(t, "127.0.0.1", ip)
+
+	c.HostConfig.NetworkMode = "bridge"
+	_, err = containerProbeIP(c)
+	assert.Error(t, err)
+
+	c.NetworkSettings = &types.NetworkSettings{
+		Networks: map[string]*types.EndpointSettings{
+			"bridge": {IPAddress: "192.168.5.2"},
+		},
+	}
+	ip, err = containerProbeIP(c)
+	assert.NoError(t, err)
+	assert.Equal(t, "192.168.5.2", ip)
+}
+
+func TestProbeTCP(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := l.Addr().(*net.TCPAddr).Port
+
+	assert.NoError(t, probeTCP("127.0.0.1", port, time.Second))
+
+	l.Close()
+	assert.Error(t, probeTCP("127.0.0.1", port, time.Second))
+}
+
+func TestProbeHTTPGet(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ready":
+			w.WriteHeader(http.StatusOK)
+		case "/redirect":
+			http.Redirect(w, r, "/nowhere", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	host, portStr, err := net.SplitHostPort(srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, _ := strconv.Atoi(portStr)
+
+	assert.NoError(t, probeHTTPGet(host, &types.HTTPGetAction{Port: int64(port), Path: "/ready"}, time.Second))
+	assert.NoError(t, probeHTTPGet(host, &types.HTTPGetAction{Port: int64(port), Path: "redirect"}, time.Second))
+	assert.Error(t, probeHTTPGet(host, &types.HTTPGetAction{Port: int64(port), Path: "/"}, time.Second))
+}
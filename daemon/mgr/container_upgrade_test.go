@@ -0,0 +1,164 @@
+package mgr
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/collect"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/reference"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeBinds(t *testing.T) {
+	binds, dests, err := mergeBinds(
+		[]string{"/host/data:/data", "vol1:/logs:ro", "/cache"},
+		[]string{"vol2:/logs/", "/host/conf:/etc/app:ro"},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/host/data:/data", "/cache", "vol2:/logs/", "/host/conf:/etc/app:ro"}, binds)
+	assert.Equal(t, map[string]struct{}{"/logs": {}, "/etc/app": {}}, dests)
+
+	_, _, err = mergeBinds(nil, []string{"/a:/data", "/b:/data"})
+	assert.Error(t, err)
+
+	_, _, err = mergeBinds(nil, []string{"/a:relative"})
+	assert.Error(t, err)
+}
+
+func TestApplyUpgradePatch(t *testing.T) {
+	mgr := &ContainerManager{}
+	oldConfig := &types.ContainerConfig{
+		Env:    []string{"A=1", "B=2"},
+		Labels: map[string]string{"app": "web", "tier": "frontend"},
+	}
+	oldHostConfig := &types.HostConfig{
+		Binds: []string{"/host/data:/data", "/host/logs:/logs"},
+	}
+	oldMounts := []*types.MountPoint{
+		{Source: "/host/data", Destination: "/data"},
+		{Source: "/host/logs", Destination: "/logs"},
+	}
+	c := &Container{
+		ID:         "123",
+		Config:     oldConfig,
+		HostConfig: oldHostConfig,
+		Mounts:     oldMounts,
+	}
+
+	// nothing to patch
+	assert.NoError(t, mgr.applyUpgradePatch(c, &types.ContainerUpgradeConfig{Image: "busybox"}))
+	assert.True(t, c.Config == oldConfig && c.HostConfig == oldHostConfig)
+
+	err := mgr.applyUpgradePatch(c, &types.ContainerUpgradeConfig{
+		Env:       []string{"A=10", "B", "C=3"},
+		Labels:    map[string]string{"tier": "", "version": "v2"},
+		Binds:     []string{"/host/logs2:/logs"},
+		Resources: &types.Resources{CPUShares: 512},
+	})
+	assert.NoError(t, err)
+
+	sort.Strings(c.Config.Env)
+	assert.Equal(t, []string{"A=10", "C=3"}, c.Config.Env)
+	assert.Equal(t, map[string]string{"app": "web", "version": "v2"}, c.Config.Labels)
+	assert.Equal(t, []string{"/host/data:/data", "/host/logs2:/logs"}, c.HostConfig.Binds)
+	assert.Equal(t, int64(512), c.HostConfig.CPUShares)
+	assert.Equal(t, []*types.MountPoint{oldMounts[0]}, c.Mounts)
+
+	// the old config is kept unchanged for revision
+	assert.Equal(t, []string{"A=1", "B=2"}, oldConfig.Env)
+	assert.Equal(t, map[string]string{"app": "web", "tier": "frontend"}, oldConfig.Labels)
+	assert.Equal(t, []string{"/host/data:/data", "/host/logs:/logs"}, oldHostConfig.Binds)
+	assert.Equal(t, int64(0), oldHostConfig.CPUShares)
+	assert.Len(t, oldMounts, 2)
+
+	// invalid patch is rejected, and the container is not changed
+	patched := c.Config
+	err = mgr.applyUpgradePatch(c, &types.ContainerUpgradeConfig{
+		Env: []string{"=invalid"},
+	})
+	assert.Error(t, err)
+	assert.True(t, c.Config == patched)
+}
+
+func TestUpgradeProbeNotRunning(t *testing.T) {
+	mgr := &ContainerManager{
+		NameToID: collect.NewSafeMap(),
+		cache:    collect.NewSafeMap(),
+	}
+	c := &Container{
+		ID:         "123",
+		Config:     &types.ContainerConfig{Image: "busybox:1.25"},
+		HostConfig: &types.HostConfig{},
+		State:      &types.ContainerState{Status: types.StatusStopped},
+	}
+	mgr.cache.Put(c.ID, c)
+
+	// the probe is rejected before the container is changed.
+	err := mgr.Upgrade(context.Background(), c.ID, &types.ContainerUpgradeConfig{
+		Image:          "busybox:latest",
+		ReadinessProbe: &types.UpgradeProbe{TCPPort: 80},
+	})
+	assert.True(t, errtypes.IsInvalidParam(err), "got %v", err)
+	assert.Equal(t, "busybox:1.25", c.Config.Image)
+}
+
+// fakeUpgradeImageMgr returns the same image config for every image.
+type fakeUpgradeImageMgr struct {
+	ImageMgr
+	config ocispec.ImageConfig
+}
+
+func (m *fakeUpgradeImageMgr) CheckReference(ctx context.Context, idOrRef string) (digest.Digest, reference.Named, reference.Named, error) {
+	ref, err := reference.Parse(idOrRef)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return digest.FromString(idOrRef), ref, ref, nil
+}
+
+func (m *fakeUpgradeImageMgr) GetOCIImageConfig(ctx context.Context, image string) (ocispec.ImageConfig, error) {
+	return m.config, nil
+}
+
+func TestUpgradeFailureRestoresConfig(t *testing.T) {
+	mgr := &ContainerManager{
+		NameToID: collect.NewSafeMap(),
+		cache:    collect.NewSafeMap(),
+		ImageMgr: &fakeUpgradeImageMgr{
+			config: ocispec.ImageConfig{
+				ExposedPorts: map[string]struct{}{"443/tcp": {}},
+				Volumes:      map[string]struct{}{"/data": {}},
+			},
+		},
+	}
+	c := &Container{
+		ID: "123",
+		Config: &types.ContainerConfig{
+			Image:        "busybox:1.25",
+			Env:          []string{"A=1"},
+			ExposedPorts: map[string]interface{}{"80/tcp": struct{}{}},
+			Volumes:      map[string]interface{}{"/logs": struct{}{}},
+		},
+		HostConfig: &types.HostConfig{},
+		State:      &types.ContainerState{Status: types.StatusStopped},
+	}
+	mgr.cache.Put(c.ID, c)
+
+	// the invalid env fails the upgrade after the image config is merged.
+	err := mgr.Upgrade(context.Background(), c.ID, &types.ContainerUpgradeConfig{
+		Image: "busybox:latest",
+		Cmd:   []string{"top"},
+		Env:   []string{"=invalid"},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, "busybox:1.25", c.Config.Image)
+	assert.Equal(t, map[string]interface{}{"80/tcp": struct{}{}}, c.Config.ExposedPorts)
+	assert.Equal(t, map[string]interface{}{"/logs": struct{}{}}, c.Config.Volumes)
+	assert.Equal(t, []string{"A=1"}, c.Config.Env)
+}
@@ -0,0 +1,217 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/userns"
+
+	"github.com/containerd/containerd/errdefs"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// UsernsModeAuto allocates a range of subordinate ids exclusive to the container.
+	UsernsModeAuto = "auto"
+	// UsernsModeRemap remaps the container to the range of subordinate ids
+	// shared by all the containers in remap mode. The range can be specified
+	// explicitly in the form of remap:start:size, which is shared by the
+	// containers specifying the same range.
+	UsernsModeRemap = "remap"
+
+	// usernsRootDir is the dir in the home dir, where the network files of
+	// containers in user namespace are kept.
+	usernsRootDir = "userns-root"
+)
+
+// isUsernsRemap indicates whether the container is remapped to a user namespace
+// with the range of subordinate ids allocated or specified.
+func isUsernsRemap(mode string) bool {
+	return mode == UsernsModeAuto || mode == UsernsModeRemap || strings.HasPrefix(mode, UsernsModeRemap+":")
+}
+
+// validateUsernsMode validates the user namespace mode and the settings
+// incompatible with user namespace.
+func validateUsernsMode(hc *types.HostConfig) error {
+	mode := hc.UsernsMode
+	switch {
+	case mode == "" || isHost(mode):
+		return nil
+	case isContainer(mode):
+		if connectedContainer(mode) == "" {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "invalid userns mode %s", mode)
+		}
+	case strings.HasPrefix(mode, UsernsModeRemap+":"):
+		if _, err := userns.ParseIDRange(strings.TrimPrefix(mode, UsernsModeRemap+":")); err != nil {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "invalid userns mode %s: %v", mode, err)
+		}
+	case !isUsernsRemap(mode):
+		return errors.Wrapf(errtypes.ErrInvalidParam, "invalid userns mode %s, only host, auto, remap[:start:size] and container:<id> are supported", mode)
+	}
+
+	if hc.Privileged {
+		return errors.Wrapf(errtypes.ErrInvalidParam, "privileged mode is incompatible with userns mode %s", mode)
+	}
+	for name, m := range map[string]string{
+		"network": hc.NetworkMode,
+		"pid":     hc.PidMode,
+		"ipc":     hc.IpcMode,
+		"uts":     hc.UTSMode,
+	} {
+		if isHost(m) {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "can't share the %s namespace of host with userns mode %s", name, mode)
+		}
+	}
+	return nil
+}
+
+// allocateIDMappings returns the uid and gid mappings of the user namespace of container.
+func (mgr *ContainerManager) allocateIDMappings(ctx context.Context, id string, hc *types.HostConfig) ([]specs.LinuxIDMapping, []specs.LinuxIDMapping, error) {
+	if err := validateUsernsMode(hc); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		mode = hc.UsernsMode
+		r    userns.IDRange
+		err  error
+	)
+	switch {
+	case !isUsernsRemap(mode) && !isContainer(mode):
+		return nil, nil, nil
+	case isContainer(mode):
+		orig, err := mgr.Get(ctx, connectedContainer(mode))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "can't join user namespace of container %s", connectedContainer(mode))
+		}
+		if len(orig.UIDMappings) == 0 {
+			return nil, nil, errors.Wrapf(errtypes.ErrInvalidParam, "container %s has no user namespace to join", orig.ID)
+		}
+		return orig.UIDMappings, orig.GIDMappings, nil
+	case mgr.usernsAllocator == nil:
+		return nil, nil, errors.Wrapf(errtypes.ErrInvalidParam, "userns mode %s requires pouchd to be started with --userns-subid-range", mode)
+	case strings.HasPrefix(mode, UsernsModeRemap+":"):
+		r, _ = userns.ParseIDRange(strings.TrimPrefix(mode, UsernsModeRemap+":"))
+		err = mgr.usernsAllocator.Reserve(id, r)
+	case mode == UsernsModeAuto:
+		r, err = mgr.usernsAllocator.Allocate(id)
+	default:
+		r, err = mgr.usernsAllocator.Allocate(userns.RemapOwner)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mappings := []specs.LinuxIDMapping{{ContainerID: 0, HostID: r.Start, Size: r.Size}}
+	return mappings, mappings, nil
+}
+
+// releaseIDMappings releases the subordinate ids allocated to the container.
+func (mgr *ContainerManager) releaseIDMappings(id string) error {
+	if mgr.usernsAllocator == nil {
+		return nil
+	}
+	return mgr.usernsAllocator.Release(id)
+}
+
+// usernsJoiners returns the ids of the containers which join the user
+// namespace of container c.
+func (mgr *ContainerManager) usernsJoiners(c *Container) []string {
+	var joiners []string
+	for _, obj := range mgr.cache.Values(nil) {
+		j, ok := obj.(*Container)
+		if !ok || j.ID == c.ID || j.HostConfig == nil || !isContainer(j.HostConfig.UsernsMode) {
+			continue
+		}
+		if orig, err := mgr.container(connectedContainer(j.HostConfig.UsernsMode)); err == nil && orig.ID == c.ID {
+			joiners = append(joiners, j.ID)
+		}
+	}
+	sort.Strings(joiners)
+	return joiners
+}
+
+// createSnapshot creates the snapshot of image for container, the files in
+// the snapshot are owned by the root of the user namespace of container.
+func (mgr *ContainerManager) createSnapshot(ctx context.Context, snapID, image string, uidMappings, gidMappings []specs.LinuxIDMapping) error {
+	if len(uidMappings) == 0 {
+		return mgr.Client.CreateSnapshot(ctx, snapID, image)
+	}
+	return mgr.Client.CreateRemappedSnapshot(ctx, snapID, image, uidMappings[0], gidMappings[0])
+}
+
+// removeSnapshot removes the snapshot of container, and the remapped parent
+// of the snapshot if no other snapshot is based on it, so that the image
+// remapped for the mappings of the last container is not left behind.
+func (mgr *ContainerManager) removeSnapshot(ctx context.Context, snapID string) error {
+	info, err := mgr.Client.GetSnapshot(ctx, snapID)
+	if err != nil {
+		return mgr.Client.RemoveSnapshot(ctx, snapID)
+	}
+
+	if err := mgr.Client.RemoveSnapshot(ctx, snapID); err != nil {
+		return err
+	}
+	if !ctrd.IsRemappedSnapshot(info.Parent) {
+		return nil
+	}
+
+	// the snapshotter refuses to remove the snapshot with children.
+	if err := mgr.Client.RemoveSnapshot(ctx, info.Parent); err != nil && !errdefs.IsFailedPrecondition(err) && !errdefs.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to remove remapped snapshot %s", info.Parent)
+	}
+	return nil
+}
+
+// prepareUsernsHome makes the home dir of pouchd traversable by the roots of
+// the user namespaces, and creates the dir where the network files of the
+// containers in user namespace are kept. The home dir is made traversable,
+// not readable, since the bind mounts of container are resolved within its
+// user namespace, where the host root has no permission.
+func prepareUsernsHome(homeDir string) error {
+	fi, err := os.Stat(homeDir)
+	if err != nil {
+		return err
+	}
+	if fi.Mode().Perm()&0001 == 0 {
+		log.With(nil).Infof("make %s traversable by the user namespaces of containers", homeDir)
+		if err := os.Chmod(homeDir, fi.Mode().Perm()|0001); err != nil {
+			return err
+		}
+	}
+
+	dir := filepath.Join(homeDir, usernsRootDir)
+	if err := os.MkdirAll(dir, 0711); err != nil {
+		return err
+	}
+	return os.Chmod(dir, 0711)
+}
+
+// prepareUsernsNetworkDir creates the dir owned by the root of the user
+// namespace of container, where the hostname, hosts and resolv.conf of the
+// container are kept.
+func (mgr *ContainerManager) prepareUsernsNetworkDir(c *Container) (string, error) {
+	dir := mgr.usernsNetworkDir(c.ID)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	if err := os.Chown(dir, int(c.UIDMappings[0].HostID), int(c.GIDMappings[0].HostID)); err != nil {
+		return "", fmt.Errorf("failed to chown %s to the root of user namespace: %v", dir, err)
+	}
+	return dir, nil
+}
+
+// usernsNetworkDir returns the dir of the network files of container in
+// user namespace.
+func (mgr *ContainerManager) usernsNetworkDir(id string) string {
+	return filepath.Join(mgr.Config.HomeDir, usernsRootDir, id)
+}
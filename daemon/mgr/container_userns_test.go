@@ -0,0 +1,366 @@
+package mgr
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"syscall"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/oci"
+	"github.com/alibaba/pouch/pkg/collect"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/meta"
+	"github.com/alibaba/pouch/pkg/userns"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/snapshots"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUsernsMode(t *testing.T) {
+	for _, tc := range []struct {
+		hc      *types.HostConfig
+		wantErr bool
+	}{
+		{hc: &types.HostConfig{}},
+		{hc: &types.HostConfig{UsernsMode: "host", Privileged: true, NetworkMode: "host"}},
+		{hc: &types.HostConfig{UsernsMode: "auto", NetworkMode: "bridge"}},
+		{hc: &types.HostConfig{UsernsMode: "remap"}},
+		{hc: &types.HostConfig{UsernsMode: "remap:100000:65536"}},
+		{hc: &types.HostConfig{UsernsMode: "container:sandbox", NetworkMode: "container:sandbox"}},
+		{hc: &types.HostConfig{UsernsMode: "remap:100000"}, wantErr: true},
+		{hc: &types.HostConfig{UsernsMode: "container:"}, wantErr: true},
+		{hc: &types.HostConfig{UsernsMode: "private"}, wantErr: true},
+		{hc: &types.HostConfig{UsernsMode: "auto", Privileged: true}, wantErr: true},
+		{hc: &types.HostConfig{UsernsMode: "auto", NetworkMode: "host"}, wantErr: true},
+		{hc: &types.HostConfig{UsernsMode: "auto", PidMode: "host"}, wantErr: true},
+		{hc: &types.HostConfig{UsernsMode: "auto", IpcMode: "host"}, wantErr: true},
+		{hc: &types.HostConfig{UsernsMode: "auto", UTSMode: "host"}, wantErr: true},
+	} {
+		err := validateUsernsMode(tc.hc)
+		assert.Equal(t, tc.wantErr, err != nil, "%+v: %v", tc.hc, err)
+	}
+}
+
+func TestAllocateIDMappings(t *testing.T) {
+	dir, err := ioutil.TempDir("", "userns-mappings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	mgr := &ContainerManager{
+		NameToID:      collect.NewSafeMap(),
+		ExecProcesses: collect.NewSafeMap(),
+		cache:         collect.NewSafeMap(),
+	}
+	ctx := context.Background()
+
+	// the allocator is required by auto and remap mode.
+	_, _, err = mgr.allocateIDMappings(ctx, "c1", &types.HostConfig{UsernsMode: "auto"})
+	assert.Error(t, err)
+	_, _, err = mgr.allocateIDMappings(ctx, "c1", &types.HostConfig{UsernsMode: "remap:100000:65536"})
+	assert.Error(t, err)
+
+	mgr.usernsAllocator, err = userns.NewAllocator(filepath.Join(dir, "userns-meta"), userns.IDRange{Start: 100000, Size: 4 * 65536}, 65536)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	uids, _, err := mgr.allocateIDMappings(ctx, "c1", &types.HostConfig{})
+	assert.NoError(t, err)
+	assert.Nil(t, uids)
+
+	// the range out of the pool is not managed by allocator.
+	uids, gids, err := mgr.allocateIDMappings(ctx, "c0", &types.HostConfig{UsernsMode: "remap:10000:65536"})
+	assert.NoError(t, err)
+	assert.Equal(t, []specs.LinuxIDMapping{{ContainerID: 0, HostID: 10000, Size: 65536}}, uids)
+	assert.Equal(t, uids, gids)
+
+	auto1, _, err := mgr.allocateIDMappings(ctx, "c1", &types.HostConfig{UsernsMode: "auto"})
+	assert.NoError(t, err)
+	auto2, _, err := mgr.allocateIDMappings(ctx, "c2", &types.HostConfig{UsernsMode: "auto"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, auto1, auto2)
+
+	// the containers in remap mode share the same range.
+	remap1, _, err := mgr.allocateIDMappings(ctx, "c3", &types.HostConfig{UsernsMode: "remap"})
+	assert.NoError(t, err)
+	remap2, _, err := mgr.allocateIDMappings(ctx, "c4", &types.HostConfig{UsernsMode: "remap"})
+	assert.NoError(t, err)
+	assert.Equal(t, remap1, remap2)
+	assert.NotEqual(t, auto1, remap1)
+	assert.NotEqual(t, auto2, remap1)
+
+	// the specified range can't overlap with the allocated ones.
+	_, _, err = mgr.allocateIDMappings(ctx, "c5", &types.HostConfig{UsernsMode: "remap:100000:65536"})
+	assert.Error(t, err)
+
+	// the containers specifying the same range share it.
+	specified1, _, err := mgr.allocateIDMappings(ctx, "c10", &types.HostConfig{UsernsMode: "remap:296608:65536"})
+	assert.NoError(t, err)
+	specified2, _, err := mgr.allocateIDMappings(ctx, "c11", &types.HostConfig{UsernsMode: "remap:296608:65536"})
+	assert.NoError(t, err)
+	assert.Equal(t, specified1, specified2)
+	assert.NoError(t, mgr.releaseIDMappings("c10"))
+	_, _, err = mgr.allocateIDMappings(ctx, "c12", &types.HostConfig{UsernsMode: "auto"})
+	assert.Error(t, err)
+
+	// the container joins the user namespace of another one.
+	mgr.cache.Put("c1", &Container{ID: "c1", UIDMappings: auto1, GIDMappings: auto1})
+	mgr.cache.Put("c6", &Container{ID: "c6"})
+	uids, gids, err = mgr.allocateIDMappings(ctx, "c7", &types.HostConfig{UsernsMode: "container:c1"})
+	assert.NoError(t, err)
+	assert.Equal(t, auto1, uids)
+	assert.Equal(t, auto1, gids)
+	_, _, err = mgr.allocateIDMappings(ctx, "c7", &types.HostConfig{UsernsMode: "container:c6"})
+	assert.Error(t, err)
+
+	// the released range can be allocated again.
+	assert.NoError(t, mgr.releaseIDMappings("c1"))
+	auto8, _, err := mgr.allocateIDMappings(ctx, "c8", &types.HostConfig{UsernsMode: "auto"})
+	assert.NoError(t, err)
+	assert.Equal(t, auto1, auto8)
+}
+
+func TestSetupUserNamespace(t *testing.T) {
+	mappings := []specs.LinuxIDMapping{{ContainerID: 0, HostID: 100000, Size: 65536}}
+
+	// no user namespace
+	s := oci.NewDefaultSpec()
+	c := &Container{HostConfig: &types.HostConfig{}}
+	assert.NoError(t, setupUserNamespace(context.Background(), c, &SpecWrapper{s: s}))
+	for _, ns := range s.Linux.Namespaces {
+		assert.NotEqual(t, specs.UserNamespace, ns.Type)
+	}
+	assert.Empty(t, s.Linux.UIDMappings)
+
+	s = oci.NewDefaultSpec()
+	c = &Container{
+		HostConfig:  &types.HostConfig{UsernsMode: "auto", NetworkMode: "bridge"},
+		UIDMappings: mappings,
+		GIDMappings: mappings,
+	}
+	assert.NoError(t, setupUserNamespace(context.Background(), c, &SpecWrapper{s: s}))
+	assert.Contains(t, s.Linux.Namespaces, specs.LinuxNamespace{Type: specs.UserNamespace})
+	assert.Equal(t, mappings, s.Linux.UIDMappings)
+	assert.Equal(t, mappings, s.Linux.GIDMappings)
+	for _, m := range s.Mounts {
+		if m.Destination == "/sys" {
+			assert.Equal(t, "sysfs", m.Type)
+		}
+	}
+
+	// join the user namespace of another container, and sysfs is bind
+	// mounted since the network namespace is not owned.
+	mgr := &ContainerManager{
+		NameToID:      collect.NewSafeMap(),
+		ExecProcesses: collect.NewSafeMap(),
+		cache:         collect.NewSafeMap(),
+	}
+	mgr.cache.Put("sandbox", &Container{ID: "sandbox", State: &types.ContainerState{Running: true, Pid: 1234}})
+	s = oci.NewDefaultSpec()
+	c = &Container{
+		HostConfig:  &types.HostConfig{UsernsMode: "container:sandbox", NetworkMode: "container:sandbox"},
+		UIDMappings: mappings,
+		GIDMappings: mappings,
+	}
+	assert.NoError(t, setupUserNamespace(context.Background(), c, &SpecWrapper{s: s, ctrMgr: mgr}))
+	assert.Contains(t, s.Linux.Namespaces, specs.LinuxNamespace{Type: specs.UserNamespace, Path: "/proc/1234/ns/user"})
+	for _, m := range s.Mounts {
+		if m.Destination == "/sys" {
+			assert.Equal(t, "bind", m.Type)
+			assert.Equal(t, "/sys", m.Source)
+		}
+	}
+
+	// the container whose user namespace is joined should be running.
+	mgr.cache.Put("stopped", &Container{ID: "stopped", State: &types.ContainerState{}})
+	c.HostConfig.UsernsMode = "container:stopped"
+	assert.Error(t, setupUserNamespace(context.Background(), c, &SpecWrapper{s: oci.NewDefaultSpec(), ctrMgr: mgr}))
+}
+
+func TestRemoveUsernsJoined(t *testing.T) {
+	mgr := &ContainerManager{
+		NameToID:      collect.NewSafeMap(),
+		ExecProcesses: collect.NewSafeMap(),
+		cache:         collect.NewSafeMap(),
+	}
+	mappings := []specs.LinuxIDMapping{{ContainerID: 0, HostID: 100000, Size: 65536}}
+
+	mgr.cache.Put("owner", &Container{
+		ID:          "owner",
+		Name:        "sandbox",
+		Config:      &types.ContainerConfig{},
+		State:       &types.ContainerState{},
+		HostConfig:  &types.HostConfig{UsernsMode: "auto"},
+		UIDMappings: mappings,
+		GIDMappings: mappings,
+	})
+	mgr.NameToID.Put("sandbox", "owner")
+	for id, mode := range map[string]string{"c1": "container:owner", "c2": "container:sandbox"} {
+		mgr.cache.Put(id, &Container{
+			ID:          id,
+			State:       &types.ContainerState{},
+			HostConfig:  &types.HostConfig{UsernsMode: mode},
+			UIDMappings: mappings,
+			GIDMappings: mappings,
+		})
+	}
+	mgr.cache.Put("c3", &Container{ID: "c3", State: &types.ContainerState{}, HostConfig: &types.HostConfig{}})
+
+	owner, err := mgr.container("owner")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c1", "c2"}, mgr.usernsJoiners(owner))
+
+	// the user namespace joined by others can't be removed even by force.
+	err = mgr.Remove(context.Background(), "sandbox", &types.ContainerRemoveOptions{Force: true})
+	assert.True(t, errtypes.IsInUse(err), "%v", err)
+	assert.True(t, mgr.cache.Get("owner").Exist())
+
+	mgr.cache.Remove("c1")
+	mgr.cache.Remove("c2")
+	assert.Empty(t, mgr.usernsJoiners(owner))
+}
+
+func TestPrepareUsernsHome(t *testing.T) {
+	homeDir, err := ioutil.TempDir("", "userns-home")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(homeDir)
+
+	assert.NoError(t, os.Chmod(homeDir, 0700))
+	assert.NoError(t, os.Mkdir(filepath.Join(homeDir, "containers"), 0700))
+	assert.NoError(t, prepareUsernsHome(homeDir))
+
+	// the home dir is traversable but not readable by others.
+	fi, err := os.Stat(homeDir)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0701), fi.Mode().Perm())
+
+	fi, err = os.Stat(filepath.Join(homeDir, usernsRootDir))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0711), fi.Mode().Perm())
+
+	// the other dirs in home dir are kept.
+	fi, err = os.Stat(filepath.Join(homeDir, "containers"))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0700), fi.Mode().Perm())
+
+	// it is idempotent.
+	assert.NoError(t, prepareUsernsHome(homeDir))
+}
+
+func TestBuildUsernsNetworkRelatedPath(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("chown requires root")
+	}
+
+	homeDir, err := ioutil.TempDir("", "userns-network")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(homeDir)
+
+	store, err := meta.NewStore(meta.Config{
+		Driver:  "local",
+		BaseDir: filepath.Join(homeDir, "containers"),
+		Buckets: []meta.Bucket{{Name: meta.MetaJSONFile, Type: reflect.TypeOf(Container{})}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mgr := &ContainerManager{Store: store, Config: &config.Config{HomeDir: homeDir}}
+
+	mappings := []specs.LinuxIDMapping{{ContainerID: 0, HostID: 100000, Size: 65536}}
+	c := &Container{
+		ID:          "c1",
+		Config:      &types.ContainerConfig{Hostname: "c1"},
+		UIDMappings: mappings,
+		GIDMappings: mappings,
+	}
+	assert.NoError(t, mgr.buildNetworkRelatedPath(c))
+
+	// the files are kept in the dir owned by the root of user namespace.
+	dir := filepath.Join(homeDir, usernsRootDir, "c1")
+	assert.Equal(t, filepath.Join(dir, "hostname"), c.HostnamePath)
+	assert.Equal(t, filepath.Join(dir, "hosts"), c.HostsPath)
+	assert.Equal(t, filepath.Join(dir, "resolv.conf"), c.ResolvConfPath)
+
+	fi, err := os.Stat(dir)
+	assert.NoError(t, err)
+	stat := fi.Sys().(*syscall.Stat_t)
+	assert.Equal(t, uint32(100000), stat.Uid)
+	assert.Equal(t, uint32(100000), stat.Gid)
+}
+
+// fakeSnapshotClient keeps the parents of snapshots, and refuses to remove
+// the snapshot with children like the snapshotter.
+type fakeSnapshotClient struct {
+	ctrd.APIClient
+
+	parents map[string]string
+}
+
+func (f *fakeSnapshotClient) GetSnapshot(ctx context.Context, id string) (snapshots.Info, error) {
+	parent, ok := f.parents[id]
+	if !ok {
+		return snapshots.Info{}, errors.Wrapf(errdefs.ErrNotFound, "snapshot %s", id)
+	}
+	return snapshots.Info{Name: id, Parent: parent}, nil
+}
+
+func (f *fakeSnapshotClient) RemoveSnapshot(ctx context.Context, id string) error {
+	if _, ok := f.parents[id]; !ok {
+		return errors.Wrapf(errdefs.ErrNotFound, "snapshot %s", id)
+	}
+	for _, parent := range f.parents {
+		if parent == id {
+			return errors.Wrapf(errdefs.ErrFailedPrecondition, "cannot remove snapshot %s with child", id)
+		}
+	}
+	delete(f.parents, id)
+	return nil
+}
+
+func TestRemoveSnapshot(t *testing.T) {
+	chainID := "sha256:b3c2a1"
+	remapped := chainID + "-userns-0:100000:65536-0:100000:65536"
+	client := &fakeSnapshotClient{
+		parents: map[string]string{
+			chainID:  "",
+			remapped: chainID,
+			"c1":     remapped,
+			"c2":     remapped,
+			"c3":     chainID,
+		},
+	}
+	mgr := &ContainerManager{Client: client}
+	ctx := context.Background()
+
+	// the remapped parent is kept while other container is based on it.
+	assert.NoError(t, mgr.removeSnapshot(ctx, "c1"))
+	assert.NotContains(t, client.parents, "c1")
+	assert.Contains(t, client.parents, remapped)
+
+	// the remapped parent is removed along with its last container.
+	assert.NoError(t, mgr.removeSnapshot(ctx, "c2"))
+	assert.NotContains(t, client.parents, "c2")
+	assert.NotContains(t, client.parents, remapped)
+
+	// the image snapshot is never removed.
+	assert.NoError(t, mgr.removeSnapshot(ctx, "c3"))
+	assert.NotContains(t, client.parents, "c3")
+	assert.Contains(t, client.parents, chainID)
+
+	assert.Error(t, mgr.removeSnapshot(ctx, "c4"))
+}
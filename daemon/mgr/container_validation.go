@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/alibaba/pouch/apis/opts"
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/daemon/logger"
 	"github.com/alibaba/pouch/daemon/logger/jsonfile"
@@ -70,6 +71,16 @@ func (mgr *ContainerManager) validateConfig(c *Container, update bool) ([]string
 		return warnings, fmt.Errorf("shm-size %d should greater than 0", *hostConfig.ShmSize)
 	}
 
+	// validate init
+	if hostConfig.Init {
+		if c.Config.Rich {
+			return warnings, fmt.Errorf("init cannot be used with rich container mode")
+		}
+		if _, err := lookupInitPath(mgr.Config.InitPath); err != nil {
+			return warnings, err
+		}
+	}
+
 	// validate log config
 	if err := mgr.validateLogConfig(c); err != nil {
 		return warnings, err
@@ -101,8 +112,17 @@ func (mgr *ContainerManager) validateDiskQuota(config *types.ContainerCreateConf
 		return errors.Errorf("invalid request, create config is nil")
 	}
 
+	for key, limit := range config.InodeQuota {
+		if key == "" {
+			return errors.Wrap(errInvalidDiskQuota, "inode quota can not be nil string")
+		}
+		if _, _, err := opts.ParseInodeLimit(limit); err != nil {
+			return errors.Wrap(errInvalidDiskQuota, err.Error())
+		}
+	}
+
 	if config.DiskQuota == nil {
-		if quota.IsSetQuotaID(config.QuotaID) {
+		if quota.IsSetQuotaID(config.QuotaID) && config.InodeQuota == nil {
 			return errors.Wrap(errInvalidDiskQuota, "set QuotaID without DiskQuota")
 		}
 		return nil
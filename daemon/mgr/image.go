@@ -99,6 +99,9 @@ type ImageMgr interface {
 
 	// GetOCIImageConfig returns the image config of OCI
 	GetOCIImageConfig(ctx context.Context, image string) (ocispec.ImageConfig, error)
+
+	// GetOCIImage returns the OCI image spec, which includes the config and history.
+	GetOCIImage(ctx context.Context, image string) (ocispec.Image, error)
 }
 
 // ImageManager is an implementation of interface ImageMgr.
@@ -734,15 +737,20 @@ func (mgr *ImageManager) ListReferences(ctx context.Context, imageID digest.Dige
 
 // GetOCIImageConfig returns the image config of OCI
 func (mgr *ImageManager) GetOCIImageConfig(ctx context.Context, image string) (ocispec.ImageConfig, error) {
-	img, err := mgr.client.GetImage(ctx, image)
+	ociImage, err := mgr.GetOCIImage(ctx, image)
 	if err != nil {
 		return ocispec.ImageConfig{}, err
 	}
-	ociImage, err := containerdImageToOciImage(ctx, img)
+	return ociImage.Config, nil
+}
+
+// GetOCIImage returns the OCI image spec read from the config content of image.
+func (mgr *ImageManager) GetOCIImage(ctx context.Context, image string) (ocispec.Image, error) {
+	img, err := mgr.client.GetImage(ctx, image)
 	if err != nil {
-		return ocispec.ImageConfig{}, err
+		return ocispec.Image{}, err
 	}
-	return ociImage.Config, nil
+	return containerdImageToOciImage(ctx, img)
 }
 
 // updateLocalStore updates the local store.
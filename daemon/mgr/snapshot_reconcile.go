@@ -0,0 +1,199 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/snapshots"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/identity"
+	"github.com/pkg/errors"
+)
+
+// OrphanSnapshotGracePeriod is the period in which the new snapshots are not
+// regarded as orphaned, since they may be used by the image pulling or
+// container creating which is in progress.
+const OrphanSnapshotGracePeriod = 10 * time.Minute
+
+// unpackSnapshotPrefix is the key prefix of snapshot which is being unpacked,
+// it is cleaned by containerd gc if the unpacking fails.
+const unpackSnapshotPrefix = "extract-"
+
+// ReconcileSnapshots finds the snapshots which belong to no container and no
+// image in every snapshotter, and removes them if remove is true. Only the
+// snapshots created before the given time are taken into account.
+func (mgr *ContainerManager) ReconcileSnapshots(ctx context.Context, remove bool, before time.Time) (*types.SystemReconcileResult, error) {
+	snapshotters, err := mgr.listSnapshotters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inUse, err := mgr.inUseSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.SystemReconcileResult{
+		OrphanSnapshots: []*types.OrphanSnapshot{},
+	}
+	for _, snapshotter := range snapshotters {
+		snCtx := ctrd.WithSnapshotter(ctx, snapshotter)
+
+		var infos []snapshots.Info
+		err := mgr.Client.WalkSnapshot(snCtx, snapshotter, func(ctx context.Context, info snapshots.Info) error {
+			infos = append(infos, info)
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to walk snapshots of snapshotter %s", snapshotter)
+		}
+
+		for _, info := range findOrphanSnapshots(infos, inUse, before) {
+			orphan := &types.OrphanSnapshot{
+				Key:         info.Name,
+				Snapshotter: snapshotter,
+				Kind:        info.Kind.String(),
+				Parent:      info.Parent,
+				Created:     info.Created.Format(time.RFC3339Nano),
+			}
+
+			if usage, err := mgr.Client.GetSnapshotUsage(snCtx, info.Name); err != nil {
+				log.With(ctx).Warnf("failed to get usage of snapshot %s in snapshotter %s: %v", info.Name, snapshotter, err)
+			} else {
+				orphan.Size = usage.Size
+				orphan.Inodes = usage.Inodes
+			}
+
+			if remove {
+				if err := mgr.Client.RemoveSnapshot(snCtx, info.Name); err != nil {
+					orphan.Error = err.Error()
+					log.With(ctx).Errorf("failed to remove orphaned snapshot %s in snapshotter %s: %v", info.Name, snapshotter, err)
+				} else {
+					orphan.Removed = true
+					result.SpaceReclaimed += orphan.Size
+				}
+			}
+
+			result.OrphanSnapshots = append(result.OrphanSnapshots, orphan)
+		}
+	}
+
+	return result, nil
+}
+
+// listSnapshotters returns the snapshotters which may have snapshots of pouch,
+// all the available snapshotters are returned if multi snapshotter is allowed.
+func (mgr *ContainerManager) listSnapshotters(ctx context.Context) ([]string, error) {
+	current := ctrd.CurrentSnapshotterName(ctrd.CleanSnapshotter(ctx))
+	if !mgr.Config.AllowMultiSnapshotter {
+		return []string{current}, nil
+	}
+
+	plugins, err := mgr.Client.Plugins(ctx, []string{fmt.Sprintf("type==%s", plugin.SnapshotPlugin)})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list snapshotters")
+	}
+
+	snapshotters := []string{current}
+	for _, p := range plugins {
+		if p.Status != ctrd.PluginStatusOk || p.ID == current {
+			continue
+		}
+		snapshotters = append(snapshotters, p.ID)
+	}
+	return snapshotters, nil
+}
+
+// inUseSnapshots returns the keys of snapshots used by containers and images.
+func (mgr *ContainerManager) inUseSnapshots(ctx context.Context) (map[string]struct{}, error) {
+	inUse := make(map[string]struct{})
+
+	containers, err := mgr.List(ctx, &ContainerListOption{All: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list containers")
+	}
+	for _, c := range containers {
+		inUse[c.SnapshotKey()] = struct{}{}
+		if c.Revision != nil {
+			inUse[c.Revision.SnapshotID] = struct{}{}
+		}
+	}
+
+	images, err := mgr.ImageMgr.ListImages(ctx, filters.NewArgs())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list images")
+	}
+	for _, img := range images {
+		if img.RootFS == nil || len(img.RootFS.Layers) == 0 {
+			continue
+		}
+
+		diffIDs := make([]digest.Digest, 0, len(img.RootFS.Layers))
+		for _, layer := range img.RootFS.Layers {
+			diffIDs = append(diffIDs, digest.Digest(layer))
+		}
+		for _, chainID := range identity.ChainIDs(diffIDs) {
+			inUse[chainID.String()] = struct{}{}
+		}
+	}
+
+	return inUse, nil
+}
+
+// findOrphanSnapshots returns the snapshots which are not in use, and not the
+// parent of snapshot in use. The children are in front of their parents, so
+// that the orphans can be removed in order.
+func findOrphanSnapshots(infos []snapshots.Info, inUse map[string]struct{}, before time.Time) []snapshots.Info {
+	index := make(map[string]snapshots.Info, len(infos))
+	for _, info := range infos {
+		index[info.Name] = info
+	}
+
+	used := make(map[string]struct{})
+	markUsed := func(name string) {
+		for name != "" {
+			if _, ok := used[name]; ok {
+				return
+			}
+			used[name] = struct{}{}
+			name = index[name].Parent
+		}
+	}
+
+	for _, info := range infos {
+		_, ok := inUse[info.Name]
+		if ok || !info.Created.Before(before) ||
+			info.Labels[ctrd.TypeLabelKey] == ctrd.ImageType ||
+			strings.HasPrefix(info.Name, unpackSnapshotPrefix) {
+			markUsed(info.Name)
+		}
+	}
+
+	depth := func(name string) int {
+		d := 0
+		for ; name != ""; name = index[name].Parent {
+			d++
+		}
+		return d
+	}
+
+	var orphans []snapshots.Info
+	for _, info := range infos {
+		if _, ok := used[info.Name]; !ok {
+			orphans = append(orphans, info)
+		}
+	}
+	sort.SliceStable(orphans, func(i, j int) bool {
+		return depth(orphans[i].Name) > depth(orphans[j].Name)
+	})
+	return orphans
+}
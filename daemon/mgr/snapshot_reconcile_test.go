@@ -0,0 +1,47 @@
+package mgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/ctrd"
+
+	"github.com/containerd/containerd/snapshots"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindOrphanSnapshots(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-time.Hour)
+
+	infos := []snapshots.Info{
+		// image layers
+		{Name: "layer1", Kind: snapshots.KindCommitted, Created: old},
+		{Name: "layer2", Parent: "layer1", Kind: snapshots.KindCommitted, Created: old},
+		// container in use
+		{Name: "container1", Parent: "layer2", Kind: snapshots.KindActive, Created: old},
+		// left by crash during creating container
+		{Name: "container2", Parent: "layer2", Kind: snapshots.KindActive, Created: old},
+		// layers of removed image without label, and the orphaned container on it
+		{Name: "layer3", Parent: "layer1", Kind: snapshots.KindCommitted, Created: old},
+		{Name: "container3", Parent: "layer3", Kind: snapshots.KindActive, Created: old},
+		// created recently
+		{Name: "container4", Parent: "layer1", Kind: snapshots.KindActive, Created: now},
+		// image layer is cleaned by containerd gc
+		{Name: "layer4", Kind: snapshots.KindCommitted, Created: old, Labels: map[string]string{ctrd.TypeLabelKey: ctrd.ImageType}},
+		// unpacking image
+		{Name: "extract-123-sha256:abc", Parent: "layer4", Kind: snapshots.KindActive, Created: old},
+	}
+	inUse := map[string]struct{}{
+		"layer2":     {},
+		"container1": {},
+	}
+
+	orphans := findOrphanSnapshots(infos, inUse, now.Add(-time.Minute))
+
+	var names []string
+	for _, o := range orphans {
+		names = append(names, o.Name)
+	}
+	assert.Equal(t, []string{"container2", "container3", "layer3"}, names)
+}
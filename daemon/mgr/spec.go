@@ -18,6 +18,7 @@ type SpecWrapper struct {
 	prioArr    []int
 	argsArr    [][]string
 	useSystemd bool
+	initPath   string
 }
 
 // All the functions related to the spec is lock-free for container instance,
@@ -41,6 +42,11 @@ func createSpec(ctx context.Context, c *Container, specWrapper *SpecWrapper) err
 		return err
 	}
 
+	// run the process with init
+	if err := setupInit(ctx, c, specWrapper); err != nil {
+		return err
+	}
+
 	// create Spec.Mounts spec
 	if err := setupMounts(ctx, c, s); err != nil {
 		return err
@@ -11,6 +11,7 @@ import (
 	"github.com/alibaba/pouch/apis/opts"
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/utils"
 
 	"github.com/opencontainers/runc/libcontainer/configs"
 	"github.com/opencontainers/runc/libcontainer/devices"
@@ -93,9 +94,56 @@ func populatePlatform(ctx context.Context, c *Container, specWrapper *SpecWrappe
 		return err
 	}
 
+	if isRichModeSystemd(c) {
+		setupSystemdMode(ctx, c, s)
+	}
+
 	return setupNamespaces(ctx, c, specWrapper)
 }
 
+// setupSystemdMode adjusts the spec to run systemd as the init process.
+func setupSystemdMode(ctx context.Context, c *Container, s *specs.Spec) {
+	if !utils.StringInSlice(s.Process.Env, systemdContainerEnv) {
+		s.Process.Env = append(s.Process.Env, systemdContainerEnv)
+	}
+
+	// systemd needs to create its own cgroups under the container's one.
+	for i := range s.Mounts {
+		if s.Mounts[i].Type == "cgroup" {
+			clearReadonly(&s.Mounts[i])
+		}
+	}
+
+	// systemd expects /run and /run/lock to be tmpfs, skip the ones
+	// specified by user.
+	for _, m := range []specs.Mount{
+		{
+			Destination: "/run",
+			Type:        "tmpfs",
+			Source:      "tmpfs",
+			Options:     []string{"nosuid", "nodev", "strictatime", "mode=755", "size=65536k"},
+		},
+		{
+			Destination: "/run/lock",
+			Type:        "tmpfs",
+			Source:      "tmpfs",
+			Options:     []string{"nosuid", "nodev", "noexec", "strictatime", "mode=1777", "size=5120k"},
+		},
+	} {
+		exist := false
+		for _, sm := range s.Mounts {
+			if sm.Destination == m.Destination {
+				exist = true
+				break
+			}
+		}
+		if !exist {
+			s.Mounts = append(s.Mounts, m)
+		}
+	}
+	s.Mounts = sortMounts(s.Mounts)
+}
+
 // setupResource creates linux resource spec.
 func setupResource(ctx context.Context, c *Container, s *specs.Spec) error {
 	if s.Linux.Resources == nil {
@@ -409,8 +457,45 @@ func getPidContainer(ctx context.Context, mgr ContainerMgr, id string) (*Contain
 	return c, nil
 }
 
-// TODO
 func setupUserNamespace(ctx context.Context, c *Container, specWrapper *SpecWrapper) error {
+	s := specWrapper.s
+	if len(c.UIDMappings) == 0 {
+		removeNamespace(s, specs.UserNamespace)
+		return nil
+	}
+
+	ns := specs.LinuxNamespace{Type: specs.UserNamespace}
+	usernsMode := c.HostConfig.UsernsMode
+	if isContainer(usernsMode) {
+		origContainer, err := specWrapper.ctrMgr.Get(ctx, connectedContainer(usernsMode))
+		if err != nil {
+			return fmt.Errorf("can't join user namespace of container %q: %v", connectedContainer(usernsMode), err)
+		}
+		if !origContainer.IsRunning() {
+			return fmt.Errorf("can't join user namespace of a non running container: %s", origContainer.ID)
+		}
+		ns.Path = fmt.Sprintf("/proc/%d/ns/user", origContainer.State.Pid)
+	}
+	setNamespace(s, ns)
+
+	s.Linux.UIDMappings = c.UIDMappings
+	s.Linux.GIDMappings = c.GIDMappings
+
+	// sysfs can't be mounted in the user namespace which doesn't own the
+	// network namespace joined, bind mount it from host instead.
+	networkMode := c.HostConfig.NetworkMode
+	if IsContainer(networkMode) || IsNetNS(networkMode) {
+		for i, m := range s.Mounts {
+			if m.Type == "sysfs" {
+				s.Mounts[i] = specs.Mount{
+					Destination: m.Destination,
+					Type:        "bind",
+					Source:      "/sys",
+					Options:     []string{"rbind", "nosuid", "noexec", "nodev", "ro"},
+				}
+			}
+		}
+	}
 	return nil
 }
 
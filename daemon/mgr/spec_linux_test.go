@@ -0,0 +1,85 @@
+package mgr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/oci"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetupSystemdMode(t *testing.T) {
+	c := &Container{
+		Config: &types.ContainerConfig{
+			Rich:                true,
+			RichMode:            types.ContainerConfigRichModeSystemd,
+			DisableNetworkFiles: true,
+		},
+		HostConfig: &types.HostConfig{},
+		Mounts: []*types.MountPoint{
+			{Source: "/tmp/lock", Destination: "/run/lock", RW: true},
+			{Source: "/tmp/secrets", Destination: "/run/secrets"},
+		},
+	}
+	assert.True(t, isRichModeSystemd(c))
+
+	s := oci.NewDefaultSpec()
+	s.Process.Env = []string{"PATH=/bin"}
+	assert.NoError(t, setupMounts(context.Background(), c, s))
+
+	setupSystemdMode(context.Background(), c, s)
+	assert.Equal(t, []string{"PATH=/bin", "container=pouch"}, s.Process.Env)
+
+	var (
+		dests  []string
+		mounts = map[string]specs.Mount{}
+	)
+	for _, m := range s.Mounts {
+		dests = append(dests, m.Destination)
+		mounts[m.Destination] = m
+	}
+
+	// /run is mounted before the mounts under it.
+	assert.Equal(t, []string{"/dev", "/dev/mqueue", "/dev/pts", "/dev/shm", "/proc", "/run", "/run/lock", "/run/secrets", "/sys", "/sys/fs/cgroup"}, dests)
+
+	assert.Equal(t, "tmpfs", mounts["/run"].Type)
+	assert.Contains(t, mounts["/run"].Options, "mode=755")
+	// the mount specified by user is kept.
+	assert.Equal(t, "bind", mounts["/run/lock"].Type)
+	assert.Equal(t, "/tmp/lock", mounts["/run/lock"].Source)
+	// the cgroup is writable.
+	assert.Equal(t, []string{"nosuid", "noexec", "nodev"}, mounts["/sys/fs/cgroup"].Options)
+
+	// setup again does not duplicate the env and mounts.
+	setupSystemdMode(context.Background(), c, s)
+	assert.Equal(t, []string{"PATH=/bin", "container=pouch"}, s.Process.Env)
+	assert.Equal(t, len(dests), len(s.Mounts))
+}
+
+func TestValidateRichModeSystemd(t *testing.T) {
+	c := &Container{
+		Config: &types.ContainerConfig{
+			Rich:     true,
+			RichMode: types.ContainerConfigRichModeSystemd,
+		},
+		HostConfig: &types.HostConfig{Privileged: true},
+	}
+	assert.NoError(t, validateRichMode(c))
+	assert.Equal(t, 37, int(c.StopSignal()))
+
+	// the container created before without stop signal is halted by
+	// SIGRTMIN+3 as well.
+	c.Config.StopSignal = ""
+	assert.Equal(t, 37, int(c.StopSignal()))
+
+	// the stop signal specified by user is kept.
+	c.Config.StopSignal = "SIGINT"
+	assert.NoError(t, validateRichMode(c))
+	assert.Equal(t, 2, int(c.StopSignal()))
+
+	c.HostConfig.Privileged = false
+	assert.Error(t, validateRichMode(c))
+}
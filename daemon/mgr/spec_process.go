@@ -3,6 +3,9 @@ package mgr
 import (
 	"context"
 	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"strings"
 
 	"github.com/alibaba/pouch/apis/types"
@@ -10,6 +13,16 @@ import (
 
 	"github.com/docker/docker/daemon/caps"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultInitBinary is the init binary looked up in PATH if the init
+	// path is not specified in daemon config.
+	DefaultInitBinary = "pouch-init"
+
+	// containerInitPath is where the init binary mounted in container.
+	containerInitPath = "/sbin/pouch-init"
 )
 
 // setupProcess setups spec process.
@@ -62,6 +75,50 @@ func setupProcess(ctx context.Context, c *Container, s *specs.Spec) error {
 	return setupNvidiaEnv(ctx, c, s)
 }
 
+// lookupInitPath returns the absolute path of init binary.
+func lookupInitPath(initPath string) (string, error) {
+	if initPath == "" {
+		p, err := exec.LookPath(DefaultInitBinary)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to find init binary %s, install it by make install-init or specify it by init-path of pouchd", DefaultInitBinary)
+		}
+		initPath = p
+	}
+
+	initPath, err := filepath.Abs(initPath)
+	if err != nil {
+		return "", err
+	}
+	if fi, err := os.Stat(initPath); err != nil {
+		return "", errors.Wrapf(err, "failed to find init binary %s", initPath)
+	} else if !fi.Mode().IsRegular() {
+		return "", fmt.Errorf("init binary %s is not a regular file", initPath)
+	}
+	return initPath, nil
+}
+
+// setupInit mounts the init binary into container and makes it the first
+// process, so that the signals are forwarded and the zombies are reaped.
+func setupInit(ctx context.Context, c *Container, specWrapper *SpecWrapper) error {
+	if !c.HostConfig.Init {
+		return nil
+	}
+
+	if specWrapper.initPath == "" {
+		return fmt.Errorf("failed to setup init: init path is not specified")
+	}
+
+	s := specWrapper.s
+	s.Mounts = append(s.Mounts, specs.Mount{
+		Source:      specWrapper.initPath,
+		Destination: containerInitPath,
+		Type:        "bind",
+		Options:     []string{"rbind", "ro"},
+	})
+	s.Process.Args = append([]string{containerInitPath, "--"}, s.Process.Args...)
+	return nil
+}
+
 func createEnvironment(c *Container) []string {
 	env := c.Config.Env
 	env = append(env, richContainerModeEnv(c)...)
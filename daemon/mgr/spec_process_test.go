@@ -0,0 +1,79 @@
+package mgr
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupInitPath(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "init-path")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	initPath := filepath.Join(tmpDir, DefaultInitBinary)
+	assert.NoError(t, ioutil.WriteFile(initPath, []byte("#!/bin/sh"), 0755))
+
+	p, err := lookupInitPath(initPath)
+	assert.NoError(t, err)
+	assert.Equal(t, initPath, p)
+
+	// look up the default init binary in PATH.
+	path := os.Getenv("PATH")
+	defer os.Setenv("PATH", path)
+	os.Setenv("PATH", tmpDir)
+
+	p, err = lookupInitPath("")
+	assert.NoError(t, err)
+	assert.Equal(t, initPath, p)
+
+	_, err = lookupInitPath(tmpDir)
+	assert.Error(t, err)
+
+	_, err = lookupInitPath(filepath.Join(tmpDir, "notexist"))
+	assert.Error(t, err)
+
+	os.Setenv("PATH", "")
+	_, err = lookupInitPath("")
+	assert.Error(t, err)
+}
+
+func TestSetupInit(t *testing.T) {
+	c := &Container{
+		Config:     &types.ContainerConfig{},
+		HostConfig: &types.HostConfig{},
+	}
+	sw := &SpecWrapper{
+		s: &specs.Spec{
+			Process: &specs.Process{Args: []string{"sh", "-c", "sleep 1"}},
+		},
+		initPath: "/usr/bin/tini",
+	}
+
+	// init is not enabled.
+	assert.NoError(t, setupInit(context.Background(), c, sw))
+	assert.Equal(t, []string{"sh", "-c", "sleep 1"}, sw.s.Process.Args)
+	assert.Nil(t, sw.s.Mounts)
+
+	c.HostConfig.Init = true
+	assert.NoError(t, setupInit(context.Background(), c, sw))
+	assert.Equal(t, []string{"/sbin/pouch-init", "--", "sh", "-c", "sleep 1"}, sw.s.Process.Args)
+	assert.Equal(t, []specs.Mount{{
+		Source:      "/usr/bin/tini",
+		Destination: "/sbin/pouch-init",
+		Type:        "bind",
+		Options:     []string{"rbind", "ro"},
+	}}, sw.s.Mounts)
+
+	sw.initPath = ""
+	assert.Error(t, setupInit(context.Background(), c, sw))
+}
@@ -133,6 +133,9 @@ func (mgr *SystemManager) Info() (types.SystemInfo, error) {
 	if selinux.GetEnabled() {
 		securityOpts = append(securityOpts, "selinux")
 	}
+	if mgr.config.UsernsSubIDRange != "" {
+		securityOpts = append(securityOpts, "userns")
+	}
 
 	info := types.SystemInfo{
 		Architecture: runtime.GOARCH,
@@ -177,12 +177,7 @@ func (vm *VolumeManager) Attach(ctx context.Context, name string, options map[st
 
 	cid, ok := options[types.OptionRef]
 	if ok && cid != "" {
-		ref := v.Option(types.OptionRef)
-		if ref == "" {
-			options[types.OptionRef] = cid
-		} else if !strings.Contains(ref, cid) {
-			options[types.OptionRef] = strings.Join([]string{ref, cid}, ",")
-		}
+		options[types.OptionRef] = addVolumeRef(v.Option(types.OptionRef), cid)
 	}
 
 	vm.LogVolumeEvent(ctx, name, "attach", map[string]string{"driver": v.Driver()})
@@ -207,20 +202,31 @@ func (vm *VolumeManager) Detach(ctx context.Context, name string, options map[st
 	cid, ok := options[types.OptionRef]
 	if ok && cid != "" {
 		ref := v.Option(types.OptionRef)
-		if !strings.Contains(ref, cid) {
+		if ref == "" {
 			return v, nil
 		}
 
-		if ref != "" {
-			ids := strings.Split(ref, ",")
-			ids = utils.StringSliceDelete(ids, cid)
-			if len(ids) > 0 {
-				options[types.OptionRef] = strings.Join(ids, ",")
-			} else {
-				options[types.OptionRef] = ""
-			}
+		ids := strings.Split(ref, ",")
+		if !utils.StringInSlice(ids, cid) {
+			return v, nil
 		}
+		options[types.OptionRef] = strings.Join(utils.StringSliceDelete(ids, cid), ",")
 	}
 	vm.LogVolumeEvent(ctx, name, "detach", map[string]string{"driver": v.Driver()})
 	return vm.core.DetachVolume(ctx, id, options)
 }
+
+// addVolumeRef returns the references of volume with the container id added.
+// The volume is attached again when the container starts, so the existing
+// references are kept if the container has referenced the volume.
+func addVolumeRef(ref, cid string) string {
+	if ref == "" {
+		return cid
+	}
+	for _, id := range strings.Split(ref, ",") {
+		if id == cid {
+			return ref
+		}
+	}
+	return ref + "," + cid
+}
@@ -0,0 +1,67 @@
+#!/usr/bin/env bash
+
+set -euo pipefail
+
+readonly TINI_VERSION="0.19.0"
+
+# pouch_init::check_version checks the command and the version.
+pouch_init::check_version() {
+  local has_installed version
+
+  has_installed="$(command -v pouch-init || echo false)"
+  if [[ "${has_installed}" = "false" ]]; then
+    echo false
+    exit 0
+  fi
+
+  version="$(pouch-init --version 2>&1 | cut -d " " -f 3)"
+  if [[ "${TINI_VERSION}" != "${version}" ]]; then
+    echo false
+    exit 0
+  fi
+
+  echo true
+}
+
+# pouch_init::install downloads the static tini from release url, which is
+# installed as pouch-init used by container with --init.
+pouch_init::install() {
+  local url target arch
+
+  target="/tmp/pouch-init"
+
+  arch="$(uname -m)"
+  case "${arch}" in
+    x86_64) arch="amd64" ;;
+    aarch64) arch="arm64" ;;
+    ppc64le) arch="ppc64el" ;;
+  esac
+
+  url="https://github.com/krallin/tini/releases/download"
+  url="${url}/v${TINI_VERSION}/tini-static-${arch}"
+
+  wget --quiet -O "${target}" "${url}"
+  mv "${target}" /usr/local/bin/
+  chmod +x /usr/local/bin/pouch-init
+}
+
+main() {
+  local has_installed
+
+  has_installed="$(pouch_init::check_version)"
+  if [[ "${has_installed}" = "true" ]]; then
+    echo "pouch-init(tini-${TINI_VERSION}) has been installed."
+    exit 0
+  fi
+
+  echo ">>>> install pouch-init(tini-${TINI_VERSION}) <<<<"
+
+  pouch_init::install
+
+  # final check
+  command -v pouch-init > /dev/null
+
+  echo
+}
+
+main
@@ -0,0 +1,127 @@
+package lxcfs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/docker/docker/pkg/reexec"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// containerLxcfsParentDir is the destination of LxcfsParentDir in container.
+const containerLxcfsParentDir = "/var/lib/lxc"
+
+// ProcFileBinds returns the binds of lxcfs proc files inside container,
+// the key is the destination and the value is the source.
+func ProcFileBinds() map[string]string {
+	sourceDir := path.Join(containerLxcfsParentDir, path.Base(LxcfsHomeDir), "proc")
+
+	binds := make(map[string]string, len(LxcfsProcFiles))
+	for _, procFile := range LxcfsProcFiles {
+		binds[path.Join("/proc", procFile)] = path.Join(sourceDir, procFile)
+	}
+	return binds
+}
+
+// RemountProcFiles re-binds the lxcfs proc files in the mount namespace of
+// the process, it is used to replace the dead binds after lxcfs restarts.
+func RemountProcFiles(pid int) error {
+	return RemountInNamespace(pid, ProcFileBinds())
+}
+
+// remountReexecName is the name of the reexec handler which remounts in the
+// mount namespace of other process.
+const remountReexecName = "pouch-lxcfs-remount"
+
+func init() {
+	reexec.Register(remountReexecName, remountReexecMain)
+}
+
+// RemountInNamespace unmounts the destinations and binds the sources on them
+// in the mount namespace of the process. The paths are resolved in the root
+// of the mount namespace.
+//
+// NOTE: the remount is done in a reexec child process, since the thread
+// entering other mount namespace may be the main thread which is never
+// terminated by go runtime, and the mount namespace would leak into the
+// whole daemon.
+func RemountInNamespace(pid int, binds map[string]string) error {
+	data, err := json.Marshal(binds)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal binds")
+	}
+
+	cmd := reexec.Command(remountReexecName, strconv.Itoa(pid))
+	cmd.Stdin = bytes.NewReader(data)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "failed to remount in mount namespace of process %d: %s", pid, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// remountReexecMain is the entry of reexec child process, which reads the
+// pid from args and the binds from stdin.
+func remountReexecMain() {
+	runtime.LockOSThread()
+
+	if len(os.Args) != 2 {
+		fmt.Fprintf(os.Stderr, "usage: %s <pid>\n", remountReexecName)
+		os.Exit(1)
+	}
+
+	pid, err := strconv.Atoi(os.Args[1])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid pid %s: %v\n", os.Args[1], err)
+		os.Exit(1)
+	}
+
+	binds := map[string]string{}
+	if err := json.NewDecoder(os.Stdin).Decode(&binds); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to decode binds: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := remountInNamespace(pid, binds); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func remountInNamespace(pid int, binds map[string]string) error {
+	// setns(CLONE_NEWNS) requires the thread doesn't share fs attributes
+	// with other threads.
+	if err := unix.Unshare(unix.CLONE_FS); err != nil {
+		return errors.Wrap(err, "failed to unshare fs attributes")
+	}
+
+	nsPath := fmt.Sprintf("/proc/%d/ns/mnt", pid)
+	fd, err := unix.Open(nsPath, unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %s", nsPath)
+	}
+	defer unix.Close(fd)
+
+	if err := unix.Setns(fd, unix.CLONE_NEWNS); err != nil {
+		return errors.Wrapf(err, "failed to enter mount namespace of process %d", pid)
+	}
+
+	for dest, source := range binds {
+		// the dead fuse mount can only be detached lazily.
+		if err := unix.Unmount(dest, unix.MNT_DETACH); err != nil && err != unix.EINVAL {
+			return errors.Wrapf(err, "failed to unmount %s", dest)
+		}
+
+		if err := unix.Mount(source, dest, "", unix.MS_BIND, ""); err != nil {
+			return errors.Wrapf(err, "failed to bind %s on %s", source, dest)
+		}
+	}
+	return nil
+}
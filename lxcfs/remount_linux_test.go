@@ -0,0 +1,96 @@
+package lxcfs
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/pkg/reexec"
+)
+
+func TestProcFileBinds(t *testing.T) {
+	LxcfsHomeDir = "/var/lib/lxcfs"
+
+	binds := ProcFileBinds()
+	if len(binds) != len(LxcfsProcFiles) {
+		t.Fatalf("expect %d binds, got %v", len(LxcfsProcFiles), binds)
+	}
+	if source := binds["/proc/meminfo"]; source != "/var/lib/lxc/lxcfs/proc/meminfo" {
+		t.Fatalf("expect source of /proc/meminfo to be /var/lib/lxc/lxcfs/proc/meminfo, got %s", source)
+	}
+}
+
+func TestRemountInNamespace(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("remount test requires root")
+	}
+	if _, err := exec.LookPath("unshare"); err != nil {
+		t.Skip("remount test requires unshare")
+	}
+
+	tmpDir, err := ioutil.TempDir("", "lxcfs-remount")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	source := filepath.Join(tmpDir, "source")
+	dest := filepath.Join(tmpDir, "dest")
+	if err := ioutil.WriteFile(source, []byte("new"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(dest, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("unshare", "-m", "--propagation", "private", "sleep", "30")
+	if err := cmd.Start(); err != nil {
+		t.Skipf("failed to create mount namespace: %v", err)
+	}
+	defer func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}()
+
+	// wait for the process to enter new mount namespace
+	pid := cmd.Process.Pid
+	self, _ := os.Readlink("/proc/self/ns/mnt")
+	for i := 0; ; i++ {
+		ns, _ := os.Readlink(filepath.Join("/proc", strconv.Itoa(pid), "ns/mnt"))
+		if ns != "" && ns != self {
+			break
+		}
+		if i == 100 {
+			t.Skip("process doesn't enter new mount namespace")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// remount twice to make sure the existing bind is replaced.
+	for i := 0; i < 2; i++ {
+		if err := RemountInNamespace(pid, map[string]string{dest: source}); err != nil {
+			t.Fatalf("failed to remount in namespace: %v", err)
+		}
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "root", dest))
+	if err != nil || string(data) != "new" {
+		t.Fatalf("expect dest to be bound in namespace, got %q, %v", data, err)
+	}
+
+	data, err = ioutil.ReadFile(dest)
+	if err != nil || string(data) != "old" {
+		t.Fatalf("expect dest not to be changed in host, got %q, %v", data, err)
+	}
+}
+
+func TestMain(m *testing.M) {
+	if reexec.Init() {
+		return
+	}
+	os.Exit(m.Run())
+}
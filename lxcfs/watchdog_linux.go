@@ -0,0 +1,190 @@
+package lxcfs
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/containerd/containerd/mount"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultWatchdogInterval is the default interval of checking lxcfs mount.
+	DefaultWatchdogInterval = 5 * time.Second
+
+	// MaxRestartRetries is the max times of retrying the containers which
+	// fail to be handled after lxcfs restarts.
+	MaxRestartRetries = 5
+)
+
+// mountKey identifies a mount of lxcfs. The mount id may be reused after the
+// old mount is unmounted, but the device of the old fuse superblock is kept
+// by the binds in containers, so it isn't reused until they are remounted.
+type mountKey struct {
+	ID    int
+	Major int
+	Minor int
+}
+
+// Watchdog watches the lxcfs fuse mount, it detects the mount becomes stale
+// (ENOTCONN) when lxcfs exits, and the mount is replaced when lxcfs restarts.
+// The watchdog doesn't hold the mount, so that lxcfs can be unmounted.
+type Watchdog struct {
+	// HomeDir is the mount dir of lxcfs.
+	HomeDir string
+
+	// Interval is the interval of checking lxcfs mount.
+	Interval time.Duration
+
+	// OnStale is called when the lxcfs mount becomes unavailable.
+	OnStale func(err error)
+
+	// OnRestart is called when lxcfs recovers from being stale or restarts.
+	// The ids are the containers to handle, nil means all of them. The ids
+	// which fail are returned and retried with backoff, at most
+	// MaxRestartRetries times. If error is returned, the ids are retried.
+	OnRestart func(ids []string) ([]string, error)
+
+	// status returns the mount of lxcfs, or the error if lxcfs is unavailable.
+	status func() (mountKey, error)
+	// now returns the current time.
+	now func() time.Time
+
+	mount mountKey
+	stale bool
+
+	// retrying is true if there are containers to retry, pending are the
+	// containers and nil means all of them.
+	retrying  bool
+	pending   []string
+	retries   int
+	nextRetry time.Time
+}
+
+// NewWatchdog creates a watchdog of lxcfs mounted on homeDir.
+func NewWatchdog(homeDir string, interval time.Duration, onStale func(err error), onRestart func(ids []string) ([]string, error)) *Watchdog {
+	if interval <= 0 {
+		interval = DefaultWatchdogInterval
+	}
+
+	return &Watchdog{
+		HomeDir:   homeDir,
+		Interval:  interval,
+		OnStale:   onStale,
+		OnRestart: onRestart,
+		status: func() (mountKey, error) {
+			return mountStatus(homeDir)
+		},
+		now: time.Now,
+	}
+}
+
+// Run checks the lxcfs mount periodically until stopCh is closed.
+func (w *Watchdog) Run(stopCh <-chan struct{}) {
+	// record the current mount of lxcfs, the containers are bound to it.
+	var err error
+	if w.mount, err = w.status(); err != nil {
+		log.With(nil).Errorf("lxcfs mounted on %s is unavailable: %v", w.HomeDir, err)
+		w.stale = true
+	}
+
+	tick := time.NewTicker(w.Interval)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-tick.C:
+			w.check()
+		}
+	}
+}
+
+// check checks the lxcfs mount once.
+func (w *Watchdog) check() {
+	key, err := w.status()
+	if err != nil {
+		if !w.stale {
+			w.stale = true
+			log.With(nil).Errorf("lxcfs mounted on %s becomes unavailable: %v", w.HomeDir, err)
+			if w.OnStale != nil {
+				w.OnStale(err)
+			}
+		}
+		return
+	}
+
+	if !w.stale && key == w.mount {
+		if w.retrying && !w.now().Before(w.nextRetry) {
+			log.With(nil).Infof("retry to handle lxcfs restart, retries %d", w.retries)
+			w.restart(w.pending)
+		}
+		return
+	}
+
+	log.With(nil).Infof("lxcfs mounted on %s is restarted, mount changes from %+v to %+v", w.HomeDir, w.mount, key)
+	w.stale = false
+	w.mount = key
+	w.retrying, w.pending, w.retries = false, nil, 0
+	w.restart(nil)
+}
+
+// restart calls OnRestart with the containers, and schedules the retry of
+// the containers which fail.
+func (w *Watchdog) restart(ids []string) {
+	if w.OnRestart == nil {
+		return
+	}
+
+	failed, err := w.OnRestart(ids)
+	if err != nil {
+		log.With(nil).Errorf("failed to handle lxcfs restart: %v", err)
+		failed = ids
+	} else if len(failed) == 0 {
+		w.retrying, w.pending = false, nil
+		return
+	}
+
+	if w.retries >= MaxRestartRetries {
+		log.With(nil).Errorf("give up handling lxcfs restart after %d retries, containers: %v", w.retries, failed)
+		w.retrying, w.pending = false, nil
+		return
+	}
+
+	// retry after Interval*2, Interval*4 and so on.
+	w.retries++
+	w.retrying, w.pending = true, failed
+	w.nextRetry = w.now().Add(w.Interval << uint(w.retries))
+}
+
+// mountStatus returns the mount of homeDir, the error is returned if
+// homeDir is not a mountpoint or the fuse connection is broken.
+func mountStatus(homeDir string) (mountKey, error) {
+	homeDir = filepath.Clean(homeDir)
+
+	mounts, err := mount.Self()
+	if err != nil {
+		return mountKey{}, errors.Wrap(err, "failed to read mountinfo")
+	}
+
+	var key mountKey
+	for _, m := range mounts {
+		// the latest mount on the same mountpoint is the visible one.
+		if m.Mountpoint == homeDir && m.ID > key.ID {
+			key = mountKey{ID: m.ID, Major: m.Major, Minor: m.Minor}
+		}
+	}
+	if key.ID == 0 {
+		return mountKey{}, errors.Errorf("%s is not a mountpoint", homeDir)
+	}
+
+	// the dead fuse mount returns ENOTCONN.
+	if _, err := os.Stat(filepath.Join(homeDir, "proc", "meminfo")); err != nil {
+		return mountKey{}, err
+	}
+	return key, nil
+}
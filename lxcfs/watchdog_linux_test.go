@@ -0,0 +1,155 @@
+package lxcfs
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestWatchdogCheck(t *testing.T) {
+	var (
+		stale, restart int
+		restartIDs     []string
+		failed         []string
+		restartErr     error
+		id             int
+		statusErr      error
+		now            = time.Now()
+	)
+
+	w := NewWatchdog("/var/lib/lxcfs", time.Second,
+		func(err error) { stale++ },
+		func(ids []string) ([]string, error) {
+			restart++
+			restartIDs = ids
+			return failed, restartErr
+		},
+	)
+	w.status = func() (mountKey, error) { return mountKey{ID: id}, statusErr }
+	w.now = func() time.Time { return now }
+	w.mount = mountKey{ID: 10}
+
+	// healthy
+	id = 10
+	w.check()
+	if stale != 0 || restart != 0 {
+		t.Fatalf("expect nothing happens, got stale %d, restart %d", stale, restart)
+	}
+
+	// lxcfs exits, stale is reported only once
+	statusErr = syscall.ENOTCONN
+	w.check()
+	w.check()
+	if stale != 1 || restart != 0 {
+		t.Fatalf("expect stale to be reported once, got stale %d, restart %d", stale, restart)
+	}
+
+	// lxcfs restarts, and remount fails for all containers
+	statusErr, id, restartErr = nil, 11, errors.New("remount failed")
+	w.check()
+	if restart != 1 || restartIDs != nil || w.mount.ID != 11 || w.stale {
+		t.Fatalf("expect restart to be handled, got restart %d, ids %v, mount %+v, stale %v", restart, restartIDs, w.mount, w.stale)
+	}
+
+	// the retry waits for backoff
+	restartErr, failed = nil, []string{"c1"}
+	w.check()
+	if restart != 1 {
+		t.Fatalf("expect retry to wait for backoff, got restart %d", restart)
+	}
+
+	// retry all containers, and c1 fails again
+	now = now.Add(2 * time.Second)
+	w.check()
+	if restart != 2 || restartIDs != nil {
+		t.Fatalf("expect all containers to be retried, got restart %d, ids %v", restart, restartIDs)
+	}
+
+	// only the failed container is retried until the max retries
+	for i := 0; i < MaxRestartRetries; i++ {
+		now = now.Add(time.Hour)
+		w.check()
+	}
+	if restart != 1+MaxRestartRetries || !reflect.DeepEqual(restartIDs, []string{"c1"}) || w.retrying {
+		t.Fatalf("expect c1 to be retried until max retries, got restart %d, ids %v, retrying %v", restart, restartIDs, w.retrying)
+	}
+
+	now = now.Add(time.Hour)
+	w.check()
+	if restart != 1+MaxRestartRetries {
+		t.Fatalf("expect no more retry, got restart %d", restart)
+	}
+
+	// lxcfs restarts without being detected stale
+	id, failed = 12, nil
+	w.check()
+	if stale != 1 || restart != 2+MaxRestartRetries || w.mount.ID != 12 || w.retrying {
+		t.Fatalf("expect restart to be handled, got stale %d, restart %d, mount %+v", stale, restart, w.mount)
+	}
+}
+
+func TestMountStatus(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("mount test requires root")
+	}
+
+	homeDir, err := ioutil.TempDir("", "lxcfs-watchdog")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(homeDir)
+
+	if _, err := mountStatus(homeDir); err == nil {
+		t.Fatalf("expect error for dir which is not a mountpoint")
+	}
+
+	mountFake := func() {
+		if err := syscall.Mount("tmpfs", homeDir, "tmpfs", 0, ""); err != nil {
+			t.Skipf("failed to mount tmpfs: %v", err)
+		}
+		if err := os.MkdirAll(filepath.Join(homeDir, "proc"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(homeDir, "proc", "meminfo"), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mountFake()
+	key1, err := mountStatus(homeDir)
+	if err != nil {
+		t.Fatalf("failed to get mount status: %v", err)
+	}
+
+	// the binds in containers keep the old superblock, simulate it by
+	// binding the mount to another dir before unmounting.
+	holder, err := ioutil.TempDir("", "lxcfs-holder")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(holder)
+	if err := syscall.Mount(homeDir, holder, "", syscall.MS_BIND, ""); err != nil {
+		t.Fatalf("failed to bind mount: %v", err)
+	}
+	defer syscall.Unmount(holder, syscall.MNT_DETACH)
+
+	// the mount isn't held by watchdog, so it can be unmounted.
+	if err := syscall.Unmount(homeDir, 0); err != nil {
+		t.Fatalf("failed to unmount: %v", err)
+	}
+	mountFake()
+	defer syscall.Unmount(homeDir, syscall.MNT_DETACH)
+
+	key2, err := mountStatus(homeDir)
+	if err != nil {
+		t.Fatalf("failed to get mount status: %v", err)
+	}
+	if key1 == key2 {
+		t.Fatalf("expect mount to be changed after remount, got %+v", key1)
+	}
+}
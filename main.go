@@ -75,13 +75,16 @@ func setupFlags(cmd *cobra.Command) {
 	flagSet.StringVar(&cfg.HomeDir, "home-dir", "/var/lib/pouch", "Specify root dir of pouchd")
 	flagSet.StringArrayVarP(&cfg.Listen, "listen", "l", []string{"unix:///var/run/pouchd.sock"}, "Specify listening addresses of Pouchd")
 	flagSet.BoolVar(&cfg.IsCriEnabled, "enable-cri", false, "Specify whether enable the cri part of pouchd which is used to support Kubernetes")
-	flagSet.StringVar(&cfg.CriConfig.CriVersion, "cri-version", "v1alpha2", "Specify the version of cri which is used to support Kubernetes")
+	flagSet.StringVar(&cfg.CriConfig.CriVersion, "cri-version", "v1", "Specify the version of cri which is used to support Kubernetes, v1 serves both v1 and v1alpha2")
 	flagSet.StringVar(&cfg.CriConfig.Listen, "listen-cri", "unix:///var/run/pouchcri.sock", "Specify listening address of CRI")
 	flagSet.StringVar(&cfg.CriConfig.NetworkPluginBinDir, "cni-bin-dir", "/opt/cni/bin", "The directory for putting cni plugin binaries.")
 	flagSet.StringVar(&cfg.CriConfig.NetworkPluginConfDir, "cni-conf-dir", "/etc/cni/net.d", "The directory for putting cni plugin configuration files.")
 	flagSet.StringVar(&cfg.CriConfig.SandboxImage, "sandbox-image", "registry.cn-hangzhou.aliyuncs.com/google-containers/pause-amd64:3.0", "The image used by sandbox container.")
 	flagSet.StringVar(&cfg.CriConfig.StreamServerPort, "stream-server-port", "10010", "The port stream server of cri is listening on.")
 	flagSet.BoolVar(&cfg.CriConfig.StreamServerReusePort, "stream-server-reuse-port", false, "Specify whether cri stream server share port with pouchd. If this is true, the listen option of pouchd should specify a tcp socket and its port should be same with stream-server-port.")
+	flagSet.StringVar(&cfg.CriConfig.StreamServerTLSCert, "stream-server-tlscert", "", "Specify cert file of TLS for cri stream server.")
+	flagSet.StringVar(&cfg.CriConfig.StreamServerTLSKey, "stream-server-tlskey", "", "Specify key file of TLS for cri stream server.")
+	flagSet.StringVar(&cfg.CriConfig.StreamServerTLSCA, "stream-server-tlscacert", "", "Specify CA file to verify the client certs of cri stream server.")
 	flagSet.IntVar(&cfg.CriConfig.CriStatsCollectPeriod, "cri-stats-collect-period", 10, "The time duration (in time.Second) cri collect stats from containerd.")
 	flagSet.BoolVar(&cfg.CriConfig.EnableCriStatsCollect, "enable-cri-stats-collect", false, "Specify whether cri collect stats from containerd. If this is true, option CriStatsCollectPeriod will take effect.")
 	flagSet.StringVar(&cfg.CriConfig.RuntimeConfigFile, "cni-runtime-config", "/etc/pouch/cni-runtime-config.json", "A config file to make the cni runtime config persistent.")
@@ -98,6 +101,7 @@ func setupFlags(cmd *cobra.Command) {
 	flagSet.BoolVar(&cfg.IsLxcfsEnabled, "enable-lxcfs", false, "Enable Lxcfs to make container to isolate /proc")
 	flagSet.StringVar(&cfg.LxcfsBinPath, "lxcfs", "/usr/local/bin/lxcfs", "Specify the path of lxcfs binary")
 	flagSet.StringVar(&cfg.LxcfsHome, "lxcfs-home", "/var/lib/lxcfs", "Specify the mount dir of lxcfs")
+	flagSet.StringVar(&cfg.InitPath, "init-path", "", "Specify the path of init binary used by container with --init, pouch-init in PATH is used if not specified")
 	flagSet.StringVar(&cfg.DefaultRegistry, "default-registry", "registry.hub.docker.com", "Default Image Registry")
 	flagSet.StringVar(&cfg.DefaultRegistryNS, "default-registry-namespace", "library", "Default Image Registry namespace")
 	flagSet.StringVar(&cfg.ImageProxy, "image-proxy", "", "Http proxy to pull image")
@@ -105,6 +109,9 @@ func setupFlags(cmd *cobra.Command) {
 	flagSet.StringVar(&cfg.ConfigFile, "config-file", "/etc/pouch/config.json", "Configuration file of pouchd")
 	flagSet.StringVar(&cfg.Snapshotter, "snapshotter", "overlayfs", "Snapshotter driver of pouchd, it will be passed to containerd")
 	flagSet.BoolVar(&cfg.AllowMultiSnapshotter, "allow-multi-snapshotter", false, "If set true, pouchd will allow multi snapshotter")
+	flagSet.BoolVar(&cfg.RemoveOrphanSnapshots, "remove-orphan-snapshots", false, "If set true, pouchd will remove the snapshots which belong to no container and no image when it starts")
+	flagSet.StringVar(&cfg.UsernsSubIDRange, "userns-subid-range", "", "Set the range of subordinate ids on host in the form of start:size, which are allocated to the user namespaces of containers")
+	flagSet.Uint32Var(&cfg.UsernsSubIDSize, "userns-subid-size", config.DefaultUsernsSubIDSize, "Set the number of subordinate ids allocated to each user namespace")
 
 	// volume config
 	flagSet.StringVar(&cfg.VolumeConfig.DriverAlias, "volume-driver-alias", "", "Set volume driver alias, <name=alias>[;name1=alias1]")
@@ -0,0 +1,284 @@
+package userns
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/alibaba/pouch/pkg/meta"
+)
+
+const (
+	// RemapOwner is the owner of the range shared by the containers in remap mode.
+	RemapOwner = "remap"
+
+	// maxID is the number of ids can be used in user namespace.
+	maxID = 1 << 32
+)
+
+// IDRange is a range of subordinate ids on the host.
+type IDRange struct {
+	// Start is the first id of the range.
+	Start uint32
+	// Size is the number of ids in the range.
+	Size uint32
+}
+
+// End returns the id after the last id of the range.
+func (r IDRange) End() uint64 {
+	return uint64(r.Start) + uint64(r.Size)
+}
+
+// Overlaps returns whether the two ranges overlap.
+func (r IDRange) Overlaps(o IDRange) bool {
+	return uint64(r.Start) < o.End() && uint64(o.Start) < r.End()
+}
+
+// Contains returns whether the range contains the other one.
+func (r IDRange) Contains(o IDRange) bool {
+	return r.Start <= o.Start && o.End() <= r.End()
+}
+
+// String returns the range in the form of start:size.
+func (r IDRange) String() string {
+	return fmt.Sprintf("%d:%d", r.Start, r.Size)
+}
+
+// ParseIDRange parses the range in the form of start:size.
+func ParseIDRange(s string) (IDRange, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return IDRange{}, fmt.Errorf("invalid id range %q, it should be in the form of start:size", s)
+	}
+	start, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return IDRange{}, fmt.Errorf("invalid start of id range %q: %v", s, err)
+	}
+	size, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return IDRange{}, fmt.Errorf("invalid size of id range %q: %v", s, err)
+	}
+
+	r := IDRange{Start: uint32(start), Size: uint32(size)}
+	if r.Start == 0 || r.Size == 0 {
+		return IDRange{}, fmt.Errorf("invalid id range %q, start and size should be greater than 0", s)
+	}
+	if r.End() > maxID {
+		return IDRange{}, fmt.Errorf("invalid id range %q, the end exceeds %d", s, uint64(maxID))
+	}
+	return r, nil
+}
+
+// Allocation is a range of ids allocated to an owner.
+type Allocation struct {
+	// Owner is the id of container, RemapOwner, or the reserved range in
+	// the form of remap:start:size.
+	Owner string
+	IDRange
+
+	// Refs are the containers which share the reserved range.
+	Refs []string `json:"refs,omitempty"`
+}
+
+// Key returns the owner of allocation.
+func (a *Allocation) Key() string {
+	return a.Owner
+}
+
+// Allocator allocates the ranges of subordinate ids in the pool to containers,
+// the allocations are persisted in the meta store to survive daemon restart.
+type Allocator struct {
+	sync.Mutex
+
+	pool  IDRange
+	size  uint32
+	store *meta.Store
+
+	allocations map[string]IDRange
+	// refs are the containers referencing the reserved ranges.
+	refs map[string][]string
+}
+
+// NewAllocator creates an allocator which allocates ranges of size from the pool,
+// the allocations are stored in baseDir.
+func NewAllocator(baseDir string, pool IDRange, size uint32) (*Allocator, error) {
+	if size == 0 || size > pool.Size {
+		return nil, fmt.Errorf("invalid size %d of allocation, it should be in (0, %d]", size, pool.Size)
+	}
+
+	store, err := meta.NewStore(meta.Config{
+		Driver:  "local",
+		BaseDir: baseDir,
+		Buckets: []meta.Bucket{
+			{
+				Name: meta.MetaJSONFile,
+				Type: reflect.TypeOf(Allocation{}),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user namespace meta store: %v", err)
+	}
+
+	a := &Allocator{
+		pool:        pool,
+		size:        size,
+		store:       store,
+		allocations: make(map[string]IDRange),
+		refs:        make(map[string][]string),
+	}
+
+	if err := store.ForEach(func(obj meta.Object) error {
+		alloc, ok := obj.(*Allocation)
+		if !ok {
+			return nil
+		}
+		a.allocations[alloc.Owner] = alloc.IDRange
+		if len(alloc.Refs) > 0 {
+			a.refs[alloc.Owner] = alloc.Refs
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to load user namespace allocations: %v", err)
+	}
+
+	return a, nil
+}
+
+// Allocate allocates a range to the owner, the same range is returned
+// if the owner has been allocated.
+func (a *Allocator) Allocate(owner string) (IDRange, error) {
+	a.Lock()
+	defer a.Unlock()
+
+	if r, ok := a.allocations[owner]; ok {
+		return r, nil
+	}
+
+	allocated := make([]IDRange, 0, len(a.allocations))
+	for _, r := range a.allocations {
+		allocated = append(allocated, r)
+	}
+	sort.Slice(allocated, func(i, j int) bool {
+		return allocated[i].Start < allocated[j].Start
+	})
+
+	// find the first gap which can hold the range.
+	next := uint64(a.pool.Start)
+	for _, r := range allocated {
+		if next+uint64(a.size) <= uint64(r.Start) {
+			break
+		}
+		if r.End() > next {
+			next = r.End()
+		}
+	}
+	if next+uint64(a.size) > a.pool.End() {
+		return IDRange{}, fmt.Errorf("no subordinate ids left in %s for user namespace", a.pool)
+	}
+
+	r := IDRange{Start: uint32(next), Size: a.size}
+	if err := a.put(owner, r, nil); err != nil {
+		return IDRange{}, err
+	}
+	return r, nil
+}
+
+// Reserve reserves the range specified by container id. The range out of
+// the pool is not managed by allocator, while the range in the pool should
+// not overlap with the allocated ones. The containers which specify the same
+// range share it, and it is released after all of them release it.
+func (a *Allocator) Reserve(id string, r IDRange) error {
+	a.Lock()
+	defer a.Unlock()
+
+	if !a.pool.Overlaps(r) {
+		return nil
+	}
+	if !a.pool.Contains(r) {
+		return fmt.Errorf("id range %s is partially in the pool %s", r, a.pool)
+	}
+
+	key := reservedKey(r)
+	for o, allocated := range a.allocations {
+		if o == key {
+			continue
+		}
+		if allocated.Overlaps(r) {
+			return fmt.Errorf("id range %s overlaps with the range %s allocated to %s", r, allocated, o)
+		}
+	}
+
+	refs := a.refs[key]
+	for _, ref := range refs {
+		if ref == id {
+			return nil
+		}
+	}
+	return a.put(key, r, append(refs[:len(refs):len(refs)], id))
+}
+
+// Release releases the range of the owner, and the references of the owner
+// to the reserved ranges.
+func (a *Allocator) Release(owner string) error {
+	a.Lock()
+	defer a.Unlock()
+
+	for key, refs := range a.refs {
+		left := make([]string, 0, len(refs))
+		for _, ref := range refs {
+			if ref != owner {
+				left = append(left, ref)
+			}
+		}
+		if len(left) == len(refs) {
+			continue
+		}
+
+		if len(left) > 0 {
+			if err := a.put(key, a.allocations[key], left); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := a.remove(key); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := a.allocations[owner]; !ok {
+		return nil
+	}
+	return a.remove(owner)
+}
+
+// reservedKey returns the owner of the reserved range.
+func reservedKey(r IDRange) string {
+	return RemapOwner + ":" + r.String()
+}
+
+// remove removes the range of owner, the caller should hold the lock.
+func (a *Allocator) remove(owner string) error {
+	if err := a.store.Remove(owner); err != nil {
+		return fmt.Errorf("failed to release user namespace ids of %s: %v", owner, err)
+	}
+	delete(a.allocations, owner)
+	delete(a.refs, owner)
+	return nil
+}
+
+// put persists the range of owner and the containers referencing it, the
+// caller should hold the lock.
+func (a *Allocator) put(owner string, r IDRange, refs []string) error {
+	if err := a.store.Put(&Allocation{Owner: owner, IDRange: r, Refs: refs}); err != nil {
+		return fmt.Errorf("failed to store user namespace ids of %s: %v", owner, err)
+	}
+	a.allocations[owner] = r
+	if len(refs) > 0 {
+		a.refs[owner] = refs
+	}
+	return nil
+}
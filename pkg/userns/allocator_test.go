@@ -0,0 +1,114 @@
+package userns
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIDRange(t *testing.T) {
+	for _, tc := range []struct {
+		s       string
+		want    IDRange
+		wantErr bool
+	}{
+		{s: "100000:65536", want: IDRange{Start: 100000, Size: 65536}},
+		{s: "4294901760:65536", want: IDRange{Start: 4294901760, Size: 65536}},
+		{s: "100000", wantErr: true},
+		{s: "0:65536", wantErr: true},
+		{s: "100000:0", wantErr: true},
+		{s: "-1:65536", wantErr: true},
+		{s: "4294901761:65536", wantErr: true},
+	} {
+		r, err := ParseIDRange(tc.s)
+		assert.Equal(t, tc.wantErr, err != nil, tc.s)
+		assert.Equal(t, tc.want, r, tc.s)
+	}
+}
+
+func TestAllocator(t *testing.T) {
+	dir, err := ioutil.TempDir("", "userns-allocator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	baseDir := filepath.Join(dir, "userns")
+	pool := IDRange{Start: 100000, Size: 3 * 65536}
+	a, err := NewAllocator(baseDir, pool, 65536)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r1, err := a.Allocate("c1")
+	assert.NoError(t, err)
+	assert.Equal(t, IDRange{Start: 100000, Size: 65536}, r1)
+
+	// the same range is returned to the same owner.
+	r, err := a.Allocate("c1")
+	assert.NoError(t, err)
+	assert.Equal(t, r1, r)
+
+	r2, err := a.Allocate(RemapOwner)
+	assert.NoError(t, err)
+	assert.Equal(t, IDRange{Start: 165536, Size: 65536}, r2)
+
+	// the reserved range can't overlap with the allocated ones.
+	assert.Error(t, a.Reserve("c3", IDRange{Start: 200000, Size: 65536}))
+	assert.Error(t, a.Reserve("c3", IDRange{Start: 50000, Size: 65536}))
+	assert.NoError(t, a.Reserve("c3", IDRange{Start: 10000, Size: 65536}))
+	assert.NoError(t, a.Reserve("c3", IDRange{Start: 231072, Size: 65536}))
+
+	// the pool is used up.
+	_, err = a.Allocate("c4")
+	assert.Error(t, err)
+
+	// the same range is shared, and kept until all the containers release it.
+	assert.NoError(t, a.Reserve("c5", IDRange{Start: 231072, Size: 65536}))
+	assert.Error(t, a.Reserve("c6", IDRange{Start: 231073, Size: 65535}))
+	assert.NoError(t, a.Release("c3"))
+	_, err = a.Allocate("c4")
+	assert.Error(t, err)
+
+	// the released range can be allocated again.
+	assert.NoError(t, a.Release("c1"))
+	assert.NoError(t, a.Release("c1"))
+	r4, err := a.Allocate("c4")
+	assert.NoError(t, err)
+	assert.Equal(t, r1, r4)
+
+	// the allocations survive the restart.
+	a, err = NewAllocator(baseDir, pool, 65536)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err = a.Allocate(RemapOwner)
+	assert.NoError(t, err)
+	assert.Equal(t, r2, r)
+	r, err = a.Allocate("c4")
+	assert.NoError(t, err)
+	assert.Equal(t, r4, r)
+	_, err = a.Allocate("c6")
+	assert.Error(t, err)
+
+	assert.NoError(t, a.Release("c5"))
+	r, err = a.Allocate("c6")
+	assert.NoError(t, err)
+	assert.Equal(t, IDRange{Start: 231072, Size: 65536}, r)
+}
+
+func TestNewAllocatorInvalidSize(t *testing.T) {
+	dir, err := ioutil.TempDir("", "userns-allocator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	_, err = NewAllocator(dir, IDRange{Start: 100000, Size: 65536}, 65537)
+	assert.Error(t, err)
+	_, err = NewAllocator(dir, IDRange{Start: 100000, Size: 65536}, 0)
+	assert.Error(t, err)
+}
@@ -7,12 +7,9 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
 	"sync"
 
-	"github.com/alibaba/pouch/pkg/bytefmt"
-	"github.com/alibaba/pouch/pkg/exec"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/system"
 
@@ -47,20 +44,23 @@ func (quota *GrpQuotaDriver) EnforceQuota(dir string) (*MountInfo, error) {
 		return nil, fmt.Errorf("failed to find mountpoint: (%s)", dir)
 	}
 
+	device, err := getMountPointDevice(mountPoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get device of mountpoint: (%s)", mountPoint)
+	}
+
 	mountInfo := &MountInfo{
 		MountPoint: mountPoint,
 		FsType:     fsType,
 		DeviceID:   devID,
+		Device:     device,
 	}
 
 	if !hasQuota {
 		// remount option grpquota for mountpoint
-		exit, stdout, stderr, err := exec.Run(0, "mount", "-o", "remount,grpquota", mountPoint)
-		if err != nil {
-			log.With(nil).Errorf("failed to remount grpquota, mountpoint: (%s), stdout: (%s), stderr: (%s), exit: (%d), err: (%v)",
-				mountPoint, stdout, stderr, exit, err)
-			return nil, errors.Wrapf(err, "failed to remount grpquota, mountpoint: (%s), stdout: (%s), stderr: (%s), exit: (%d)",
-				mountPoint, stdout, stderr, exit)
+		if err := remountWithOption(mountPoint, "grpquota"); err != nil {
+			log.With(nil).Errorf("failed to remount grpquota, mountpoint: (%s), err: (%v)", mountPoint, err)
+			return nil, errors.Wrapf(err, "failed to remount grpquota, mountpoint: (%s)", mountPoint)
 		}
 	}
 
@@ -68,8 +68,13 @@ func (quota *GrpQuotaDriver) EnforceQuota(dir string) (*MountInfo, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get vfs version and quota file")
 	}
+	format, err := getQuotaFormat(vfsVersion)
+	if err != nil {
+		return nil, err
+	}
 
 	filename := mountPoint + "/" + quotaFilename
+	created := false
 	if _, err := os.Stat(filename); err != nil {
 		os.Remove(mountPoint + "/aquota.user")
 
@@ -84,38 +89,38 @@ func (quota *GrpQuotaDriver) EnforceQuota(dir string) (*MountInfo, error) {
 			return nil, errors.Wrapf(writeErr, "failed to write file, filename: (%s), vfs version: (%s)",
 				filename, vfsVersion)
 		}
-		if exit, stdout, stderr, err := exec.Run(0, "setquota", "-g", "-t", "43200", "43200", mountPoint); err != nil {
+		created = true
+	}
+
+	// turn on group quota, it is no-op if the quota has been turned on.
+	if err := quotaOn(device, grpQuotaType, format, filename); err != nil {
+		if created {
 			os.Remove(filename)
-			log.With(nil).Errorf("failed to setquota, stdout: (%s), stderr: (%s), exit: (%d), err: (%v)",
-				stdout, stderr, exit, err)
-			return nil, errors.Wrapf(err, "failed to setquota, stdout: (%s), stderr: (%s), exit: (%d)",
-				stdout, stderr, exit)
 		}
-		if err := quota.setQuota(0, 0, mountPoint); err != nil {
+		log.With(nil).Errorf("failed to quota on for mountpoint: (%s), device: (%s), err: (%v)", mountPoint, device, err)
+		return nil, errors.Wrapf(err, "failed to quota on for mountpoint: (%s), device: (%s)", mountPoint, device)
+	}
+
+	if created {
+		if err := quota.initQuotaFile(mountInfo); err != nil {
+			quotaOff(device, grpQuotaType)
 			os.Remove(filename)
-			log.With(nil).Errorf("failed to set quota, mountpoint: (%s), err: (%v)", mountPoint, err)
-			return nil, errors.Wrapf(err, "failed to set quota, mountpoint: (%s)", mountPoint)
+			log.With(nil).Errorf("failed to init quota file, mountpoint: (%s), err: (%v)", mountPoint, err)
+			return nil, errors.Wrapf(err, "failed to init quota file, mountpoint: (%s)", mountPoint)
 		}
 	}
 
-	// check group quota status, on or not, pay attention, the right exit code of command 'quotaon' is '1'.
-	exit, stdout, stderr, err := exec.Run(0, "quotaon", "-pg", mountPoint)
-	if err != nil && exit != 1 {
-		log.With(nil).Errorf("failed to quota on for mountpoint: (%s), exit: (%d), stdout: (%s), stderr: (%s), err: (%v)",
-			mountPoint, exit, stdout, stderr, err)
-		return nil, errors.Wrapf(err, "failed to quota on for mountpoint: (%s), stdout: (%s), stderr: (%s), exit: (%d)",
-			mountPoint, stdout, stderr, exit)
-	}
-	if strings.Contains(stdout, " is on") {
-		return mountInfo, nil
-	}
-	if exit, stdout, stderr, err = exec.Run(0, "quotaon", mountPoint); err != nil {
-		mountPoint = ""
-		err = errors.Wrapf(err, "failed to quotaon, mountpoint: (%s), stdout: (%s), stderr: (%s), exit: (%d)",
-			mountPoint, stdout, stderr, exit)
-	}
+	return mountInfo, nil
+}
 
-	return mountInfo, err
+// initQuotaFile sets the grace time and the limits of quota id 0 in the new quota file,
+// it is the same with `setquota -g -t 43200 43200 mountpoint`
+// and `setquota -g 0 0 0 0 0 mountpoint`.
+func (quota *GrpQuotaDriver) initQuotaFile(mountInfo *MountInfo) error {
+	if err := setQuotaGrace(mountInfo.Device, grpQuotaType, 43200, 43200); err != nil {
+		return errors.Wrapf(err, "failed to set grace time, device: (%s)", mountInfo.Device)
+	}
+	return quota.setQuota(0, 0, InodeLimit{}, mountInfo)
 }
 
 // CheckMountpoint is used to check mount point.
@@ -182,8 +187,9 @@ func (quota *GrpQuotaDriver) CheckMountpoint(devID uint64) (string, bool, string
 }
 
 // SetDiskQuota is used to set quota for directory.
-func (quota *GrpQuotaDriver) SetDiskQuota(dir string, size string, quotaID uint32) error {
-	log.With(nil).Debugf("set disk quota, dir: %s, size: %s, quotaID: %d", dir, size, quotaID)
+func (quota *GrpQuotaDriver) SetDiskQuota(dir string, size string, inodes InodeLimit, quotaID uint32) error {
+	log.With(nil).Debugf("set disk quota, dir: %s, size: %s, inodes: %d/%d, quotaID: %d",
+		dir, size, inodes.Soft, inodes.Hard, quotaID)
 
 	mountInfo, err := quota.EnforceQuota(dir)
 	if err != nil {
@@ -194,9 +200,9 @@ func (quota *GrpQuotaDriver) SetDiskQuota(dir string, size string, quotaID uint3
 	}
 
 	// transfer limit from kbyte to byte
-	limit, err := bytefmt.ToKilobytes(size)
+	limit, err := getBlockLimit(size)
 	if err != nil {
-		return errors.Wrapf(err, "failed to change size: (%s) to kilobytes", size)
+		return err
 	}
 
 	if err := checkDevLimit(mountInfo, limit*1024); err != nil {
@@ -211,22 +217,20 @@ func (quota *GrpQuotaDriver) SetDiskQuota(dir string, size string, quotaID uint3
 		return errors.Errorf("failed to find quota id to set subtree")
 	}
 
-	return quota.setQuota(id, limit, mountInfo.MountPoint)
+	return quota.setQuota(id, limit, inodes, mountInfo)
 }
 
 // GetQuotaIDInFileAttr returns quota ID in the directory attributes.
-// getfattr -n system.subtree --only-values --absolute-names /
+// It is the same with `getfattr -n system.subtree --only-values --absolute-names $DIR`.
 func (quota *GrpQuotaDriver) GetQuotaIDInFileAttr(dir string) uint32 {
 	log.With(nil).Debugf("get file attr, dir: %s", dir)
 
-	exit, stdout, stderr, err := exec.Run(0, "getfattr", "-n", "system.subtree", "--only-values", "--absolute-names", dir)
+	id, err := getSubtreeID(dir)
 	if err != nil {
-		log.With(nil).Errorf("failed to getfattr, dir: (%s), stdout: (%s), stderr: (%s), exit: (%d), err: (%s)",
-			dir, stdout, stderr, exit, err)
+		log.With(nil).Errorf("failed to get file attr, dir: (%s), err: (%v)", dir, err)
 		return 0
 	}
-	v, _ := strconv.Atoi(stdout)
-	return uint32(v)
+	return id
 }
 
 // SetQuotaIDInFileAttr is used to set quota ID in file attributes.
@@ -238,10 +242,7 @@ func (quota *GrpQuotaDriver) SetQuotaIDInFileAttr(dir string, id uint32) error {
 		return err
 	}
 
-	strid := strconv.FormatUint(uint64(id), 10)
-	exit, stdout, stderr, err := exec.Run(0, "setfattr", "-n", "system.subtree", "-v", strid, dir)
-	return errors.Wrapf(err, "failed to setfattr, dir: (%s), quota id: (%d), stdout: (%s), stderr: (%s), exit: (%d)",
-		dir, id, stdout, stderr, exit)
+	return errors.Wrapf(setSubtreeID(dir, id), "failed to set file attr, dir: (%s), quota id: (%d)", dir, id)
 }
 
 // SetQuotaIDInFileAttrNoOutput is used to set file attributes without error.
@@ -251,11 +252,8 @@ func (quota *GrpQuotaDriver) setQuotaIDInFileAttrNoOutput(dir string, quotaID ui
 		return
 	}
 
-	strid := strconv.FormatUint(uint64(quotaID), 10)
-	exit, stdout, stderr, err := exec.Run(0, "setfattr", "-n", "system.subtree", "-v", strid, dir)
-	if err != nil {
-		log.With(nil).Errorf("failed to setfattr, dir: (%s), quota id: (%d), stdout: (%s), stderr: (%s), exit: (%d), err: (%v)",
-			dir, quotaID, stdout, stderr, exit, err)
+	if err := setSubtreeID(dir, quotaID); err != nil {
+		log.With(nil).Errorf("failed to set file attr, dir: (%s), quota id: (%d), err: (%v)", dir, quotaID, err)
 	}
 }
 
@@ -266,10 +264,17 @@ func (quota *GrpQuotaDriver) GetNextQuotaID() (uint32, error) {
 
 	if quota.lastID == 0 {
 		var err error
-		quota.quotaIDs, quota.lastID, err = loadQuotaIDs("-gan")
+		var quotaIDs map[uint32]struct{}
+		quotaIDs, quota.lastID, err = loadQuotaIDs(grpQuotaType, "grpquota", "grpjquota")
 		if err != nil {
 			return 0, errors.Wrap(err, "failed to load quota list")
 		}
+
+		// keep the quota ids which are set before loading.
+		for id := range quota.quotaIDs {
+			quotaIDs[id] = struct{}{}
+		}
+		quota.quotaIDs = quotaIDs
 	}
 	id := quota.lastID
 	for {
@@ -288,6 +293,19 @@ func (quota *GrpQuotaDriver) GetNextQuotaID() (uint32, error) {
 	return id, nil
 }
 
+// getQuotaFormat returns the quota format of the vfs version in jqfmt mount option.
+func getQuotaFormat(vfsVersion string) (int, error) {
+	switch vfsVersion {
+	case "vfsold":
+		return qfmtVfsOld, nil
+	case "vfsv0":
+		return qfmtVfsV0, nil
+	case "vfsv1":
+		return qfmtVfsV1, nil
+	}
+	return 0, errors.Errorf("unsupported quota format: (%s)", vfsVersion)
+}
+
 func getVFSVersionAndQuotaFile(devID uint64) (string, string, error) {
 	output, err := ioutil.ReadFile(procMountFile)
 	if err != nil {
@@ -367,20 +385,34 @@ func (quota *GrpQuotaDriver) setQuotaID(dir string, qid uint32) (uint32, error)
 	if err != nil {
 		return 0, errors.Wrapf(err, "failed to get file: (%s) quota id", dir)
 	}
-	strid := strconv.FormatUint(uint64(id), 10)
-	exit, stdout, stderr, err := exec.Run(0, "setfattr", "-n", "system.subtree", "-v", strid, dir)
-
-	return id, errors.Wrapf(err, "failed to setfattr, dir: (%s), quota id: (%s), stdout: (%s), stderr: (%s), exit: (%d)",
-		dir, strid, stdout, stderr, exit)
+	return id, errors.Wrapf(setSubtreeID(dir, id), "failed to set file attr, dir: (%s), quota id: (%d)", dir, id)
 }
 
-func (quota *GrpQuotaDriver) setQuota(quotaID uint32, diskQuota uint64, mountPoint string) error {
-	log.With(nil).Debugf("set user quota, quotaID: %d, limit: %d, mountpoint: %s", quotaID, diskQuota, mountPoint)
+// setQuota uses quotactl(2) to set group quota for binding of limit and mountpoint and quotaID.
+// It is the same with `setquota -g qid $softlimit $hardlimit $softinode $hardinode mountpoint`.
+func (quota *GrpQuotaDriver) setQuota(quotaID uint32, diskQuota uint64, inodes InodeLimit, mountInfo *MountInfo) error {
+	mountPoint := mountInfo.MountPoint
+	log.With(nil).Debugf("set user quota, quotaID: %d, limit: %d, inodes: %d/%d, mountpoint: %s",
+		quotaID, diskQuota, inodes.Soft, inodes.Hard, mountPoint)
 
-	quotaIDStr := strconv.FormatUint(uint64(quotaID), 10)
-	limit := strconv.FormatUint(diskQuota, 10)
+	if err := setQuotaLimits(mountInfo.Device, grpQuotaType, quotaID, diskQuota, inodes); err != nil {
+		return errors.Wrapf(err, "failed to set quota, mountpoint: (%s), quota id: (%d), quota: (%d kbytes), inodes: (%d/%d)",
+			mountPoint, quotaID, diskQuota, inodes.Soft, inodes.Hard)
+	}
 
-	exit, stdout, stderr, err := exec.Run(0, "setquota", "-g", quotaIDStr, "0", limit, "0", "0", mountPoint)
-	return errors.Wrapf(err, "failed to set quota, mountpoint: (%s), quota id: (%d), quota: (%d kbytes), stdout: (%s), stderr: (%s), exit: (%d)",
-		mountPoint, quotaID, diskQuota, stdout, stderr, exit)
+	if quotaID != 0 {
+		quota.MarkQuotaID(quotaID)
+	}
+	return nil
+}
+
+// MarkQuotaID records the quota id in cache, so it won't be allocated again.
+func (quota *GrpQuotaDriver) MarkQuotaID(quotaID uint32) {
+	quota.lock.Lock()
+	defer quota.lock.Unlock()
+
+	if quota.quotaIDs == nil {
+		quota.quotaIDs = make(map[uint32]struct{})
+	}
+	quota.quotaIDs[quotaID] = struct{}{}
 }
@@ -5,13 +5,9 @@ package quota
 import (
 	"fmt"
 	"io/ioutil"
-	"path"
-	"strconv"
 	"strings"
 	"sync"
 
-	"github.com/alibaba/pouch/pkg/bytefmt"
-	"github.com/alibaba/pouch/pkg/exec"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/system"
 
@@ -49,25 +45,24 @@ func (quota *PrjQuotaDriver) EnforceQuota(dir string) (*MountInfo, error) {
 	}
 	if !hasQuota {
 		// remount option prjquota for mountpoint
-		exit, stdout, stderr, err := exec.Run(0, "mount", "-o", "remount,prjquota", mountPoint)
-		if err != nil {
-			log.With(nil).Errorf("failed to remount prjquota, mountpoint: (%s), stdout: (%s), stderr: (%s), exit: (%d), err: (%v)",
-				mountPoint, stdout, stderr, exit, err)
-			return nil, errors.Wrapf(err, "failed to remount prjquota, mountpoint: (%s), stdout: (%s), stderr: (%s), exit: (%d)",
-				mountPoint, stdout, stderr, exit)
+		if err := remountWithOption(mountPoint, "prjquota"); err != nil {
+			log.With(nil).Errorf("failed to remount prjquota, mountpoint: (%s), err: (%v)", mountPoint, err)
+			return nil, errors.Wrapf(err, "failed to remount prjquota, mountpoint: (%s)", mountPoint)
 		}
 	}
 
-	// use tool quotaon to set disk quota for mountpoint
-	exit, stdout, stderr, err := exec.Run(0, "quotaon", "-P", mountPoint)
+	device, err := getMountPointDevice(mountPoint)
 	if err != nil {
-		if strings.Contains(stderr, " File exists") {
-			err = nil
-		} else {
-			log.With(nil).Errorf("failed to quota on, mountpoint: (%s), stdout: (%s), stderr: (%s), exit: (%d), err: (%v)",
-				mountPoint, stdout, stderr, exit, err)
-			err = errors.Wrapf(err, "failed to quota on, mountpoint: (%s), stdout: (%s), stderr: (%s), exit: (%d)",
-				mountPoint, stdout, stderr, exit)
+		return nil, errors.Wrapf(err, "failed to get device of mountpoint: (%s)", mountPoint)
+	}
+
+	// xfs turns on project quota by mount option, ext4 needs to turn on
+	// the quota which is stored in hidden quota inodes.
+	if fsType != "xfs" {
+		if err = quotaOn(device, prjQuotaType, qfmtVfsV1, ""); err != nil {
+			log.With(nil).Errorf("failed to quota on, mountpoint: (%s), device: (%s), err: (%v)",
+				mountPoint, device, err)
+			err = errors.Wrapf(err, "failed to quota on, mountpoint: (%s), device: (%s)", mountPoint, device)
 			mountPoint = ""
 		}
 	}
@@ -76,13 +71,14 @@ func (quota *PrjQuotaDriver) EnforceQuota(dir string) (*MountInfo, error) {
 		MountPoint: mountPoint,
 		DeviceID:   devID,
 		FsType:     fsType,
+		Device:     device,
 	}, err
 }
 
 // SetSubtree is used to set quota id for substree dir which is container's root dir.
 // For container, it has its own root dir.
 // And this dir is a subtree of the host dir which is mapped to a device.
-// ext4: chattr -p quotaid +P $DIR
+// It is the same with `chattr -p quotaid +P $DIR`.
 func (quota *PrjQuotaDriver) setQuotaID(dir string, qid uint32, mountInfo *MountInfo) (uint32, error) {
 	log.With(nil).Debugf("set subtree, dir: %s, quotaID: %d", dir, qid)
 
@@ -103,19 +99,18 @@ func (quota *PrjQuotaDriver) setQuotaID(dir string, qid uint32, mountInfo *Mount
 		}
 	}
 
-	strid := strconv.FormatUint(uint64(id), 10)
-	exit, stdout, stderr, err := exec.Run(0, "chattr", "-p", strid, "+P", dir)
-	log.With(nil).Infof("set quota id, dir: (%s), quota id: (%s), stdout: (%s), stderr: (%s), exit: (%d)",
-		dir, strid, stdout, stderr, exit)
-	return id, errors.Wrapf(err, "failed to chattr, dir: (%s), quota id: (%s), stdout: (%s), stderr: (%s), exit: (%d)",
-		dir, strid, stdout, stderr, exit)
+	err = setProjectID(dir, id)
+	log.With(nil).Infof("set quota id, dir: (%s), quota id: (%d), err: (%v)", dir, id, err)
+	return id, errors.Wrapf(err, "failed to set project id, dir: (%s), quota id: (%d)", dir, id)
 }
 
-// SetDiskQuota uses the following two parameters to set disk quota for a directory.
-// * quota size: a byte size of requested quota.
+// SetDiskQuota uses the following three parameters to set disk quota for a directory.
+// * quota size: a byte size of requested quota, empty means no block limit.
+// * inodes: the soft and hard limits of inode number.
 // * quota ID: an ID represent quota attr which is used in the global scope.
-func (quota *PrjQuotaDriver) SetDiskQuota(dir string, size string, quotaID uint32) error {
-	log.With(nil).Debugf("set disk quota, dir: %s, size: %s, quotaID: %d", dir, size, quotaID)
+func (quota *PrjQuotaDriver) SetDiskQuota(dir string, size string, inodes InodeLimit, quotaID uint32) error {
+	log.With(nil).Debugf("set disk quota, dir: %s, size: %s, inodes: %d/%d, quotaID: %d",
+		dir, size, inodes.Soft, inodes.Hard, quotaID)
 	mountInfo, err := quota.EnforceQuota(dir)
 	if err != nil {
 		return errors.Wrapf(err, "failed to enforce quota, dir: (%s)", dir)
@@ -125,9 +120,9 @@ func (quota *PrjQuotaDriver) SetDiskQuota(dir string, size string, quotaID uint3
 	}
 
 	// transfer limit from kbyte to byte
-	limit, err := bytefmt.ToKilobytes(size)
+	limit, err := getBlockLimit(size)
 	if err != nil {
-		return errors.Wrapf(err, "failed to change size: (%s) to kilobytes", size)
+		return err
 	}
 
 	if err := checkDevLimit(mountInfo, limit*1024); err != nil {
@@ -142,7 +137,7 @@ func (quota *PrjQuotaDriver) SetDiskQuota(dir string, size string, quotaID uint3
 		return errors.Errorf("failed to find quota id to set subtree")
 	}
 
-	return quota.setQuota(id, limit, mountInfo)
+	return quota.setQuota(id, limit, inodes, mountInfo)
 }
 
 // CheckMountpoint is used to check mount point.
@@ -209,56 +204,55 @@ func (quota *PrjQuotaDriver) CheckMountpoint(devID uint64) (string, bool, string
 	return mountPoint, enableQuota, fsType
 }
 
-// setQuota uses system tool "setquota" to set project quota for binding of limit and mountpoint and quotaID.
+// setQuota uses quotactl(2) to set project quota for binding of limit and mountpoint and quotaID.
 // * quotaID: quota ID which means this ID is used in the global scope.
 // * blockLimit: block limit number for mountpoint.
-// * mountPoint: the mountpoint of the device in the filesystem
-// ext4: setquota -P qid $softlimit $hardlimit $softinode $hardinode mountpoint
-func (quota *PrjQuotaDriver) setQuota(quotaID uint32, blockLimit uint64, mountInfo *MountInfo) error {
+// * inodes: inode soft and hard limits for mountpoint.
+// * mountInfo: the mountpoint and the device in the filesystem
+// It is the same with `setquota -P qid $softlimit $hardlimit $softinode $hardinode mountpoint`.
+func (quota *PrjQuotaDriver) setQuota(quotaID uint32, blockLimit uint64, inodes InodeLimit, mountInfo *MountInfo) error {
 	mountPoint := mountInfo.MountPoint
-	log.With(nil).Debugf("set project quota, quotaID: %d, limit: %d, mountpoint: %s", quotaID, blockLimit, mountPoint)
+	log.With(nil).Debugf("set project quota, quotaID: %d, limit: %d, inodes: %d/%d, mountpoint: %s",
+		quotaID, blockLimit, inodes.Soft, inodes.Hard, mountPoint)
 
-	quotaIDStr := strconv.FormatUint(uint64(quotaID), 10)
-	blockLimitStr := strconv.FormatUint(blockLimit, 10)
 	// set project quota
-	exit, stdout, stderr, err := exec.Run(0, "setquota", "-P", quotaIDStr, "0", blockLimitStr, "0", "0", mountPoint)
-	log.With(nil).Infof("set quota size, mountpoint: (%s), quota id: (%d), quota: (%d kbytes), stdout: (%s), stderr: (%s), exit: (%d)",
-		mountPoint, quotaID, blockLimit, stdout, stderr, exit)
-	return errors.Wrapf(err, "failed to set quota, mountpoint: (%s), quota id: (%d), quota: (%d kbytes), stdout: (%s), stderr: (%s), exit: (%d)",
-		mountPoint, quotaID, blockLimit, stdout, stderr, exit)
+	err := setQuotaLimits(mountInfo.Device, prjQuotaType, quotaID, blockLimit, inodes)
+	log.With(nil).Infof("set quota size, mountpoint: (%s), quota id: (%d), quota: (%d kbytes), inodes: (%d/%d), err: (%v)",
+		mountPoint, quotaID, blockLimit, inodes.Soft, inodes.Hard, err)
+	if err != nil {
+		return errors.Wrapf(err, "failed to set quota, mountpoint: (%s), quota id: (%d), quota: (%d kbytes), inodes: (%d/%d)",
+			mountPoint, quotaID, blockLimit, inodes.Soft, inodes.Hard)
+	}
+
+	quota.MarkQuotaID(quotaID)
+	return nil
+}
+
+// MarkQuotaID records the quota id in cache, so it won't be allocated again.
+func (quota *PrjQuotaDriver) MarkQuotaID(quotaID uint32) {
+	quota.lock.Lock()
+	defer quota.lock.Unlock()
+
+	if quota.quotaIDs == nil {
+		quota.quotaIDs = make(map[uint32]struct{})
+	}
+	quota.quotaIDs[quotaID] = struct{}{}
 }
 
 // GetQuotaIDInFileAttr gets attributes of the file which is in the inode.
 // The returned result is quota ID.
 // return 0 if failure happens, since quota ID must be positive.
-// execution command: `lsattr -p $dir`
+// It is the same with `lsattr -p $dir`.
 func (quota *PrjQuotaDriver) GetQuotaIDInFileAttr(dir string) uint32 {
-	parent := path.Dir(dir)
-	qid := 0
-
-	exit, stdout, stderr, err := exec.Run(0, "lsattr", "-p", parent)
+	qid, err := getProjectID(dir)
 	if err != nil {
 		// failure, then return invalid value 0 for quota ID.
-		log.With(nil).Errorf("failed to lsattr, dir: (%s), stdout: (%s), stderr: (%s), exit: (%d), err: (%v)",
-			dir, stdout, stderr, exit, err)
+		log.With(nil).Errorf("failed to get file attr of quota ID for dir %s, err: (%v)", dir, err)
 		return 0
 	}
 
-	// example output:
-	// 16777256 --------------e---P ./exampleDir
-	lines := strings.Split(stdout, "\n")
-	for _, line := range lines {
-		parts := strings.Split(line, " ")
-		if len(parts) > 2 && parts[2] == dir {
-			// find the corresponding quota ID, return directly.
-			qid, _ = strconv.Atoi(parts[0])
-			log.With(nil).Debugf("get file attr: [%s], quota id: [%d]", dir, qid)
-			return uint32(qid)
-		}
-	}
-
-	log.With(nil).Errorf("failed to get file attr of quota ID for dir %s", dir)
-	return 0
+	log.With(nil).Debugf("get file attr: [%s], quota id: [%d]", dir, qid)
+	return qid
 }
 
 // SetQuotaIDInFileAttr sets file attributes of quota ID for the input directory.
@@ -271,10 +265,8 @@ func (quota *PrjQuotaDriver) SetQuotaIDInFileAttr(dir string, quotaID uint32) er
 		return errors.Errorf("file(%s) is not regular file", dir)
 	}
 
-	strid := strconv.FormatUint(uint64(quotaID), 10)
-	exit, stdout, stderr, err := exec.Run(0, "chattr", "-p", strid, "+P", dir)
-	return errors.Wrapf(err, "failed to chattr, dir: (%s), quota id: (%d), stdout: (%s), stderr: (%s), exit: (%d)",
-		dir, quotaID, stdout, stderr, exit)
+	return errors.Wrapf(setProjectID(dir, quotaID), "failed to set project id, dir: (%s), quota id: (%d)",
+		dir, quotaID)
 }
 
 // GetNextQuotaID returns the next available quota id.
@@ -284,10 +276,17 @@ func (quota *PrjQuotaDriver) GetNextQuotaID() (uint32, error) {
 
 	if quota.lastID == 0 {
 		var err error
-		quota.quotaIDs, quota.lastID, err = loadQuotaIDs("-Pan")
+		var quotaIDs map[uint32]struct{}
+		quotaIDs, quota.lastID, err = loadQuotaIDs(prjQuotaType, "prjquota", "pquota")
 		if err != nil {
 			return 0, errors.Wrap(err, "failed to load quota list")
 		}
+
+		// keep the quota ids which are set before loading.
+		for id := range quota.quotaIDs {
+			quotaIDs[id] = struct{}{}
+		}
+		quota.quotaIDs = quotaIDs
 	}
 	id := quota.lastID
 	for {
@@ -313,11 +312,9 @@ func (quota *PrjQuotaDriver) SetFileAttrRecursive(dir string, quotaID uint32) er
 		return errors.Errorf("file(%s) is not regular file", dir)
 	}
 
-	strID := strconv.FormatUint(uint64(quotaID), 10)
-
-	// ext4 use chattr to change project id
-	exit, stdout, stderr, err := exec.Run(0, "chattr", "-R", "-p", strID, "+P", dir)
-	log.With(nil).Infof("set ext4 project quota id recursively, dir: (%s), quota id: (%s), stdout: (%s), stderr: (%s), exit: (%d)",
-		dir, strID, stdout, stderr, exit)
-	return errors.Wrapf(err, "failed to set file(%s) quota id(%s) by recursively", dir, strID)
+	// the same with `chattr -R -p quotaid +P $DIR`
+	err := setProjectIDRecursive(dir, quotaID)
+	log.With(nil).Infof("set project quota id recursively, dir: (%s), quota id: (%d), err: (%v)",
+		dir, quotaID, err)
+	return errors.Wrapf(err, "failed to set file(%s) quota id(%d) by recursively", dir, quotaID)
 }
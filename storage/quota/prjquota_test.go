@@ -0,0 +1,191 @@
+// +build linux
+
+package quota
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+
+	pouchexec "github.com/alibaba/pouch/pkg/exec"
+)
+
+// setupLoopbackFS creates an ext4 image with project quota feature and mounts it
+// by loop device, it returns the mountpoint and the cleanup function.
+func setupLoopbackFS(t *testing.T) (string, func()) {
+	if os.Getuid() != 0 {
+		t.Skip("loopback filesystem test requires root")
+	}
+	for _, bin := range []string{"truncate", "mkfs.ext4", "mount"} {
+		if _, err := exec.LookPath(bin); err != nil {
+			t.Skipf("loopback filesystem test requires %s", bin)
+		}
+	}
+
+	tmpDir, err := ioutil.TempDir("", "quota-loopback")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+
+	image := filepath.Join(tmpDir, "disk.img")
+	mountPoint := filepath.Join(tmpDir, "mnt")
+	if err := os.MkdirAll(mountPoint, 0755); err != nil {
+		t.Fatalf("failed to create mountpoint: %v", err)
+	}
+
+	if _, _, stderr, err := pouchexec.Run(0, "truncate", "-s", "64M", image); err != nil {
+		os.RemoveAll(tmpDir)
+		t.Skipf("failed to create image: %s, %v", stderr, err)
+	}
+	if _, _, stderr, err := pouchexec.Run(0, "mkfs.ext4", "-q", "-F", "-O", "quota,project", image); err != nil {
+		os.RemoveAll(tmpDir)
+		t.Skipf("failed to make ext4 filesystem with project quota: %s, %v", stderr, err)
+	}
+	if _, _, stderr, err := pouchexec.Run(0, "mount", "-o", "loop,prjquota", image, mountPoint); err != nil {
+		os.RemoveAll(tmpDir)
+		t.Skipf("failed to mount loopback image: %s, %v", stderr, err)
+	}
+
+	return mountPoint, func() {
+		syscall.Unmount(mountPoint, 0)
+		os.RemoveAll(tmpDir)
+	}
+}
+
+// getProjectQuotaLimits returns the block hard limit, inode soft and hard limits of quota id.
+func getProjectQuotaLimits(t *testing.T, mountPoint string, quotaID uint32) []uint64 {
+	device, err := getMountPointDevice(mountPoint)
+	if err != nil {
+		t.Fatalf("failed to get device of %s: %v", mountPoint, err)
+	}
+
+	d, err := getQuotaLimits(device, prjQuotaType, quotaID)
+	if err != nil {
+		t.Fatalf("failed to get quota of %d: %v", quotaID, err)
+	}
+	return []uint64{d.BHardLimit, d.ISoftLimit, d.IHardLimit}
+}
+
+func TestPrjQuotaSetDiskQuotaWithInodes(t *testing.T) {
+	mountPoint, cleanup := setupLoopbackFS(t)
+	defer cleanup()
+
+	dir := filepath.Join(mountPoint, "container")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+
+	driver := &PrjQuotaDriver{quotaIDs: make(map[uint32]struct{})}
+	quotaID := QuotaMinID + 100
+
+	if err := driver.SetDiskQuota(dir, "10m", InodeLimit{Soft: 80, Hard: 100}, quotaID); err != nil {
+		t.Fatalf("failed to set disk quota: %v", err)
+	}
+	if got := getProjectQuotaLimits(t, mountPoint, quotaID); got[0] != 10240 || got[1] != 80 || got[2] != 100 {
+		t.Fatalf("expect limits [10240 80 100], got %v", got)
+	}
+
+	// files exceed the inode hard limit can not be created.
+	var createErr error
+	for i := 0; i < 200 && createErr == nil; i++ {
+		createErr = ioutil.WriteFile(filepath.Join(dir, strconv.Itoa(i)), nil, 0644)
+	}
+	if createErr == nil {
+		t.Fatalf("expect creating files to exceed inode quota, but succeeded")
+	}
+
+	// only set inode limit and remove block limit.
+	if err := driver.SetDiskQuota(dir, "", InodeLimit{Hard: 1000}, quotaID); err != nil {
+		t.Fatalf("failed to update disk quota: %v", err)
+	}
+	if got := getProjectQuotaLimits(t, mountPoint, quotaID); got[0] != 0 || got[1] != 0 || got[2] != 1000 {
+		t.Fatalf("expect limits [0 0 1000], got %v", got)
+	}
+}
+
+func TestPrjQuotaFileAttrAndQuotaIDs(t *testing.T) {
+	mountPoint, cleanup := setupLoopbackFS(t)
+	defer cleanup()
+
+	dir := filepath.Join(mountPoint, "container")
+	if err := os.MkdirAll(filepath.Join(dir, "sub"), 0755); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "sub", "file"), []byte("data"), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+
+	driver := &PrjQuotaDriver{quotaIDs: make(map[uint32]struct{})}
+	quotaID := QuotaMinID + 200
+
+	if err := driver.SetFileAttrRecursive(dir, quotaID); err != nil {
+		t.Fatalf("failed to set file attr recursively: %v", err)
+	}
+	for _, p := range []string{dir, filepath.Join(dir, "sub"), filepath.Join(dir, "sub", "file")} {
+		if got := driver.GetQuotaIDInFileAttr(p); got != quotaID {
+			t.Fatalf("expect quota id of %s to be %d, got %d", p, quotaID, got)
+		}
+	}
+
+	// new file inherits the quota id of directory
+	newFile := filepath.Join(dir, "new")
+	if err := ioutil.WriteFile(newFile, nil, 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+	if got := driver.GetQuotaIDInFileAttr(newFile); got != quotaID {
+		t.Fatalf("expect quota id of %s to be %d, got %d", newFile, quotaID, got)
+	}
+
+	if err := driver.SetDiskQuota(dir, "10m", InodeLimit{}, quotaID); err != nil {
+		t.Fatalf("failed to set disk quota: %v", err)
+	}
+
+	device, err := getMountPointDevice(mountPoint)
+	if err != nil {
+		t.Fatalf("failed to get device of %s: %v", mountPoint, err)
+	}
+	quotaIDs := make(map[uint32]struct{})
+	if err := loadDeviceQuotaIDs(device, prjQuotaType, quotaIDs); err != nil {
+		t.Skipf("kernel doesn't support Q_GETNEXTQUOTA: %v", err)
+	}
+	if _, ok := quotaIDs[quotaID]; !ok {
+		t.Fatalf("expect quota id %d to be loaded, got %v", quotaID, quotaIDs)
+	}
+}
+
+func TestScanDeviceQuotaIDs(t *testing.T) {
+	mountPoint, cleanup := setupLoopbackFS(t)
+	defer cleanup()
+
+	device, err := getMountPointDevice(mountPoint)
+	if err != nil {
+		t.Fatalf("failed to get device of %s: %v", mountPoint, err)
+	}
+
+	// the ids with limits are found within the scan window.
+	ids := []uint32{QuotaMinID + 1, QuotaMinID + 100, QuotaMinID + 100 + quotaIDScanWindow}
+	for _, id := range ids {
+		if err := setQuotaLimits(device, prjQuotaType, id, 1024, InodeLimit{}); err != nil {
+			t.Fatalf("failed to set quota of %d: %v", id, err)
+		}
+	}
+
+	quotaIDs := make(map[uint32]struct{})
+	if err := scanDeviceQuotaIDs(device, prjQuotaType, quotaIDs); err != nil {
+		t.Fatalf("failed to scan quota ids: %v", err)
+	}
+	if _, ok := quotaIDs[ids[0]]; !ok {
+		t.Fatalf("expect quota id %d to be scanned, got %v", ids[0], quotaIDs)
+	}
+	if _, ok := quotaIDs[ids[1]]; !ok {
+		t.Fatalf("expect quota id %d to be scanned, got %v", ids[1], quotaIDs)
+	}
+	// the scan stops after the window of unused ids.
+	if _, ok := quotaIDs[ids[2]]; ok {
+		t.Fatalf("expect quota id %d not to be scanned, got %v", ids[2], quotaIDs)
+	}
+}
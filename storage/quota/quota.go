@@ -7,12 +7,11 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
-	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
-	"github.com/alibaba/pouch/pkg/exec"
+	"github.com/alibaba/pouch/pkg/bytefmt"
 	"github.com/alibaba/pouch/pkg/kernel"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/system"
@@ -39,10 +38,11 @@ type BaseQuota interface {
 	// EnforceQuota is used to enforce disk quota effect on specified directory.
 	EnforceQuota(dir string) (*MountInfo, error)
 
-	// SetDiskQuota uses the following two parameters to set disk quota for a directory.
-	// * quota size: a byte size of requested quota.
+	// SetDiskQuota uses the following three parameters to set disk quota for a directory.
+	// * quota size: a byte size of requested quota, empty means no block limit.
+	// * inodes: the soft and hard limits of inode number.
 	// * quota ID: an ID represent quota attr which is used in the global scope.
-	SetDiskQuota(dir string, size string, quotaID uint32) error
+	SetDiskQuota(dir string, size string, inodes InodeLimit, quotaID uint32) error
 
 	// CheckMountpoint is used to check mount point.
 	// It returns mointpoint, enable quota and filesystem type of the device.
@@ -59,6 +59,9 @@ type BaseQuota interface {
 	// GetNextQuotaID gets next quota ID in global scope of host.
 	GetNextQuotaID() (uint32, error)
 
+	// MarkQuotaID records the quota ID in use, so it won't be allocated again.
+	MarkQuotaID(quotaID uint32)
+
 	// SetFileAttrRecursive set the file attr by recursively.
 	SetFileAttrRecursive(dir string, quotaID uint32) error
 }
@@ -97,13 +100,14 @@ func SetQuotaDriver(name string) {
 }
 
 // SetDiskQuota is used to set quota for directory.
-func SetDiskQuota(dir string, size string, quotaID uint32) error {
-	log.With(nil).Infof("set disk quota, dir(%s), size(%s), quotaID(%d)", dir, size, quotaID)
+func SetDiskQuota(dir string, size string, inodes InodeLimit, quotaID uint32) error {
+	log.With(nil).Infof("set disk quota, dir(%s), size(%s), inodes(%d/%d), quotaID(%d)",
+		dir, size, inodes.Soft, inodes.Hard, quotaID)
 	if isRegular, err := CheckRegularFile(dir); err != nil || !isRegular {
 		log.With(nil).Debugf("set quota skip not regular file: %s", dir)
 		return err
 	}
-	return GQuotaDriver.SetDiskQuota(dir, size, quotaID)
+	return GQuotaDriver.SetDiskQuota(dir, size, inodes, quotaID)
 }
 
 // CheckMountpoint is used to check mount point.
@@ -121,6 +125,13 @@ func GetNextQuotaID() (uint32, error) {
 	return GQuotaDriver.GetNextQuotaID()
 }
 
+// MarkQuotaID records the quota id in use, so it won't be allocated again.
+// The quota ids which are recorded in container metadata are marked, since
+// they may be missed by scanning quota ids on the kernel without Q_GETNEXTQUOTA.
+func MarkQuotaID(quotaID uint32) {
+	GQuotaDriver.MarkQuotaID(quotaID)
+}
+
 // GetQuotaID returns the quota id of directory,
 // if no quota id, it will alloc the next available quota id.
 func GetQuotaID(dir string) (uint32, error) {
@@ -137,7 +148,7 @@ func GetQuotaID(dir string) (uint32, error) {
 }
 
 // SetRootfsDiskQuota is to set container rootfs dir disk quota.
-func SetRootfsDiskQuota(basefs, size string, quotaID uint32, update bool) (uint32, error) {
+func SetRootfsDiskQuota(basefs, size string, inodes InodeLimit, quotaID uint32, update bool) (uint32, error) {
 	overlayMountInfo, err := getOverlayMountInfo(basefs)
 	if err != nil {
 		return 0, errors.Wrapf(err, "failed to get overlay(%s) mount info", basefs)
@@ -151,7 +162,7 @@ func SetRootfsDiskQuota(basefs, size string, quotaID uint32, update bool) (uint3
 			}
 		}
 
-		if err := SetDiskQuota(dir, size, quotaID); err != nil {
+		if err := SetDiskQuota(dir, size, inodes, quotaID); err != nil {
 			return 0, errors.Wrapf(err, "failed to set dir(%s) disk quota", dir)
 		}
 
@@ -165,6 +176,39 @@ func SetRootfsDiskQuota(basefs, size string, quotaID uint32, update bool) (uint3
 	return quotaID, nil
 }
 
+// GetDevicePath returns the block device path on which the directory lies,
+// the device is resolved by the mountpoint of the directory.
+func GetDevicePath(dir string) (string, error) {
+	devID, err := getDevID(dir)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get device id for directory: (%s)", dir)
+	}
+
+	mountPoint, _, _ := CheckMountpoint(devID)
+	if mountPoint == "" {
+		return "", errors.Errorf("mountPoint not found for the device on which dir (%s) lies", dir)
+	}
+
+	return getMountPointDevice(mountPoint)
+}
+
+// getMountDevice returns the source mounted on the mountpoint
+// from the content of /proc/mounts.
+func getMountDevice(mounts, mountPoint string) string {
+	// /dev/sdb1 /home/pouch ext4 rw,relatime,prjquota,data=ordered 0 0
+	for _, line := range strings.Split(mounts, "\n") {
+		parts := strings.Split(line, " ")
+		if len(parts) != 6 {
+			continue
+		}
+
+		if parts[1] == mountPoint {
+			return parts[0]
+		}
+	}
+	return ""
+}
+
 // SetFileAttrRecursive set the file attr by recursively.
 func SetFileAttrRecursive(dir string, quotaID uint32) error {
 	return GQuotaDriver.SetFileAttrRecursive(dir, quotaID)
@@ -191,6 +235,19 @@ func IsSetQuotaID(id string) bool {
 	return id != "" && id != "0"
 }
 
+// getBlockLimit transfers the quota size into kilobytes, empty size means no limit.
+func getBlockLimit(size string) (uint64, error) {
+	if size == "" {
+		return 0, nil
+	}
+
+	limit, err := bytefmt.ToKilobytes(size)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to change size: (%s) to kilobytes", size)
+	}
+	return limit, nil
+}
+
 // getOverlayMountInfo gets overlayFS informantion from /proc/mounts.
 // upperdir, mergeddir and workdir would be dealt.
 func getOverlayMountInfo(basefs string) (*OverlayMount, error) {
@@ -242,64 +299,6 @@ func getOverlayMountInfo(basefs string) (*OverlayMount, error) {
 	}, nil
 }
 
-// loadQuotaIDs loads quota IDs for quota driver from reqquota execution result.
-// This function utils `repquota` which summarizes quotas for a filesystem.
-// see http://man7.org/linux/man-pages/man8/repquota.8.html
-//
-// $ repquota -Pan
-// Project         used    soft    hard  grace    used  soft  hard  grace
-// ----------------------------------------------------------------------
-// #0        --     220       0       0             25     0     0
-// #123      --       4       0 88589934592          1     0     0
-// #8888     --       8       0       0              2     0     0
-//
-// Or
-//
-// $ repquota -gan
-// Group           used    soft    hard  grace    used  soft  hard  grace
-// ----------------------------------------------------------------------
-// #0        --  494472       0       0            938     0     0
-// #54       --       8       0       0              2     0     0
-// #4        --      16       0       0              4     0     0
-// #22       --      28       0       0              4     0     0
-// #16777220 +- 2048576       0 2048575              9     0     0
-// #500      --   47504       0       0            101     0     0
-// #16777221 -- 3048576       0 3048576              8     0     0
-func loadQuotaIDs(repquotaOpt string) (map[uint32]struct{}, uint32, error) {
-	quotaIDs := make(map[uint32]struct{})
-
-	minID := QuotaMinID
-	exit, output, stderr, err := exec.Run(0, "repquota", repquotaOpt)
-	if err != nil {
-		return nil, 0, errors.Wrapf(err, "failed to execute [repquota %s], stdout: (%s), stderr: (%s), exit: (%d)",
-			repquotaOpt, output, stderr, exit)
-	}
-
-	lines := strings.Split(string(output), "\n")
-	for _, line := range lines {
-		if len(line) == 0 || line[0] != '#' {
-			continue
-		}
-		// find all lines with prefix '#'
-		parts := strings.Split(line, " ")
-		// part[0] is "#123456"
-		if len(parts[0]) <= 1 {
-			continue
-		}
-
-		id, err := strconv.Atoi(parts[0][1:])
-		quotaID := uint32(id)
-		if err == nil && quotaID > QuotaMinID {
-			quotaIDs[quotaID] = struct{}{}
-			if quotaID > minID {
-				minID = quotaID
-			}
-		}
-	}
-	log.With(nil).Infof("Load repquota ids(%d), list(%v)", len(quotaIDs), quotaIDs)
-	return quotaIDs, minID, nil
-}
-
 // getDevLimit returns the device storage upper limit.
 func getDevLimit(info *MountInfo) (uint64, error) {
 	mp := info.MountPoint
@@ -28,3 +28,41 @@ func Test_getDevID(t *testing.T) {
 		t.Fatalf("getDevID error expect %d got %d", expectID, gotID)
 	}
 }
+
+func Test_getMountDevice(t *testing.T) {
+	mounts := `/dev/sda3 / ext4 rw,relatime,data=ordered 0 0
+tmpfs /run tmpfs rw,nosuid,nodev,mode=755 0 0
+/dev/sdb1 /home/pouch ext4 rw,relatime,prjquota,data=ordered 0 0
+overlay /var/lib/pouch/rootfs overlay rw,relatime,lowerdir=/l,upperdir=/u,workdir=/w 0 0`
+
+	for _, tc := range []struct {
+		mountPoint string
+		expected   string
+	}{
+		{mountPoint: "/", expected: "/dev/sda3"},
+		{mountPoint: "/home/pouch", expected: "/dev/sdb1"},
+		{mountPoint: "/run", expected: "tmpfs"},
+		{mountPoint: "/var/lib/pouch/rootfs", expected: "overlay"},
+		{mountPoint: "/not/exist", expected: ""},
+	} {
+		if got := getMountDevice(mounts, tc.mountPoint); got != tc.expected {
+			t.Fatalf("getMountDevice(%s) expect %s got %s", tc.mountPoint, tc.expected, got)
+		}
+	}
+}
+
+func TestMarkQuotaID(t *testing.T) {
+	for _, driver := range []BaseQuota{NewQuotaDriver("prjquota"), NewQuotaDriver("grpquota")} {
+		// the quota id marked before loading is never allocated.
+		marked := QuotaMinID + 1
+		driver.MarkQuotaID(marked)
+
+		id, err := driver.GetNextQuotaID()
+		if err != nil {
+			t.Fatalf("failed to get next quota id: %v", err)
+		}
+		if id == marked {
+			t.Fatalf("expect marked quota id %d not to be allocated", marked)
+		}
+	}
+}
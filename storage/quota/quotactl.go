@@ -0,0 +1,530 @@
+// +build linux
+
+package quota
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/system"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// the definitions are from include/uapi/linux/quota.h and include/uapi/linux/fs.h
+const (
+	grpQuotaType = 1
+	prjQuotaType = 2
+
+	qQuotaOn      = 0x800002
+	qQuotaOff     = 0x800003
+	qSetInfo      = 0x800006
+	qGetQuota     = 0x800007
+	qSetQuota     = 0x800008
+	qGetNextQuota = 0x800009
+
+	// the quota formats, qfmtVfsV1 is also the format of ext4 hidden quota inodes.
+	qfmtVfsOld = 1
+	qfmtVfsV0  = 2
+	qfmtVfsV1  = 4
+
+	qifBLimits = 1
+	qifILimits = 4
+	qifLimits  = qifBLimits | qifILimits
+
+	iifBGrace = 1
+	iifIGrace = 2
+
+	fsXflagProjInherit = 0x00000200
+
+	// stRelatime is ST_RELATIME of statfs(2), the other mount flags
+	// reported by statfs(2) have the same values with mount(2).
+	stRelatime = 0x1000
+
+	// subtreeXattr is the extended attribute which keeps the group quota id.
+	subtreeXattr = "system.subtree"
+
+	sysBlockDir = "/sys/dev/block"
+
+	// quotaIDScanWindow is the number of consecutive unused quota ids after
+	// which scanning quota ids by Q_GETQUOTA stops.
+	quotaIDScanWindow = 4096
+)
+
+// dqblk is the disk quota block of quotactl(2), see struct if_dqblk.
+type dqblk struct {
+	BHardLimit uint64
+	BSoftLimit uint64
+	CurSpace   uint64
+	IHardLimit uint64
+	ISoftLimit uint64
+	CurInodes  uint64
+	BTime      uint64
+	ITime      uint64
+	Valid      uint32
+}
+
+// nextDqblk is the disk quota block of Q_GETNEXTQUOTA, see struct if_nextdqblk.
+type nextDqblk struct {
+	BHardLimit uint64
+	BSoftLimit uint64
+	CurSpace   uint64
+	IHardLimit uint64
+	ISoftLimit uint64
+	CurInodes  uint64
+	BTime      uint64
+	ITime      uint64
+	Valid      uint32
+	ID         uint32
+}
+
+// dqinfo is the quota information of quotactl(2), see struct if_dqinfo.
+type dqinfo struct {
+	BGrace uint64
+	IGrace uint64
+	Flags  uint32
+	Valid  uint32
+}
+
+// fsxattr is the extended file attributes of FS_IOC_FSGETXATTR, see struct fsxattr.
+type fsxattr struct {
+	XFlags     uint32
+	ExtSize    uint32
+	NextEnts   uint32
+	ProjID     uint32
+	CowExtSize uint32
+	Pad        [8]byte
+}
+
+// quotactl is the wrapper of quotactl(2).
+func quotactl(cmd, quotaType int, device string, id uint32, addr unsafe.Pointer) error {
+	special, err := unix.BytePtrFromString(device)
+	if err != nil {
+		return err
+	}
+
+	// QCMD(cmd, type) (((cmd) << SUBCMDSHIFT) | ((type) & SUBCMDMASK))
+	qcmd := uintptr(cmd<<8 | quotaType&0xff)
+	_, _, errno := unix.Syscall6(unix.SYS_QUOTACTL, qcmd, uintptr(unsafe.Pointer(special)),
+		uintptr(id), uintptr(addr), 0, 0)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// quotaOn turns on the quota of the device in the format. The quota is stored
+// in the quota file, or the hidden quota inodes if quotaFile is empty.
+// It is no-op if the quota has been turned on.
+func quotaOn(device string, quotaType, format int, quotaFile string) error {
+	var addr unsafe.Pointer
+	if quotaFile != "" {
+		p, err := unix.BytePtrFromString(quotaFile)
+		if err != nil {
+			return err
+		}
+		addr = unsafe.Pointer(p)
+	}
+
+	err := quotactl(qQuotaOn, quotaType, device, uint32(format), addr)
+	if err == unix.EEXIST || err == unix.EBUSY {
+		return nil
+	}
+	return err
+}
+
+// quotaOff turns off the quota of the device.
+func quotaOff(device string, quotaType int) error {
+	return quotactl(qQuotaOff, quotaType, device, 0, nil)
+}
+
+// setQuotaGrace sets the grace time (seconds) of block and inode soft limits on the device.
+// It is the same with `setquota -t $blockgrace $inodegrace mountpoint`.
+func setQuotaGrace(device string, quotaType int, blockGrace, inodeGrace uint64) error {
+	info := dqinfo{
+		BGrace: blockGrace,
+		IGrace: inodeGrace,
+		Valid:  iifBGrace | iifIGrace,
+	}
+	return quotactl(qSetInfo, quotaType, device, 0, unsafe.Pointer(&info))
+}
+
+// remountWithOption remounts the mountpoint with the filesystem option,
+// the mount flags of the mountpoint are kept.
+func remountWithOption(mountPoint, option string) error {
+	var stfs unix.Statfs_t
+	if err := unix.Statfs(mountPoint, &stfs); err != nil {
+		return errors.Wrapf(err, "failed to statfs(%s)", mountPoint)
+	}
+
+	flags := uint64(stfs.Flags) & (unix.MS_RDONLY | unix.MS_NOSUID | unix.MS_NODEV | unix.MS_NOEXEC |
+		unix.MS_SYNCHRONOUS | unix.MS_MANDLOCK | unix.MS_NOATIME | unix.MS_NODIRATIME)
+	if uint64(stfs.Flags)&stRelatime != 0 {
+		flags |= unix.MS_RELATIME
+	}
+
+	return unix.Mount("", mountPoint, "", uintptr(flags|unix.MS_REMOUNT), option)
+}
+
+// setQuotaLimits sets the block hard limit (kilobytes) and inode limits for quota id on the device.
+func setQuotaLimits(device string, quotaType int, id uint32, blockLimit uint64, inodes InodeLimit) error {
+	d := dqblk{
+		BHardLimit: blockLimit,
+		IHardLimit: inodes.Hard,
+		ISoftLimit: inodes.Soft,
+		Valid:      qifLimits,
+	}
+	return quotactl(qSetQuota, quotaType, device, id, unsafe.Pointer(&d))
+}
+
+// getQuotaLimits gets the quota block of quota id on the device.
+func getQuotaLimits(device string, quotaType int, id uint32) (*dqblk, error) {
+	d := &dqblk{}
+	if err := quotactl(qGetQuota, quotaType, device, id, unsafe.Pointer(d)); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// loadDeviceQuotaIDs returns the quota ids which are larger than QuotaMinID on the device
+// by Q_GETNEXTQUOTA, the command is supported since linux 4.6.
+func loadDeviceQuotaIDs(device string, quotaType int, quotaIDs map[uint32]struct{}) error {
+	id := QuotaMinID
+	for {
+		var d nextDqblk
+		if err := quotactl(qGetNextQuota, quotaType, device, id, unsafe.Pointer(&d)); err != nil {
+			if err == unix.ENOENT {
+				return nil
+			}
+			return err
+		}
+
+		if d.ID > QuotaMinID {
+			quotaIDs[d.ID] = struct{}{}
+		}
+		if d.ID == ^uint32(0) {
+			return nil
+		}
+		id = d.ID + 1
+	}
+}
+
+// scanDeviceQuotaIDs returns the quota ids which are larger than QuotaMinID on
+// the device by Q_GETQUOTA, for the kernel which doesn't support Q_GETNEXTQUOTA.
+// The quota ids are allocated in order from QuotaMinID, so the scan stops after
+// quotaIDScanWindow consecutive ids without limits or usage. The quota ids set
+// beyond the window are marked by the metadata of containers, see MarkQuotaID.
+func scanDeviceQuotaIDs(device string, quotaType int, quotaIDs map[uint32]struct{}) error {
+	unused := 0
+	for id := QuotaMinID + 1; id != 0 && unused < quotaIDScanWindow; id++ {
+		d, err := getQuotaLimits(device, quotaType, id)
+		if err != nil {
+			return err
+		}
+
+		if d.BHardLimit == 0 && d.BSoftLimit == 0 && d.IHardLimit == 0 && d.ISoftLimit == 0 &&
+			d.CurSpace == 0 && d.CurInodes == 0 {
+			unused++
+			continue
+		}
+		quotaIDs[id] = struct{}{}
+		unused = 0
+	}
+	return nil
+}
+
+// isNextQuotaUnsupported returns true if the error means Q_GETNEXTQUOTA is not
+// supported by the kernel or the filesystem.
+func isNextQuotaUnsupported(err error) bool {
+	return err == unix.EINVAL || err == unix.ENOSYS || err == unix.EOPNOTSUPP
+}
+
+// loadQuotaIDs loads the quota ids of all devices which turn on the quota,
+// the mountOpts is the mount options which mean quota is turned on. The device
+// whose quota ids fail to load is skipped, so that it doesn't break the others.
+func loadQuotaIDs(quotaType int, mountOpts ...string) (map[uint32]struct{}, uint32, error) {
+	output, err := ioutil.ReadFile(procMountFile)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to read file(%s)", procMountFile)
+	}
+
+	var (
+		quotaIDs = make(map[uint32]struct{})
+		visited  = make(map[string]struct{})
+	)
+	for _, mountPoint := range getQuotaMountPoints(string(output), mountOpts...) {
+		device, err := getMountPointDevice(mountPoint)
+		if err != nil {
+			log.With(nil).Warnf("skip loading quota ids of mountpoint(%s): %v", mountPoint, err)
+			continue
+		}
+		if _, ok := visited[device]; ok {
+			continue
+		}
+		visited[device] = struct{}{}
+
+		err = loadDeviceQuotaIDs(device, quotaType, quotaIDs)
+		if err != nil && isNextQuotaUnsupported(err) {
+			log.With(nil).Debugf("Q_GETNEXTQUOTA is not supported on device(%s), scan quota ids: %v", device, err)
+			err = scanDeviceQuotaIDs(device, quotaType, quotaIDs)
+		}
+		if err != nil {
+			log.With(nil).Warnf("skip loading quota ids of device(%s): %v", device, err)
+		}
+	}
+
+	minID := QuotaMinID
+	for id := range quotaIDs {
+		if id > minID {
+			minID = id
+		}
+	}
+
+	log.With(nil).Infof("load quota ids(%d), list(%v)", len(quotaIDs), quotaIDs)
+	return quotaIDs, minID, nil
+}
+
+// getQuotaMountPoints returns the mountpoints which are mounted with one of the
+// mount options, only the first mountpoint of each mount source is returned.
+func getQuotaMountPoints(mounts string, mountOpts ...string) []string {
+	var (
+		mountPoints []string
+		visited     = make(map[string]struct{})
+	)
+
+	for _, line := range strings.Split(mounts, "\n") {
+		parts := strings.Split(line, " ")
+		if len(parts) != 6 {
+			continue
+		}
+		if _, ok := visited[parts[0]]; ok {
+			continue
+		}
+
+		for _, opt := range strings.Split(parts[3], ",") {
+			if hasMountOption(opt, mountOpts) {
+				visited[parts[0]] = struct{}{}
+				mountPoints = append(mountPoints, parts[1])
+				break
+			}
+		}
+	}
+	return mountPoints
+}
+
+func hasMountOption(opt string, mountOpts []string) bool {
+	for _, o := range mountOpts {
+		if opt == o || strings.HasPrefix(opt, o+"=") {
+			return true
+		}
+	}
+	return false
+}
+
+// getMountPointDevice returns the block device of the mountpoint. The mount
+// source is used if it is a block device, otherwise, such as /dev/root, the
+// device is resolved by the device number of the mountpoint.
+func getMountPointDevice(mountPoint string) (string, error) {
+	output, err := ioutil.ReadFile(procMountFile)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read file(%s)", procMountFile)
+	}
+
+	source := getMountDevice(string(output), mountPoint)
+	if source == "" {
+		return "", errors.Errorf("failed to find mountpoint(%s) in %s", mountPoint, procMountFile)
+	}
+	if isBlockDevice(source) {
+		return source, nil
+	}
+
+	devID, err := system.GetDevID(mountPoint)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get device id of mountpoint(%s)", mountPoint)
+	}
+	device, err := getDeviceByDevID(devID)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to find block device of mountpoint(%s), source(%s)", mountPoint, source)
+	}
+	return device, nil
+}
+
+// getDeviceByDevID returns the block device of the device number by the
+// DEVNAME in /sys/dev/block/<major>:<minor>/uevent.
+func getDeviceByDevID(devID uint64) (string, error) {
+	uevent := filepath.Join(sysBlockDir, fmt.Sprintf("%d:%d", unix.Major(devID), unix.Minor(devID)), "uevent")
+	content, err := ioutil.ReadFile(uevent)
+	if err != nil {
+		return "", err
+	}
+
+	name := parseUeventDevName(string(content))
+	if name == "" {
+		return "", errors.Errorf("DEVNAME not found in %s", uevent)
+	}
+
+	device := filepath.Join("/dev", name)
+	if !isBlockDevice(device) {
+		return "", errors.Errorf("%s is not a block device", device)
+	}
+	return device, nil
+}
+
+// GetDiskDevicePath returns the whole disk of the block device. The blkio
+// throttles of cgroup v1 only accept the device number of a whole disk, so a
+// partition such as /dev/sdb1 is resolved to its parent disk /dev/sdb.
+func GetDiskDevicePath(device string) (string, error) {
+	var st unix.Stat_t
+	if err := unix.Stat(device, &st); err != nil {
+		return "", errors.Wrapf(err, "failed to stat device(%s)", device)
+	}
+
+	diskID, err := getDiskDevID(sysBlockDir, uint64(st.Rdev))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get disk of device(%s)", device)
+	}
+	if diskID == uint64(st.Rdev) {
+		return device, nil
+	}
+	return getDeviceByDevID(diskID)
+}
+
+// getDiskDevID returns the device number of the whole disk of the device. The
+// entry of a partition in /sys/dev/block is a symlink into the directory of
+// its parent disk, such as ../../block/sdb/sdb1, and contains a partition file.
+func getDiskDevID(sysDir string, devID uint64) (uint64, error) {
+	entry := filepath.Join(sysDir, fmt.Sprintf("%d:%d", unix.Major(devID), unix.Minor(devID)))
+	if _, err := os.Stat(filepath.Join(entry, "partition")); err != nil {
+		if os.IsNotExist(err) {
+			return devID, nil
+		}
+		return 0, err
+	}
+
+	path, err := filepath.EvalSymlinks(entry)
+	if err != nil {
+		return 0, err
+	}
+	content, err := ioutil.ReadFile(filepath.Join(filepath.Dir(path), "dev"))
+	if err != nil {
+		return 0, err
+	}
+
+	var major, minor uint32
+	if _, err := fmt.Sscanf(strings.TrimSpace(string(content)), "%d:%d", &major, &minor); err != nil {
+		return 0, errors.Wrapf(err, "invalid device number %q of disk", content)
+	}
+	return unix.Mkdev(major, minor), nil
+}
+
+// parseUeventDevName returns the DEVNAME in the content of uevent, such as:
+//
+// MAJOR=8
+// MINOR=1
+// DEVNAME=sda1
+// DEVTYPE=partition
+func parseUeventDevName(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(line, "DEVNAME=") {
+			return strings.TrimPrefix(line, "DEVNAME=")
+		}
+	}
+	return ""
+}
+
+// isBlockDevice returns whether the file is a block device.
+func isBlockDevice(file string) bool {
+	fi, err := os.Stat(file)
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeDevice != 0 && fi.Mode()&os.ModeCharDevice == 0
+}
+
+// getSubtreeID returns the group quota id in the system.subtree attribute of file,
+// zero is returned if the attribute is not set.
+func getSubtreeID(file string) (uint32, error) {
+	buf := make([]byte, 32)
+	n, err := unix.Getxattr(file, subtreeXattr, buf)
+	if err == unix.ENODATA {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := strconv.ParseUint(strings.TrimRight(string(buf[:n]), "\x00\n"), 10, 32)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s attribute of file(%s)", subtreeXattr, file)
+	}
+	return uint32(id), nil
+}
+
+// setSubtreeID sets the group quota id in the system.subtree attribute of file,
+// it is the same with `setfattr -n system.subtree -v $QUOTAID $FILE`.
+func setSubtreeID(file string, id uint32) error {
+	return unix.Setxattr(file, subtreeXattr, []byte(strconv.FormatUint(uint64(id), 10)), 0)
+}
+
+// getProjectID gets the project id of file by FS_IOC_FSGETXATTR.
+func getProjectID(file string) (uint32, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	var attr fsxattr
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), fsIocFsGetXattr, uintptr(unsafe.Pointer(&attr))); errno != 0 {
+		return 0, errors.Wrapf(errno, "failed to get fsxattr of file(%s)", file)
+	}
+	return attr.ProjID, nil
+}
+
+// setProjectID sets the project id of file by FS_IOC_FSSETXATTR,
+// the directory also inherits the project id to its new children.
+func setProjectID(file string, id uint32) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var attr fsxattr
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), fsIocFsGetXattr, uintptr(unsafe.Pointer(&attr))); errno != 0 {
+		return errors.Wrapf(errno, "failed to get fsxattr of file(%s)", file)
+	}
+
+	attr.ProjID = id
+	if fi, err := f.Stat(); err == nil && fi.IsDir() {
+		attr.XFlags |= fsXflagProjInherit
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), fsIocFsSetXattr, uintptr(unsafe.Pointer(&attr))); errno != 0 {
+		return errors.Wrapf(errno, "failed to set fsxattr of file(%s)", file)
+	}
+	return nil
+}
+
+// setProjectIDRecursive sets the project id of directory and all its children,
+// symlinks and special files are skipped since they can not be opened.
+func setProjectIDRecursive(dir string, id uint32) error {
+	return filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			log.With(nil).Warnf("set project id walk dir %s get error %v", path, err)
+			return nil
+		}
+
+		if !fi.Mode().IsDir() && !fi.Mode().IsRegular() {
+			return nil
+		}
+		return setProjectID(path, id)
+	})
+}
@@ -0,0 +1,11 @@
+// +build linux,ppc64 linux,ppc64le
+
+package quota
+
+const (
+	// fsIocFsGetXattr is _IOR('X', 31, struct fsxattr)
+	fsIocFsGetXattr = 0x401c581f
+
+	// fsIocFsSetXattr is _IOW('X', 32, struct fsxattr)
+	fsIocFsSetXattr = 0x801c5820
+)
@@ -0,0 +1,176 @@
+// +build linux
+
+package quota
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/alibaba/pouch/pkg/system"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestQuotactlStructSize(t *testing.T) {
+	// the sizes must be the same with the structs in kernel headers.
+	if size := unsafe.Sizeof(dqblk{}); size != 72 {
+		t.Fatalf("expect size of if_dqblk to be 72, got %d", size)
+	}
+	if size := unsafe.Sizeof(nextDqblk{}); size != 72 {
+		t.Fatalf("expect size of if_nextdqblk to be 72, got %d", size)
+	}
+	if size := unsafe.Sizeof(fsxattr{}); size != 28 {
+		t.Fatalf("expect size of fsxattr to be 28, got %d", size)
+	}
+}
+
+func TestQuotactlInfoSize(t *testing.T) {
+	if size := unsafe.Sizeof(dqinfo{}); size != 24 {
+		t.Fatalf("expect size of if_dqinfo to be 24, got %d", size)
+	}
+}
+
+func TestGetQuotaMountPoints(t *testing.T) {
+	mounts := `/dev/sda3 / ext4 rw,relatime,data=ordered 0 0
+/dev/sdb1 /home/pouch ext4 rw,relatime,prjquota,data=ordered 0 0
+/dev/sdb1 /var/lib/pouch ext4 rw,relatime,prjquota,data=ordered 0 0
+/dev/sdc1 /data xfs rw,relatime,attr2,inode64,prjquota 0 0
+/dev/root /home/admin ext4 rw,relatime,data=ordered,jqfmt=vfsv0,grpjquota=aquota.group 0 0
+tmpfs /run tmpfs rw,nosuid,nodev,mode=755 0 0`
+
+	got := getQuotaMountPoints(mounts, "prjquota", "pquota")
+	if expected := []string{"/home/pouch", "/data"}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expect project quota mountpoints %v, got %v", expected, got)
+	}
+
+	// the mount source out of /dev is kept, it is resolved by device number.
+	got = getQuotaMountPoints(mounts, "grpquota", "grpjquota")
+	if expected := []string{"/home/admin"}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expect group quota mountpoints %v, got %v", expected, got)
+	}
+}
+
+func TestParseUeventDevName(t *testing.T) {
+	uevent := "MAJOR=8\nMINOR=1\nDEVNAME=sda1\nDEVTYPE=partition\n"
+	if got := parseUeventDevName(uevent); got != "sda1" {
+		t.Fatalf("expect DEVNAME sda1, got %s", got)
+	}
+	if got := parseUeventDevName("MAJOR=8\nMINOR=1\n"); got != "" {
+		t.Fatalf("expect empty DEVNAME, got %s", got)
+	}
+}
+
+func TestGetMountPointDeviceByDevID(t *testing.T) {
+	// the root filesystem may be mounted by /dev/root or an overlay,
+	// the device is resolved by device number if it is a block device.
+	devID, err := system.GetDevID("/")
+	if err != nil {
+		t.Fatalf("failed to get device id of /: %v", err)
+	}
+	device, err := getDeviceByDevID(devID)
+	if err != nil {
+		t.Skipf("root filesystem is not on a block device: %v", err)
+	}
+	if !isBlockDevice(device) {
+		t.Fatalf("expect %s to be a block device", device)
+	}
+
+	got, err := getMountPointDevice("/")
+	if err != nil {
+		t.Fatalf("failed to get device of /: %v", err)
+	}
+	if !isBlockDevice(got) {
+		t.Fatalf("expect %s to be a block device", got)
+	}
+}
+
+func TestGetDiskDevID(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sys-block")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// layout of sysfs: dev/block/8:16 -> ../../block/sdb,
+	// dev/block/8:17 -> ../../block/sdb/sdb1.
+	diskDir := filepath.Join(dir, "block", "sdb")
+	partDir := filepath.Join(diskDir, "sdb1")
+	if err := os.MkdirAll(partDir, 0755); err != nil {
+		t.Fatalf("failed to create %s: %v", partDir, err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(diskDir, "dev"), []byte("8:16\n"), 0644); err != nil {
+		t.Fatalf("failed to write dev file: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(partDir, "dev"), []byte("8:17\n"), 0644); err != nil {
+		t.Fatalf("failed to write dev file: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(partDir, "partition"), []byte("1\n"), 0644); err != nil {
+		t.Fatalf("failed to write partition file: %v", err)
+	}
+
+	sysDir := filepath.Join(dir, "dev", "block")
+	if err := os.MkdirAll(sysDir, 0755); err != nil {
+		t.Fatalf("failed to create %s: %v", sysDir, err)
+	}
+	if err := os.Symlink("../../block/sdb", filepath.Join(sysDir, "8:16")); err != nil {
+		t.Fatalf("failed to create symlink: %v", err)
+	}
+	if err := os.Symlink("../../block/sdb/sdb1", filepath.Join(sysDir, "8:17")); err != nil {
+		t.Fatalf("failed to create symlink: %v", err)
+	}
+
+	// the partition is resolved to the parent disk.
+	got, err := getDiskDevID(sysDir, unix.Mkdev(8, 17))
+	if err != nil {
+		t.Fatalf("failed to get disk of partition: %v", err)
+	}
+	if got != unix.Mkdev(8, 16) {
+		t.Fatalf("expect disk 8:16, got %d:%d", unix.Major(got), unix.Minor(got))
+	}
+
+	// the whole disk is kept.
+	got, err = getDiskDevID(sysDir, unix.Mkdev(8, 16))
+	if err != nil {
+		t.Fatalf("failed to get disk of disk: %v", err)
+	}
+	if got != unix.Mkdev(8, 16) {
+		t.Fatalf("expect disk 8:16, got %d:%d", unix.Major(got), unix.Minor(got))
+	}
+}
+
+func TestSubtreeID(t *testing.T) {
+	dir, err := ioutil.TempDir("", "subtree-id")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if id, err := getSubtreeID(dir); err != nil && err != unix.ENOTSUP {
+		t.Fatalf("failed to get subtree id: %v", err)
+	} else if id != 0 {
+		t.Fatalf("expect no subtree id, got %d", id)
+	}
+
+	// system.subtree is only supported by the kernel with the group quota patch.
+	if err := setSubtreeID(dir, 16777217); err != nil {
+		t.Skipf("system.subtree is not supported: %v", err)
+	}
+	if id, err := getSubtreeID(dir); err != nil || id != 16777217 {
+		t.Fatalf("expect subtree id 16777217, got %d, %v", id, err)
+	}
+}
+
+func TestGetQuotaFormat(t *testing.T) {
+	for vfs, expected := range map[string]int{"vfsold": qfmtVfsOld, "vfsv0": qfmtVfsV0, "vfsv1": qfmtVfsV1} {
+		if got, err := getQuotaFormat(vfs); err != nil || got != expected {
+			t.Fatalf("expect format %d of %s, got %d, %v", expected, vfs, got, err)
+		}
+	}
+	if _, err := getQuotaFormat("xfs"); err == nil {
+		t.Fatalf("expect error of unsupported format")
+	}
+}
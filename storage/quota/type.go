@@ -6,9 +6,16 @@ type QMap struct {
 	Destination string
 	Expression  string
 	Size        string
+	Inodes      InodeLimit
 	QuotaID     uint32
 }
 
+// InodeLimit defines the limits of inode number, zero means no limit.
+type InodeLimit struct {
+	Soft uint64
+	Hard uint64
+}
+
 // OverlayMount represents the parameters of overlay mount.
 type OverlayMount struct {
 	Merged string
@@ -22,4 +29,27 @@ type MountInfo struct {
 	MountPoint string
 	FsType     string
 	DeviceID   uint64
+	Device     string
+}
+
+// DiskProfile bundles the disk limits which are applied to container rootfs,
+// including the disk quota and the blkio throttles of the underlying device.
+type DiskProfile struct {
+	// Size is the disk quota size of rootfs, such as "10g".
+	Size string `json:"size,omitempty"`
+
+	// Inodes is the limit number of inodes in rootfs.
+	Inodes uint64 `json:"inodes,omitempty"`
+
+	// ReadBps is the read rate (bytes per second) of the underlying device.
+	ReadBps uint64 `json:"read-bps,omitempty"`
+
+	// WriteBps is the write rate (bytes per second) of the underlying device.
+	WriteBps uint64 `json:"write-bps,omitempty"`
+
+	// ReadIOps is the read rate (io per second) of the underlying device.
+	ReadIOps uint64 `json:"read-iops,omitempty"`
+
+	// WriteIOps is the write rate (io per second) of the underlying device.
+	WriteIOps uint64 `json:"write-iops,omitempty"`
 }
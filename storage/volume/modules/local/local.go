@@ -5,21 +5,157 @@ package local
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
 	"path"
+	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/alibaba/pouch/pkg/bytefmt"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/storage/quota"
 	"github.com/alibaba/pouch/storage/volume/driver"
 	"github.com/alibaba/pouch/storage/volume/types"
+
+	"github.com/containerd/containerd/mount"
+	"github.com/pkg/errors"
 )
 
 var (
 	defaultDataPath = "/var/lib/pouch/volume"
 )
 
+const (
+	// optMountType is the filesystem type of device mounted to volume, eg: nfs, cifs, tmpfs.
+	optMountType = "type"
+	// optMountDevice is the device mounted to volume, eg: /dev/sdb, :/data, tmpfs.
+	optMountDevice = "device"
+	// optMountOptions is the comma-separated mount options, eg: addr=192.168.0.10,rw.
+	optMountOptions = "o"
+)
+
+// mountOptions describes the device mounted to the local volume.
+type mountOptions struct {
+	Type    string
+	Device  string
+	Options string
+}
+
+// getMountOptions returns the mount options of volume, nil means the volume
+// is a plain local directory.
+func getMountOptions(opts map[string]string) (*mountOptions, error) {
+	mo := &mountOptions{
+		Type:    opts[optMountType],
+		Device:  opts[optMountDevice],
+		Options: opts[optMountOptions],
+	}
+
+	if mo.Type == "" && mo.Device == "" {
+		if mo.Options != "" {
+			return nil, errors.Errorf("option %q requires %q and %q", optMountOptions, optMountType, optMountDevice)
+		}
+		return nil, nil
+	}
+
+	if mo.Type == "" || mo.Device == "" {
+		return nil, errors.Errorf("options %q and %q must be specified together", optMountType, optMountDevice)
+	}
+
+	return mo, nil
+}
+
+// resolveAddrOption resolves the hostname of "addr=" option to ip address,
+// since the kernel nfs client only accepts ip address.
+func resolveAddrOption(options string) (string, error) {
+	if options == "" {
+		return "", nil
+	}
+
+	opts := strings.Split(options, ",")
+	for i, opt := range opts {
+		if !strings.HasPrefix(opt, "addr=") {
+			continue
+		}
+
+		host := strings.TrimPrefix(opt, "addr=")
+		if net.ParseIP(host) != nil {
+			continue
+		}
+
+		ip, err := net.ResolveIPAddr("ip", host)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to resolve address %s", host)
+		}
+		opts[i] = "addr=" + ip.String()
+	}
+
+	return strings.Join(opts, ","), nil
+}
+
+// isMountpoint checks whether the dir is a mountpoint by mountinfo,
+// bind mounts on the same filesystem are also detected.
+func isMountpoint(dir string) (bool, error) {
+	dir = filepath.Clean(dir)
+
+	mounts, err := mount.Self()
+	if err != nil {
+		return false, err
+	}
+
+	for _, m := range mounts {
+		if m.Mountpoint == dir {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// mountDevice mounts the device to mountPath if it has not been mounted.
+func mountDevice(mountPath string, mo *mountOptions) error {
+	mounted, err := isMountpoint(mountPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to check mountpoint %s", mountPath)
+	}
+	if mounted {
+		return nil
+	}
+
+	options, err := resolveAddrOption(mo.Options)
+	if err != nil {
+		return err
+	}
+
+	m := mount.Mount{
+		Type:   mo.Type,
+		Source: mo.Device,
+	}
+	if options != "" {
+		m.Options = strings.Split(options, ",")
+	}
+
+	if err := m.Mount(mountPath); err != nil {
+		return errors.Wrapf(err, "failed to mount %s(%s) on %s with options(%s)", mo.Device, mo.Type, mountPath, options)
+	}
+	return nil
+}
+
+// unmountDevice unmounts the device from mountPath if it is mounted.
+func unmountDevice(mountPath string) error {
+	mounted, err := isMountpoint(mountPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to check mountpoint %s", mountPath)
+	}
+	if !mounted {
+		return nil
+	}
+
+	if err := mount.Unmount(mountPath, 0); err != nil {
+		return errors.Wrapf(err, "failed to unmount %s", mountPath)
+	}
+	return nil
+}
+
 func init() {
 	if err := driver.Register(&Local{}); err != nil {
 		panic(err)
@@ -77,6 +213,15 @@ func (p *Local) Create(ctx context.Context, id types.VolumeContext) (*types.Volu
 		size = strconv.Itoa(int(sizeInt))
 	}
 
+	// parse the device mounted to volume.
+	mo, err := getMountOptions(id.Options)
+	if err != nil {
+		return nil, err
+	}
+	if mo != nil && size != "" {
+		return nil, errors.Errorf("size is not supported by volume mounted with device, use the mount options of %s instead", mo.Type)
+	}
+
 	// create the volume path
 	if st, exist := os.Stat(mountPath); exist != nil {
 		if e := os.MkdirAll(mountPath, 0755); e != nil {
@@ -94,6 +239,13 @@ func (p *Local) Remove(ctx context.Context, v *types.Volume) error {
 	log.With(ctx).Debugf("Local remove volume: %s", v.Name)
 	mountPath := v.Path()
 
+	// the data in device must not be removed, so unmount it first.
+	if v.Option(optMountType) != "" {
+		if err := unmountDevice(mountPath); err != nil {
+			return err
+		}
+	}
+
 	if err := os.RemoveAll(mountPath); err != nil && !os.IsNotExist(err) {
 		return fmt.Errorf("remove %q directory failed, err: %v", mountPath, err)
 	}
@@ -125,7 +277,10 @@ func (p *Local) Path(ctx context.Context, v *types.Volume) (string, error) {
 // Options returns local volume's options.
 func (p *Local) Options() map[string]types.Option {
 	return map[string]types.Option{
-		"mount": {Value: "", Desc: "local directory"},
+		"mount":         {Value: "", Desc: "local directory"},
+		optMountType:    {Value: "", Desc: "filesystem type of device mounted to volume, eg: nfs, cifs, tmpfs"},
+		optMountDevice:  {Value: "", Desc: "device mounted to volume, eg: /dev/sdb, :/data, tmpfs"},
+		optMountOptions: {Value: "", Desc: "comma-separated mount options of device, eg: addr=192.168.0.10,rw"},
 	}
 }
 
@@ -150,8 +305,19 @@ func (p *Local) Attach(ctx context.Context, v *types.Volume) error {
 		return fmt.Errorf("mount path is not a dir %s", mountPath)
 	}
 
+	mo, err := getMountOptions(v.Options())
+	if err != nil {
+		return err
+	}
+
+	// the device is mounted by the first attached container, and the
+	// following containers share the mountpoint.
+	if mo != nil {
+		return mountDevice(mountPath, mo)
+	}
+
 	if size != "" && size != "0" {
-		if ex := quota.SetDiskQuota(mountPath, size, 0); ex != nil {
+		if ex := quota.SetDiskQuota(mountPath, size, quota.InodeLimit{}, 0); ex != nil {
 			return ex
 		}
 	}
@@ -163,5 +329,15 @@ func (p *Local) Attach(ctx context.Context, v *types.Volume) error {
 func (p *Local) Detach(ctx context.Context, v *types.Volume) error {
 	log.With(ctx).Debugf("Local detach volume: %s", v.Name)
 
-	return nil
+	if v.Option(optMountType) == "" {
+		return nil
+	}
+
+	// unmount the device until the last container detaches the volume.
+	if ref := v.Option(types.OptionRef); ref != "" {
+		log.With(ctx).Debugf("Local volume %s is still referenced by %s", v.Name, ref)
+		return nil
+	}
+
+	return unmountDevice(v.Path())
 }
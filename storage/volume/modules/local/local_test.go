@@ -0,0 +1,157 @@
+// +build linux
+
+package local
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pouchexec "github.com/alibaba/pouch/pkg/exec"
+	"github.com/alibaba/pouch/storage/volume/types"
+)
+
+func TestGetMountOptions(t *testing.T) {
+	for _, tc := range []struct {
+		opts    map[string]string
+		expect  *mountOptions
+		wantErr bool
+	}{
+		{opts: map[string]string{"mount": "/data"}, expect: nil},
+		{
+			opts:   map[string]string{"type": "nfs", "device": ":/data", "o": "addr=1.1.1.1,rw"},
+			expect: &mountOptions{Type: "nfs", Device: ":/data", Options: "addr=1.1.1.1,rw"},
+		},
+		{opts: map[string]string{"type": "tmpfs"}, wantErr: true},
+		{opts: map[string]string{"device": "/dev/sdb"}, wantErr: true},
+		{opts: map[string]string{"o": "rw"}, wantErr: true},
+	} {
+		mo, err := getMountOptions(tc.opts)
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("getMountOptions(%v) expect error %v, got %v", tc.opts, tc.wantErr, err)
+		}
+		if tc.wantErr {
+			continue
+		}
+		if (mo == nil) != (tc.expect == nil) || (mo != nil && *mo != *tc.expect) {
+			t.Fatalf("getMountOptions(%v) expect %v, got %v", tc.opts, tc.expect, mo)
+		}
+	}
+}
+
+func TestResolveAddrOption(t *testing.T) {
+	got, err := resolveAddrOption("addr=192.168.0.10,rw,nfsvers=4")
+	if err != nil || got != "addr=192.168.0.10,rw,nfsvers=4" {
+		t.Fatalf("expect options unchanged, got %s, %v", got, err)
+	}
+
+	got, err = resolveAddrOption("rw,addr=localhost")
+	if err != nil {
+		t.Fatalf("failed to resolve localhost: %v", err)
+	}
+	if !strings.HasPrefix(got, "rw,addr=") || net.ParseIP(strings.TrimPrefix(got, "rw,addr=")) == nil {
+		t.Fatalf("expect addr to be resolved to ip, got %s", got)
+	}
+}
+
+// attachDetach creates a volume with options, attaches it by two containers
+// and checks the device is only unmounted when the last container detaches.
+func attachDetach(t *testing.T, opts map[string]string) {
+	dataPath, err := ioutil.TempDir("", "local-volume")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dataPath)
+
+	ctx := context.Background()
+	p := &Local{DataPath: dataPath}
+
+	v, err := p.Create(ctx, types.NewVolumeContext("test", "local", opts, nil))
+	if err != nil {
+		t.Fatalf("failed to create volume: %v", err)
+	}
+	mountPath := v.Path()
+
+	for _, ref := range []string{"c1", "c1,c2"} {
+		v.SetOption(types.OptionRef, ref)
+		if err := p.Attach(ctx, v); err != nil {
+			t.Fatalf("failed to attach volume: %v", err)
+		}
+		if mounted, _ := isMountpoint(mountPath); !mounted {
+			t.Fatalf("expect %s to be mounted after attached by %s", mountPath, ref)
+		}
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(mountPath, "data"), []byte("data"), 0644); err != nil {
+		t.Fatalf("failed to write file in volume: %v", err)
+	}
+
+	v.SetOption(types.OptionRef, "c2")
+	if err := p.Detach(ctx, v); err != nil {
+		t.Fatalf("failed to detach volume: %v", err)
+	}
+	if mounted, _ := isMountpoint(mountPath); !mounted {
+		t.Fatalf("expect %s to be mounted until the last container detaches", mountPath)
+	}
+
+	v.SetOption(types.OptionRef, "")
+	if err := p.Detach(ctx, v); err != nil {
+		t.Fatalf("failed to detach volume: %v", err)
+	}
+	if mounted, _ := isMountpoint(mountPath); mounted {
+		t.Fatalf("expect %s to be unmounted after the last container detaches", mountPath)
+	}
+	if _, err := os.Stat(filepath.Join(mountPath, "data")); !os.IsNotExist(err) {
+		t.Fatalf("expect file to be stored in device instead of volume directory: %v", err)
+	}
+
+	if err := p.Remove(ctx, v); err != nil {
+		t.Fatalf("failed to remove volume: %v", err)
+	}
+}
+
+func TestLocalVolumeMountTmpfs(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("mount test requires root")
+	}
+
+	attachDetach(t, map[string]string{"type": "tmpfs", "device": "tmpfs", "o": "size=16m,mode=0755"})
+}
+
+func TestLocalVolumeMountLoopDevice(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("mount test requires root")
+	}
+	for _, bin := range []string{"truncate", "mkfs.ext4", "losetup"} {
+		if _, err := exec.LookPath(bin); err != nil {
+			t.Skipf("loop device test requires %s", bin)
+		}
+	}
+
+	tmpDir, err := ioutil.TempDir("", "local-volume-loop")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	image := filepath.Join(tmpDir, "disk.img")
+	if _, _, stderr, err := pouchexec.Run(0, "truncate", "-s", "32M", image); err != nil {
+		t.Skipf("failed to create image: %s, %v", stderr, err)
+	}
+	if _, _, stderr, err := pouchexec.Run(0, "mkfs.ext4", "-q", "-F", image); err != nil {
+		t.Skipf("failed to make ext4 filesystem: %s, %v", stderr, err)
+	}
+	_, stdout, stderr, err := pouchexec.Run(0, "losetup", "-f", "--show", image)
+	if err != nil {
+		t.Skipf("failed to setup loop device: %s, %v", stderr, err)
+	}
+	device := strings.TrimSpace(stdout)
+	defer pouchexec.Run(0, "losetup", "-d", device)
+
+	attachDetach(t, map[string]string{"type": "ext4", "device": device, "o": "rw,noatime"})
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"path/filepath"
+
 	"github.com/alibaba/pouch/test/command"
 	"github.com/alibaba/pouch/test/environment"
 	"github.com/alibaba/pouch/test/util"
@@ -87,3 +89,113 @@ func (suite *PouchCheckpointSuite) TestCheckpointDelete(c *check.C) {
 	ret.Assert(c, icmd.Success)
 	c.Assert(ret.Stdout(), check.Equals, "")
 }
+
+// TestCheckpointExportImport tests exporting a checkpoint and restoring it
+// in a new container.
+func (suite *PouchCheckpointSuite) TestCheckpointExportImport(c *check.C) {
+	cname := "TestCheckpointExportImport"
+	restored := "TestCheckpointExportImportRestored"
+
+	command.PouchRun("run", "-d", "--name", cname, busyboxImage, "top").Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, cname)
+
+	command.PouchRun("exec", cname, "sh", "-c", "echo 1234 > /test").Assert(c, icmd.Success)
+	command.PouchRun("checkpoint", "create", cname, "cp0").Assert(c, icmd.Success)
+
+	bundle := filepath.Join(c.MkDir(), "cp0.tar")
+	command.PouchRun("checkpoint", "export", "--rootfs", "-o", bundle, cname, "cp0").Assert(c, icmd.Success)
+
+	ret := command.PouchRun("checkpoint", "import", "-i", bundle, restored)
+	ret.Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, restored)
+	c.Assert(ret.Stdout(), check.Equals, "cp0\n")
+
+	command.PouchRun("start", "--checkpoint", "cp0", restored).Assert(c, icmd.Success)
+
+	ret = command.PouchRun("exec", restored, "cat", "/test")
+	ret.Assert(c, icmd.Success)
+	c.Assert(ret.Stdout(), check.Equals, "1234\n")
+}
+
+// TestCheckpointExportRunning tests exporting the writable layer of a
+// container which keeps running after checkpoint.
+func (suite *PouchCheckpointSuite) TestCheckpointExportRunning(c *check.C) {
+	cname := "TestCheckpointExportRunning"
+	restored := "TestCheckpointExportRunningRestored"
+
+	command.PouchRun("run", "-d", "--name", cname, busyboxImage, "top").Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, cname)
+
+	command.PouchRun("exec", cname, "sh", "-c", "echo 1234 > /test").Assert(c, icmd.Success)
+	command.PouchRun("checkpoint", "create", "--leave-running", cname, "cp0").Assert(c, icmd.Success)
+
+	bundle := filepath.Join(c.MkDir(), "cp0.tar")
+	command.PouchRun("checkpoint", "export", "--rootfs", "-o", bundle, cname, "cp0").Assert(c, icmd.Success)
+
+	command.PouchRun("checkpoint", "import", "-i", bundle, restored).Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, restored)
+
+	command.PouchRun("start", "--checkpoint", "cp0", restored).Assert(c, icmd.Success)
+
+	ret := command.PouchRun("exec", restored, "cat", "/test")
+	ret.Assert(c, icmd.Success)
+	c.Assert(ret.Stdout(), check.Equals, "1234\n")
+}
+
+// TestCheckpointTty tests checkpoint and restore container with tty.
+func (suite *PouchCheckpointSuite) TestCheckpointTty(c *check.C) {
+	cname := "TestCheckpointTty"
+	restored := "TestCheckpointTtyRestored"
+
+	command.PouchRun("run", "-d", "-t", "--name", cname, busyboxImage, "top").Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, cname)
+
+	ret := command.PouchRun("checkpoint", "create", cname, "cp0")
+	ret.Assert(c, icmd.Success)
+	c.Assert(ret.Stdout(), check.Equals, "cp0\n")
+
+	dir := c.MkDir()
+	bundle := filepath.Join(dir, "cp0.tar")
+	command.PouchRun("checkpoint", "export", "-o", bundle, cname, "cp0").Assert(c, icmd.Success)
+	command.PouchRun("checkpoint", "import", "-i", bundle, restored).Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, restored)
+
+	command.PouchRun("start", "--checkpoint", "cp0", restored).Assert(c, icmd.Success)
+
+	ret = command.PouchRun("exec", restored, "ps")
+	ret.Assert(c, icmd.Success)
+	c.Assert(util.PartialEqual(ret.Stdout(), "top"), check.IsNil)
+}
+
+// TestCheckpointPreDump tests creating checkpoint with pre-dump parent.
+func (suite *PouchCheckpointSuite) TestCheckpointPreDump(c *check.C) {
+	cname := "TestCheckpointPreDump"
+	restored := "TestCheckpointPreDumpRestored"
+
+	command.PouchRun("run", "-d", "--name", cname, busyboxImage, "top").Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, cname)
+
+	// parent should exist
+	ret := command.PouchRun("checkpoint", "create", "--parent", "nonexist", cname, "cp0")
+	c.Assert(util.PartialEqual(ret.Stderr(), "checkpoint nonexist is not exist"), check.IsNil)
+
+	command.PouchRun("checkpoint", "create", "--pre-dump", cname, "pre0").Assert(c, icmd.Success)
+	command.PouchRun("checkpoint", "create", "--pre-dump", "--parent", "pre0", cname, "pre1").Assert(c, icmd.Success)
+
+	state, err := inspectFilter(cname, ".State.Running")
+	c.Assert(err, check.IsNil)
+	c.Assert(state, check.Equals, "true")
+
+	command.PouchRun("checkpoint", "create", "--parent", "pre1", cname, "cp0").Assert(c, icmd.Success)
+
+	// the checkpoint can be restored without its parents.
+	command.PouchRun("checkpoint", "rm", cname, "pre0").Assert(c, icmd.Success)
+	command.PouchRun("checkpoint", "rm", cname, "pre1").Assert(c, icmd.Success)
+
+	bundle := filepath.Join(c.MkDir(), "cp0.tar")
+	command.PouchRun("checkpoint", "export", "-o", bundle, cname, "cp0").Assert(c, icmd.Success)
+	command.PouchRun("checkpoint", "import", "-i", bundle, restored).Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, restored)
+
+	command.PouchRun("start", "--checkpoint", "cp0", restored).Assert(c, icmd.Success)
+}
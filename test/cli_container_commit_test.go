@@ -116,3 +116,37 @@ func (suite *PouchCommitSuite) TestCommitBindMount(c *check.C) {
 	ret.Assert(c, icmd.Success)
 	DelContainerForceMultyTime(c, nname)
 }
+
+// TestCommitWithChangesAndSquash tests commit a container with changes and
+// squashed layer.
+func (suite *PouchCommitSuite) TestCommitWithChangesAndSquash(c *check.C) {
+	cname := "TestCommitWithChangesAndSquash"
+	image := "foo:squash"
+
+	command.PouchRun("run", "-d", "--name", cname, busyboxImage, "top").Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, cname)
+	command.PouchRun("exec", cname, "sh", "-c", "echo a > /foo").Assert(c, icmd.Success)
+
+	// invalid change is rejected
+	ret := command.PouchRun("commit", "-c", "RUN echo a", cname, image)
+	c.Assert(ret.Error, check.NotNil)
+
+	ret = command.PouchRun("commit", "--squash", "--pause=false",
+		"-c", "ENV FOO=bar", "-c", "WORKDIR /tmp", "-c", "EXPOSE 8080", "-c", "LABEL tier=backend",
+		"-c", `CMD ["cat", "/foo"]`, cname, image)
+	ret.Assert(c, icmd.Success)
+	defer DelImageForceOk(c, image)
+
+	output := command.PouchRun("image", "inspect", "-f", "{{len .RootFS.Layers}} {{.Config.Env}} {{.Config.WorkingDir}} {{.Config.Labels}}", image).Stdout()
+	for _, expected := range []string{"1 ", "FOO=bar", "/tmp", "tier:backend"} {
+		if !strings.Contains(output, expected) {
+			c.Fatalf("expected %s in image config, got %s", expected, output)
+		}
+	}
+
+	nname := "fromSquash"
+	ret = command.PouchRun("run", "--name", nname, image)
+	defer DelContainerForceMultyTime(c, nname)
+	ret.Assert(c, icmd.Success)
+	c.Assert(ret.Stdout(), check.Equals, "a\n")
+}
@@ -266,10 +266,25 @@ func (suite *PouchRichContainerSuite) TestRichContainerSystemdWorks(c *check.C)
 	c.Assert(err, check.IsNil)
 	c.Assert(richMode, check.Equals, "systemd")
 
+	stopSignal, err := inspectFilter(cname, ".Config.StopSignal")
+	c.Assert(err, check.IsNil)
+	c.Assert(stopSignal, check.Equals, "SIGRTMIN+3")
+
 	waitSystemdPullProcess(c, cname, "sleep")
 	c.Assert(checkPidofProcess(c, cname, "systemd", "1"), check.Equals, true)
 	c.Assert(checkPPid(c, cname, "sleep", "1"), check.Equals, true)
 
+	// /run and /run/lock are tmpfs.
+	for _, dir := range []string{"/run", "/run/lock"} {
+		res = command.PouchRun("exec", cname, "stat", "-f", "-c", "%T", dir)
+		res.Assert(c, icmd.Success)
+		c.Assert(strings.TrimSpace(res.Stdout()), check.Equals, "tmpfs")
+	}
+
+	res = command.PouchRun("exec", cname, "cat", "/proc/1/environ")
+	res.Assert(c, icmd.Success)
+	c.Assert(strings.Contains(res.Stdout(), "container=pouch"), check.Equals, true)
+
 	// stop and start could work well.
 	command.PouchRun("stop", "-t", "1", cname).Assert(c, icmd.Success)
 	command.PouchRun("start", cname).Assert(c, icmd.Success)
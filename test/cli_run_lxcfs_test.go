@@ -50,3 +50,29 @@ func (suite *PouchRunLxcfsSuite) TestRunEnableLxcfs(c *check.C) {
 		c.Fatalf("upexpected output %v, expected %s\n", res, "524288 kB")
 	}
 }
+
+// TestRemountLxcfs is to verify remount-lxcfs re-binds lxcfs in container.
+func (suite *PouchRunLxcfsSuite) TestRemountLxcfs(c *check.C) {
+	SkipIfFalse(c, environment.IsLxcfsEnabled)
+	name := "test-remount-lxcfs"
+
+	res := command.PouchRun("run", "-d", "--name", name,
+		"-m", "512M", "--enableLxcfs=true",
+		busyboxImage, "sleep", "10000")
+	res.Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, name)
+	id := strings.TrimSpace(res.Stdout())
+
+	res = command.PouchRun("remount-lxcfs")
+	res.Assert(c, icmd.Success)
+	if out := res.Stdout(); !strings.Contains(out, id[:6]+"   OK") {
+		c.Fatalf("unexpected output %s, expected container %s to be remounted", out, id[:6])
+	}
+
+	// the memory should be still equal to 512M after remount
+	res = command.PouchRun("exec", name, "head", "-n", "5", "/proc/meminfo")
+	res.Assert(c, icmd.Success)
+	if out := res.Combined(); !strings.Contains(out, "524288 kB") {
+		c.Fatalf("upexpected output %v, expected %s\n", res, "524288 kB")
+	}
+}
@@ -488,3 +488,35 @@ func (suite *PouchRunSuite) TestRunWithTty(c *check.C) {
 	errString := res.Stderr()
 	assert.Equal(c, errString, "Error: the input device is not a TTY\n")
 }
+
+// TestRunWithInit is to verify run container with init.
+func (suite *PouchRunSuite) TestRunWithInit(c *check.C) {
+	name := "test-run-with-init"
+
+	res := command.PouchRun("run", "-d", "--init", "--name", name, busyboxImage, "top")
+	defer DelContainerForceMultyTime(c, name)
+	if res.ExitCode != 0 && strings.Contains(res.Combined(), "failed to find init binary") {
+		c.Skip("init binary is not installed for pouchd")
+	}
+	res.Assert(c, icmd.Success)
+
+	initEnabled, err := inspectFilter(name, ".HostConfig.Init")
+	c.Assert(err, check.IsNil)
+	c.Assert(initEnabled, check.Equals, "true")
+
+	// the init is pid 1 and top is its child.
+	res = command.PouchRun("exec", name, "cat", "/proc/1/cmdline")
+	res.Assert(c, icmd.Success)
+	c.Assert(strings.HasPrefix(res.Stdout(), "/sbin/pouch-init"), check.Equals, true)
+
+	res = command.PouchRun("exec", name, "pidof", "top")
+	res.Assert(c, icmd.Success)
+	res = command.PouchRun("exec", name, "cat", fmt.Sprintf("/proc/%s/stat", strings.TrimSpace(res.Stdout())))
+	res.Assert(c, icmd.Success)
+	c.Assert(strings.Fields(res.Stdout())[3], check.Equals, "1")
+
+	// init cannot be used with rich mode.
+	res = command.PouchRun("run", "-d", "--init", "--rich", busyboxImage, "top")
+	c.Assert(res.ExitCode, check.Not(check.Equals), 0)
+	c.Assert(strings.Contains(res.Stderr(), "init cannot be used with rich container mode"), check.Equals, true)
+}
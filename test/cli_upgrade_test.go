@@ -140,3 +140,118 @@ func (suite *PouchUpgradeSuite) TestPouchUpgradeCheckVolume(c *check.C) {
 		c.Errorf("failed to exec in container, expected 5678 got %s", out)
 	}
 }
+
+// TestPouchUpgradePreservePath is to verify the files changed under the
+// specified path are carried over to the new container.
+func (suite *PouchUpgradeSuite) TestPouchUpgradePreservePath(c *check.C) {
+	name := "TestPouchUpgradePreservePath"
+
+	command.PouchRun("run", "-d", "--name", name, busyboxImage, "top").Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, name)
+
+	command.PouchRun("exec", name, "sh", "-c", "mkdir -p /preserved && echo 1234 > /preserved/test && echo 5678 > /dropped").Assert(c, icmd.Success)
+
+	command.PouchRun("upgrade", "--image", busyboxImage125, "--preserve-path", "/preserved", name).Assert(c, icmd.Success)
+
+	out := command.PouchRun("exec", name, "cat", "/preserved/test").Stdout()
+	if !strings.Contains(out, "1234") {
+		c.Errorf("expected /preserved/test to be carried over, got %s", out)
+	}
+
+	command.PouchRun("exec", name, "ls", "/dropped").Assert(c, icmd.Expected{ExitCode: 1})
+}
+
+// TestPouchUpgradeRollback is to verify the image and writable layer are
+// restored after rollback.
+func (suite *PouchUpgradeSuite) TestPouchUpgradeRollback(c *check.C) {
+	name := "TestPouchUpgradeRollback"
+
+	command.PouchRun("run", "-d", "--name", name, busyboxImage, "top").Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, name)
+
+	// no revision to rollback
+	res := command.PouchRun("upgrade", "--rollback", name)
+	c.Assert(res.Error, check.NotNil)
+	if out := res.Combined(); !strings.Contains(out, "no revision to rollback") {
+		c.Fatalf("unexpected output: %s, expected no revision error", out)
+	}
+
+	oldImage, err := inspectFilter(name, ".Image")
+	c.Assert(err, check.IsNil)
+
+	command.PouchRun("exec", name, "sh", "-c", "echo 1234 > /test").Assert(c, icmd.Success)
+	command.PouchRun("upgrade", "--image", busyboxImage125, name).Assert(c, icmd.Success)
+	command.PouchRun("exec", name, "ls", "/test").Assert(c, icmd.Expected{ExitCode: 1})
+
+	command.PouchRun("upgrade", "--rollback", name).Assert(c, icmd.Success)
+
+	image, err := inspectFilter(name, ".Image")
+	c.Assert(err, check.IsNil)
+	c.Assert(image, check.Equals, oldImage)
+
+	state, err := inspectFilter(name, ".State.Running")
+	c.Assert(err, check.IsNil)
+	c.Assert(state, check.Equals, "true")
+
+	out := command.PouchRun("exec", name, "cat", "/test").Stdout()
+	if !strings.Contains(out, "1234") {
+		c.Errorf("expected writable layer to be restored, got %s", out)
+	}
+}
+
+// TestPouchUpgradeReadinessProbe is to verify the container is rolled back
+// if the readiness probe fails after upgrade.
+func (suite *PouchUpgradeSuite) TestPouchUpgradeReadinessProbe(c *check.C) {
+	name := "TestPouchUpgradeReadinessProbe"
+
+	command.PouchRun("run", "-d", "--name", name, busyboxImage, "top").Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, name)
+
+	oldImage, err := inspectFilter(name, ".Image")
+	c.Assert(err, check.IsNil)
+
+	res := command.PouchRun("upgrade", "--image", busyboxImage125, "--probe-cmd", "ls /not-ready", "--probe-retries", "2", name)
+	c.Assert(res.Error, check.NotNil)
+	if out := res.Combined(); !strings.Contains(out, "readiness probe failed") {
+		c.Fatalf("unexpected output: %s, expected readiness probe failed", out)
+	}
+
+	image, err := inspectFilter(name, ".Image")
+	c.Assert(err, check.IsNil)
+	c.Assert(image, check.Equals, oldImage)
+
+	state, err := inspectFilter(name, ".State.Running")
+	c.Assert(err, check.IsNil)
+	c.Assert(state, check.Equals, "true")
+
+	command.PouchRun("upgrade", "--image", busyboxImage125, "--probe-cmd", "ls /bin", name).Assert(c, icmd.Success)
+}
+
+// TestPouchUpgradeWithPatch is to verify env and labels are patched when
+// upgrading container with the same image.
+func (suite *PouchUpgradeSuite) TestPouchUpgradeWithPatch(c *check.C) {
+	name := "TestPouchUpgradeWithPatch"
+
+	command.PouchRun("run", "-d", "-e", "A=1", "-e", "B=2", "-l", "tier=frontend",
+		"--name", name, busyboxImage, "top").Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, name)
+
+	command.PouchRun("upgrade", "-e", "A=10", "-e", "B", "-l", "tier=", "-l", "version=v2", name).Assert(c, icmd.Success)
+
+	out := command.PouchRun("exec", name, "env").Stdout()
+	if !strings.Contains(out, "A=10") || strings.Contains(out, "B=2") {
+		c.Errorf("expected env to be patched, got %s", out)
+	}
+
+	labels, err := inspectFilter(name, ".Config.Labels")
+	c.Assert(err, check.IsNil)
+	if !strings.Contains(labels, "version:v2") || strings.Contains(labels, "tier") {
+		c.Errorf("expected labels to be patched, got %s", labels)
+	}
+
+	command.PouchRun("upgrade", "--rollback", name).Assert(c, icmd.Success)
+	out = command.PouchRun("exec", name, "env").Stdout()
+	if !strings.Contains(out, "B=2") {
+		c.Errorf("expected env to be restored, got %s", out)
+	}
+}
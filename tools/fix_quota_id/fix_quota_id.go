@@ -17,7 +17,7 @@ var (
 )
 
 func run(cmd *cobra.Command) error {
-	err := quota.SetDiskQuota(dir, size, quotaID)
+	err := quota.SetDiskQuota(dir, size, quota.InodeLimit{}, quotaID)
 	if err != nil {
 		log.With(nil).Errorf("failed to set subtree for %s, quota id: %d, err: %v", dir, quotaID, err)
 		return err